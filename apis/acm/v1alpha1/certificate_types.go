@@ -22,6 +22,10 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// ResourceCredentialsSecretARNKey is the key inside a connection secret for
+// the ARN of the certificate.
+const ResourceCredentialsSecretARNKey = "arn"
+
 // Tag represents user-provided metadata that can be associated
 type Tag struct {
 
@@ -43,6 +47,29 @@ type DomainValidationOption struct {
 	ValidationDomain string `json:"validationDomain"`
 }
 
+// DomainValidationRecord is the DNS record that has to be created to prove
+// ownership of a domain of the certificate.
+type DomainValidationRecord struct {
+	// DomainName is the domain that is validated with this record.
+	DomainName string `json:"domainName"`
+
+	// ValidationStatus is the validation status of the domain.
+	// +optional
+	ValidationStatus string `json:"validationStatus,omitempty"`
+
+	// Name of the DNS record to create.
+	// +optional
+	ResourceRecordName string `json:"resourceRecordName,omitempty"`
+
+	// Type of the DNS record to create, currently always CNAME.
+	// +optional
+	ResourceRecordType string `json:"resourceRecordType,omitempty"`
+
+	// Value of the DNS record to create.
+	// +optional
+	ResourceRecordValue string `json:"resourceRecordValue,omitempty"`
+}
+
 // CertificateSpec defines the desired state of Certificate
 type CertificateSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
@@ -65,6 +92,12 @@ type CertificateExternalStatus struct {
 	// Type of the certificate
 	// +kubebuilder:validation:Enum=IMPORTED;AMAZON_ISSUED;PRIVATE
 	Type acm.CertificateType `json:"type,omitempty"`
+
+	// DomainValidationRecords are the DNS records that have to be created,
+	// e.g. in Route53, for the certificate to be issued when DNS validation
+	// is used.
+	// +optional
+	DomainValidationRecords []DomainValidationRecord `json:"domainValidationRecords,omitempty"`
 }
 
 // An CertificateStatus represents the observed state of an Certificate manager.
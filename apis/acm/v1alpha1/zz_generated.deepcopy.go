@@ -56,6 +56,11 @@ func (in *Certificate) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateExternalStatus) DeepCopyInto(out *CertificateExternalStatus) {
 	*out = *in
+	if in.DomainValidationRecords != nil {
+		in, out := &in.DomainValidationRecords, &out.DomainValidationRecords
+		*out = make([]DomainValidationRecord, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateExternalStatus.
@@ -193,7 +198,7 @@ func (in *CertificateSpec) DeepCopy() *CertificateSpec {
 func (in *CertificateStatus) DeepCopyInto(out *CertificateStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateStatus.
@@ -221,6 +226,21 @@ func (in *DomainValidationOption) DeepCopy() *DomainValidationOption {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainValidationRecord) DeepCopyInto(out *DomainValidationRecord) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainValidationRecord.
+func (in *DomainValidationRecord) DeepCopy() *DomainValidationRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainValidationRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tag) DeepCopyInto(out *Tag) {
 	*out = *in
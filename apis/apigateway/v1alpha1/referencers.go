@@ -0,0 +1,49 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	lambda "github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+)
+
+// ResolveReferences of this RestAPI
+func (mg *RestAPI) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.lambdaFunctionArn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.LambdaFunctionARN),
+		Reference:    mg.Spec.ForProvider.LambdaFunctionARNRef,
+		Selector:     mg.Spec.ForProvider.LambdaFunctionARNSelector,
+		To:           reference.To{Managed: &lambda.Function{}, List: &lambda.FunctionList{}},
+		Extract:      lambda.FunctionARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.lambdaFunctionArn")
+	}
+	mg.Spec.ForProvider.LambdaFunctionARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.LambdaFunctionARNRef = rsp.ResolvedReference
+
+	return nil
+}
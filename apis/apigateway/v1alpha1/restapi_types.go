@@ -0,0 +1,140 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ResourceCredentialsSecretInvokeURLKeyPrefix is the prefix of the keys in
+// the connection secret for the invoke URLs of the stages of a RestAPI. The
+// key of a stage is the prefix followed by the name of the stage, e.g.
+// invokeUrl.prod.
+const ResourceCredentialsSecretInvokeURLKeyPrefix = "invokeUrl."
+
+// RestAPIParameters define the desired state of an AWS API Gateway REST API.
+type RestAPIParameters struct {
+	// Region is the region you'd like your RestAPI to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// The name of the RestAPI.
+	Name string `json:"name"`
+
+	// A description of the RestAPI.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// The endpoint type of the RestAPI. Defaults to EDGE.
+	// +optional
+	// +kubebuilder:validation:Enum=EDGE;REGIONAL;PRIVATE
+	EndpointType *string `json:"endpointType,omitempty"`
+
+	// An OpenAPI definition, in JSON or YAML, of the resources, methods and
+	// integrations of the RestAPI. It overwrites the existing definition
+	// whenever it changes.
+	// +optional
+	Body *string `json:"body,omitempty"`
+
+	// The ARN of a Lambda function the RestAPI proxies all requests to. It
+	// is used to generate the definition of the RestAPI when no body is
+	// given. The function has to allow API Gateway to invoke it.
+	// +optional
+	LambdaFunctionARN *string `json:"lambdaFunctionArn,omitempty"`
+
+	// LambdaFunctionARNRef is a reference to a Function used to set the
+	// LambdaFunctionARN.
+	// +optional
+	LambdaFunctionARNRef *xpv1.Reference `json:"lambdaFunctionArnRef,omitempty"`
+
+	// LambdaFunctionARNSelector selects a reference to a Function used to
+	// set the LambdaFunctionARN.
+	// +optional
+	LambdaFunctionARNSelector *xpv1.Selector `json:"lambdaFunctionArnSelector,omitempty"`
+
+	// The names of the stages the RestAPI is deployed to. Each change of
+	// the definition is deployed to all of them. Stages that are not listed
+	// are left alone.
+	// +optional
+	StageNames []string `json:"stageNames,omitempty"`
+}
+
+// A RestAPISpec defines the desired state of a RestAPI.
+type RestAPISpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RestAPIParameters `json:"forProvider"`
+}
+
+// StageObservation is the observed state of a stage of a RestAPI.
+type StageObservation struct {
+	// The name of the stage.
+	Name string `json:"name"`
+
+	// The ID of the deployment the stage points to.
+	DeploymentID string `json:"deploymentId,omitempty"`
+
+	// The URL to invoke the RestAPI at this stage.
+	InvokeURL string `json:"invokeUrl,omitempty"`
+}
+
+// RestAPIObservation keeps the state for the external resource
+type RestAPIObservation struct {
+	// The ID of the RestAPI.
+	ID string `json:"id,omitempty"`
+
+	// The SHA-256 checksum of the last definition that was put to the
+	// RestAPI.
+	BodySHA256 string `json:"bodySha256,omitempty"`
+
+	// The stages of the RestAPI.
+	Stages []StageObservation `json:"stages,omitempty"`
+}
+
+// A RestAPIStatus represents the observed state of a RestAPI.
+type RestAPIStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RestAPIObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RestAPI is a managed resource that represents an AWS API Gateway REST
+// API.
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type RestAPI struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RestAPISpec   `json:"spec"`
+	Status RestAPIStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RestAPIList contains a list of RestAPIs
+type RestAPIList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RestAPI `json:"items"`
+}
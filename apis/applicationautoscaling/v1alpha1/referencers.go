@@ -0,0 +1,94 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dynamodb "github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
+)
+
+// DynamoDBTableResourceID returns the resource ID of a DynamoDB Table, i.e.
+// table/<name>.
+func DynamoDBTableResourceID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		if _, ok := mg.(*dynamodb.Table); !ok {
+			return ""
+		}
+		return "table/" + meta.GetExternalName(mg)
+	}
+}
+
+// ScalableTargetResourceID returns the spec.forProvider.resourceId of a
+// ScalableTarget.
+func ScalableTargetResourceID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*ScalableTarget)
+		if !ok {
+			return ""
+		}
+		return reference.FromPtrValue(r.Spec.ForProvider.ResourceID)
+	}
+}
+
+// ResolveReferences of this ScalableTarget
+func (mg *ScalableTarget) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ResourceID),
+		Reference:    mg.Spec.ForProvider.ResourceIDRef,
+		Selector:     mg.Spec.ForProvider.ResourceIDSelector,
+		To:           reference.To{Managed: &dynamodb.Table{}, List: &dynamodb.TableList{}},
+		Extract:      DynamoDBTableResourceID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceId")
+	}
+	mg.Spec.ForProvider.ResourceID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ResourceIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this ScalingPolicy
+func (mg *ScalingPolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ResourceID),
+		Reference:    mg.Spec.ForProvider.ResourceIDRef,
+		Selector:     mg.Spec.ForProvider.ResourceIDSelector,
+		To:           reference.To{Managed: &ScalableTarget{}, List: &ScalableTargetList{}},
+		Extract:      ScalableTargetResourceID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceId")
+	}
+	mg.Spec.ForProvider.ResourceID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ResourceIDRef = rsp.ResolvedReference
+
+	return nil
+}
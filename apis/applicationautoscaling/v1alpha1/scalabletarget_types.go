@@ -0,0 +1,119 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ScalableTargetParameters define the desired state of an AWS Application Auto
+// Scaling scalable target. A scalable target is identified by its service
+// namespace, resource ID and scalable dimension.
+type ScalableTargetParameters struct {
+	// Region is the region you'd like your ScalableTarget to be created in.
+	Region string `json:"region"`
+
+	// The namespace of the AWS service that provides the resource, e.g.
+	// dynamodb or ecs.
+	// +immutable
+	// +kubebuilder:validation:Enum=ecs;elasticmapreduce;ec2;appstream;dynamodb;rds;sagemaker;custom-resource;comprehend;lambda;cassandra
+	ServiceNamespace string `json:"serviceNamespace"`
+
+	// The identifier of the resource, e.g. table/my-table for a DynamoDB
+	// table or service/my-cluster/my-service for an ECS service.
+	// +optional
+	// +immutable
+	ResourceID *string `json:"resourceId,omitempty"`
+
+	// ResourceIDRef is a reference to a DynamoDB Table used to set the
+	// ResourceID.
+	// +optional
+	ResourceIDRef *xpv1.Reference `json:"resourceIdRef,omitempty"`
+
+	// ResourceIDSelector selects a reference to a DynamoDB Table used to
+	// set the ResourceID.
+	// +optional
+	ResourceIDSelector *xpv1.Selector `json:"resourceIdSelector,omitempty"`
+
+	// The property of the resource that is scaled, e.g.
+	// dynamodb:table:ReadCapacityUnits or ecs:service:DesiredCount.
+	// +immutable
+	ScalableDimension string `json:"scalableDimension"`
+
+	// The minimum value the scalable dimension is scaled in to.
+	// +kubebuilder:validation:Minimum=0
+	MinCapacity int64 `json:"minCapacity"`
+
+	// The maximum value the scalable dimension is scaled out to.
+	// +kubebuilder:validation:Minimum=0
+	MaxCapacity int64 `json:"maxCapacity"`
+
+	// The ARN of the role that allows Application Auto Scaling to modify
+	// the resource. The service-linked role of the service is used if it is
+	// not given.
+	// +optional
+	RoleARN *string `json:"roleArn,omitempty"`
+}
+
+// A ScalableTargetSpec defines the desired state of a ScalableTarget.
+type ScalableTargetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ScalableTargetParameters `json:"forProvider"`
+}
+
+// ScalableTargetObservation keeps the state for the external resource
+type ScalableTargetObservation struct {
+	// The ARN of the role Application Auto Scaling uses to modify the
+	// resource.
+	RoleARN string `json:"roleArn,omitempty"`
+}
+
+// A ScalableTargetStatus represents the observed state of a ScalableTarget.
+type ScalableTargetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ScalableTargetObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ScalableTarget is a managed resource that represents an AWS Application
+// Auto Scaling scalable target.
+// +kubebuilder:printcolumn:name="RESOURCE",type="string",JSONPath=".spec.forProvider.resourceId"
+// +kubebuilder:printcolumn:name="DIMENSION",type="string",JSONPath=".spec.forProvider.scalableDimension"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ScalableTarget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ScalableTargetSpec   `json:"spec"`
+	Status ScalableTargetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ScalableTargetList contains a list of ScalableTargets
+type ScalableTargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ScalableTarget `json:"items"`
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// MetricDimension narrows down the metric a customized metric specification
+// tracks.
+type MetricDimension struct {
+	// The name of the dimension.
+	Name string `json:"name"`
+
+	// The value of the dimension.
+	Value string `json:"value"`
+}
+
+// CustomizedMetricSpecification is a CloudWatch metric tracked by a target
+// tracking policy.
+type CustomizedMetricSpecification struct {
+	// The namespace of the metric.
+	Namespace string `json:"namespace"`
+
+	// The name of the metric.
+	MetricName string `json:"metricName"`
+
+	// The dimensions of the metric.
+	// +optional
+	Dimensions []MetricDimension `json:"dimensions,omitempty"`
+
+	// The statistic of the metric.
+	// +kubebuilder:validation:Enum=Average;Minimum;Maximum;SampleCount;Sum
+	Statistic string `json:"statistic"`
+
+	// The unit of the metric.
+	// +optional
+	Unit *string `json:"unit,omitempty"`
+}
+
+// TargetTrackingConfiguration keeps a metric at the target value by scaling
+// the target in and out.
+type TargetTrackingConfiguration struct {
+	// The value the metric is kept at.
+	TargetValue float64 `json:"targetValue"`
+
+	// A metric predefined by Application Auto Scaling, e.g.
+	// DynamoDBReadCapacityUtilization or ECSServiceAverageCPUUtilization.
+	// Either this or CustomizedMetricSpecification has to be given.
+	// +optional
+	PredefinedMetricType *string `json:"predefinedMetricType,omitempty"`
+
+	// Identifies the resource of the predefined metric. Only needed for
+	// ALBRequestCountPerTarget.
+	// +optional
+	ResourceLabel *string `json:"resourceLabel,omitempty"`
+
+	// A CloudWatch metric to track instead of a predefined metric.
+	// +optional
+	CustomizedMetricSpecification *CustomizedMetricSpecification `json:"customizedMetricSpecification,omitempty"`
+
+	// The time in seconds after a scale in activity before another scale in
+	// activity can start.
+	// +optional
+	ScaleInCooldown *int64 `json:"scaleInCooldown,omitempty"`
+
+	// The time in seconds after a scale out activity before another scale
+	// out activity can start.
+	// +optional
+	ScaleOutCooldown *int64 `json:"scaleOutCooldown,omitempty"`
+
+	// Whether the policy only scales out. Defaults to false.
+	// +optional
+	DisableScaleIn *bool `json:"disableScaleIn,omitempty"`
+}
+
+// ScalingPolicyParameters define the desired state of an AWS Application Auto
+// Scaling target tracking scaling policy.
+type ScalingPolicyParameters struct {
+	// Region is the region you'd like your ScalingPolicy to be created in.
+	Region string `json:"region"`
+
+	// The namespace of the AWS service that provides the scaled resource.
+	// +immutable
+	// +kubebuilder:validation:Enum=ecs;elasticmapreduce;ec2;appstream;dynamodb;rds;sagemaker;custom-resource;comprehend;lambda;cassandra
+	ServiceNamespace string `json:"serviceNamespace"`
+
+	// The identifier of the scaled resource. The resource has to be
+	// registered as a scalable target.
+	// +optional
+	// +immutable
+	ResourceID *string `json:"resourceId,omitempty"`
+
+	// ResourceIDRef is a reference to a ScalableTarget used to set the
+	// ResourceID.
+	// +optional
+	ResourceIDRef *xpv1.Reference `json:"resourceIdRef,omitempty"`
+
+	// ResourceIDSelector selects a reference to a ScalableTarget used to set
+	// the ResourceID.
+	// +optional
+	ResourceIDSelector *xpv1.Selector `json:"resourceIdSelector,omitempty"`
+
+	// The scaled property of the resource.
+	// +immutable
+	ScalableDimension string `json:"scalableDimension"`
+
+	// The target tracking configuration of the policy.
+	TargetTrackingConfiguration TargetTrackingConfiguration `json:"targetTrackingConfiguration"`
+}
+
+// A ScalingPolicySpec defines the desired state of a ScalingPolicy.
+type ScalingPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ScalingPolicyParameters `json:"forProvider"`
+}
+
+// ScalingPolicyObservation keeps the state for the external resource
+type ScalingPolicyObservation struct {
+	// The ARN of the policy.
+	PolicyARN string `json:"policyArn,omitempty"`
+
+	// The names of the CloudWatch alarms created for the policy.
+	Alarms []string `json:"alarms,omitempty"`
+}
+
+// A ScalingPolicyStatus represents the observed state of a ScalingPolicy.
+type ScalingPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ScalingPolicyObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ScalingPolicy is a managed resource that represents an AWS Application
+// Auto Scaling target tracking scaling policy.
+// +kubebuilder:printcolumn:name="RESOURCE",type="string",JSONPath=".spec.forProvider.resourceId"
+// +kubebuilder:printcolumn:name="TARGET",type="number",JSONPath=".spec.forProvider.targetTrackingConfiguration.targetValue"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ScalingPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ScalingPolicySpec   `json:"spec"`
+	Status ScalingPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ScalingPolicyList contains a list of ScalingPolicies
+type ScalingPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ScalingPolicy `json:"items"`
+}
@@ -22,21 +22,33 @@ import (
 
 	acmv1alpha1 "github.com/crossplane/provider-aws/apis/acm/v1alpha1"
 	acmpcav1alpha1 "github.com/crossplane/provider-aws/apis/acmpca/v1alpha1"
+	apigatewayv1alpha1 "github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
 	apigatewayv2 "github.com/crossplane/provider-aws/apis/apigatewayv2/v1alpha1"
+	applicationautoscalingv1alpha1 "github.com/crossplane/provider-aws/apis/applicationautoscaling/v1alpha1"
+	batchv1alpha1 "github.com/crossplane/provider-aws/apis/batch/v1alpha1"
 	cachev1alpha1 "github.com/crossplane/provider-aws/apis/cache/v1alpha1"
 	cachev1beta1 "github.com/crossplane/provider-aws/apis/cache/v1beta1"
+	cloudfrontv1alpha1 "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	cloudwatchv1alpha1 "github.com/crossplane/provider-aws/apis/cloudwatch/v1alpha1"
+	cloudwatchlogsv1alpha1 "github.com/crossplane/provider-aws/apis/cloudwatchlogs/v1alpha1"
+	databasev1alpha1 "github.com/crossplane/provider-aws/apis/database/v1alpha1"
 	databasev1beta1 "github.com/crossplane/provider-aws/apis/database/v1beta1"
 	dynamodbv1alpha1 "github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
 	ec2v1alpha1 "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
 	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	ecrv1alpha1 "github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
+	ecsv1alpha1 "github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
 	efsv1alpha1 "github.com/crossplane/provider-aws/apis/efs/v1alpha1"
 	eksv1alpha1 "github.com/crossplane/provider-aws/apis/eks/v1alpha1"
 	eksv1beta1 "github.com/crossplane/provider-aws/apis/eks/v1beta1"
 	elasticloadbalancingv1alpha1 "github.com/crossplane/provider-aws/apis/elasticloadbalancing/v1alpha1"
+	elasticsearchv1alpha1 "github.com/crossplane/provider-aws/apis/elasticsearch/v1alpha1"
+	gluev1alpha1 "github.com/crossplane/provider-aws/apis/glue/v1alpha1"
 	identityv1alpha1 "github.com/crossplane/provider-aws/apis/identity/v1alpha1"
 	identityv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	kinesisv1alpha1 "github.com/crossplane/provider-aws/apis/kinesis/v1alpha1"
 	kmsv1alpha1 "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+	lambdav1alpha1 "github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
 	notificationv1alpha3 "github.com/crossplane/provider-aws/apis/notification/v1alpha1"
 	rdsv1alpha1 "github.com/crossplane/provider-aws/apis/rds/v1alpha1"
 	redshiftv1alpha1 "github.com/crossplane/provider-aws/apis/redshift/v1alpha1"
@@ -46,8 +58,11 @@ import (
 	secretsmanagerv1alpha1 "github.com/crossplane/provider-aws/apis/secretsmanager/v1alpha1"
 	sfnv1alpha1 "github.com/crossplane/provider-aws/apis/sfn/v1alpha1"
 	sqsv1beta1 "github.com/crossplane/provider-aws/apis/sqs/v1beta1"
+	ssmv1alpha1 "github.com/crossplane/provider-aws/apis/ssm/v1alpha1"
+	transferv1alpha1 "github.com/crossplane/provider-aws/apis/transfer/v1alpha1"
 	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
 	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+	wafv2v1alpha1 "github.com/crossplane/provider-aws/apis/wafv2/v1alpha1"
 )
 
 func init() {
@@ -81,6 +96,21 @@ func init() {
 		efsv1alpha1.SchemeBuilder.AddToScheme,
 		rdsv1alpha1.SchemeBuilder.AddToScheme,
 		ec2v1alpha1.SchemeBuilder.AddToScheme,
+		elasticsearchv1alpha1.SchemeBuilder.AddToScheme,
+		lambdav1alpha1.SchemeBuilder.AddToScheme,
+		cloudwatchv1alpha1.SchemeBuilder.AddToScheme,
+		kinesisv1alpha1.SchemeBuilder.AddToScheme,
+		databasev1alpha1.SchemeBuilder.AddToScheme,
+		wafv2v1alpha1.SchemeBuilder.AddToScheme,
+		apigatewayv1alpha1.SchemeBuilder.AddToScheme,
+		cloudfrontv1alpha1.SchemeBuilder.AddToScheme,
+		applicationautoscalingv1alpha1.SchemeBuilder.AddToScheme,
+		ssmv1alpha1.SchemeBuilder.AddToScheme,
+		gluev1alpha1.SchemeBuilder.AddToScheme,
+		cloudwatchlogsv1alpha1.SchemeBuilder.AddToScheme,
+		ecsv1alpha1.SchemeBuilder.AddToScheme,
+		transferv1alpha1.SchemeBuilder.AddToScheme,
+		batchv1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
 
@@ -0,0 +1,234 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// States and statuses of compute environments and job queues.
+const (
+	StateEnabled  = "ENABLED"
+	StateDisabled = "DISABLED"
+
+	StatusCreating = "CREATING"
+	StatusUpdating = "UPDATING"
+	StatusDeleting = "DELETING"
+	StatusDeleted  = "DELETED"
+	StatusValid    = "VALID"
+	StatusInvalid  = "INVALID"
+)
+
+// ComputeResource defines the infrastructure that AWS Batch provisions for a
+// managed compute environment.
+type ComputeResource struct {
+	// The type of the compute resources. EC2 and SPOT launch Amazon EC2
+	// instances, FARGATE and FARGATE_SPOT run jobs on AWS Fargate.
+	// +kubebuilder:validation:Enum=EC2;SPOT;FARGATE;FARGATE_SPOT
+	// +immutable
+	Type string `json:"type"`
+
+	// The strategy used to pick instance types when Amazon EC2 capacity is
+	// constrained. Not used by Fargate compute resources.
+	// +kubebuilder:validation:Enum=BEST_FIT;BEST_FIT_PROGRESSIVE;SPOT_CAPACITY_OPTIMIZED
+	// +immutable
+	// +optional
+	AllocationStrategy *string `json:"allocationStrategy,omitempty"`
+
+	// The minimum number of vCPUs the compute environment keeps running. Not
+	// used by Fargate compute resources.
+	// +optional
+	MinvCPUs *int64 `json:"minvCpus,omitempty"`
+
+	// The maximum number of vCPUs the compute environment can scale out to.
+	MaxvCPUs int64 `json:"maxvCpus"`
+
+	// The number of vCPUs the compute environment should run. Not used by
+	// Fargate compute resources.
+	// +optional
+	DesiredvCPUs *int64 `json:"desiredvCpus,omitempty"`
+
+	// The instance types that may be launched, for example c5.large, or
+	// optimal to pick from the C4, M4 and R4 families. Required for EC2 and
+	// SPOT compute resources.
+	// +immutable
+	// +optional
+	InstanceTypes []string `json:"instanceTypes,omitempty"`
+
+	// The name or ARN of the instance profile that is attached to the Amazon
+	// EC2 instances. Required for EC2 and SPOT compute resources.
+	// +immutable
+	// +optional
+	InstanceRole *string `json:"instanceRole,omitempty"`
+
+	// The name of the EC2 key pair used for the instances.
+	// +immutable
+	// +optional
+	EC2KeyPair *string `json:"ec2KeyPair,omitempty"`
+
+	// The maximum percentage of the On-Demand price a Spot instance may cost.
+	// Only used by SPOT compute resources.
+	// +immutable
+	// +optional
+	BidPercentage *int64 `json:"bidPercentage,omitempty"`
+
+	// The ARN of the IAM role applied to the Spot Fleet. Only used by SPOT
+	// compute resources that use the BEST_FIT allocation strategy.
+	// +immutable
+	// +optional
+	SpotIAMFleetRole *string `json:"spotIamFleetRole,omitempty"`
+
+	// The IDs of the subnets to launch the compute resources in.
+	// +optional
+	Subnets []string `json:"subnets,omitempty"`
+
+	// SubnetRefs references Subnets to retrieve their IDs.
+	// +optional
+	SubnetRefs []xpv1.Reference `json:"subnetRefs,omitempty"`
+
+	// SubnetSelector selects references to Subnets to retrieve their IDs.
+	// +optional
+	SubnetSelector *xpv1.Selector `json:"subnetSelector,omitempty"`
+
+	// The IDs of the security groups that are attached to the compute
+	// resources.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs references SecurityGroups to retrieve their IDs.
+	// +optional
+	SecurityGroupIDRefs []xpv1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups to
+	// retrieve their IDs.
+	// +optional
+	SecurityGroupIDSelector *xpv1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// Tags applied to the instances launched for the compute environment.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// ComputeEnvironmentParameters define the desired state of an AWS Batch
+// compute environment.
+type ComputeEnvironmentParameters struct {
+	// Region is the region you'd like your ComputeEnvironment to be created
+	// in.
+	Region string `json:"region"`
+
+	// The type of the compute environment. AWS Batch provisions and scales
+	// the instances of a MANAGED compute environment, while those of an
+	// UNMANAGED one are provided by you.
+	// +kubebuilder:validation:Enum=MANAGED;UNMANAGED
+	// +immutable
+	Type string `json:"type"`
+
+	// Whether the compute environment accepts jobs from its job queues. A
+	// compute environment is enabled if none is given.
+	// +kubebuilder:validation:Enum=ENABLED;DISABLED
+	// +optional
+	State *string `json:"state,omitempty"`
+
+	// The ARN of the IAM role that allows AWS Batch to call other AWS
+	// services on your behalf.
+	// +optional
+	ServiceRole *string `json:"serviceRole,omitempty"`
+
+	// ServiceRoleRef references an IAMRole to retrieve its ARN.
+	// +optional
+	ServiceRoleRef *xpv1.Reference `json:"serviceRoleRef,omitempty"`
+
+	// ServiceRoleSelector selects a reference to an IAMRole to retrieve its
+	// ARN.
+	// +optional
+	ServiceRoleSelector *xpv1.Selector `json:"serviceRoleSelector,omitempty"`
+
+	// ComputeResources defines the infrastructure of a MANAGED compute
+	// environment.
+	// +optional
+	ComputeResources *ComputeResource `json:"computeResources,omitempty"`
+
+	// Tags to assign to the compute environment.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A ComputeEnvironmentSpec defines the desired state of a
+// ComputeEnvironment.
+type ComputeEnvironmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ComputeEnvironmentParameters `json:"forProvider"`
+}
+
+// ComputeEnvironmentObservation keeps the state for the external resource
+type ComputeEnvironmentObservation struct {
+	// The ARN of the compute environment.
+	ARN string `json:"arn,omitempty"`
+
+	// The ARN of the Amazon ECS cluster used by the compute environment.
+	ECSClusterARN string `json:"ecsClusterArn,omitempty"`
+
+	// The state of the compute environment.
+	State string `json:"state,omitempty"`
+
+	// The status of the compute environment.
+	Status string `json:"status,omitempty"`
+
+	// A short description of the status of the compute environment.
+	StatusReason string `json:"statusReason,omitempty"`
+}
+
+// A ComputeEnvironmentStatus represents the observed state of a
+// ComputeEnvironment.
+type ComputeEnvironmentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ComputeEnvironmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ComputeEnvironment is a managed resource that represents an AWS Batch
+// compute environment. The external name of the compute environment is its
+// name. It is disabled before it is deleted.
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ComputeEnvironment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ComputeEnvironmentSpec   `json:"spec"`
+	Status ComputeEnvironmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ComputeEnvironmentList contains a list of ComputeEnvironments
+type ComputeEnvironmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ComputeEnvironment `json:"items"`
+}
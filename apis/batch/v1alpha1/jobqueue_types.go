@@ -0,0 +1,129 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ComputeEnvironmentOrder places a compute environment in the order in which
+// a job queue tries to schedule its jobs.
+type ComputeEnvironmentOrder struct {
+	// The order of the compute environment. Compute environments with a
+	// lower order are tried first.
+	Order int64 `json:"order"`
+
+	// The name or ARN of the compute environment.
+	// +optional
+	ComputeEnvironment *string `json:"computeEnvironment,omitempty"`
+
+	// ComputeEnvironmentRef references a ComputeEnvironment to retrieve its
+	// ARN.
+	// +optional
+	ComputeEnvironmentRef *xpv1.Reference `json:"computeEnvironmentRef,omitempty"`
+
+	// ComputeEnvironmentSelector selects a reference to a ComputeEnvironment
+	// to retrieve its ARN.
+	// +optional
+	ComputeEnvironmentSelector *xpv1.Selector `json:"computeEnvironmentSelector,omitempty"`
+}
+
+// JobQueueParameters define the desired state of an AWS Batch job queue.
+type JobQueueParameters struct {
+	// Region is the region you'd like your JobQueue to be created in.
+	Region string `json:"region"`
+
+	// The priority of the job queue. Job queues with a higher priority are
+	// evaluated first when they share a compute environment.
+	Priority int64 `json:"priority"`
+
+	// Whether the job queue accepts new jobs. A job queue is enabled if none
+	// is given.
+	// +kubebuilder:validation:Enum=ENABLED;DISABLED
+	// +optional
+	State *string `json:"state,omitempty"`
+
+	// The compute environments that run the jobs of the queue, at most three.
+	// All of them must be either Amazon EC2 or Fargate compute environments.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=3
+	ComputeEnvironmentOrder []ComputeEnvironmentOrder `json:"computeEnvironmentOrder"`
+
+	// Tags to assign to the job queue.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A JobQueueSpec defines the desired state of a JobQueue.
+type JobQueueSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       JobQueueParameters `json:"forProvider"`
+}
+
+// JobQueueObservation keeps the state for the external resource
+type JobQueueObservation struct {
+	// The ARN of the job queue.
+	ARN string `json:"arn,omitempty"`
+
+	// The state of the job queue.
+	State string `json:"state,omitempty"`
+
+	// The status of the job queue.
+	Status string `json:"status,omitempty"`
+
+	// A short description of the status of the job queue.
+	StatusReason string `json:"statusReason,omitempty"`
+}
+
+// A JobQueueStatus represents the observed state of a JobQueue.
+type JobQueueStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          JobQueueObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A JobQueue is a managed resource that represents an AWS Batch job queue.
+// The external name of the job queue is its name. It is disabled before it is
+// deleted.
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type JobQueue struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   JobQueueSpec   `json:"spec"`
+	Status JobQueueStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// JobQueueList contains a list of JobQueues
+type JobQueueList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []JobQueue `json:"items"`
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	ec2 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	iam "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+)
+
+// ComputeEnvironmentARN returns the status.atProvider.arn of a
+// ComputeEnvironment.
+func ComputeEnvironmentARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*ComputeEnvironment)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.ARN
+	}
+}
+
+// ResolveReferences of this ComputeEnvironment
+func (mg *ComputeEnvironment) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.serviceRole
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ServiceRole),
+		Reference:    mg.Spec.ForProvider.ServiceRoleRef,
+		Selector:     mg.Spec.ForProvider.ServiceRoleSelector,
+		To:           reference.To{Managed: &iam.IAMRole{}, List: &iam.IAMRoleList{}},
+		Extract:      iam.IAMRoleARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.serviceRole")
+	}
+	mg.Spec.ForProvider.ServiceRole = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ServiceRoleRef = rsp.ResolvedReference
+
+	cr := mg.Spec.ForProvider.ComputeResources
+	if cr == nil {
+		return nil
+	}
+
+	// Resolve spec.forProvider.computeResources.subnets
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: cr.Subnets,
+		References:    cr.SubnetRefs,
+		Selector:      cr.SubnetSelector,
+		To:            reference.To{Managed: &ec2.Subnet{}, List: &ec2.SubnetList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.computeResources.subnets")
+	}
+	cr.Subnets = mrsp.ResolvedValues
+	cr.SubnetRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.computeResources.securityGroupIds
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: cr.SecurityGroupIDs,
+		References:    cr.SecurityGroupIDRefs,
+		Selector:      cr.SecurityGroupIDSelector,
+		To:            reference.To{Managed: &ec2.SecurityGroup{}, List: &ec2.SecurityGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.computeResources.securityGroupIds")
+	}
+	cr.SecurityGroupIDs = mrsp.ResolvedValues
+	cr.SecurityGroupIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this JobQueue
+func (mg *JobQueue) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.computeEnvironmentOrder[].computeEnvironment
+	for i := range mg.Spec.ForProvider.ComputeEnvironmentOrder {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ComputeEnvironmentOrder[i].ComputeEnvironment),
+			Reference:    mg.Spec.ForProvider.ComputeEnvironmentOrder[i].ComputeEnvironmentRef,
+			Selector:     mg.Spec.ForProvider.ComputeEnvironmentOrder[i].ComputeEnvironmentSelector,
+			To:           reference.To{Managed: &ComputeEnvironment{}, List: &ComputeEnvironmentList{}},
+			Extract:      ComputeEnvironmentARN(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.computeEnvironmentOrder[%d].computeEnvironment", i)
+		}
+		mg.Spec.ForProvider.ComputeEnvironmentOrder[i].ComputeEnvironment = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.ComputeEnvironmentOrder[i].ComputeEnvironmentRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
@@ -0,0 +1,445 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeEnvironment) DeepCopyInto(out *ComputeEnvironment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeEnvironment.
+func (in *ComputeEnvironment) DeepCopy() *ComputeEnvironment {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeEnvironment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComputeEnvironment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeEnvironmentList) DeepCopyInto(out *ComputeEnvironmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ComputeEnvironment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeEnvironmentList.
+func (in *ComputeEnvironmentList) DeepCopy() *ComputeEnvironmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeEnvironmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComputeEnvironmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeEnvironmentObservation) DeepCopyInto(out *ComputeEnvironmentObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeEnvironmentObservation.
+func (in *ComputeEnvironmentObservation) DeepCopy() *ComputeEnvironmentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeEnvironmentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeEnvironmentOrder) DeepCopyInto(out *ComputeEnvironmentOrder) {
+	*out = *in
+	if in.ComputeEnvironment != nil {
+		in, out := &in.ComputeEnvironment, &out.ComputeEnvironment
+		*out = new(string)
+		**out = **in
+	}
+	if in.ComputeEnvironmentRef != nil {
+		in, out := &in.ComputeEnvironmentRef, &out.ComputeEnvironmentRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ComputeEnvironmentSelector != nil {
+		in, out := &in.ComputeEnvironmentSelector, &out.ComputeEnvironmentSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeEnvironmentOrder.
+func (in *ComputeEnvironmentOrder) DeepCopy() *ComputeEnvironmentOrder {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeEnvironmentOrder)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeEnvironmentParameters) DeepCopyInto(out *ComputeEnvironmentParameters) {
+	*out = *in
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceRole != nil {
+		in, out := &in.ServiceRole, &out.ServiceRole
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceRoleRef != nil {
+		in, out := &in.ServiceRoleRef, &out.ServiceRoleRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ServiceRoleSelector != nil {
+		in, out := &in.ServiceRoleSelector, &out.ServiceRoleSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ComputeResources != nil {
+		in, out := &in.ComputeResources, &out.ComputeResources
+		*out = new(ComputeResource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeEnvironmentParameters.
+func (in *ComputeEnvironmentParameters) DeepCopy() *ComputeEnvironmentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeEnvironmentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeEnvironmentSpec) DeepCopyInto(out *ComputeEnvironmentSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeEnvironmentSpec.
+func (in *ComputeEnvironmentSpec) DeepCopy() *ComputeEnvironmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeEnvironmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeEnvironmentStatus) DeepCopyInto(out *ComputeEnvironmentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeEnvironmentStatus.
+func (in *ComputeEnvironmentStatus) DeepCopy() *ComputeEnvironmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeEnvironmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeResource) DeepCopyInto(out *ComputeResource) {
+	*out = *in
+	if in.AllocationStrategy != nil {
+		in, out := &in.AllocationStrategy, &out.AllocationStrategy
+		*out = new(string)
+		**out = **in
+	}
+	if in.MinvCPUs != nil {
+		in, out := &in.MinvCPUs, &out.MinvCPUs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DesiredvCPUs != nil {
+		in, out := &in.DesiredvCPUs, &out.DesiredvCPUs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.InstanceTypes != nil {
+		in, out := &in.InstanceTypes, &out.InstanceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InstanceRole != nil {
+		in, out := &in.InstanceRole, &out.InstanceRole
+		*out = new(string)
+		**out = **in
+	}
+	if in.EC2KeyPair != nil {
+		in, out := &in.EC2KeyPair, &out.EC2KeyPair
+		*out = new(string)
+		**out = **in
+	}
+	if in.BidPercentage != nil {
+		in, out := &in.BidPercentage, &out.BidPercentage
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SpotIAMFleetRole != nil {
+		in, out := &in.SpotIAMFleetRole, &out.SpotIAMFleetRole
+		*out = new(string)
+		**out = **in
+	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetRefs != nil {
+		in, out := &in.SubnetRefs, &out.SubnetRefs
+		*out = make([]v1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetSelector != nil {
+		in, out := &in.SubnetSelector, &out.SubnetSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupIDRefs != nil {
+		in, out := &in.SecurityGroupIDRefs, &out.SecurityGroupIDRefs
+		*out = make([]v1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupIDSelector != nil {
+		in, out := &in.SecurityGroupIDSelector, &out.SecurityGroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeResource.
+func (in *ComputeResource) DeepCopy() *ComputeResource {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobQueue) DeepCopyInto(out *JobQueue) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobQueue.
+func (in *JobQueue) DeepCopy() *JobQueue {
+	if in == nil {
+		return nil
+	}
+	out := new(JobQueue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JobQueue) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobQueueList) DeepCopyInto(out *JobQueueList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]JobQueue, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobQueueList.
+func (in *JobQueueList) DeepCopy() *JobQueueList {
+	if in == nil {
+		return nil
+	}
+	out := new(JobQueueList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JobQueueList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobQueueObservation) DeepCopyInto(out *JobQueueObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobQueueObservation.
+func (in *JobQueueObservation) DeepCopy() *JobQueueObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(JobQueueObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobQueueParameters) DeepCopyInto(out *JobQueueParameters) {
+	*out = *in
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.ComputeEnvironmentOrder != nil {
+		in, out := &in.ComputeEnvironmentOrder, &out.ComputeEnvironmentOrder
+		*out = make([]ComputeEnvironmentOrder, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobQueueParameters.
+func (in *JobQueueParameters) DeepCopy() *JobQueueParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(JobQueueParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobQueueSpec) DeepCopyInto(out *JobQueueSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobQueueSpec.
+func (in *JobQueueSpec) DeepCopy() *JobQueueSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JobQueueSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobQueueStatus) DeepCopyInto(out *JobQueueStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobQueueStatus.
+func (in *JobQueueStatus) DeepCopy() *JobQueueStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JobQueueStatus)
+	in.DeepCopyInto(out)
+	return out
+}
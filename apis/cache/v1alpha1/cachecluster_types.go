@@ -68,8 +68,9 @@ type CacheNode struct {
 	SourceCacheNodeID *string `json:"sourceCacheNodeId,omitempty"`
 }
 
-// CacheParameterGroupStatus represent status of CacheParameterGroup
-type CacheParameterGroupStatus struct {
+// CacheClusterParameterGroupStatus represent status of the parameter group
+// of a CacheCluster
+type CacheClusterParameterGroupStatus struct {
 
 	// A list of the cache node IDs which need to be rebooted for parameter changes
 	// to be applied.
@@ -140,7 +141,7 @@ type CacheClusterObservation struct {
 	CacheNodes []CacheNode `json:"cacheNodes,omitempty"`
 
 	// Status of the cache parameter group.
-	CacheParameterGroup CacheParameterGroupStatus `json:"cacheParameterGroup,omitempty"`
+	CacheParameterGroup CacheClusterParameterGroupStatus `json:"cacheParameterGroup,omitempty"`
 
 	// The URL of the web page where you can download the latest ElastiCache client
 	// library.
@@ -207,6 +208,15 @@ type CacheClusterParameters struct {
 	// +optional
 	CacheParameterGroupName *string `json:"cacheParameterGroupName,omitempty"`
 
+	// A referencer to retrieve the name of a CacheParameterGroup
+	// +optional
+	CacheParameterGroupNameRef *xpv1.Reference `json:"cacheParameterGroupNameRef,omitempty"`
+
+	// A selector to select a referencer to retrieve the name of a
+	// CacheParameterGroup
+	// +optional
+	CacheParameterGroupNameSelector *xpv1.Selector `json:"cacheParameterGroupNameSelector,omitempty"`
+
 	// A list of security group names to associate with this cluster.
 	// +optional
 	CacheSecurityGroupNames []string `json:"cacheSecurityGroupNames,omitempty"`
@@ -0,0 +1,103 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CacheParameter is a parameter of a cache engine and the value it should
+// have.
+type CacheParameter struct {
+	// The name of the parameter, e.g. maxmemory-policy.
+	Name string `json:"name"`
+
+	// The value of the parameter.
+	Value string `json:"value"`
+}
+
+// CacheParameterGroupParameters define the desired state of an AWS ElastiCache
+// Parameter Group. The name of the group is the external name of the
+// resource.
+type CacheParameterGroupParameters struct {
+	// Region is the region you'd like your CacheParameterGroup to be created in.
+	Region string `json:"region"`
+
+	// The cache engine and version the parameter group can be used with,
+	// e.g. redis6.x or memcached1.6.
+	// +immutable
+	CacheParameterGroupFamily string `json:"cacheParameterGroupFamily"`
+
+	// A description for the cache parameter group.
+	// +immutable
+	Description string `json:"description"`
+
+	// Parameters whose values differ from the engine defaults. Parameters
+	// that are removed from this list are reset to their defaults.
+	// +optional
+	Parameters []CacheParameter `json:"parameters,omitempty"`
+}
+
+// A CacheParameterGroupSpec defines the desired state of a
+// CacheParameterGroup.
+type CacheParameterGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CacheParameterGroupParameters `json:"forProvider"`
+}
+
+// CacheParameterGroupObservation keeps the state for the external resource
+type CacheParameterGroupObservation struct {
+	// The Amazon Resource Name (ARN) of the cache parameter group.
+	ARN string `json:"arn,omitempty"`
+
+	// Whether the parameter group is associated with a Global Datastore.
+	IsGlobal bool `json:"isGlobal,omitempty"`
+}
+
+// A CacheParameterGroupStatus represents the observed state of a
+// CacheParameterGroup.
+type CacheParameterGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CacheParameterGroupObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CacheParameterGroup is a managed resource that represents an AWS
+// ElastiCache Parameter Group.
+// +kubebuilder:printcolumn:name="FAMILY",type="string",JSONPath=".spec.forProvider.cacheParameterGroupFamily"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type CacheParameterGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CacheParameterGroupSpec   `json:"spec"`
+	Status CacheParameterGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CacheParameterGroupList contains a list of CacheParameterGroup
+type CacheParameterGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CacheParameterGroup `json:"items"`
+}
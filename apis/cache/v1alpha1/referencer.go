@@ -65,6 +65,20 @@ func (mg *CacheCluster) ResolveReferences(ctx context.Context, c client.Reader)
 	mg.Spec.ForProvider.CacheSubnetGroupName = aws.String(rsp.ResolvedValue)
 	mg.Spec.ForProvider.CacheSubnetGroupNameRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.cacheParameterGroupName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: aws.StringValue(mg.Spec.ForProvider.CacheParameterGroupName),
+		Reference:    mg.Spec.ForProvider.CacheParameterGroupNameRef,
+		Selector:     mg.Spec.ForProvider.CacheParameterGroupNameSelector,
+		To:           reference.To{Managed: &CacheParameterGroup{}, List: &CacheParameterGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.CacheParameterGroupName = aws.String(rsp.ResolvedValue)
+	mg.Spec.ForProvider.CacheParameterGroupNameRef = rsp.ResolvedReference
+
 	// Resolve spec.forProvider.securityGroupIds
 	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
 		CurrentValues: mg.Spec.ForProvider.SecurityGroupIDs,
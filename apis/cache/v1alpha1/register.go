@@ -53,7 +53,16 @@ var (
 	CacheClusterGroupVersionKind = SchemeGroupVersion.WithKind(CacheClusterKind)
 )
 
+// CacheParameterGroup type metadata.
+var (
+	CacheParameterGroupKind             = reflect.TypeOf(CacheParameterGroup{}).Name()
+	CacheParameterGroupGroupKind        = schema.GroupKind{Group: Group, Kind: CacheParameterGroupKind}.String()
+	CacheParameterGroupKindAPIVersion   = CacheParameterGroupKind + "." + SchemeGroupVersion.String()
+	CacheParameterGroupGroupVersionKind = SchemeGroupVersion.WithKind(CacheParameterGroupKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&CacheCluster{}, &CacheClusterList{})
 	SchemeBuilder.Register(&CacheSubnetGroup{}, &CacheSubnetGroupList{})
+	SchemeBuilder.Register(&CacheParameterGroup{}, &CacheParameterGroupList{})
 }
@@ -110,6 +110,26 @@ func (in *CacheClusterObservation) DeepCopy() *CacheClusterObservation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheClusterParameterGroupStatus) DeepCopyInto(out *CacheClusterParameterGroupStatus) {
+	*out = *in
+	if in.CacheNodeIDsToReboot != nil {
+		in, out := &in.CacheNodeIDsToReboot, &out.CacheNodeIDsToReboot
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheClusterParameterGroupStatus.
+func (in *CacheClusterParameterGroupStatus) DeepCopy() *CacheClusterParameterGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheClusterParameterGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CacheClusterParameters) DeepCopyInto(out *CacheClusterParameters) {
 	*out = *in
@@ -143,6 +163,16 @@ func (in *CacheClusterParameters) DeepCopyInto(out *CacheClusterParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.CacheParameterGroupNameRef != nil {
+		in, out := &in.CacheParameterGroupNameRef, &out.CacheParameterGroupNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.CacheParameterGroupNameSelector != nil {
+		in, out := &in.CacheParameterGroupNameSelector, &out.CacheParameterGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CacheSecurityGroupNames != nil {
 		in, out := &in.CacheSecurityGroupNames, &out.CacheSecurityGroupNames
 		*out = make([]string, len(*in))
@@ -317,15 +347,138 @@ func (in *CacheNode) DeepCopy() *CacheNode {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CacheParameterGroupStatus) DeepCopyInto(out *CacheParameterGroupStatus) {
+func (in *CacheParameter) DeepCopyInto(out *CacheParameter) {
 	*out = *in
-	if in.CacheNodeIDsToReboot != nil {
-		in, out := &in.CacheNodeIDsToReboot, &out.CacheNodeIDsToReboot
-		*out = make([]string, len(*in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheParameter.
+func (in *CacheParameter) DeepCopy() *CacheParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheParameterGroup) DeepCopyInto(out *CacheParameterGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheParameterGroup.
+func (in *CacheParameterGroup) DeepCopy() *CacheParameterGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheParameterGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CacheParameterGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheParameterGroupList) DeepCopyInto(out *CacheParameterGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CacheParameterGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheParameterGroupList.
+func (in *CacheParameterGroupList) DeepCopy() *CacheParameterGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheParameterGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CacheParameterGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheParameterGroupObservation) DeepCopyInto(out *CacheParameterGroupObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheParameterGroupObservation.
+func (in *CacheParameterGroupObservation) DeepCopy() *CacheParameterGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheParameterGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheParameterGroupParameters) DeepCopyInto(out *CacheParameterGroupParameters) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]CacheParameter, len(*in))
 		copy(*out, *in)
 	}
 }
 
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheParameterGroupParameters.
+func (in *CacheParameterGroupParameters) DeepCopy() *CacheParameterGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheParameterGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheParameterGroupSpec) DeepCopyInto(out *CacheParameterGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheParameterGroupSpec.
+func (in *CacheParameterGroupSpec) DeepCopy() *CacheParameterGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheParameterGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheParameterGroupStatus) DeepCopyInto(out *CacheParameterGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheParameterGroupStatus.
 func (in *CacheParameterGroupStatus) DeepCopy() *CacheParameterGroupStatus {
 	if in == nil {
@@ -94,6 +94,11 @@ type NodeGroup struct {
 	// node group (shard).
 	PrimaryEndpoint Endpoint `json:"primaryEndpoint,omitempty"`
 
+	// ReaderEndpoint is the endpoint of the replica nodes in this node group
+	// (shard). It balances read connections across all replicas and is only
+	// applicable on Redis (cluster mode disabled) replication groups.
+	ReaderEndpoint Endpoint `json:"readerEndpoint,omitempty"`
+
 	// Slots is the keyspace for this node group (shard).
 	Slots string `json:"slots,omitempty"`
 
@@ -49,6 +49,7 @@ func (in *NodeGroup) DeepCopyInto(out *NodeGroup) {
 		copy(*out, *in)
 	}
 	out.PrimaryEndpoint = in.PrimaryEndpoint
+	out.ReaderEndpoint = in.ReaderEndpoint
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroup.
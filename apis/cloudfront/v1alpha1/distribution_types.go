@@ -0,0 +1,274 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Connection secret keys of a Distribution.
+const (
+	// ResourceCredentialsSecretDomainNameKey is the name of the key in the
+	// connection secret for the domain name of the Distribution.
+	ResourceCredentialsSecretDomainNameKey = "domainName"
+
+	// ResourceCredentialsSecretIDKey is the name of the key in the
+	// connection secret for the ID of the Distribution.
+	ResourceCredentialsSecretIDKey = "id"
+)
+
+// The status of a Distribution whose configuration has been propagated to all
+// edge locations.
+const DistributionStatusDeployed = "Deployed"
+
+// Origin is a location CloudFront gets the content it serves from. Either
+// S3BucketName or DomainName has to be given.
+type Origin struct {
+	// The unique ID of the origin, used by cache behaviors to target it.
+	ID string `json:"id"`
+
+	// The name of the S3 bucket to serve content from.
+	// +optional
+	S3BucketName *string `json:"s3BucketName,omitempty"`
+
+	// S3BucketNameRef is a reference to a Bucket used to set the
+	// S3BucketName.
+	// +optional
+	S3BucketNameRef *xpv1.Reference `json:"s3BucketNameRef,omitempty"`
+
+	// S3BucketNameSelector selects a reference to a Bucket used to set the
+	// S3BucketName.
+	// +optional
+	S3BucketNameSelector *xpv1.Selector `json:"s3BucketNameSelector,omitempty"`
+
+	// The origin access identity that is allowed to read from the S3
+	// bucket, in the form origin-access-identity/cloudfront/ID. The bucket
+	// has to be publicly readable if it is not given.
+	// +optional
+	OriginAccessIdentity *string `json:"originAccessIdentity,omitempty"`
+
+	// The domain name of a custom origin, such as a load balancer.
+	// +optional
+	DomainName *string `json:"domainName,omitempty"`
+
+	// DomainNameRef is a reference to a LoadBalancer used to set the
+	// DomainName.
+	// +optional
+	DomainNameRef *xpv1.Reference `json:"domainNameRef,omitempty"`
+
+	// DomainNameSelector selects a reference to a LoadBalancer used to set
+	// the DomainName.
+	// +optional
+	DomainNameSelector *xpv1.Selector `json:"domainNameSelector,omitempty"`
+
+	// The protocol CloudFront uses to connect to a custom origin. Defaults
+	// to https-only.
+	// +optional
+	// +kubebuilder:validation:Enum=http-only;match-viewer;https-only
+	OriginProtocolPolicy *string `json:"originProtocolPolicy,omitempty"`
+
+	// The HTTP port of a custom origin. Defaults to 80.
+	// +optional
+	HTTPPort *int64 `json:"httpPort,omitempty"`
+
+	// The HTTPS port of a custom origin. Defaults to 443.
+	// +optional
+	HTTPSPort *int64 `json:"httpsPort,omitempty"`
+
+	// The directory in the origin CloudFront requests the content from,
+	// starting with a slash.
+	// +optional
+	OriginPath *string `json:"originPath,omitempty"`
+}
+
+// DefaultCacheBehavior defines how CloudFront serves the requests that do not
+// match any other cache behavior.
+type DefaultCacheBehavior struct {
+	// The ID of the origin the requests are routed to.
+	TargetOriginID string `json:"targetOriginId"`
+
+	// The protocols viewers can use to access the content.
+	// +kubebuilder:validation:Enum=allow-all;https-only;redirect-to-https
+	ViewerProtocolPolicy string `json:"viewerProtocolPolicy"`
+
+	// The HTTP methods CloudFront forwards to the origin. Defaults to GET
+	// and HEAD.
+	// +optional
+	AllowedMethods []string `json:"allowedMethods,omitempty"`
+
+	// The HTTP methods whose responses CloudFront caches. Defaults to GET
+	// and HEAD.
+	// +optional
+	CachedMethods []string `json:"cachedMethods,omitempty"`
+
+	// Whether to compress the content for viewers that support it.
+	// +optional
+	Compress *bool `json:"compress,omitempty"`
+
+	// Whether to forward query strings to the origin and cache based on
+	// them.
+	// +optional
+	ForwardQueryString bool `json:"forwardQueryString,omitempty"`
+
+	// Which cookies to forward to the origin. Defaults to none.
+	// +optional
+	// +kubebuilder:validation:Enum=none;all
+	ForwardCookies *string `json:"forwardCookies,omitempty"`
+
+	// The headers to forward to the origin and cache based on.
+	// +optional
+	ForwardHeaders []string `json:"forwardHeaders,omitempty"`
+
+	// The minimum time in seconds objects stay in the cache. Defaults to 0.
+	// +optional
+	MinTTL *int64 `json:"minTtl,omitempty"`
+
+	// The time in seconds objects stay in the cache if the origin does not
+	// say otherwise.
+	// +optional
+	DefaultTTL *int64 `json:"defaultTtl,omitempty"`
+
+	// The maximum time in seconds objects stay in the cache.
+	// +optional
+	MaxTTL *int64 `json:"maxTtl,omitempty"`
+}
+
+// ViewerCertificate defines the certificate CloudFront serves to viewers.
+type ViewerCertificate struct {
+	// The ARN of an ACM certificate in us-east-1 that covers the aliases.
+	// +optional
+	ACMCertificateARN *string `json:"acmCertificateArn,omitempty"`
+
+	// ACMCertificateARNRef is a reference to a Certificate used to set the
+	// ACMCertificateARN.
+	// +optional
+	ACMCertificateARNRef *xpv1.Reference `json:"acmCertificateArnRef,omitempty"`
+
+	// ACMCertificateARNSelector selects a reference to a Certificate used to
+	// set the ACMCertificateARN.
+	// +optional
+	ACMCertificateARNSelector *xpv1.Selector `json:"acmCertificateArnSelector,omitempty"`
+
+	// The minimum TLS version viewers have to use, e.g. TLSv1.2_2019.
+	// +optional
+	MinimumProtocolVersion *string `json:"minimumProtocolVersion,omitempty"`
+
+	// How CloudFront serves HTTPS requests. Defaults to sni-only.
+	// +optional
+	// +kubebuilder:validation:Enum=sni-only;vip
+	SSLSupportMethod *string `json:"sslSupportMethod,omitempty"`
+}
+
+// DistributionParameters define the desired state of an AWS CloudFront
+// distribution.
+type DistributionParameters struct {
+	// A comment to describe the Distribution.
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+
+	// Whether the Distribution accepts requests. Defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// The alternate domain names of the Distribution.
+	// +optional
+	Aliases []string `json:"aliases,omitempty"`
+
+	// The object CloudFront returns for requests to the root URL, e.g.
+	// index.html.
+	// +optional
+	DefaultRootObject *string `json:"defaultRootObject,omitempty"`
+
+	// The price class of the Distribution, which limits the edge locations
+	// it is served from.
+	// +optional
+	// +kubebuilder:validation:Enum=PriceClass_100;PriceClass_200;PriceClass_All
+	PriceClass *string `json:"priceClass,omitempty"`
+
+	// Whether the Distribution can be reached over IPv6.
+	// +optional
+	IsIPV6Enabled *bool `json:"isIpv6Enabled,omitempty"`
+
+	// The origins of the Distribution.
+	// +kubebuilder:validation:MinItems=1
+	Origins []Origin `json:"origins"`
+
+	// How CloudFront serves the requests.
+	DefaultCacheBehavior DefaultCacheBehavior `json:"defaultCacheBehavior"`
+
+	// The certificate CloudFront serves to viewers. The CloudFront default
+	// certificate for *.cloudfront.net is used if it is not given.
+	// +optional
+	ViewerCertificate *ViewerCertificate `json:"viewerCertificate,omitempty"`
+}
+
+// A DistributionSpec defines the desired state of a Distribution.
+type DistributionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DistributionParameters `json:"forProvider"`
+}
+
+// DistributionObservation keeps the state for the external resource
+type DistributionObservation struct {
+	// The ID of the Distribution.
+	ID string `json:"id,omitempty"`
+
+	// The ARN of the Distribution.
+	ARN string `json:"arn,omitempty"`
+
+	// The domain name of the Distribution, e.g. d111111abcdef8.cloudfront.net.
+	DomainName string `json:"domainName,omitempty"`
+
+	// The status of the Distribution, InProgress or Deployed.
+	Status string `json:"status,omitempty"`
+}
+
+// A DistributionStatus represents the observed state of a Distribution.
+type DistributionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DistributionObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Distribution is a managed resource that represents an AWS CloudFront
+// distribution.
+// +kubebuilder:printcolumn:name="DOMAIN",type="string",JSONPath=".status.atProvider.domainName"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Distribution struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DistributionSpec   `json:"spec"`
+	Status DistributionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DistributionList contains a list of Distributions
+type DistributionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Distribution `json:"items"`
+}
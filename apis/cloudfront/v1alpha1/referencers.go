@@ -0,0 +1,84 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	acm "github.com/crossplane/provider-aws/apis/acm/v1alpha1"
+	elbv2 "github.com/crossplane/provider-aws/apis/elasticloadbalancing/v1alpha1"
+	s3v1beta1 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
+)
+
+// ResolveReferences of this Distribution
+func (mg *Distribution) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	for i := range mg.Spec.ForProvider.Origins {
+		o := &mg.Spec.ForProvider.Origins[i]
+
+		// Resolve spec.forProvider.origins[].s3BucketName
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(o.S3BucketName),
+			Reference:    o.S3BucketNameRef,
+			Selector:     o.S3BucketNameSelector,
+			To:           reference.To{Managed: &s3v1beta1.Bucket{}, List: &s3v1beta1.BucketList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.origins[%d].s3BucketName", i)
+		}
+		o.S3BucketName = reference.ToPtrValue(rsp.ResolvedValue)
+		o.S3BucketNameRef = rsp.ResolvedReference
+
+		// Resolve spec.forProvider.origins[].domainName
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(o.DomainName),
+			Reference:    o.DomainNameRef,
+			Selector:     o.DomainNameSelector,
+			To:           reference.To{Managed: &elbv2.LoadBalancer{}, List: &elbv2.LoadBalancerList{}},
+			Extract:      elbv2.LoadBalancerDNSName(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.origins[%d].domainName", i)
+		}
+		o.DomainName = reference.ToPtrValue(rsp.ResolvedValue)
+		o.DomainNameRef = rsp.ResolvedReference
+	}
+
+	// Resolve spec.forProvider.viewerCertificate.acmCertificateArn
+	if vc := mg.Spec.ForProvider.ViewerCertificate; vc != nil {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(vc.ACMCertificateARN),
+			Reference:    vc.ACMCertificateARNRef,
+			Selector:     vc.ACMCertificateARNSelector,
+			To:           reference.To{Managed: &acm.Certificate{}, List: &acm.CertificateList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.viewerCertificate.acmCertificateArn")
+		}
+		vc.ACMCertificateARN = reference.ToPtrValue(rsp.ResolvedValue)
+		vc.ACMCertificateARNRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
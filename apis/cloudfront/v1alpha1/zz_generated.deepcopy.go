@@ -0,0 +1,357 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultCacheBehavior) DeepCopyInto(out *DefaultCacheBehavior) {
+	*out = *in
+	if in.AllowedMethods != nil {
+		in, out := &in.AllowedMethods, &out.AllowedMethods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CachedMethods != nil {
+		in, out := &in.CachedMethods, &out.CachedMethods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Compress != nil {
+		in, out := &in.Compress, &out.Compress
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ForwardCookies != nil {
+		in, out := &in.ForwardCookies, &out.ForwardCookies
+		*out = new(string)
+		**out = **in
+	}
+	if in.ForwardHeaders != nil {
+		in, out := &in.ForwardHeaders, &out.ForwardHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MinTTL != nil {
+		in, out := &in.MinTTL, &out.MinTTL
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DefaultTTL != nil {
+		in, out := &in.DefaultTTL, &out.DefaultTTL
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxTTL != nil {
+		in, out := &in.MaxTTL, &out.MaxTTL
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultCacheBehavior.
+func (in *DefaultCacheBehavior) DeepCopy() *DefaultCacheBehavior {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultCacheBehavior)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Distribution) DeepCopyInto(out *Distribution) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Distribution.
+func (in *Distribution) DeepCopy() *Distribution {
+	if in == nil {
+		return nil
+	}
+	out := new(Distribution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Distribution) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DistributionList) DeepCopyInto(out *DistributionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Distribution, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionList.
+func (in *DistributionList) DeepCopy() *DistributionList {
+	if in == nil {
+		return nil
+	}
+	out := new(DistributionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DistributionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DistributionObservation) DeepCopyInto(out *DistributionObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionObservation.
+func (in *DistributionObservation) DeepCopy() *DistributionObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DistributionObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DistributionParameters) DeepCopyInto(out *DistributionParameters) {
+	*out = *in
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Aliases != nil {
+		in, out := &in.Aliases, &out.Aliases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DefaultRootObject != nil {
+		in, out := &in.DefaultRootObject, &out.DefaultRootObject
+		*out = new(string)
+		**out = **in
+	}
+	if in.PriceClass != nil {
+		in, out := &in.PriceClass, &out.PriceClass
+		*out = new(string)
+		**out = **in
+	}
+	if in.IsIPV6Enabled != nil {
+		in, out := &in.IsIPV6Enabled, &out.IsIPV6Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Origins != nil {
+		in, out := &in.Origins, &out.Origins
+		*out = make([]Origin, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.DefaultCacheBehavior.DeepCopyInto(&out.DefaultCacheBehavior)
+	if in.ViewerCertificate != nil {
+		in, out := &in.ViewerCertificate, &out.ViewerCertificate
+		*out = new(ViewerCertificate)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionParameters.
+func (in *DistributionParameters) DeepCopy() *DistributionParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DistributionParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DistributionSpec) DeepCopyInto(out *DistributionSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionSpec.
+func (in *DistributionSpec) DeepCopy() *DistributionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DistributionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DistributionStatus) DeepCopyInto(out *DistributionStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionStatus.
+func (in *DistributionStatus) DeepCopy() *DistributionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DistributionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Origin) DeepCopyInto(out *Origin) {
+	*out = *in
+	if in.S3BucketName != nil {
+		in, out := &in.S3BucketName, &out.S3BucketName
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3BucketNameRef != nil {
+		in, out := &in.S3BucketNameRef, &out.S3BucketNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.S3BucketNameSelector != nil {
+		in, out := &in.S3BucketNameSelector, &out.S3BucketNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OriginAccessIdentity != nil {
+		in, out := &in.OriginAccessIdentity, &out.OriginAccessIdentity
+		*out = new(string)
+		**out = **in
+	}
+	if in.DomainName != nil {
+		in, out := &in.DomainName, &out.DomainName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DomainNameRef != nil {
+		in, out := &in.DomainNameRef, &out.DomainNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.DomainNameSelector != nil {
+		in, out := &in.DomainNameSelector, &out.DomainNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OriginProtocolPolicy != nil {
+		in, out := &in.OriginProtocolPolicy, &out.OriginProtocolPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.HTTPPort != nil {
+		in, out := &in.HTTPPort, &out.HTTPPort
+		*out = new(int64)
+		**out = **in
+	}
+	if in.HTTPSPort != nil {
+		in, out := &in.HTTPSPort, &out.HTTPSPort
+		*out = new(int64)
+		**out = **in
+	}
+	if in.OriginPath != nil {
+		in, out := &in.OriginPath, &out.OriginPath
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Origin.
+func (in *Origin) DeepCopy() *Origin {
+	if in == nil {
+		return nil
+	}
+	out := new(Origin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ViewerCertificate) DeepCopyInto(out *ViewerCertificate) {
+	*out = *in
+	if in.ACMCertificateARN != nil {
+		in, out := &in.ACMCertificateARN, &out.ACMCertificateARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ACMCertificateARNRef != nil {
+		in, out := &in.ACMCertificateARNRef, &out.ACMCertificateARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ACMCertificateARNSelector != nil {
+		in, out := &in.ACMCertificateARNSelector, &out.ACMCertificateARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MinimumProtocolVersion != nil {
+		in, out := &in.MinimumProtocolVersion, &out.MinimumProtocolVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.SSLSupportMethod != nil {
+		in, out := &in.SSLSupportMethod, &out.SSLSupportMethod
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ViewerCertificate.
+func (in *ViewerCertificate) DeepCopy() *ViewerCertificate {
+	if in == nil {
+		return nil
+	}
+	out := new(ViewerCertificate)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Tag is a key value pair that is attached to an Alarm.
+type Tag struct {
+	// The key of the tag.
+	Key string `json:"key"`
+
+	// The value of the tag.
+	Value string `json:"value"`
+}
+
+// Dimension narrows down the metric an Alarm watches, e.g. to a single RDS
+// instance with the name DBInstanceIdentifier.
+type Dimension struct {
+	// The name of the dimension.
+	Name string `json:"name"`
+
+	// The value of the dimension.
+	Value string `json:"value"`
+}
+
+// AlarmParameters define the desired state of an AWS CloudWatch metric alarm.
+type AlarmParameters struct {
+	// Region is the region you'd like your Alarm to be created in.
+	Region string `json:"region"`
+
+	// The description of the alarm.
+	// +optional
+	AlarmDescription *string `json:"alarmDescription,omitempty"`
+
+	// Whether actions are executed when the alarm changes its state.
+	// Defaults to true.
+	// +optional
+	ActionsEnabled *bool `json:"actionsEnabled,omitempty"`
+
+	// The ARNs of the actions to execute when the alarm transitions into the
+	// ALARM state, e.g. SNS topics.
+	// +optional
+	AlarmActions []string `json:"alarmActions,omitempty"`
+
+	// AlarmActionRefs references SNSTopics to retrieve their ARNs for
+	// AlarmActions.
+	// +optional
+	AlarmActionRefs []xpv1.Reference `json:"alarmActionRefs,omitempty"`
+
+	// AlarmActionSelector selects references to SNSTopics to retrieve their
+	// ARNs for AlarmActions.
+	// +optional
+	AlarmActionSelector *xpv1.Selector `json:"alarmActionSelector,omitempty"`
+
+	// The ARNs of the actions to execute when the alarm transitions into the
+	// OK state, e.g. SNS topics.
+	// +optional
+	OKActions []string `json:"okActions,omitempty"`
+
+	// OKActionRefs references SNSTopics to retrieve their ARNs for OKActions.
+	// +optional
+	OKActionRefs []xpv1.Reference `json:"okActionRefs,omitempty"`
+
+	// OKActionSelector selects references to SNSTopics to retrieve their ARNs
+	// for OKActions.
+	// +optional
+	OKActionSelector *xpv1.Selector `json:"okActionSelector,omitempty"`
+
+	// The ARNs of the actions to execute when the alarm transitions into the
+	// INSUFFICIENT_DATA state.
+	// +optional
+	InsufficientDataActions []string `json:"insufficientDataActions,omitempty"`
+
+	// The namespace of the metric, e.g. AWS/RDS.
+	Namespace string `json:"namespace"`
+
+	// The name of the metric, e.g. CPUUtilization.
+	MetricName string `json:"metricName"`
+
+	// The dimensions of the metric.
+	// +optional
+	Dimensions []Dimension `json:"dimensions,omitempty"`
+
+	// The statistic applied to the metric.
+	// +kubebuilder:validation:Enum=SampleCount;Average;Sum;Minimum;Maximum
+	Statistic string `json:"statistic"`
+
+	// The length, in seconds, of the periods the statistic is applied to.
+	// +kubebuilder:validation:Minimum=10
+	Period int64 `json:"period"`
+
+	// The unit of the metric.
+	// +optional
+	Unit *string `json:"unit,omitempty"`
+
+	// The number of periods over which data is compared to the threshold.
+	// +kubebuilder:validation:Minimum=1
+	EvaluationPeriods int64 `json:"evaluationPeriods"`
+
+	// The number of data points within EvaluationPeriods that must be
+	// breaching to trigger the alarm. Defaults to EvaluationPeriods.
+	// +optional
+	DatapointsToAlarm *int64 `json:"datapointsToAlarm,omitempty"`
+
+	// The value the statistic is compared to.
+	Threshold float64 `json:"threshold"`
+
+	// How the statistic is compared to the threshold.
+	// +kubebuilder:validation:Enum=GreaterThanOrEqualToThreshold;GreaterThanThreshold;LessThanThreshold;LessThanOrEqualToThreshold
+	ComparisonOperator string `json:"comparisonOperator"`
+
+	// How the alarm handles missing data points.
+	// +optional
+	// +kubebuilder:validation:Enum=breaching;notBreaching;ignore;missing
+	TreatMissingData *string `json:"treatMissingData,omitempty"`
+
+	// Tags to add to the alarm when it is created.
+	// +optional
+	// +immutable
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// An AlarmSpec defines the desired state of an Alarm.
+type AlarmSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AlarmParameters `json:"forProvider"`
+}
+
+// AlarmObservation keeps the state for the external resource
+type AlarmObservation struct {
+	// The ARN of the alarm.
+	AlarmARN string `json:"alarmArn,omitempty"`
+
+	// The state of the alarm, OK, ALARM or INSUFFICIENT_DATA.
+	StateValue string `json:"stateValue,omitempty"`
+
+	// An explanation of the state of the alarm.
+	StateReason string `json:"stateReason,omitempty"`
+}
+
+// An AlarmStatus represents the observed state of an Alarm.
+type AlarmStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AlarmObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Alarm is a managed resource that represents an AWS CloudWatch metric
+// alarm.
+// +kubebuilder:printcolumn:name="METRIC",type="string",JSONPath=".spec.forProvider.metricName"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.stateValue"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Alarm struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AlarmSpec   `json:"spec"`
+	Status AlarmStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AlarmList contains a list of Alarms
+type AlarmList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Alarm `json:"items"`
+}
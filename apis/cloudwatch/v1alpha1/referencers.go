@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	sns "github.com/crossplane/provider-aws/apis/notification/v1alpha1"
+)
+
+// ResolveReferences of this Alarm
+func (mg *Alarm) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.alarmActions
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.AlarmActions,
+		References:    mg.Spec.ForProvider.AlarmActionRefs,
+		Selector:      mg.Spec.ForProvider.AlarmActionSelector,
+		To:            reference.To{Managed: &sns.SNSTopic{}, List: &sns.SNSTopicList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.alarmActions")
+	}
+	mg.Spec.ForProvider.AlarmActions = mrsp.ResolvedValues
+	mg.Spec.ForProvider.AlarmActionRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.okActions
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.OKActions,
+		References:    mg.Spec.ForProvider.OKActionRefs,
+		Selector:      mg.Spec.ForProvider.OKActionSelector,
+		To:            reference.To{Managed: &sns.SNSTopic{}, List: &sns.SNSTopicList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.okActions")
+	}
+	mg.Spec.ForProvider.OKActions = mrsp.ResolvedValues
+	mg.Spec.ForProvider.OKActionRefs = mrsp.ResolvedReferences
+
+	return nil
+}
@@ -0,0 +1,249 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Alarm) DeepCopyInto(out *Alarm) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Alarm.
+func (in *Alarm) DeepCopy() *Alarm {
+	if in == nil {
+		return nil
+	}
+	out := new(Alarm)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Alarm) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlarmList) DeepCopyInto(out *AlarmList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Alarm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlarmList.
+func (in *AlarmList) DeepCopy() *AlarmList {
+	if in == nil {
+		return nil
+	}
+	out := new(AlarmList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlarmList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlarmObservation) DeepCopyInto(out *AlarmObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlarmObservation.
+func (in *AlarmObservation) DeepCopy() *AlarmObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AlarmObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlarmParameters) DeepCopyInto(out *AlarmParameters) {
+	*out = *in
+	if in.AlarmDescription != nil {
+		in, out := &in.AlarmDescription, &out.AlarmDescription
+		*out = new(string)
+		**out = **in
+	}
+	if in.ActionsEnabled != nil {
+		in, out := &in.ActionsEnabled, &out.ActionsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AlarmActions != nil {
+		in, out := &in.AlarmActions, &out.AlarmActions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AlarmActionRefs != nil {
+		in, out := &in.AlarmActionRefs, &out.AlarmActionRefs
+		*out = make([]v1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.AlarmActionSelector != nil {
+		in, out := &in.AlarmActionSelector, &out.AlarmActionSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OKActions != nil {
+		in, out := &in.OKActions, &out.OKActions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OKActionRefs != nil {
+		in, out := &in.OKActionRefs, &out.OKActionRefs
+		*out = make([]v1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.OKActionSelector != nil {
+		in, out := &in.OKActionSelector, &out.OKActionSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InsufficientDataActions != nil {
+		in, out := &in.InsufficientDataActions, &out.InsufficientDataActions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Dimensions != nil {
+		in, out := &in.Dimensions, &out.Dimensions
+		*out = make([]Dimension, len(*in))
+		copy(*out, *in)
+	}
+	if in.Unit != nil {
+		in, out := &in.Unit, &out.Unit
+		*out = new(string)
+		**out = **in
+	}
+	if in.DatapointsToAlarm != nil {
+		in, out := &in.DatapointsToAlarm, &out.DatapointsToAlarm
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TreatMissingData != nil {
+		in, out := &in.TreatMissingData, &out.TreatMissingData
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlarmParameters.
+func (in *AlarmParameters) DeepCopy() *AlarmParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AlarmParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlarmSpec) DeepCopyInto(out *AlarmSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlarmSpec.
+func (in *AlarmSpec) DeepCopy() *AlarmSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlarmSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlarmStatus) DeepCopyInto(out *AlarmStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlarmStatus.
+func (in *AlarmStatus) DeepCopy() *AlarmStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AlarmStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Dimension) DeepCopyInto(out *Dimension) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Dimension.
+func (in *Dimension) DeepCopy() *Dimension {
+	if in == nil {
+		return nil
+	}
+	out := new(Dimension)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tag) DeepCopyInto(out *Tag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
+	if in == nil {
+		return nil
+	}
+	out := new(Tag)
+	in.DeepCopyInto(out)
+	return out
+}
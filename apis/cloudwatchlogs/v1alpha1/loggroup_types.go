@@ -0,0 +1,110 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// LogGroupParameters define the desired state of an AWS CloudWatch Logs log
+// group.
+type LogGroupParameters struct {
+	// Region is the region you'd like your LogGroup to be created in.
+	Region string `json:"region"`
+
+	// The number of days to keep the log events of the log group for. Log
+	// events are kept forever if it is not set.
+	// +kubebuilder:validation:Enum=1;3;5;7;14;30;60;90;120;150;180;365;400;545;731;1827;3653
+	// +optional
+	RetentionInDays *int64 `json:"retentionInDays,omitempty"`
+
+	// The ARN of the AWS KMS key that encrypts the log events of the log
+	// group. The key policy has to allow CloudWatch Logs to use the key.
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// KMSKeyIDRef is a reference to a KMS Key used to set KMSKeyID.
+	// +optional
+	KMSKeyIDRef *xpv1.Reference `json:"kmsKeyIdRef,omitempty"`
+
+	// KMSKeyIDSelector selects a reference to a KMS Key used to set
+	// KMSKeyID.
+	// +optional
+	KMSKeyIDSelector *xpv1.Selector `json:"kmsKeyIdSelector,omitempty"`
+
+	// Tags to assign to the log group.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A LogGroupSpec defines the desired state of a LogGroup.
+type LogGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LogGroupParameters `json:"forProvider"`
+}
+
+// LogGroupObservation keeps the state for the external resource
+type LogGroupObservation struct {
+	// The ARN of the log group.
+	ARN string `json:"arn,omitempty"`
+
+	// The time the log group was created.
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+
+	// The number of bytes stored in the log group.
+	StoredBytes int64 `json:"storedBytes,omitempty"`
+
+	// The number of metric filters of the log group.
+	MetricFilterCount int64 `json:"metricFilterCount,omitempty"`
+}
+
+// A LogGroupStatus represents the observed state of a LogGroup.
+type LogGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          LogGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LogGroup is a managed resource that represents an AWS CloudWatch Logs log
+// group. The external name of the log group is its name, e.g.
+// /aws/lambda/my-function.
+// +kubebuilder:printcolumn:name="RETENTION",type="integer",JSONPath=".spec.forProvider.retentionInDays"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type LogGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LogGroupSpec   `json:"spec"`
+	Status LogGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LogGroupList contains a list of LogGroups
+type LogGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LogGroup `json:"items"`
+}
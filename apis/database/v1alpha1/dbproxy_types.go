@@ -0,0 +1,192 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// DBProxy engine families.
+const (
+	DBProxyEngineFamilyMySQL      = "MYSQL"
+	DBProxyEngineFamilyPostgreSQL = "POSTGRESQL"
+)
+
+// DBProxyAuth describes the credentials a DBProxy uses to connect to the
+// database.
+type DBProxyAuth struct {
+	// SecretARN is the ARN of the Secrets Manager secret that holds the
+	// username and password of the database user.
+	// +optional
+	SecretARN *string `json:"secretArn,omitempty"`
+
+	// SecretARNRef references a Secret to retrieve its ARN.
+	// +optional
+	SecretARNRef *xpv1.Reference `json:"secretArnRef,omitempty"`
+
+	// SecretARNSelector selects a reference to a Secret to retrieve its ARN.
+	// +optional
+	SecretARNSelector *xpv1.Selector `json:"secretArnSelector,omitempty"`
+
+	// IAMAuth specifies whether clients have to use IAM authentication to
+	// connect through the proxy.
+	// +kubebuilder:validation:Enum=DISABLED;REQUIRED
+	// +optional
+	IAMAuth *string `json:"iamAuth,omitempty"`
+
+	// Description of the credentials.
+	// +optional
+	Description *string `json:"description,omitempty"`
+}
+
+// DBProxyParameters define the desired state of an AWS RDS Proxy.
+type DBProxyParameters struct {
+	// Region is the region you'd like your DBProxy to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// EngineFamily is the kind of database engine the proxy connects to.
+	// +kubebuilder:validation:Enum=MYSQL;POSTGRESQL
+	// +immutable
+	EngineFamily string `json:"engineFamily"`
+
+	// Auth is the list of credentials the proxy uses to connect to the
+	// database.
+	Auth []DBProxyAuth `json:"auth"`
+
+	// RoleARN is the ARN of the IAM role the proxy uses to read the Secrets
+	// Manager secrets.
+	// +optional
+	RoleARN *string `json:"roleArn,omitempty"`
+
+	// RoleARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	RoleARNRef *xpv1.Reference `json:"roleArnRef,omitempty"`
+
+	// RoleARNSelector selects a reference to an IAMRole to retrieve its ARN.
+	// +optional
+	RoleARNSelector *xpv1.Selector `json:"roleArnSelector,omitempty"`
+
+	// VPCSubnetIDs are the IDs of the subnets the proxy is placed in.
+	// +optional
+	// +immutable
+	VPCSubnetIDs []string `json:"vpcSubnetIds,omitempty"`
+
+	// VPCSubnetIDRefs references Subnets to retrieve their IDs.
+	// +optional
+	VPCSubnetIDRefs []xpv1.Reference `json:"vpcSubnetIdRefs,omitempty"`
+
+	// VPCSubnetIDSelector selects references to Subnets to retrieve their
+	// IDs.
+	// +optional
+	VPCSubnetIDSelector *xpv1.Selector `json:"vpcSubnetIdSelector,omitempty"`
+
+	// VPCSecurityGroupIDs are the IDs of the security groups of the proxy.
+	// +optional
+	VPCSecurityGroupIDs []string `json:"vpcSecurityGroupIds,omitempty"`
+
+	// VPCSecurityGroupIDRefs references SecurityGroups to retrieve their IDs.
+	// +optional
+	VPCSecurityGroupIDRefs []xpv1.Reference `json:"vpcSecurityGroupIdRefs,omitempty"`
+
+	// VPCSecurityGroupIDSelector selects references to SecurityGroups to
+	// retrieve their IDs.
+	// +optional
+	VPCSecurityGroupIDSelector *xpv1.Selector `json:"vpcSecurityGroupIdSelector,omitempty"`
+
+	// IdleClientTimeout is the number of seconds a client connection can be
+	// idle before the proxy closes it.
+	// +optional
+	IdleClientTimeout *int64 `json:"idleClientTimeout,omitempty"`
+
+	// RequireTLS specifies whether clients have to use TLS to connect to the
+	// proxy.
+	// +optional
+	RequireTLS *bool `json:"requireTLS,omitempty"`
+
+	// DebugLogging specifies whether the proxy logs detailed information
+	// about the SQL statements it processes.
+	// +optional
+	DebugLogging *bool `json:"debugLogging,omitempty"`
+
+	// DBInstanceIdentifier is the identifier of the DB instance the proxy
+	// connects to.
+	// +optional
+	DBInstanceIdentifier *string `json:"dbInstanceIdentifier,omitempty"`
+
+	// DBInstanceIdentifierRef references an RDSInstance to retrieve its
+	// identifier.
+	// +optional
+	DBInstanceIdentifierRef *xpv1.Reference `json:"dbInstanceIdentifierRef,omitempty"`
+
+	// DBInstanceIdentifierSelector selects a reference to an RDSInstance to
+	// retrieve its identifier.
+	// +optional
+	DBInstanceIdentifierSelector *xpv1.Selector `json:"dbInstanceIdentifierSelector,omitempty"`
+}
+
+// A DBProxySpec defines the desired state of a DBProxy.
+type DBProxySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DBProxyParameters `json:"forProvider"`
+}
+
+// DBProxyObservation keeps the state for the external resource.
+type DBProxyObservation struct {
+	// DBProxyARN is the ARN of the proxy.
+	DBProxyARN string `json:"dbProxyArn,omitempty"`
+
+	// Endpoint is the endpoint that clients connect to.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Status of the proxy.
+	Status string `json:"status,omitempty"`
+}
+
+// A DBProxyStatus represents the observed state of a DBProxy.
+type DBProxyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DBProxyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DBProxy is a managed resource that represents an AWS RDS Proxy.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="ENDPOINT",type="string",JSONPath=".status.atProvider.endpoint"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DBProxy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DBProxySpec   `json:"spec"`
+	Status DBProxyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DBProxyList contains a list of DBProxies
+type DBProxyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DBProxy `json:"items"`
+}
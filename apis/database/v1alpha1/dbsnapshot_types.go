@@ -0,0 +1,173 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// DB snapshot states.
+const (
+	// The snapshot is ready to be restored or copied.
+	DBSnapshotStateAvailable = "available"
+	// The snapshot of a DB instance is being taken.
+	DBSnapshotStateCreating = "creating"
+	// The snapshot is being copied from its source snapshot.
+	DBSnapshotStateCopying = "copying"
+	// The snapshot is being deleted.
+	DBSnapshotStateDeleting = "deleting"
+)
+
+// ResourceCredentialsSecretSnapshotARNKey is the key of the ARN of the snapshot
+// in the connection secret of a DBSnapshot.
+const ResourceCredentialsSecretSnapshotARNKey = "snapshotArn"
+
+// A DBSnapshotTag is a tag of a DB snapshot.
+type DBSnapshotTag struct {
+	// The key of the tag.
+	Key string `json:"key"`
+
+	// The value of the tag.
+	Value string `json:"value"`
+}
+
+// DBSnapshotParameters define the desired state of an AWS RDS DB snapshot.
+// Exactly one of DBInstanceIdentifier and SourceDBSnapshotIdentifier must be
+// given.
+type DBSnapshotParameters struct {
+	// Region is the region you'd like your DBSnapshot to be created in. It
+	// is the destination region when a snapshot is copied.
+	Region string `json:"region"`
+
+	// The identifier of the DB instance to take the snapshot of. The DB
+	// instance has to be in the same region as the snapshot.
+	// +immutable
+	// +optional
+	DBInstanceIdentifier *string `json:"dbInstanceIdentifier,omitempty"`
+
+	// The identifier of the snapshot to copy. It has to be the ARN of the
+	// snapshot when it is copied from another region, e.g.
+	// arn:aws:rds:us-west-2:123456789012:snapshot:mysql-instance1-snapshot-20130805.
+	// +immutable
+	// +optional
+	SourceDBSnapshotIdentifier *string `json:"sourceDBSnapshotIdentifier,omitempty"`
+
+	// The region of the snapshot to copy. It has to be given when the source
+	// snapshot is in another region than the copy.
+	// +immutable
+	// +optional
+	SourceRegion *string `json:"sourceRegion,omitempty"`
+
+	// The AWS KMS key ID of the key that encrypts the copy, e.g. its ARN or
+	// alias. A copy of an encrypted snapshot in another region has to use a
+	// key of the destination region.
+	// +immutable
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// Whether the tags of the source snapshot are copied to the copy.
+	// +immutable
+	// +optional
+	CopyTags *bool `json:"copyTags,omitempty"`
+
+	// The name of the option group of the copy. It has to be given when an
+	// Oracle snapshot that uses an option group is copied to another region.
+	// +immutable
+	// +optional
+	OptionGroupName *string `json:"optionGroupName,omitempty"`
+
+	// Tags to assign to the snapshot.
+	// +immutable
+	// +optional
+	Tags []DBSnapshotTag `json:"tags,omitempty"`
+}
+
+// A DBSnapshotSpec defines the desired state of a DBSnapshot.
+type DBSnapshotSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DBSnapshotParameters `json:"forProvider"`
+}
+
+// DBSnapshotObservation keeps the state for the external resource
+type DBSnapshotObservation struct {
+	// The ARN of the snapshot.
+	DBSnapshotARN string `json:"dbSnapshotArn,omitempty"`
+
+	// The state of the snapshot.
+	Status string `json:"status,omitempty"`
+
+	// How much of the snapshot has been taken or copied in percent.
+	PercentProgress int64 `json:"percentProgress,omitempty"`
+
+	// The time the snapshot was taken.
+	SnapshotCreateTime *metav1.Time `json:"snapshotCreateTime,omitempty"`
+
+	// The ARN of the snapshot this snapshot was copied from, if it is a copy.
+	SourceDBSnapshotIdentifier string `json:"sourceDBSnapshotIdentifier,omitempty"`
+
+	// The region of the snapshot this snapshot was copied from, if it is a
+	// copy.
+	SourceRegion string `json:"sourceRegion,omitempty"`
+
+	// The engine of the DB instance of the snapshot.
+	Engine string `json:"engine,omitempty"`
+
+	// The engine version of the DB instance of the snapshot.
+	EngineVersion string `json:"engineVersion,omitempty"`
+
+	// Whether the snapshot is encrypted.
+	Encrypted bool `json:"encrypted,omitempty"`
+
+	// The ID of the AWS KMS key that encrypts the snapshot.
+	KMSKeyID string `json:"kmsKeyId,omitempty"`
+}
+
+// A DBSnapshotStatus represents the observed state of a DBSnapshot.
+type DBSnapshotStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DBSnapshotObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DBSnapshot is a managed resource that represents a manual AWS RDS DB
+// snapshot. It either takes a snapshot of a DB instance or copies an existing
+// snapshot, which may be in another region.
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="PROGRESS",type="integer",JSONPath=".status.atProvider.percentProgress"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DBSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DBSnapshotSpec   `json:"spec"`
+	Status DBSnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DBSnapshotList contains a list of DBSnapshots
+type DBSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DBSnapshot `json:"items"`
+}
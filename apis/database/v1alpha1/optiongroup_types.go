@@ -0,0 +1,142 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// OptionSetting is a setting of an option, e.g. the list of events that
+// Oracle native auditing writes.
+type OptionSetting struct {
+	// The name of the setting.
+	Name string `json:"name"`
+
+	// The value of the setting.
+	Value string `json:"value"`
+}
+
+// OptionConfiguration is an option that is added to an option group, e.g.
+// S3_INTEGRATION for Oracle.
+type OptionConfiguration struct {
+	// The name of the option.
+	OptionName string `json:"optionName"`
+
+	// The version of the option.
+	// +optional
+	OptionVersion *string `json:"optionVersion,omitempty"`
+
+	// The port that the option listens on, for options that use one.
+	// +optional
+	Port *int64 `json:"port,omitempty"`
+
+	// The settings of the option. Settings that are not given keep their
+	// default values.
+	// +optional
+	OptionSettings []OptionSetting `json:"optionSettings,omitempty"`
+
+	// The IDs of the VPC security groups that control access to the option,
+	// for options that use a port.
+	// +optional
+	VPCSecurityGroupMemberships []string `json:"vpcSecurityGroupMemberships,omitempty"`
+}
+
+// OptionGroupParameters define the desired state of an AWS RDS option group.
+type OptionGroupParameters struct {
+	// Region is the region you'd like your OptionGroup to be created in.
+	Region string `json:"region"`
+
+	// The name of the engine the option group can be applied to, e.g.
+	// oracle-ee or sqlserver-se.
+	// +immutable
+	EngineName string `json:"engineName"`
+
+	// The major version of the engine the option group can be applied to,
+	// e.g. 19 for Oracle or 15.00 for SQL Server.
+	// +immutable
+	MajorEngineVersion string `json:"majorEngineVersion"`
+
+	// The description of the option group.
+	// +immutable
+	OptionGroupDescription string `json:"optionGroupDescription"`
+
+	// The options of the option group. Options that are not listed are
+	// removed from the option group, except for permanent ones.
+	// +optional
+	Options []OptionConfiguration `json:"options,omitempty"`
+
+	// Whether changes to the options are applied to the DB instances that
+	// use the option group immediately, rather than during their next
+	// maintenance window.
+	// +optional
+	ApplyImmediately *bool `json:"applyImmediately,omitempty"`
+}
+
+// An OptionGroupSpec defines the desired state of an OptionGroup.
+type OptionGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OptionGroupParameters `json:"forProvider"`
+}
+
+// OptionGroupObservation keeps the state for the external resource
+type OptionGroupObservation struct {
+	// The ARN of the option group.
+	OptionGroupARN string `json:"optionGroupArn,omitempty"`
+
+	// The ID of the VPC the option group can be used in, if it is limited to
+	// one.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// The names of the options in the option group.
+	Options []string `json:"options,omitempty"`
+}
+
+// An OptionGroupStatus represents the observed state of an OptionGroup.
+type OptionGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OptionGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OptionGroup is a managed resource that represents an AWS RDS option
+// group, which enables features such as Oracle native auditing or S3
+// integration for the DB instances that use it.
+// +kubebuilder:printcolumn:name="ENGINE",type="string",JSONPath=".spec.forProvider.engineName"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type OptionGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OptionGroupSpec   `json:"spec"`
+	Status OptionGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OptionGroupList contains a list of OptionGroups
+type OptionGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OptionGroup `json:"items"`
+}
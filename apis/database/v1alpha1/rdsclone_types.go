@@ -0,0 +1,167 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// RDSCloneParameters define the desired state of an RDSClone. At least one of
+// SourceDBInstanceIdentifier and DBSnapshotIdentifier must be given.
+type RDSCloneParameters struct {
+	// Region is the region of the source DB instance, and the region the
+	// clone is created in.
+	Region string `json:"region"`
+
+	// SourceDBInstanceIdentifier is the identifier of the DB instance to
+	// clone. A snapshot of it is taken for the clone unless
+	// dbSnapshotIdentifier is given. Unless set explicitly, the clone is
+	// created in the same DB subnet group and with the same VPC security
+	// groups, DB parameter group and port as the source DB instance.
+	// +immutable
+	// +optional
+	SourceDBInstanceIdentifier *string `json:"sourceDBInstanceIdentifier,omitempty"`
+
+	// SourceDBInstanceIdentifierRef references an RDSInstance to retrieve
+	// its identifier.
+	// +immutable
+	// +optional
+	SourceDBInstanceIdentifierRef *xpv1.Reference `json:"sourceDBInstanceIdentifierRef,omitempty"`
+
+	// SourceDBInstanceIdentifierSelector selects a reference to an
+	// RDSInstance to retrieve its identifier.
+	// +optional
+	SourceDBInstanceIdentifierSelector *xpv1.Selector `json:"sourceDBInstanceIdentifierSelector,omitempty"`
+
+	// DBSnapshotIdentifier is the identifier of an existing snapshot the
+	// clone is restored from. It is reused by all clones restored from it and
+	// is not deleted with them. Snapshots taken by the clone itself are
+	// deleted with it.
+	// +immutable
+	// +optional
+	DBSnapshotIdentifier *string `json:"dbSnapshotIdentifier,omitempty"`
+
+	// DBSnapshotIdentifierRef references a DBSnapshot to retrieve its
+	// identifier.
+	// +immutable
+	// +optional
+	DBSnapshotIdentifierRef *xpv1.Reference `json:"dbSnapshotIdentifierRef,omitempty"`
+
+	// DBSnapshotIdentifierSelector selects a reference to a DBSnapshot to
+	// retrieve its identifier.
+	// +optional
+	DBSnapshotIdentifierSelector *xpv1.Selector `json:"dbSnapshotIdentifierSelector,omitempty"`
+
+	// DBInstanceClass is the compute and memory capacity of the clone, e.g.
+	// db.t3.micro. It defaults to the class of the DB instance the snapshot
+	// was taken of.
+	// +immutable
+	// +optional
+	DBInstanceClass *string `json:"dbInstanceClass,omitempty"`
+
+	// DBSubnetGroupName is the DB subnet group the clone is created in.
+	// +immutable
+	// +optional
+	DBSubnetGroupName *string `json:"dbSubnetGroupName,omitempty"`
+
+	// VPCSecurityGroupIDs are the VPC security groups of the clone.
+	// +immutable
+	// +optional
+	VPCSecurityGroupIDs []string `json:"vpcSecurityGroupIds,omitempty"`
+
+	// PubliclyAccessible specifies whether the clone is reachable from
+	// outside of its VPC.
+	// +immutable
+	// +optional
+	PubliclyAccessible *bool `json:"publiclyAccessible,omitempty"`
+
+	// Tags to assign to the clone.
+	// +immutable
+	// +optional
+	Tags []DBSnapshotTag `json:"tags,omitempty"`
+}
+
+// An RDSCloneSpec defines the desired state of an RDSClone.
+type RDSCloneSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RDSCloneParameters `json:"forProvider"`
+}
+
+// RDSCloneObservation is the observed state of an RDSClone.
+type RDSCloneObservation struct {
+	// DBSnapshotIdentifier is the identifier of the snapshot the clone is
+	// restored from.
+	DBSnapshotIdentifier string `json:"dbSnapshotIdentifier,omitempty"`
+
+	// DBSnapshotStatus is the state of the snapshot while the clone waits
+	// for it to become available.
+	DBSnapshotStatus string `json:"dbSnapshotStatus,omitempty"`
+
+	// DBInstanceARN is the ARN of the clone.
+	DBInstanceARN string `json:"dbInstanceArn,omitempty"`
+
+	// DBInstanceStatus is the state of the clone.
+	DBInstanceStatus string `json:"dbInstanceStatus,omitempty"`
+
+	// EngineVersion is the engine version of the clone.
+	EngineVersion string `json:"engineVersion,omitempty"`
+
+	// Address is the DNS address of the clone.
+	Address string `json:"address,omitempty"`
+
+	// Port is the port the clone listens on.
+	Port int64 `json:"port,omitempty"`
+}
+
+// An RDSCloneStatus represents the observed state of an RDSClone.
+type RDSCloneStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RDSCloneObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An RDSClone is a managed resource that represents a short-lived copy of an
+// AWS RDS DB instance, e.g. for a preview environment. The clone is restored
+// from a snapshot, which is taken of the source DB instance unless an
+// existing one is given. The clone is deleted without a final snapshot, and
+// so is the snapshot it took, unless its deletionPolicy is Orphan.
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.dbInstanceStatus"
+// +kubebuilder:printcolumn:name="ADDRESS",type="string",JSONPath=".status.atProvider.address"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type RDSClone struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RDSCloneSpec   `json:"spec"`
+	Status RDSCloneStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RDSCloneList contains a list of RDSClones
+type RDSCloneList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RDSClone `json:"items"`
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	ec2 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	iam "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	secretsmanager "github.com/crossplane/provider-aws/apis/secretsmanager/v1alpha1"
+)
+
+// ResolveReferences of this RDSClone. The reference to its source RDSInstance
+// is resolved by its controller, since the RDSInstance API imports this one.
+func (mg *RDSClone) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.dbSnapshotIdentifier
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DBSnapshotIdentifier),
+		Reference:    mg.Spec.ForProvider.DBSnapshotIdentifierRef,
+		Selector:     mg.Spec.ForProvider.DBSnapshotIdentifierSelector,
+		To:           reference.To{Managed: &DBSnapshot{}, List: &DBSnapshotList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.dbSnapshotIdentifier")
+	}
+	mg.Spec.ForProvider.DBSnapshotIdentifier = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DBSnapshotIdentifierRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this DBProxy. The reference to its target RDSInstance
+// is resolved by its controller, since the RDSInstance API imports this one.
+func (mg *DBProxy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.auth[].secretArn
+	for i := range mg.Spec.ForProvider.Auth {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Auth[i].SecretARN),
+			Reference:    mg.Spec.ForProvider.Auth[i].SecretARNRef,
+			Selector:     mg.Spec.ForProvider.Auth[i].SecretARNSelector,
+			To:           reference.To{Managed: &secretsmanager.Secret{}, List: &secretsmanager.SecretList{}},
+			Extract:      secretsmanager.SecretARN(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.auth[%d].secretArn", i)
+		}
+		mg.Spec.ForProvider.Auth[i].SecretARN = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Auth[i].SecretARNRef = rsp.ResolvedReference
+	}
+
+	// Resolve spec.forProvider.roleArn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.RoleARN),
+		Reference:    mg.Spec.ForProvider.RoleARNRef,
+		Selector:     mg.Spec.ForProvider.RoleARNSelector,
+		To:           reference.To{Managed: &iam.IAMRole{}, List: &iam.IAMRoleList{}},
+		Extract:      iam.IAMRoleARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.roleArn")
+	}
+	mg.Spec.ForProvider.RoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RoleARNRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.vpcSubnetIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.VPCSubnetIDs,
+		References:    mg.Spec.ForProvider.VPCSubnetIDRefs,
+		Selector:      mg.Spec.ForProvider.VPCSubnetIDSelector,
+		To:            reference.To{Managed: &ec2.Subnet{}, List: &ec2.SubnetList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.vpcSubnetIds")
+	}
+	mg.Spec.ForProvider.VPCSubnetIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.VPCSubnetIDRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.vpcSecurityGroupIds
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.VPCSecurityGroupIDs,
+		References:    mg.Spec.ForProvider.VPCSecurityGroupIDRefs,
+		Selector:      mg.Spec.ForProvider.VPCSecurityGroupIDSelector,
+		To:            reference.To{Managed: &ec2.SecurityGroup{}, List: &ec2.SecurityGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.vpcSecurityGroupIds")
+	}
+	mg.Spec.ForProvider.VPCSecurityGroupIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.VPCSecurityGroupIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "database.aws.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// OptionGroup type metadata.
+var (
+	OptionGroupKind             = reflect.TypeOf(OptionGroup{}).Name()
+	OptionGroupGroupKind        = schema.GroupKind{Group: Group, Kind: OptionGroupKind}.String()
+	OptionGroupKindAPIVersion   = OptionGroupKind + "." + SchemeGroupVersion.String()
+	OptionGroupGroupVersionKind = SchemeGroupVersion.WithKind(OptionGroupKind)
+)
+
+// DBSnapshot type metadata.
+var (
+	DBSnapshotKind             = reflect.TypeOf(DBSnapshot{}).Name()
+	DBSnapshotGroupKind        = schema.GroupKind{Group: Group, Kind: DBSnapshotKind}.String()
+	DBSnapshotKindAPIVersion   = DBSnapshotKind + "." + SchemeGroupVersion.String()
+	DBSnapshotGroupVersionKind = SchemeGroupVersion.WithKind(DBSnapshotKind)
+)
+
+// RDSClone type metadata.
+var (
+	RDSCloneKind             = reflect.TypeOf(RDSClone{}).Name()
+	RDSCloneGroupKind        = schema.GroupKind{Group: Group, Kind: RDSCloneKind}.String()
+	RDSCloneKindAPIVersion   = RDSCloneKind + "." + SchemeGroupVersion.String()
+	RDSCloneGroupVersionKind = SchemeGroupVersion.WithKind(RDSCloneKind)
+)
+
+// DBProxy type metadata.
+var (
+	DBProxyKind             = reflect.TypeOf(DBProxy{}).Name()
+	DBProxyGroupKind        = schema.GroupKind{Group: Group, Kind: DBProxyKind}.String()
+	DBProxyKindAPIVersion   = DBProxyKind + "." + SchemeGroupVersion.String()
+	DBProxyGroupVersionKind = SchemeGroupVersion.WithKind(DBProxyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&OptionGroup{}, &OptionGroupList{})
+	SchemeBuilder.Register(&DBSnapshot{}, &DBSnapshotList{})
+	SchemeBuilder.Register(&RDSClone{}, &RDSCloneList{})
+	SchemeBuilder.Register(&DBProxy{}, &DBProxyList{})
+}
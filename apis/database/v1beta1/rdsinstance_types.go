@@ -17,6 +17,7 @@ limitations under the License.
 package v1beta1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -28,6 +29,14 @@ const (
 	PostgresqlEngine = "postgres"
 )
 
+// ResourceCredentialsSecretDatabaseKey is the key inside a connection secret
+// for the name of the database created in the instance.
+const ResourceCredentialsSecretDatabaseKey = "database"
+
+// ResourceCredentialsSecretReaderEndpointKey is the key inside a connection
+// secret for the reader endpoint of the DB cluster an instance belongs to.
+const ResourceCredentialsSecretReaderEndpointKey = "readerEndpoint"
+
 // Tag is a metadata assigned to an Amazon RDS resource consisting of a key-value pair.
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/rds-2014-10-31/Tag
 type Tag struct {
@@ -106,6 +115,19 @@ type ScalingConfiguration struct {
 	SecondsUntilAutoPause *int `json:"secondsUntilAutoPause,omitempty"`
 }
 
+// PasswordCharacterClass is a class of characters an auto-generated password
+// is made of.
+// +kubebuilder:validation:Enum=Lowercase;Uppercase;Digits;Symbols
+type PasswordCharacterClass string
+
+// Character classes of auto-generated passwords.
+const (
+	PasswordCharacterClassLowercase PasswordCharacterClass = "Lowercase"
+	PasswordCharacterClassUppercase PasswordCharacterClass = "Uppercase"
+	PasswordCharacterClassDigits    PasswordCharacterClass = "Digits"
+	PasswordCharacterClassSymbols   PasswordCharacterClass = "Symbols"
+)
+
 // RDSInstanceParameters define the desired state of an AWS Relational Database
 // Service instance.
 type RDSInstanceParameters struct {
@@ -154,6 +176,7 @@ type RDSInstanceParameters struct {
 	//    * Magnetic storage (standard):
 	// Enterprise and Standard editions: Must be an integer from 200 to 1024.
 	// Web and Express editions: Must be an integer from 20 to 1024.
+	// +kubebuilder:validation:Minimum=5
 	// +optional
 	AllocatedStorage *int `json:"allocatedStorage,omitempty"`
 
@@ -187,10 +210,14 @@ type RDSInstanceParameters struct {
 	// Constraints:
 	//    * Must be a value from 0 to 35
 	//    * Cannot be set to 0 if the DB instance is a source to Read Replicas
+	// +kubebuilder:validation:Maximum=35
+	// +kubebuilder:validation:Minimum=0
 	// +optional
 	BackupRetentionPeriod *int `json:"backupRetentionPeriod,omitempty"`
 
 	// CACertificateIdentifier indicates the certificate that needs to be associated with the instance.
+	// The creation API does not accept a CA certificate, so it is applied
+	// with a modification once the instance is available.
 	// +optional
 	CACertificateIdentifier *string `json:"caCertificateIdentifier,omitempty"`
 
@@ -210,11 +237,26 @@ type RDSInstanceParameters struct {
 
 	// DBClusterIdentifier is the identifier of the DB cluster that the instance will belong to.
 	// For information on creating a DB cluster, see CreateDBCluster.
+	// The master username and password of a cluster member are managed by
+	// the cluster, so none is generated, and the connection secret holds the
+	// endpoints of the cluster.
 	// Type: String
 	// +immutable
 	// +optional
 	DBClusterIdentifier *string `json:"dbClusterIdentifier,omitempty"`
 
+	// DBClusterIdentifierRef is a reference to a DBCluster used to set the
+	// DBClusterIdentifier.
+	// +immutable
+	// +optional
+	DBClusterIdentifierRef *xpv1.Reference `json:"dbClusterIdentifierRef,omitempty"`
+
+	// DBClusterIdentifierSelector selects a reference to a DBCluster used to
+	// set the DBClusterIdentifier.
+	// +immutable
+	// +optional
+	DBClusterIdentifierSelector *xpv1.Selector `json:"dbClusterIdentifierSelector,omitempty"`
+
 	// DBInstanceClass is the compute and memory capacity of the DB instance, for example, db.m4.large.
 	// Not all DB instance classes are available in all AWS Regions, or for all
 	// database engines. For the full list of DB instance classes, and availability
@@ -262,6 +304,7 @@ type RDSInstanceParameters struct {
 	// Constraints:
 	//    * Must contain 1 to 64 letters or numbers.
 	//    * Cannot be a word reserved by the specified database engine
+	// The name is published to the connection secret under the database key.
 	// +immutable
 	// +optional
 	DBName *string `json:"dbName,omitempty"`
@@ -376,7 +419,8 @@ type RDSInstanceParameters struct {
 	// Constraints: Must be a multiple between 1 and 50 of the storage amount for
 	// the DB instance. Must also be an integer multiple of 1000. For example, if
 	// the size of your DB instance is 500 GiB, then your IOPS value can be 2000,
-	// 3000, 4000, or 5000.
+	// 3000, 4000, or 5000. The io2 storage type allows up to 500 times the
+	// storage amount. IOPS can only be set for io1 and io2 storage types.
 	// +optional
 	IOPS *int `json:"iops,omitempty"`
 
@@ -441,16 +485,39 @@ type RDSInstanceParameters struct {
 	// MasterPasswordSecretRef references the secret that contains the password used
 	// in the creation of this RDS instance. If no reference is given, a password
 	// will be auto-generated.
+	// Omit writeConnectionSecretToRef to not write a connection secret. In that
+	// case a password should be given here, since an auto-generated one is only
+	// written to the connection secret, and changes to it are not detected
+	// because there is no connection secret to compare it with.
 	// +optional
 	// +immutable
 	MasterPasswordSecretRef *xpv1.SecretKeySelector `json:"masterPasswordSecretRef,omitempty"`
 
+	// MasterPasswordLength is the length of the auto-generated password.
+	// Defaults to 27. Engines limit the length, e.g. MySQL to 41 and Oracle
+	// to 30 characters.
+	// +kubebuilder:validation:Minimum=8
+	// +kubebuilder:validation:Maximum=128
+	// +optional
+	// +immutable
+	MasterPasswordLength *int `json:"masterPasswordLength,omitempty"`
+
+	// MasterPasswordCharacterClasses are the classes of characters the
+	// auto-generated password is made of. It contains at least one character
+	// of each class. Defaults to Lowercase, Uppercase and Digits. Symbols
+	// never include the characters RDS does not allow in passwords: /, @, "
+	// and space.
+	// +optional
+	// +immutable
+	MasterPasswordCharacterClasses []PasswordCharacterClass `json:"masterPasswordCharacterClasses,omitempty"`
+
 	// MonitoringInterval is the interval, in seconds, between points when Enhanced Monitoring metrics
 	// are collected for the DB instance. To disable collecting Enhanced Monitoring
 	// metrics, specify 0. The default is 0.
 	// If MonitoringRoleARN is specified, then you must also set MonitoringInterval
 	// to a value other than 0.
 	// Valid Values: 0, 1, 5, 10, 15, 30, 60
+	// +kubebuilder:validation:Enum=0;1;5;10;15;30;60
 	// +optional
 	MonitoringInterval *int `json:"monitoringInterval,omitempty"`
 
@@ -517,6 +584,10 @@ type RDSInstanceParameters struct {
 	// Default: 3306
 	// Valid Values: 1150-65535
 	// Type: Integer
+	// Changing the port of an existing instance reboots it. The new port is
+	// written to the connection secret once the reboot has completed.
+	// +kubebuilder:validation:Maximum=65535
+	// +kubebuilder:validation:Minimum=1150
 	// +optional
 	Port *int `json:"port,omitempty"`
 
@@ -561,6 +632,8 @@ type RDSInstanceParameters struct {
 	// in the Amazon Aurora User Guide.
 	// Default: 1
 	// Valid Values: 0 - 15
+	// +kubebuilder:validation:Maximum=15
+	// +kubebuilder:validation:Minimum=0
 	// +optional
 	PromotionTier *int `json:"promotionTier,omitempty"`
 
@@ -582,6 +655,9 @@ type RDSInstanceParameters struct {
 	//    attached to it, the DB instance is private.
 	//    * If the subnets are part of a VPC that has an Internet gateway attached
 	//    to it, the DB instance is public.
+	// Changing this on an existing instance is applied through ModifyDBInstance
+	// and may change the IP address the endpoint resolves to. The instance
+	// reports as unavailable until the modification completes.
 	// +optional
 	PubliclyAccessible *bool `json:"publiclyAccessible,omitempty"`
 
@@ -601,8 +677,8 @@ type RDSInstanceParameters struct {
 	StorageEncrypted *bool `json:"storageEncrypted,omitempty"`
 
 	// StorageType specifies the storage type to be associated with the DB instance.
-	// Valid values: standard | gp2 | io1
-	// If you specify io1, you must also include a value for the IOPS parameter.
+	// Valid values: standard | gp2 | io1 | io2
+	// If you specify io1 or io2, you must also include a value for the IOPS parameter.
 	// Default: io1 if the IOPS parameter is specified, otherwise standard
 	// +optional
 	StorageType *string `json:"storageType,omitempty"`
@@ -661,6 +737,24 @@ type RDSInstanceParameters struct {
 	// +optional
 	AllowMajorVersionUpgrade *bool `json:"allowMajorVersionUpgrade,omitempty"`
 
+	// AllowSubnetGroupChange indicates that the instance may be moved to a
+	// different DB subnet group when DBSubnetGroupName changes. Moving an
+	// instance can place it in a different Availability Zone and causes an
+	// outage, so such changes are refused unless this is set to true.
+	// +optional
+	AllowSubnetGroupChange *bool `json:"allowSubnetGroupChange,omitempty"`
+
+	// MajorVersionUpgradeStrategy determines how a major engine version
+	// change that is allowed by AllowMajorVersionUpgrade is applied. InPlace
+	// modifies the instance. SnapshotRestore leaves the instance untouched,
+	// restores a snapshot of it to a new upgrade candidate instance and
+	// upgrades the candidate, which can be validated before cutting over to
+	// it with the database.aws.crossplane.io/upgrade-cutover annotation.
+	// InPlace is used if none is given.
+	// +kubebuilder:validation:Enum=InPlace;SnapshotRestore
+	// +optional
+	MajorVersionUpgradeStrategy *string `json:"majorVersionUpgradeStrategy,omitempty"`
+
 	// ApplyModificationsImmediately specifies whether the modifications in this request and any pending modifications
 	// are asynchronously applied as soon as possible, regardless of the PreferredMaintenanceWindow
 	// setting for the DB instance.
@@ -721,6 +815,16 @@ type RDSInstanceParameters struct {
 	// +optional
 	OptionGroupName *string `json:"optionGroupName,omitempty"`
 
+	// OptionGroupNameRef is a reference to an OptionGroup used to set
+	// OptionGroupName.
+	// +optional
+	OptionGroupNameRef *xpv1.Reference `json:"optionGroupNameRef,omitempty"`
+
+	// OptionGroupNameSelector selects a reference to an OptionGroup used to
+	// set OptionGroupName.
+	// +optional
+	OptionGroupNameSelector *xpv1.Selector `json:"optionGroupNameSelector,omitempty"`
+
 	// A value that specifies that the DB instance class of the DB instance uses
 	// its default processor features.
 	UseDefaultProcessorFeatures *bool `json:"useDefaultProcessorFeatures,omitempty"`
@@ -749,10 +853,117 @@ type RDSInstanceParameters struct {
 	FinalDBSnapshotIdentifier *string `json:"finalDBSnapshotIdentifier,omitempty"`
 }
 
+// RDSInstanceReadinessProbe configures a TCP connectivity check that has to
+// succeed before an available RDSInstance is reported as Available.
+type RDSInstanceReadinessProbe struct {
+	// TimeoutSeconds is how long to wait for a TCP connection to the endpoint
+	// address and port to be established. Defaults to 5.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	TimeoutSeconds *int `json:"timeoutSeconds,omitempty"`
+}
+
 // An RDSInstanceSpec defines the desired state of an RDSInstance.
 type RDSInstanceSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
-	ForProvider       RDSInstanceParameters `json:"forProvider"`
+
+	// ConnectionSecretKeyMap renames the keys written to the connection
+	// secret, e.g. endpoint: DB_HOST. Keys that are not in the map are written
+	// with their default names.
+	// +optional
+	ConnectionSecretKeyMap map[string]string `json:"connectionSecretKeyMap,omitempty"`
+
+	// SecretsManagerSecretName is the name of an AWS Secrets Manager secret in
+	// the region of the instance that the connection details are mirrored to
+	// as a JSON object, in addition to the connection secret. The secret is
+	// created if it doesn't exist and kept in sync when the password changes.
+	// When the RDSInstance is deleted, a secret it created is deleted
+	// immediately, without a recovery window, while only the connection
+	// details are removed from a secret that existed before.
+	// +optional
+	SecretsManagerSecretName *string `json:"secretsManagerSecretName,omitempty"`
+
+	// ReadinessProbe makes the RDSInstance report Available only once the
+	// endpoint accepts TCP connections, rather than as soon as AWS reports
+	// the instance as available. DNS for a new endpoint may lag behind the
+	// instance status.
+	// +optional
+	ReadinessProbe *RDSInstanceReadinessProbe `json:"readinessProbe,omitempty"`
+
+	ForProvider RDSInstanceParameters `json:"forProvider"`
+}
+
+// Major version upgrade strategies of an RDSInstance.
+const (
+	MajorVersionUpgradeStrategyInPlace         = "InPlace"
+	MajorVersionUpgradeStrategySnapshotRestore = "SnapshotRestore"
+)
+
+// AnnotationKeyUpgradeCutover requests the cutover of an RDSInstance to its
+// upgrade candidate once the candidate runs the desired engine version. Its
+// value has to be the identifier of the candidate, as reported in
+// status.atProvider.upgradeCandidate, so that only a validated candidate is
+// cut over to. The external name of the RDSInstance is then set to the
+// candidate and the identifier of the original instance is recorded in
+// status.atProvider.previousDBInstanceIdentifier. The original instance and
+// its snapshot are left in place for a rollback until they are deleted with
+// the database.aws.crossplane.io/delete-previous-instance annotation or along
+// with the RDSInstance.
+const AnnotationKeyUpgradeCutover = "database.aws.crossplane.io/upgrade-cutover"
+
+// AnnotationKeyDeletePreviousInstance requests the deletion of the instance an
+// RDSInstance was cut over from by a SnapshotRestore upgrade, and of the
+// snapshot its upgrade candidate was restored from. Its value has to be the
+// identifier of that instance, as reported in
+// status.atProvider.previousDBInstanceIdentifier. A final snapshot of the
+// instance named after it with a "-final" suffix is taken unless
+// skipFinalSnapshotBeforeDeletion is true.
+const AnnotationKeyDeletePreviousInstance = "database.aws.crossplane.io/delete-previous-instance"
+
+// AnnotationKeyForceDelete allows an RDSInstance that is in use by a claim to
+// be deleted when set to "true". Such deletions are otherwise rejected by the
+// RDSInstance deletion webhook, if it is enabled.
+const AnnotationKeyForceDelete = "database.aws.crossplane.io/force-delete"
+
+// AnnotationKeyValidateOnly makes the controller validate the spec of an
+// RDSInstance that does not exist yet instead of creating it when set to
+// "true". The engine, engine version, instance class and license model are
+// checked against the offerings in the region, and the result is reported in
+// the SpecValid condition. The spec is checked again at the poll interval
+// while the annotation is set. Removing the annotation creates the instance.
+const AnnotationKeyValidateOnly = "database.aws.crossplane.io/validate-only"
+
+// TypeSpecValid indicates whether the spec of an RDSInstance passed the
+// checks of the validate-only mode.
+const TypeSpecValid xpv1.ConditionType = "SpecValid"
+
+// Reasons the spec of an RDSInstance is or is not valid.
+const (
+	ReasonSpecValid   xpv1.ConditionReason = "ValidSpec"
+	ReasonSpecInvalid xpv1.ConditionReason = "InvalidSpec"
+)
+
+// SpecValid returns a condition that indicates the spec of an RDSInstance
+// would be accepted by AWS.
+func SpecValid() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeSpecValid,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonSpecValid,
+	}
+}
+
+// SpecInvalid returns a condition that indicates the spec of an RDSInstance
+// would be rejected by AWS.
+func SpecInvalid(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeSpecValid,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonSpecInvalid,
+		Message:            err.Error(),
+	}
 }
 
 // RDSInstanceState represents the state of an RDS instance.
@@ -1003,6 +1214,10 @@ type RDSInstanceObservation struct {
 	// DBInstanceArn is the Amazon Resource Name (ARN) for the DB instance.
 	DBInstanceArn string `json:"dbInstanceArn,omitempty"`
 
+	// CACertificateIdentifier is the identifier of the CA certificate
+	// currently used by the DB instance.
+	CACertificateIdentifier string `json:"caCertificateIdentifier,omitempty"`
+
 	// DBParameterGroups provides the list of DB parameter groups applied to this DB instance.
 	DBParameterGroups []DBParameterGroupStatus `json:"dbParameterGroups,omitempty"`
 
@@ -1078,6 +1293,33 @@ type RDSInstanceObservation struct {
 	// VPCSecurityGroups provides a list of VPC security group elements that the DB instance belongs
 	// to.
 	VPCSecurityGroups []VPCSecurityGroupMembership `json:"vpcSecurityGroups,omitempty"`
+
+	// UpgradeCandidate is the instance that a major engine version upgrade
+	// is validated on when the SnapshotRestore upgrade strategy is used.
+	UpgradeCandidate *UpgradeCandidate `json:"upgradeCandidate,omitempty"`
+
+	// PreviousDBInstanceIdentifier is the identifier of the instance that the
+	// RDSInstance was cut over from by the last SnapshotRestore upgrade. The
+	// instance is left in place for a rollback until it is deleted with the
+	// database.aws.crossplane.io/delete-previous-instance annotation or along
+	// with the RDSInstance.
+	PreviousDBInstanceIdentifier string `json:"previousDBInstanceIdentifier,omitempty"`
+}
+
+// UpgradeCandidate is a copy of an RDS instance, restored from a snapshot, that
+// is upgraded to a new major engine version.
+type UpgradeCandidate struct {
+	// DBInstanceIdentifier is the identifier of the candidate.
+	DBInstanceIdentifier string `json:"dbInstanceIdentifier"`
+
+	// DBInstanceStatus specifies the current state of the candidate.
+	DBInstanceStatus string `json:"dbInstanceStatus,omitempty"`
+
+	// EngineVersion is the engine version the candidate runs.
+	EngineVersion string `json:"engineVersion,omitempty"`
+
+	// Endpoint is the connection endpoint of the candidate.
+	Endpoint Endpoint `json:"endpoint,omitempty"`
 }
 
 // An RDSInstanceStatus represents the observed state of an RDSInstance.
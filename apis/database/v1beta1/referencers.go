@@ -23,11 +23,25 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
 	network "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	"github.com/crossplane/provider-aws/apis/identity/v1beta1"
 )
 
+// RDSInstanceEndpointAddress returns a function that extracts the endpoint
+// address of an RDSInstance.
+func RDSInstanceEndpointAddress() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*RDSInstance)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.Endpoint.Address
+	}
+}
+
 // ResolveReferences of this DBSubnetGroup
 func (mg *DBSubnetGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -81,6 +95,20 @@ func (mg *RDSInstance) ResolveReferences(ctx context.Context, c client.Reader) e
 	mg.Spec.ForProvider.DomainIAMRoleName = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.DomainIAMRoleNameRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.optionGroupName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.OptionGroupName),
+		Reference:    mg.Spec.ForProvider.OptionGroupNameRef,
+		Selector:     mg.Spec.ForProvider.OptionGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha1.OptionGroup{}, List: &v1alpha1.OptionGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.optionGroupName")
+	}
+	mg.Spec.ForProvider.OptionGroupName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.OptionGroupNameRef = rsp.ResolvedReference
+
 	// Resolve spec.forProvider.monitoringRoleArn
 	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.MonitoringRoleARN),
@@ -502,6 +502,11 @@ func (in *RDSInstanceObservation) DeepCopyInto(out *RDSInstanceObservation) {
 		*out = make([]VPCSecurityGroupMembership, len(*in))
 		copy(*out, *in)
 	}
+	if in.UpgradeCandidate != nil {
+		in, out := &in.UpgradeCandidate, &out.UpgradeCandidate
+		*out = new(UpgradeCandidate)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDSInstanceObservation.
@@ -562,6 +567,16 @@ func (in *RDSInstanceParameters) DeepCopyInto(out *RDSInstanceParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.DBClusterIdentifierRef != nil {
+		in, out := &in.DBClusterIdentifierRef, &out.DBClusterIdentifierRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.DBClusterIdentifierSelector != nil {
+		in, out := &in.DBClusterIdentifierSelector, &out.DBClusterIdentifierSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DBName != nil {
 		in, out := &in.DBName, &out.DBName
 		*out = new(string)
@@ -637,6 +652,16 @@ func (in *RDSInstanceParameters) DeepCopyInto(out *RDSInstanceParameters) {
 		*out = new(v1.SecretKeySelector)
 		**out = **in
 	}
+	if in.MasterPasswordLength != nil {
+		in, out := &in.MasterPasswordLength, &out.MasterPasswordLength
+		*out = new(int)
+		**out = **in
+	}
+	if in.MasterPasswordCharacterClasses != nil {
+		in, out := &in.MasterPasswordCharacterClasses, &out.MasterPasswordCharacterClasses
+		*out = make([]PasswordCharacterClass, len(*in))
+		copy(*out, *in)
+	}
 	if in.MonitoringInterval != nil {
 		in, out := &in.MonitoringInterval, &out.MonitoringInterval
 		*out = new(int)
@@ -747,6 +772,16 @@ func (in *RDSInstanceParameters) DeepCopyInto(out *RDSInstanceParameters) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.AllowSubnetGroupChange != nil {
+		in, out := &in.AllowSubnetGroupChange, &out.AllowSubnetGroupChange
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MajorVersionUpgradeStrategy != nil {
+		in, out := &in.MajorVersionUpgradeStrategy, &out.MajorVersionUpgradeStrategy
+		*out = new(string)
+		**out = **in
+	}
 	if in.ApplyModificationsImmediately != nil {
 		in, out := &in.ApplyModificationsImmediately, &out.ApplyModificationsImmediately
 		*out = new(bool)
@@ -787,6 +822,16 @@ func (in *RDSInstanceParameters) DeepCopyInto(out *RDSInstanceParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.OptionGroupNameRef != nil {
+		in, out := &in.OptionGroupNameRef, &out.OptionGroupNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.OptionGroupNameSelector != nil {
+		in, out := &in.OptionGroupNameSelector, &out.OptionGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.UseDefaultProcessorFeatures != nil {
 		in, out := &in.UseDefaultProcessorFeatures, &out.UseDefaultProcessorFeatures
 		*out = new(bool)
@@ -814,10 +859,47 @@ func (in *RDSInstanceParameters) DeepCopy() *RDSInstanceParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RDSInstanceReadinessProbe) DeepCopyInto(out *RDSInstanceReadinessProbe) {
+	*out = *in
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDSInstanceReadinessProbe.
+func (in *RDSInstanceReadinessProbe) DeepCopy() *RDSInstanceReadinessProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(RDSInstanceReadinessProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RDSInstanceSpec) DeepCopyInto(out *RDSInstanceSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	if in.ConnectionSecretKeyMap != nil {
+		in, out := &in.ConnectionSecretKeyMap, &out.ConnectionSecretKeyMap
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SecretsManagerSecretName != nil {
+		in, out := &in.SecretsManagerSecretName, &out.SecretsManagerSecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(RDSInstanceReadinessProbe)
+		(*in).DeepCopyInto(*out)
+	}
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
@@ -929,6 +1011,22 @@ func (in *Tag) DeepCopy() *Tag {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeCandidate) DeepCopyInto(out *UpgradeCandidate) {
+	*out = *in
+	out.Endpoint = in.Endpoint
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeCandidate.
+func (in *UpgradeCandidate) DeepCopy() *UpgradeCandidate {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeCandidate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCSecurityGroupMembership) DeepCopyInto(out *VPCSecurityGroupMembership) {
 	*out = *in
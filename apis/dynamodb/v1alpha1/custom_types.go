@@ -32,7 +32,36 @@ type CustomBackupParameters struct {
 }
 
 // CustomTableParameters are custom parameters for Table.
-type CustomTableParameters struct{}
+type CustomTableParameters struct {
+	// ProvisionedThroughputAutoScaled has to be set to true if the provisioned
+	// throughput of the Table is scaled by ScalableTargets. The provisioned
+	// throughput is then only used to create the Table, and the changes made
+	// by Application Auto Scaling are not reverted.
+	// +optional
+	ProvisionedThroughputAutoScaled *bool `json:"provisionedThroughputAutoScaled,omitempty"`
+
+	// TimeToLiveSpecification configures the attribute that holds the
+	// expiry time of the items of the Table. Leave it empty if you do not
+	// want the time to live setting to be managed.
+	// +optional
+	TimeToLiveSpecification *CustomTimeToLiveSpecification `json:"timeToLiveSpecification,omitempty"`
+
+	// PointInTimeRecoveryEnabled indicates whether point in time recovery
+	// is enabled for the Table. It is not managed if left empty.
+	// +optional
+	PointInTimeRecoveryEnabled *bool `json:"pointInTimeRecoveryEnabled,omitempty"`
+}
+
+// CustomTimeToLiveSpecification represents the time to live settings of a
+// Table.
+type CustomTimeToLiveSpecification struct {
+	// AttributeName is the name of the attribute that stores the expiry time
+	// of an item as a Unix epoch timestamp in seconds.
+	AttributeName string `json:"attributeName"`
+
+	// Enabled indicates whether time to live is enabled for the Table.
+	Enabled bool `json:"enabled"`
+}
 
 // CustomGlobalTableParameters are custom parameters for GlobalTable.
 type CustomGlobalTableParameters struct{}
@@ -666,6 +666,21 @@ func (in *CustomGlobalTableParameters) DeepCopy() *CustomGlobalTableParameters {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomTableParameters) DeepCopyInto(out *CustomTableParameters) {
 	*out = *in
+	if in.ProvisionedThroughputAutoScaled != nil {
+		in, out := &in.ProvisionedThroughputAutoScaled, &out.ProvisionedThroughputAutoScaled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TimeToLiveSpecification != nil {
+		in, out := &in.TimeToLiveSpecification, &out.TimeToLiveSpecification
+		*out = new(CustomTimeToLiveSpecification)
+		**out = **in
+	}
+	if in.PointInTimeRecoveryEnabled != nil {
+		in, out := &in.PointInTimeRecoveryEnabled, &out.PointInTimeRecoveryEnabled
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomTableParameters.
@@ -678,6 +693,21 @@ func (in *CustomTableParameters) DeepCopy() *CustomTableParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomTimeToLiveSpecification) DeepCopyInto(out *CustomTimeToLiveSpecification) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomTimeToLiveSpecification.
+func (in *CustomTimeToLiveSpecification) DeepCopy() *CustomTimeToLiveSpecification {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomTimeToLiveSpecification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Delete) DeepCopyInto(out *Delete) {
 	*out = *in
@@ -2607,7 +2637,7 @@ func (in *TableParameters) DeepCopyInto(out *TableParameters) {
 			}
 		}
 	}
-	out.CustomTableParameters = in.CustomTableParameters
+	in.CustomTableParameters.DeepCopyInto(&out.CustomTableParameters)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableParameters.
@@ -0,0 +1,198 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// Instance power states.
+const (
+	InstancePowerRunning = "Running"
+	InstancePowerStopped = "Stopped"
+)
+
+// Instance states.
+const (
+	InstanceStatePending      = "pending"
+	InstanceStateRunning      = "running"
+	InstanceStateShuttingDown = "shutting-down"
+	InstanceStateTerminated   = "terminated"
+	InstanceStateStopping     = "stopping"
+	InstanceStateStopped      = "stopped"
+)
+
+// Instance connection detail keys.
+const (
+	ResourceCredentialsSecretInstanceIDKey = "instanceId"
+	ResourceCredentialsSecretPrivateIPKey  = "privateIp"
+	ResourceCredentialsSecretPublicIPKey   = "publicIp"
+)
+
+// InstanceParameters define the desired state of an EC2 Instance.
+type InstanceParameters struct {
+	// Region is the region you'd like your Instance to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// ImageID is the ID of the AMI to launch the instance from.
+	// +immutable
+	ImageID string `json:"imageId"`
+
+	// InstanceType is the instance type, e.g. t3.micro.
+	// +immutable
+	InstanceType string `json:"instanceType"`
+
+	// SubnetID is the ID of the subnet to launch the instance in.
+	// +immutable
+	// +optional
+	SubnetID *string `json:"subnetId,omitempty"`
+
+	// SubnetIDRef references a Subnet to retrieve its subnetId
+	// +immutable
+	// +optional
+	SubnetIDRef *xpv1.Reference `json:"subnetIdRef,omitempty"`
+
+	// SubnetIDSelector selects a reference to a Subnet to retrieve its subnetId
+	// +immutable
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// SecurityGroupIDs are the IDs of the security groups of the instance.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs references SecurityGroups to retrieve their groupIds
+	// +optional
+	SecurityGroupIDRefs []xpv1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups to
+	// retrieve their groupIds
+	// +optional
+	SecurityGroupIDSelector *xpv1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// KeyName is the name of the key pair to allow SSH access with.
+	// +immutable
+	// +optional
+	KeyName *string `json:"keyName,omitempty"`
+
+	// UserData is the user data to make available to the instance. It is
+	// base64 encoded by the controller.
+	// +immutable
+	// +optional
+	UserData *string `json:"userData,omitempty"`
+
+	// AllocationID is the allocation ID of an Elastic IP address to
+	// associate with the instance. It replaces any address that is already
+	// associated with the instance.
+	// +optional
+	AllocationID *string `json:"allocationId,omitempty"`
+
+	// AllocationIDRef references an Address to retrieve its allocationId
+	// +optional
+	AllocationIDRef *xpv1.Reference `json:"allocationIdRef,omitempty"`
+
+	// AllocationIDSelector selects a reference to an Address to retrieve its
+	// allocationId
+	// +optional
+	AllocationIDSelector *xpv1.Selector `json:"allocationIdSelector,omitempty"`
+
+	// Power is the desired power state of the instance. The instance is
+	// started or stopped to match it. Defaults to Running.
+	// +kubebuilder:validation:Enum=Running;Stopped
+	// +optional
+	Power *string `json:"power,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// An InstanceSpec defines the desired state of an Instance.
+type InstanceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InstanceParameters `json:"forProvider"`
+}
+
+// InstanceObservation keeps the state for the external resource
+type InstanceObservation struct {
+	// InstanceID is the ID of the instance.
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// State is the current state of the instance.
+	State string `json:"state,omitempty"`
+
+	// PrivateIPAddress is the private IPv4 address of the instance.
+	PrivateIPAddress string `json:"privateIpAddress,omitempty"`
+
+	// PublicIPAddress is the public IPv4 address of the instance, if any.
+	PublicIPAddress string `json:"publicIpAddress,omitempty"`
+
+	// PrivateDNSName is the private DNS hostname of the instance.
+	PrivateDNSName string `json:"privateDnsName,omitempty"`
+
+	// PublicDNSName is the public DNS hostname of the instance, if any.
+	PublicDNSName string `json:"publicDnsName,omitempty"`
+
+	// VPCID is the ID of the VPC the instance is running in.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// AddressAssociationID is the ID of the association of the Elastic IP
+	// address given by allocationId with the instance.
+	AddressAssociationID string `json:"addressAssociationId,omitempty"`
+
+	// LaunchTime is the time the instance was launched.
+	LaunchTime *metav1.Time `json:"launchTime,omitempty"`
+}
+
+// An InstanceStatus represents the observed state of an Instance.
+type InstanceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          InstanceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Instance is a managed resource that represents an AWS EC2 Instance.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="PRIVATE-IP",type="string",JSONPath=".status.atProvider.privateIpAddress"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Instance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InstanceSpec   `json:"spec"`
+	Status InstanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InstanceList contains a list of Instances
+type InstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Instance `json:"items"`
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// NetworkACLEntry describes a rule in a network ACL. Entries are identified
+// by their rule number and direction.
+type NetworkACLEntry struct {
+	// The rule number for the entry. ACL entries are processed in ascending
+	// order by rule number. Constraints: Positive integer from 1 to 32766.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=32766
+	RuleNumber int64 `json:"ruleNumber"`
+
+	// Indicates whether this is an egress rule (rule is applied to traffic
+	// leaving the subnet).
+	// +optional
+	Egress bool `json:"egress,omitempty"`
+
+	// The protocol number. A value of "-1" means all protocols. If you specify
+	// "-1" or a protocol number other than "6" (TCP), "17" (UDP), or "1"
+	// (ICMP), traffic on all ports is allowed, regardless of any ports or ICMP
+	// types or codes that you specify.
+	Protocol string `json:"protocol"`
+
+	// Indicates whether to allow or deny the traffic that matches the rule.
+	// +kubebuilder:validation:Enum=allow;deny
+	RuleAction string `json:"ruleAction"`
+
+	// The IPv4 network range to allow or deny, in CIDR notation (for example
+	// 172.16.0.0/24).
+	// +optional
+	CIDRBlock *string `json:"cidrBlock,omitempty"`
+
+	// The IPv6 network range to allow or deny, in CIDR notation (for example
+	// 2001:db8:1234:1a00::/64).
+	// +optional
+	IPv6CIDRBlock *string `json:"ipv6CidrBlock,omitempty"`
+
+	// The first port in the range. Required if specifying protocol 6 (TCP)
+	// or 17 (UDP).
+	// +optional
+	FromPort *int64 `json:"fromPort,omitempty"`
+
+	// The last port in the range. Required if specifying protocol 6 (TCP) or
+	// 17 (UDP).
+	// +optional
+	ToPort *int64 `json:"toPort,omitempty"`
+
+	// The ICMP type. Required if specifying protocol 1 (ICMP). A value of -1
+	// means all types.
+	// +optional
+	ICMPType *int64 `json:"icmpType,omitempty"`
+
+	// The ICMP code. Required if specifying protocol 1 (ICMP). A value of -1
+	// means all codes for the given ICMP type.
+	// +optional
+	ICMPCode *int64 `json:"icmpCode,omitempty"`
+}
+
+// NetworkACLAssociation describes an association between a network ACL and
+// a subnet.
+type NetworkACLAssociation struct {
+	// The ID of the subnet.
+	// +optional
+	SubnetID *string `json:"subnetId,omitempty"`
+
+	// A referencer to retrieve the ID of a subnet
+	// +optional
+	SubnetIDRef *xpv1.Reference `json:"subnetIdRef,omitempty"`
+
+	// A selector to select a referencer to retrieve the ID of a subnet
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+}
+
+// NetworkACLAssociationState describes an association of the network ACL
+// as observed in AWS.
+type NetworkACLAssociationState struct {
+	// The ID of the association between a network ACL and a subnet.
+	AssociationID string `json:"associationId,omitempty"`
+
+	// The ID of the subnet.
+	SubnetID string `json:"subnetId,omitempty"`
+}
+
+// NetworkACLParameters define the desired state of an AWS VPC Network ACL.
+type NetworkACLParameters struct {
+	// Region is the region you'd like your NetworkACL to be created in.
+	Region string `json:"region"`
+
+	// The entries (rules) of the network ACL. The default rules that deny all
+	// traffic not matched by any other rule are managed by AWS and must not be
+	// listed here.
+	// +optional
+	Entries []NetworkACLEntry `json:"entries,omitempty"`
+
+	// The subnets that should be associated with the network ACL. A subnet
+	// that is removed from this list is associated with the default network
+	// ACL of the VPC again.
+	// +optional
+	Associations []NetworkACLAssociation `json:"associations,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []v1beta1.Tag `json:"tags,omitempty"`
+
+	// VPCID is the ID of the VPC.
+	// +optional
+	// +immutable
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId
+	// +optional
+	// +immutable
+	VPCIDRef *xpv1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId
+	// +optional
+	VPCIDSelector *xpv1.Selector `json:"vpcIdSelector,omitempty"`
+}
+
+// A NetworkACLSpec defines the desired state of a NetworkACL.
+type NetworkACLSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NetworkACLParameters `json:"forProvider"`
+}
+
+// NetworkACLObservation keeps the state for the external resource
+type NetworkACLObservation struct {
+	// The ID of the AWS account that owns the network ACL.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// NetworkACLID is the ID of the NetworkACL.
+	NetworkACLID string `json:"networkAclId,omitempty"`
+
+	// Indicates whether this is the default network ACL for the VPC.
+	IsDefault bool `json:"isDefault,omitempty"`
+
+	// The actual associations of the network ACL.
+	Associations []NetworkACLAssociationState `json:"associations,omitempty"`
+}
+
+// A NetworkACLStatus represents the observed state of a NetworkACL.
+type NetworkACLStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NetworkACLObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NetworkACL is a managed resource that represents an AWS VPC Network ACL.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="VPC",type="string",JSONPath=".spec.forProvider.vpcId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type NetworkACL struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkACLSpec   `json:"spec"`
+	Status NetworkACLStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkACLList contains a list of NetworkACLs
+type NetworkACLList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkACL `json:"items"`
+}
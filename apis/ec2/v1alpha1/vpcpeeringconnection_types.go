@@ -0,0 +1,163 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// VPCPeeringConnectionParameters define the desired state of an AWS VPC
+// Peering Connection.
+type VPCPeeringConnectionParameters struct {
+	// Region is the region of the requester VPC.
+	Region string `json:"region"`
+
+	// VPCID is the ID of the requester VPC.
+	// +optional
+	// +immutable
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId
+	// +optional
+	// +immutable
+	VPCIDRef *xpv1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId
+	// +optional
+	VPCIDSelector *xpv1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// PeerVPCID is the ID of the VPC with which you are creating the VPC
+	// peering connection.
+	// +optional
+	// +immutable
+	PeerVPCID *string `json:"peerVpcId,omitempty"`
+
+	// PeerVPCIDRef references a VPC to retrieve its vpcId. It can only be
+	// used when the peer VPC is managed in this cluster.
+	// +optional
+	// +immutable
+	PeerVPCIDRef *xpv1.Reference `json:"peerVpcIdRef,omitempty"`
+
+	// PeerVPCIDSelector selects a reference to a VPC to retrieve its vpcId
+	// +optional
+	PeerVPCIDSelector *xpv1.Selector `json:"peerVpcIdSelector,omitempty"`
+
+	// The AWS account ID of the owner of the peer VPC. Defaults to the
+	// account of the requester.
+	// +optional
+	// +immutable
+	PeerOwnerID *string `json:"peerOwnerId,omitempty"`
+
+	// The Region code for the peer VPC, if it is different from the
+	// requester's Region.
+	// +optional
+	// +immutable
+	PeerRegion *string `json:"peerRegion,omitempty"`
+
+	// AutoAccept accepts the peering connection request using the
+	// credentials of this managed resource. This only works when the peer
+	// VPC is owned by the same account; a cross-account request has to be
+	// accepted by the owner of the peer VPC.
+	// +optional
+	AutoAccept bool `json:"autoAccept,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// A VPCPeeringConnectionSpec defines the desired state of a
+// VPCPeeringConnection.
+type VPCPeeringConnectionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VPCPeeringConnectionParameters `json:"forProvider"`
+}
+
+// VPCPeeringConnectionVPCInfo describes a VPC in a VPC peering connection.
+type VPCPeeringConnectionVPCInfo struct {
+	// The IPv4 CIDR block for the VPC.
+	CIDRBlock string `json:"cidrBlock,omitempty"`
+
+	// The AWS account ID of the VPC owner.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// The Region in which the VPC is located.
+	Region string `json:"region,omitempty"`
+
+	// The ID of the VPC.
+	VPCID string `json:"vpcId,omitempty"`
+}
+
+// VPCPeeringConnectionObservation keeps the state for the external resource
+type VPCPeeringConnectionObservation struct {
+	// VPCPeeringConnectionID is the ID of the VPC peering connection.
+	VPCPeeringConnectionID string `json:"vpcPeeringConnectionId,omitempty"`
+
+	// The status of the VPC peering connection.
+	Status string `json:"status,omitempty"`
+
+	// A message that provides more information about the status, if
+	// applicable.
+	StatusMessage string `json:"statusMessage,omitempty"`
+
+	// The time that an unaccepted VPC peering connection will expire.
+	ExpirationTime *metav1.Time `json:"expirationTime,omitempty"`
+
+	// Information about the accepter VPC.
+	AccepterVPCInfo VPCPeeringConnectionVPCInfo `json:"accepterVpcInfo,omitempty"`
+
+	// Information about the requester VPC.
+	RequesterVPCInfo VPCPeeringConnectionVPCInfo `json:"requesterVpcInfo,omitempty"`
+}
+
+// A VPCPeeringConnectionStatus represents the observed state of a
+// VPCPeeringConnection.
+type VPCPeeringConnectionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VPCPeeringConnectionObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VPCPeeringConnection is a managed resource that represents an AWS VPC
+// Peering Connection.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VPCPeeringConnection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VPCPeeringConnectionSpec   `json:"spec"`
+	Status VPCPeeringConnectionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VPCPeeringConnectionList contains a list of VPCPeeringConnections
+type VPCPeeringConnectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VPCPeeringConnection `json:"items"`
+}
@@ -22,6 +22,11 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 )
 
+// Address connection detail keys.
+const (
+	ResourceCredentialsSecretPublicIPKey = "publicIp"
+)
+
 // AddressParameters define the desired state of an AWS Elastic IP
 type AddressParameters struct {
 	// Region is the region you'd like your VPC to be created in.
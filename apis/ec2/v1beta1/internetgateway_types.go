@@ -31,6 +31,12 @@ const (
 	AttachmentStatusAttaching = "creating"
 )
 
+const (
+	// ResourceCredentialsSecretInternetGatewayIDKey is the name of the key in
+	// the connection secret for the InternetGateway ID.
+	ResourceCredentialsSecretInternetGatewayIDKey = "internetGatewayId"
+)
+
 // InternetGatewayParameters define the desired state of an AWS VPC Internet
 // Gateway.
 type InternetGatewayParameters struct {
@@ -97,6 +97,9 @@ type RouteState struct {
 	// decisions are based on the most specific match.
 	DestinationIPV6CIDRBlock string `json:"destinationIpv6CidrBlock,omitempty"`
 
+	// The ID of an egress-only internet gateway.
+	EgressOnlyInternetGatewayID string `json:"egressOnlyInternetGatewayId,omitempty"`
+
 	// The ID of an internet gateway or virtual private gateway attached to your
 	// VPC.
 	GatewayID string `json:"gatewayId,omitempty"`
@@ -41,13 +41,16 @@ type SecurityGroupParameters struct {
 	// +immutable
 	GroupName string `json:"groupName"`
 
-	// One or more inbound rules associated with the security group.
+	// One or more inbound rules associated with the security group. Rules
+	// are not managed if omitted, while an empty list revokes all of them.
 	// +optional
-	Ingress []IPPermission `json:"ingress,omitempty"`
+	Ingress []IPPermission `json:"ingress"`
 
 	// [EC2-VPC] One or more outbound rules associated with the security group.
+	// Rules are not managed if omitted, while an empty list revokes all of
+	// them.
 	// +optional
-	Egress []IPPermission `json:"egress,omitempty"`
+	Egress []IPPermission `json:"egress"`
 
 	// Tags represents to current ec2 tags.
 	// +optional
@@ -22,6 +22,12 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 )
 
+const (
+	// ResourceCredentialsSecretSubnetIDKey is the name of the key in the
+	// connection secret for the Subnet ID.
+	ResourceCredentialsSecretSubnetIDKey = "subnetId"
+)
+
 // SubnetParameters define the desired state of an AWS VPC Subnet.
 type SubnetParameters struct {
 
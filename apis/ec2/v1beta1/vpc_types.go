@@ -22,6 +22,12 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 )
 
+const (
+	// ResourceCredentialsSecretVPCIDKey is the name of the key in the
+	// connection secret for the VPC ID.
+	ResourceCredentialsSecretVPCIDKey = "vpcId"
+)
+
 // VPCCIDRBlockState represents the state of a CIDR Block
 type VPCCIDRBlockState struct {
 
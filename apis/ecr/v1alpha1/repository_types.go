@@ -22,6 +22,12 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 )
 
+const (
+	// ResourceCredentialsSecretRepositoryURIKey is the name of the key in the
+	// connection secret for the repository URI.
+	ResourceCredentialsSecretRepositoryURIKey = "repositoryUri"
+)
+
 // RepositoryParameters define the desired state of an AWS Elastic Container Repository
 type RepositoryParameters struct {
 
@@ -41,6 +47,11 @@ type RepositoryParameters struct {
 	// +kubebuilder:validation:Enum=MUTABLE;IMMUTABLE
 	ImageTagMutability *string `json:"imageTagMutability,omitempty"`
 
+	// The JSON text of the lifecycle policy of the repository. The lifecycle
+	// policy of the repository is left untouched if this is omitted.
+	// +optional
+	LifecyclePolicy *string `json:"lifecyclePolicy,omitempty"`
+
 	// Metadata tagging key value pairs
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
@@ -0,0 +1,120 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ECS cluster statuses.
+const (
+	ECSClusterStatusActive         = "ACTIVE"
+	ECSClusterStatusProvisioning   = "PROVISIONING"
+	ECSClusterStatusDeprovisioning = "DEPROVISIONING"
+	ECSClusterStatusFailed         = "FAILED"
+	ECSClusterStatusInactive       = "INACTIVE"
+)
+
+// Tag is a key-value pair attached to an ECS resource.
+type Tag struct {
+	// The key of the tag.
+	Key string `json:"key"`
+
+	// The value of the tag.
+	// +optional
+	Value string `json:"value,omitempty"`
+}
+
+// ECSClusterParameters define the desired state of an AWS ECS Cluster. The
+// name of the cluster is the external name of the resource.
+type ECSClusterParameters struct {
+	// Region is the region you'd like your ECSCluster to be created in.
+	Region string `json:"region"`
+
+	// ContainerInsights turns CloudWatch Container Insights on or off for
+	// the cluster.
+	// +kubebuilder:validation:Enum=enabled;disabled
+	// +optional
+	ContainerInsights *string `json:"containerInsights,omitempty"`
+
+	// Tags to attach to the cluster when it is created.
+	// +immutable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// An ECSClusterSpec defines the desired state of an ECSCluster.
+type ECSClusterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ECSClusterParameters `json:"forProvider"`
+}
+
+// ECSClusterObservation keeps the state for the external resource
+type ECSClusterObservation struct {
+	// The Amazon Resource Name (ARN) of the cluster.
+	ClusterARN string `json:"clusterArn,omitempty"`
+
+	// The status of the cluster.
+	Status string `json:"status,omitempty"`
+
+	// The number of services running on the cluster in an ACTIVE state.
+	ActiveServicesCount int64 `json:"activeServicesCount,omitempty"`
+
+	// The number of tasks in the cluster that are in the RUNNING state.
+	RunningTasksCount int64 `json:"runningTasksCount,omitempty"`
+
+	// The number of tasks in the cluster that are in the PENDING state.
+	PendingTasksCount int64 `json:"pendingTasksCount,omitempty"`
+
+	// The number of container instances registered into the cluster.
+	RegisteredContainerInstancesCount int64 `json:"registeredContainerInstancesCount,omitempty"`
+}
+
+// An ECSClusterStatus represents the observed state of an ECSCluster.
+type ECSClusterStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ECSClusterObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ECSCluster is a managed resource that represents an AWS Elastic
+// Container Service cluster.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ECSCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ECSClusterSpec   `json:"spec"`
+	Status ECSClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ECSClusterList contains a list of ECSClusters
+type ECSClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ECSCluster `json:"items"`
+}
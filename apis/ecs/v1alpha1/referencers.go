@@ -0,0 +1,93 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	ec2 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// ECSClusterARN returns the status.atProvider.clusterArn of an ECSCluster.
+func ECSClusterARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*ECSCluster)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.ClusterARN
+	}
+}
+
+// ResolveReferences of this Service
+func (mg *Service) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.cluster
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Cluster),
+		Reference:    mg.Spec.ForProvider.ClusterRef,
+		Selector:     mg.Spec.ForProvider.ClusterSelector,
+		To:           reference.To{Managed: &ECSCluster{}, List: &ECSClusterList{}},
+		Extract:      ECSClusterARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.cluster")
+	}
+	mg.Spec.ForProvider.Cluster = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ClusterRef = rsp.ResolvedReference
+
+	nc := mg.Spec.ForProvider.NetworkConfiguration
+	if nc == nil {
+		return nil
+	}
+
+	// Resolve spec.forProvider.networkConfiguration.subnets
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: nc.Subnets,
+		References:    nc.SubnetRefs,
+		Selector:      nc.SubnetSelector,
+		To:            reference.To{Managed: &ec2.Subnet{}, List: &ec2.SubnetList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.networkConfiguration.subnets")
+	}
+	nc.Subnets = mrsp.ResolvedValues
+	nc.SubnetRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.networkConfiguration.securityGroups
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: nc.SecurityGroups,
+		References:    nc.SecurityGroupRefs,
+		Selector:      nc.SecurityGroupSelector,
+		To:            reference.To{Managed: &ec2.SecurityGroup{}, List: &ec2.SecurityGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.networkConfiguration.securityGroups")
+	}
+	nc.SecurityGroups = mrsp.ResolvedValues
+	nc.SecurityGroupRefs = mrsp.ResolvedReferences
+
+	return nil
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ECS service statuses.
+const (
+	ServiceStatusActive   = "ACTIVE"
+	ServiceStatusDraining = "DRAINING"
+	ServiceStatusInactive = "INACTIVE"
+)
+
+// DeploymentConfiguration controls how many tasks run during a deployment
+// and the ordering of stopping and starting tasks.
+type DeploymentConfiguration struct {
+	// The upper limit on the number of tasks that are allowed in the
+	// RUNNING or PENDING state during a deployment, as a percentage of the
+	// desired count.
+	// +optional
+	MaximumPercent *int64 `json:"maximumPercent,omitempty"`
+
+	// The lower limit on the number of tasks that must remain in the
+	// RUNNING state during a deployment, as a percentage of the desired
+	// count.
+	// +optional
+	MinimumHealthyPercent *int64 `json:"minimumHealthyPercent,omitempty"`
+}
+
+// ServiceLoadBalancer registers the tasks of the service with a load
+// balancer target group.
+type ServiceLoadBalancer struct {
+	// The ARN of the Elastic Load Balancing target group to register the
+	// tasks with.
+	TargetGroupARN string `json:"targetGroupArn"`
+
+	// The name of the container, as it appears in the task definition, to
+	// associate with the load balancer.
+	ContainerName string `json:"containerName"`
+
+	// The port on the container to associate with the load balancer.
+	ContainerPort int64 `json:"containerPort"`
+}
+
+// NetworkConfiguration is the VPC configuration of tasks that use the awsvpc
+// network mode.
+type NetworkConfiguration struct {
+	// The IDs of the subnets associated with the task or service.
+	// +optional
+	Subnets []string `json:"subnets,omitempty"`
+
+	// SubnetRefs references Subnets to retrieve their IDs.
+	// +optional
+	SubnetRefs []xpv1.Reference `json:"subnetRefs,omitempty"`
+
+	// SubnetSelector selects references to Subnets to retrieve their IDs.
+	// +optional
+	SubnetSelector *xpv1.Selector `json:"subnetSelector,omitempty"`
+
+	// The IDs of the security groups associated with the task or service.
+	// +optional
+	SecurityGroups []string `json:"securityGroups,omitempty"`
+
+	// SecurityGroupRefs references SecurityGroups to retrieve their IDs.
+	// +optional
+	SecurityGroupRefs []xpv1.Reference `json:"securityGroupRefs,omitempty"`
+
+	// SecurityGroupSelector selects references to SecurityGroups to
+	// retrieve their IDs.
+	// +optional
+	SecurityGroupSelector *xpv1.Selector `json:"securityGroupSelector,omitempty"`
+
+	// Whether the task's elastic network interface receives a public IP
+	// address.
+	// +kubebuilder:validation:Enum=ENABLED;DISABLED
+	// +optional
+	AssignPublicIP *string `json:"assignPublicIp,omitempty"`
+}
+
+// ServiceParameters define the desired state of an AWS ECS Service. The name
+// of the service is the external name of the resource.
+type ServiceParameters struct {
+	// Region is the region you'd like your Service to be created in.
+	Region string `json:"region"`
+
+	// The short name or full ARN of the cluster to run the service on.
+	// +immutable
+	// +optional
+	Cluster *string `json:"cluster,omitempty"`
+
+	// ClusterRef references an ECSCluster to retrieve its ARN.
+	// +immutable
+	// +optional
+	ClusterRef *xpv1.Reference `json:"clusterRef,omitempty"`
+
+	// ClusterSelector selects a reference to an ECSCluster to retrieve its
+	// ARN.
+	// +immutable
+	// +optional
+	ClusterSelector *xpv1.Selector `json:"clusterSelector,omitempty"`
+
+	// The family and revision (family:revision) or full ARN of the task
+	// definition to run. If a revision is not specified, the latest ACTIVE
+	// revision is used when the service is created or updated.
+	TaskDefinition string `json:"taskDefinition"`
+
+	// The number of instantiations of the task definition to keep running.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	DesiredCount *int64 `json:"desiredCount,omitempty"`
+
+	// The launch type on which to run the service.
+	// +immutable
+	// +kubebuilder:validation:Enum=EC2;FARGATE
+	// +optional
+	LaunchType *string `json:"launchType,omitempty"`
+
+	// The platform version the tasks run on. Only used with the FARGATE
+	// launch type.
+	// +optional
+	PlatformVersion *string `json:"platformVersion,omitempty"`
+
+	// Optional deployment parameters that control how many tasks run during
+	// the deployment and the ordering of stopping and starting tasks.
+	// +optional
+	DeploymentConfiguration *DeploymentConfiguration `json:"deploymentConfiguration,omitempty"`
+
+	// The period of time, in seconds, that the scheduler ignores unhealthy
+	// load balancer health checks after a task has first started. Only
+	// valid if LoadBalancers is set.
+	// +optional
+	HealthCheckGracePeriodSeconds *int64 `json:"healthCheckGracePeriodSeconds,omitempty"`
+
+	// The load balancer target groups to register the tasks with.
+	// +immutable
+	// +optional
+	LoadBalancers []ServiceLoadBalancer `json:"loadBalancers,omitempty"`
+
+	// The network configuration for the service. Required for task
+	// definitions that use the awsvpc network mode.
+	// +optional
+	NetworkConfiguration *NetworkConfiguration `json:"networkConfiguration,omitempty"`
+
+	// The name or full ARN of the IAM role that allows ECS to make calls to
+	// the load balancer on your behalf.
+	// +immutable
+	// +optional
+	Role *string `json:"role,omitempty"`
+
+	// Tags to attach to the service when it is created.
+	// +immutable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A ServiceSpec defines the desired state of a Service.
+type ServiceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServiceParameters `json:"forProvider"`
+}
+
+// ServiceDeployment is a deployment of the service.
+type ServiceDeployment struct {
+	// The ID of the deployment.
+	ID string `json:"id,omitempty"`
+
+	// The status of the deployment: PRIMARY, ACTIVE or INACTIVE.
+	Status string `json:"status,omitempty"`
+
+	// The task definition the deployment runs.
+	TaskDefinition string `json:"taskDefinition,omitempty"`
+
+	// The number of tasks the deployment wants to keep running.
+	DesiredCount int64 `json:"desiredCount,omitempty"`
+
+	// The number of tasks of the deployment in the RUNNING state.
+	RunningCount int64 `json:"runningCount,omitempty"`
+}
+
+// ServiceObservation keeps the state for the external resource
+type ServiceObservation struct {
+	// The Amazon Resource Name (ARN) of the service.
+	ServiceARN string `json:"serviceArn,omitempty"`
+
+	// The ARN of the cluster that hosts the service.
+	ClusterARN string `json:"clusterArn,omitempty"`
+
+	// The status of the service.
+	Status string `json:"status,omitempty"`
+
+	// The number of tasks in the service that are in the RUNNING state.
+	RunningCount int64 `json:"runningCount,omitempty"`
+
+	// The number of tasks in the service that are in the PENDING state.
+	PendingCount int64 `json:"pendingCount,omitempty"`
+
+	// The current deployments of the service. A service in steady state has
+	// a single deployment.
+	Deployments []ServiceDeployment `json:"deployments,omitempty"`
+
+	// The time the service was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+}
+
+// A ServiceStatus represents the observed state of a Service.
+type ServiceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServiceObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Service is a managed resource that represents an AWS Elastic Container
+// Service service. It reports Available once it has reached steady state.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DESIRED",type="integer",JSONPath=".spec.forProvider.desiredCount"
+// +kubebuilder:printcolumn:name="RUNNING",type="integer",JSONPath=".status.atProvider.runningCount"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Service struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceSpec   `json:"spec"`
+	Status ServiceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceList contains a list of Services
+type ServiceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Service `json:"items"`
+}
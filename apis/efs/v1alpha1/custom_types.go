@@ -24,6 +24,10 @@ const (
 	// ResourceCredentialsSecretIDKey is the name of the key in the connection
 	// secret for FileSystem ID.
 	ResourceCredentialsSecretIDKey = "id"
+
+	// ResourceCredentialsSecretDNSNameKey is the name of the key in the
+	// connection secret for the DNS name of the FileSystem.
+	ResourceCredentialsSecretDNSNameKey = "dnsName"
 )
 
 // CustomFileSystemParameters contains the additional fields for FileSystemParameters.
@@ -0,0 +1,156 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// MountTargetParameters define the desired state of an AWS EFS mount target.
+type MountTargetParameters struct {
+	// Region is the region you'd like your MountTarget to be created in.
+	Region string `json:"region"`
+
+	// FileSystemID is the ID of the file system to create the mount target
+	// for.
+	// +immutable
+	// +optional
+	FileSystemID *string `json:"fileSystemId,omitempty"`
+
+	// FileSystemIDRef references a FileSystem to retrieve its ID.
+	// +immutable
+	// +optional
+	FileSystemIDRef *xpv1.Reference `json:"fileSystemIdRef,omitempty"`
+
+	// FileSystemIDSelector selects a reference to a FileSystem to retrieve
+	// its ID.
+	// +immutable
+	// +optional
+	FileSystemIDSelector *xpv1.Selector `json:"fileSystemIdSelector,omitempty"`
+
+	// SubnetID is the ID of the subnet to add the mount target in. There can
+	// be one mount target per Availability Zone for a file system.
+	// +immutable
+	// +optional
+	SubnetID *string `json:"subnetId,omitempty"`
+
+	// SubnetIDRef references a Subnet to retrieve its subnetId.
+	// +immutable
+	// +optional
+	SubnetIDRef *xpv1.Reference `json:"subnetIdRef,omitempty"`
+
+	// SubnetIDSelector selects a reference to a Subnet to retrieve its
+	// subnetId.
+	// +immutable
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// IPAddress is a valid IPv4 address within the address range of the
+	// subnet. One is picked from the subnet if it's not given.
+	// +immutable
+	// +optional
+	IPAddress *string `json:"ipAddress,omitempty"`
+
+	// SecurityGroups are up to five VPC security group IDs, of the form
+	// sg-xxxxxxxx. They must be for the same VPC as the subnet. The default
+	// security group of the VPC is used if none are given.
+	// +optional
+	SecurityGroups []string `json:"securityGroups,omitempty"`
+
+	// SecurityGroupsRefs references SecurityGroups to retrieve their IDs.
+	// +optional
+	SecurityGroupsRefs []xpv1.Reference `json:"securityGroupsRefs,omitempty"`
+
+	// SecurityGroupsSelector selects references to SecurityGroups to
+	// retrieve their IDs.
+	// +optional
+	SecurityGroupsSelector *xpv1.Selector `json:"securityGroupsSelector,omitempty"`
+}
+
+// A MountTargetSpec defines the desired state of a MountTarget.
+type MountTargetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MountTargetParameters `json:"forProvider"`
+}
+
+// MountTargetObservation keeps the state for the external resource
+type MountTargetObservation struct {
+	// The ID of the mount target.
+	MountTargetID string `json:"mountTargetId,omitempty"`
+
+	// The lifecycle state of the mount target.
+	LifeCycleState string `json:"lifeCycleState,omitempty"`
+
+	// The IPv4 address of the mount target.
+	IPAddress string `json:"ipAddress,omitempty"`
+
+	// The name of the Availability Zone of the mount target.
+	AvailabilityZoneName string `json:"availabilityZoneName,omitempty"`
+
+	// The ID of the network interface that Amazon EFS created when it created
+	// the mount target.
+	NetworkInterfaceID string `json:"networkInterfaceId,omitempty"`
+}
+
+// A MountTargetStatus represents the observed state of a MountTarget.
+type MountTargetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          MountTargetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A MountTarget is a managed resource that represents an AWS EFS mount
+// target.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".status.atProvider.ipAddress"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type MountTarget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MountTargetSpec   `json:"spec"`
+	Status MountTargetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MountTargetList contains a list of MountTargets
+type MountTargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MountTarget `json:"items"`
+}
+
+// MountTarget type metadata.
+var (
+	MountTargetKind             = "MountTarget"
+	MountTargetGroupKind        = schema.GroupKind{Group: Group, Kind: MountTargetKind}.String()
+	MountTargetKindAPIVersion   = MountTargetKind + "." + GroupVersion.String()
+	MountTargetGroupVersionKind = GroupVersion.WithKind(MountTargetKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&MountTarget{}, &MountTargetList{})
+}
@@ -28,3 +28,12 @@ func (l *FileSystemList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this MountTargetList.
+func (l *MountTargetList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Connection secret keys of a LoadBalancer.
+const (
+	ResourceCredentialsSecretDNSNameKey      = "dnsName"
+	ResourceCredentialsSecretHostedZoneIDKey = "hostedZoneId"
+)
+
+// FixedResponseAction returns a custom HTTP response.
+type FixedResponseAction struct {
+	// The HTTP response code (2XX, 4XX, or 5XX).
+	StatusCode string `json:"statusCode"`
+
+	// The content type, e.g. text/plain or application/json.
+	// +optional
+	ContentType *string `json:"contentType,omitempty"`
+
+	// The message body.
+	// +optional
+	MessageBody *string `json:"messageBody,omitempty"`
+}
+
+// RedirectAction redirects requests to another URL. Fields that are not set
+// keep the value of the original request.
+type RedirectAction struct {
+	// The HTTP redirect code.
+	// +kubebuilder:validation:Enum=HTTP_301;HTTP_302
+	StatusCode string `json:"statusCode"`
+
+	// The protocol, HTTP or HTTPS.
+	// +optional
+	Protocol *string `json:"protocol,omitempty"`
+
+	// The port, from 1 to 65535.
+	// +optional
+	Port *string `json:"port,omitempty"`
+
+	// The hostname.
+	// +optional
+	Host *string `json:"host,omitempty"`
+
+	// The absolute path, starting with a leading "/".
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// The query parameters, without the leading "?".
+	// +optional
+	Query *string `json:"query,omitempty"`
+}
+
+// ListenerAction is the action a listener takes for a request.
+type ListenerAction struct {
+	// The type of the action.
+	// +kubebuilder:validation:Enum=forward;fixed-response;redirect
+	Type string `json:"type"`
+
+	// The ARN of the target group to forward requests to. Required if Type
+	// is forward.
+	// +optional
+	TargetGroupARN *string `json:"targetGroupArn,omitempty"`
+
+	// The response to return. Required if Type is fixed-response.
+	// +optional
+	FixedResponse *FixedResponseAction `json:"fixedResponse,omitempty"`
+
+	// The redirect to send. Required if Type is redirect.
+	// +optional
+	Redirect *RedirectAction `json:"redirect,omitempty"`
+}
+
+// LoadBalancerListener checks for connection requests on a port of the
+// LoadBalancer.
+type LoadBalancerListener struct {
+	// The port on which the load balancer is listening. Listeners are
+	// identified by their port.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int64 `json:"port"`
+
+	// The protocol for connections from clients to the load balancer.
+	// +kubebuilder:validation:Enum=HTTP;HTTPS
+	Protocol string `json:"protocol"`
+
+	// The ARN of the default certificate. Required if Protocol is HTTPS.
+	// +optional
+	CertificateARN *string `json:"certificateArn,omitempty"`
+
+	// CertificateARNRef references an ACM Certificate to retrieve its ARN.
+	// +optional
+	CertificateARNRef *xpv1.Reference `json:"certificateArnRef,omitempty"`
+
+	// CertificateARNSelector selects a reference to an ACM Certificate to
+	// retrieve its ARN.
+	// +optional
+	CertificateARNSelector *xpv1.Selector `json:"certificateArnSelector,omitempty"`
+
+	// The security policy that defines which protocols and ciphers are
+	// supported. Only used if Protocol is HTTPS.
+	// +optional
+	SSLPolicy *string `json:"sslPolicy,omitempty"`
+
+	// The action taken for requests to this listener.
+	DefaultAction ListenerAction `json:"defaultAction"`
+}
+
+// LoadBalancerParameters define the desired state of an AWS Application Load
+// Balancer.
+type LoadBalancerParameters struct {
+	// Region is the region you'd like your LoadBalancer to be created in.
+	Region string `json:"region"`
+
+	// Whether the load balancer is reachable from the internet or only from
+	// within its VPC.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=internet-facing;internal
+	Scheme *string `json:"scheme,omitempty"`
+
+	// The type of IP addresses used by the subnets of the load balancer.
+	// +optional
+	// +kubebuilder:validation:Enum=ipv4;dualstack
+	IPAddressType *string `json:"ipAddressType,omitempty"`
+
+	// The IDs of the subnets to attach to the load balancer. At least two
+	// subnets in different Availability Zones are required.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs references Subnets to retrieve their SubnetIDs.
+	// +optional
+	SubnetIDRefs []xpv1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to Subnets to retrieve their
+	// SubnetIDs.
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// The IDs of the security groups to assign to the load balancer.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs references SecurityGroups to retrieve their
+	// SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDRefs []xpv1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups to
+	// retrieve their SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDSelector *xpv1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// The listeners of the load balancer.
+	// +optional
+	Listeners []LoadBalancerListener `json:"listeners,omitempty"`
+
+	// A list of tags to assign to the load balancer.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A LoadBalancerSpec defines the desired state of a LoadBalancer.
+type LoadBalancerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LoadBalancerParameters `json:"forProvider"`
+}
+
+// LoadBalancerObservation keeps the state for the external resource
+type LoadBalancerObservation struct {
+	// The ARN of the load balancer.
+	LoadBalancerARN string `json:"loadBalancerArn,omitempty"`
+
+	// The DNS name of the load balancer.
+	DNSName string `json:"dnsName,omitempty"`
+
+	// The ID of the Amazon Route 53 hosted zone of the load balancer.
+	CanonicalHostedZoneID string `json:"canonicalHostedZoneId,omitempty"`
+
+	// The state of the load balancer.
+	State string `json:"state,omitempty"`
+
+	// The ID of the VPC of the load balancer.
+	VPCID string `json:"vpcId,omitempty"`
+}
+
+// A LoadBalancerStatus represents the observed state of a LoadBalancer.
+type LoadBalancerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          LoadBalancerObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LoadBalancer is a managed resource that represents an AWS Application
+// Load Balancer.
+// +kubebuilder:printcolumn:name="DNSNAME",type="string",JSONPath=".status.atProvider.dnsName"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type LoadBalancer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LoadBalancerSpec   `json:"spec"`
+	Status LoadBalancerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LoadBalancerList contains a list of LoadBalancers
+type LoadBalancerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoadBalancer `json:"items"`
+}
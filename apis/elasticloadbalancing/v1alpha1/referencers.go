@@ -23,10 +23,35 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
+	acm "github.com/crossplane/provider-aws/apis/acm/v1alpha1"
 	ec2 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 )
 
+// LoadBalancerARN returns the status.atProvider.loadBalancerArn of a
+// LoadBalancer.
+func LoadBalancerARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*LoadBalancer)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.LoadBalancerARN
+	}
+}
+
+// LoadBalancerDNSName returns the status.atProvider.dnsName of a LoadBalancer.
+func LoadBalancerDNSName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*LoadBalancer)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.DNSName
+	}
+}
+
 // ResolveReferences of this ELB
 func (mg *ELB) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -82,3 +107,55 @@ func (mg *ELBAttachment) ResolveReferences(ctx context.Context, c client.Reader)
 
 	return nil
 }
+
+// ResolveReferences of this LoadBalancer
+func (mg *LoadBalancer) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.subnetIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.SubnetIDs,
+		References:    mg.Spec.ForProvider.SubnetIDRefs,
+		Selector:      mg.Spec.ForProvider.SubnetIDSelector,
+		To:            reference.To{Managed: &ec2.Subnet{}, List: &ec2.SubnetList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.subnetIds")
+	}
+	mg.Spec.ForProvider.SubnetIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.SubnetIDRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.securityGroupIds
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.SecurityGroupIDs,
+		References:    mg.Spec.ForProvider.SecurityGroupIDRefs,
+		Selector:      mg.Spec.ForProvider.SecurityGroupIDSelector,
+		To:            reference.To{Managed: &ec2.SecurityGroup{}, List: &ec2.SecurityGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.securityGroupIds")
+	}
+	mg.Spec.ForProvider.SecurityGroupIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.SecurityGroupIDRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.listeners[].certificateArn
+	for i := range mg.Spec.ForProvider.Listeners {
+		l := &mg.Spec.ForProvider.Listeners[i]
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(l.CertificateARN),
+			Reference:    l.CertificateARNRef,
+			Selector:     l.CertificateARNSelector,
+			To:           reference.To{Managed: &acm.Certificate{}, List: &acm.CertificateList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.listeners[%d].certificateArn", i)
+		}
+		l.CertificateARN = reference.ToPtrValue(rsp.ResolvedValue)
+		l.CertificateARNRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
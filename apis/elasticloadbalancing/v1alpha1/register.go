@@ -53,7 +53,16 @@ var (
 	ELBAttachmentGroupVersionKind = SchemeGroupVersion.WithKind(ELBAttachmentKind)
 )
 
+// LoadBalancer type metadata.
+var (
+	LoadBalancerKind             = reflect.TypeOf(LoadBalancer{}).Name()
+	LoadBalancerGroupKind        = schema.GroupKind{Group: Group, Kind: LoadBalancerKind}.String()
+	LoadBalancerKindAPIVersion   = LoadBalancerKind + "." + SchemeGroupVersion.String()
+	LoadBalancerGroupVersionKind = SchemeGroupVersion.WithKind(LoadBalancerKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&ELB{}, &ELBList{})
 	SchemeBuilder.Register(&ELBAttachment{}, &ELBAttachmentList{})
+	SchemeBuilder.Register(&LoadBalancer{}, &LoadBalancerList{})
 }
@@ -37,3 +37,12 @@ func (l *ELBList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this LoadBalancerList.
+func (l *LoadBalancerList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
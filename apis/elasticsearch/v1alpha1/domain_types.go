@@ -0,0 +1,146 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ElasticsearchClusterConfig specifies the instances of an Elasticsearch
+// domain.
+type ElasticsearchClusterConfig struct {
+	// The instance type for an Elasticsearch cluster, e.g. m5.large.elasticsearch.
+	// +optional
+	InstanceType *string `json:"instanceType,omitempty"`
+
+	// The number of instances in the specified domain cluster.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	InstanceCount *int64 `json:"instanceCount,omitempty"`
+}
+
+// EBSOptions specifies the EBS volumes attached to the data nodes of an
+// Elasticsearch domain.
+type EBSOptions struct {
+	// Specifies whether EBS-based storage is enabled.
+	// +optional
+	EBSEnabled *bool `json:"ebsEnabled,omitempty"`
+
+	// Specifies the size of EBS volumes in GiB.
+	// +kubebuilder:validation:Minimum=10
+	// +optional
+	VolumeSize *int64 `json:"volumeSize,omitempty"`
+
+	// Specifies the volume type for EBS-based storage.
+	// +kubebuilder:validation:Enum=standard;gp2;io1
+	// +optional
+	VolumeType *string `json:"volumeType,omitempty"`
+
+	// Specifies the IOPS for Provisioned IOPS (io1) volumes.
+	// +optional
+	IOPS *int64 `json:"iops,omitempty"`
+}
+
+// DomainParameters define the desired state of an AWS Elasticsearch Service
+// domain.
+type DomainParameters struct {
+	// Region is the region you'd like your Domain to be created in.
+	Region string `json:"region"`
+
+	// The version of Elasticsearch, e.g. "7.9". If not specified, AWS uses its
+	// current default version.
+	// +immutable
+	// +optional
+	ElasticsearchVersion *string `json:"elasticsearchVersion,omitempty"`
+
+	// Configuration options for the instances of the domain.
+	// +optional
+	ElasticsearchClusterConfig *ElasticsearchClusterConfig `json:"elasticsearchClusterConfig,omitempty"`
+
+	// Options to enable, disable and specify the properties of EBS storage
+	// volumes.
+	// +optional
+	EBSOptions *EBSOptions `json:"ebsOptions,omitempty"`
+
+	// IAM access policy as a JSON-formatted string.
+	// +optional
+	AccessPolicies *string `json:"accessPolicies,omitempty"`
+}
+
+// A DomainSpec defines the desired state of a Domain.
+type DomainSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DomainParameters `json:"forProvider"`
+}
+
+// DomainObservation keeps the state for the external resource
+type DomainObservation struct {
+	// The Amazon resource name (ARN) of the domain.
+	ARN string `json:"arn,omitempty"`
+
+	// The unique identifier for the domain.
+	DomainID string `json:"domainId,omitempty"`
+
+	// The endpoint used to submit index and search requests.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Processing is true while the configuration changes of the domain are
+	// still being applied.
+	Processing bool `json:"processing,omitempty"`
+
+	// Created is true once the creation of the domain is complete.
+	Created bool `json:"created,omitempty"`
+
+	// Deleted is true once the domain has been submitted for deletion.
+	Deleted bool `json:"deleted,omitempty"`
+}
+
+// A DomainStatus represents the observed state of a Domain.
+type DomainStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DomainObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Domain is a managed resource that represents an AWS Elasticsearch Service
+// domain.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ENDPOINT",type="string",JSONPath=".status.atProvider.endpoint"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Domain struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DomainSpec   `json:"spec"`
+	Status DomainStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DomainList contains a list of Domains
+type DomainList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Domain `json:"items"`
+}
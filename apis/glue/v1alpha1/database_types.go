@@ -0,0 +1,91 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ResourceCredentialsSecretDatabaseNameKey is the key of the name of the
+// Glue database in the connection secret.
+const ResourceCredentialsSecretDatabaseNameKey = "databaseName"
+
+// DatabaseParameters define the desired state of an AWS Glue Database. The
+// name of the database is the external name of the resource.
+type DatabaseParameters struct {
+	// Region is the region you'd like your Database to be created in.
+	Region string `json:"region"`
+
+	// A description of the database.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// The location of the database, e.g. an S3 path like
+	// s3://bucket/prefix.
+	// +optional
+	LocationURI *string `json:"locationUri,omitempty"`
+
+	// Key-value pairs that define the properties of the database.
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+}
+
+// A DatabaseSpec defines the desired state of a Database.
+type DatabaseSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DatabaseParameters `json:"forProvider"`
+}
+
+// DatabaseObservation keeps the state for the external resource
+type DatabaseObservation struct {
+	// The time the database was created.
+	CreateTime *metav1.Time `json:"createTime,omitempty"`
+}
+
+// A DatabaseStatus represents the observed state of a Database.
+type DatabaseStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DatabaseObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Database is a managed resource that represents an AWS Glue Data Catalog
+// database.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Database struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DatabaseSpec   `json:"spec"`
+	Status DatabaseStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DatabaseList contains a list of Databases
+type DatabaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Database `json:"items"`
+}
@@ -22,6 +22,26 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 )
 
+// Annotations used to rotate an IAMAccessKey.
+const (
+	// AnnotationKeyRotate requests a rotation of the access key whenever its
+	// value changes, e.g. to the current date. A new access key is created
+	// and published to the connection secret, and the previous one is
+	// deactivated once the connection secret holds the new one. The previous
+	// key is deleted on the next rotation.
+	AnnotationKeyRotate = "identity.aws.crossplane.io/rotate"
+
+	// AnnotationKeyRotated records the value of AnnotationKeyRotate that the
+	// access key was last rotated for.
+	AnnotationKeyRotated = "identity.aws.crossplane.io/rotated"
+
+	// AnnotationKeyPreviousAccessKeyID records the ID of the access key that
+	// was replaced by the last rotation. It is written together with the
+	// external name of the new access key so that the previous one is never
+	// lost track of.
+	AnnotationKeyPreviousAccessKeyID = "identity.aws.crossplane.io/previous-access-key-id"
+)
+
 // IAMAccessKeyParameters define the desired state of an AWS IAM Access Key.
 type IAMAccessKeyParameters struct {
 	// IAMUsername contains the name of the IAMUser.
@@ -49,9 +69,20 @@ type IAMAccessKeySpec struct {
 	ForProvider       IAMAccessKeyParameters `json:"forProvider"`
 }
 
+// IAMAccessKeyObservation keeps the state for the external resource
+type IAMAccessKeyObservation struct {
+	// PreviousAccessKeyID is the ID of the access key that was replaced by
+	// the last rotation, as recorded in the
+	// identity.aws.crossplane.io/previous-access-key-id annotation. It is
+	// deactivated once the connection secret holds the new access key, and
+	// deleted on the next rotation or when the IAMAccessKey is deleted.
+	PreviousAccessKeyID string `json:"previousAccessKeyId,omitempty"`
+}
+
 // IAMAccessKeyStatus represents the observed state of an IAM Access Key.
 type IAMAccessKeyStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          IAMAccessKeyObservation `json:"atProvider"`
 }
 
 // +kubebuilder:object:root=true
@@ -84,6 +84,21 @@ func (in *IAMAccessKeyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMAccessKeyObservation) DeepCopyInto(out *IAMAccessKeyObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMAccessKeyObservation.
+func (in *IAMAccessKeyObservation) DeepCopy() *IAMAccessKeyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMAccessKeyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IAMAccessKeyParameters) DeepCopyInto(out *IAMAccessKeyParameters) {
 	*out = *in
@@ -130,6 +145,7 @@ func (in *IAMAccessKeySpec) DeepCopy() *IAMAccessKeySpec {
 func (in *IAMAccessKeyStatus) DeepCopyInto(out *IAMAccessKeyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMAccessKeyStatus.
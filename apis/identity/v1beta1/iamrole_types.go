@@ -22,6 +22,12 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 )
 
+const (
+	// ResourceCredentialsSecretARNKey is the name of the key in the connection
+	// secret for the IAMRole ARN.
+	ResourceCredentialsSecretARNKey = "arn"
+)
+
 // Tag represents user-provided metadata that can be associated
 // with a IAM role. For more information about tagging,
 // see Tagging IAM Identities (https://docs.aws.amazon.com/IAM/latest/UserGuide/id_tags.html)
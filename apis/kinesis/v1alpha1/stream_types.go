@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Connection secret keys of a Stream.
+const (
+	// ResourceCredentialsSecretARNKey is the name of the key in the
+	// connection secret for the ARN of the Stream.
+	ResourceCredentialsSecretARNKey = "arn"
+
+	// ResourceCredentialsSecretNameKey is the name of the key in the
+	// connection secret for the name of the Stream.
+	ResourceCredentialsSecretNameKey = "name"
+)
+
+// StreamParameters define the desired state of an AWS Kinesis data stream.
+type StreamParameters struct {
+	// Region is the region you'd like your Stream to be created in.
+	Region string `json:"region"`
+
+	// The number of shards of the stream. Changes are applied with uniform
+	// scaling, which AWS limits to doubling or halving the current count.
+	// +kubebuilder:validation:Minimum=1
+	ShardCount int64 `json:"shardCount"`
+
+	// The number of hours data records are accessible after they are added
+	// to the stream. Defaults to 24.
+	// +optional
+	// +kubebuilder:validation:Minimum=24
+	// +kubebuilder:validation:Maximum=8760
+	RetentionPeriodHours *int64 `json:"retentionPeriodHours,omitempty"`
+}
+
+// A StreamSpec defines the desired state of a Stream.
+type StreamSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       StreamParameters `json:"forProvider"`
+}
+
+// StreamObservation keeps the state for the external resource
+type StreamObservation struct {
+	// The ARN of the stream.
+	StreamARN string `json:"streamArn,omitempty"`
+
+	// The status of the stream, CREATING, ACTIVE, UPDATING or DELETING.
+	StreamStatus string `json:"streamStatus,omitempty"`
+
+	// The number of open shards of the stream.
+	OpenShardCount int64 `json:"openShardCount,omitempty"`
+
+	// The number of hours data records are accessible after they are added
+	// to the stream.
+	RetentionPeriodHours int64 `json:"retentionPeriodHours,omitempty"`
+}
+
+// A StreamStatus represents the observed state of a Stream.
+type StreamStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          StreamObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Stream is a managed resource that represents an AWS Kinesis data stream.
+// +kubebuilder:printcolumn:name="SHARDS",type="integer",JSONPath=".status.atProvider.openShardCount"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.streamStatus"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Stream struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StreamSpec   `json:"spec"`
+	Status StreamStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StreamList contains a list of Streams
+type StreamList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Stream `json:"items"`
+}
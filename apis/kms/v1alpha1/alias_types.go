@@ -0,0 +1,103 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// AliasParameters define the desired state of an AWS KMS alias.
+type AliasParameters struct {
+	// Region is the region you'd like your Alias to be created in.
+	Region string `json:"region"`
+
+	// The ID or ARN of the customer managed CMK that the alias points to.
+	// +optional
+	TargetKeyID *string `json:"targetKeyId,omitempty"`
+
+	// TargetKeyIDRef references a Key to retrieve its ID.
+	// +optional
+	TargetKeyIDRef *xpv1.Reference `json:"targetKeyIdRef,omitempty"`
+
+	// TargetKeyIDSelector selects a reference to a Key to retrieve its ID.
+	// +optional
+	TargetKeyIDSelector *xpv1.Selector `json:"targetKeyIdSelector,omitempty"`
+}
+
+// An AliasSpec defines the desired state of an Alias.
+type AliasSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AliasParameters `json:"forProvider"`
+}
+
+// AliasObservation keeps the state for the external resource
+type AliasObservation struct {
+	// The Amazon Resource Name (ARN) of the alias.
+	AliasARN string `json:"aliasArn,omitempty"`
+
+	// The ID of the CMK that the alias currently points to.
+	TargetKeyID string `json:"targetKeyId,omitempty"`
+}
+
+// An AliasStatus represents the observed state of an Alias.
+type AliasStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AliasObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Alias is a managed resource that represents an AWS KMS alias. The
+// external name of an Alias is its name without the "alias/" prefix.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="KEY",type="string",JSONPath=".status.atProvider.targetKeyId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Alias struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AliasSpec   `json:"spec"`
+	Status AliasStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AliasList contains a list of Aliases
+type AliasList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Alias `json:"items"`
+}
+
+// Alias type metadata.
+var (
+	AliasKind             = "Alias"
+	AliasGroupKind        = schema.GroupKind{Group: Group, Kind: AliasKind}.String()
+	AliasKindAPIVersion   = AliasKind + "." + GroupVersion.String()
+	AliasGroupVersionKind = GroupVersion.WithKind(AliasKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Alias{}, &AliasList{})
+}
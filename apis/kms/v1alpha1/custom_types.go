@@ -1,10 +1,26 @@
 package v1alpha1
 
+const (
+	// ResourceCredentialsSecretARNKey is the name of the key in the connection
+	// secret for the ARN of the Key.
+	ResourceCredentialsSecretARNKey = "arn"
+
+	// ResourceCredentialsSecretKeyIDKey is the name of the key in the
+	// connection secret for the ID of the Key.
+	ResourceCredentialsSecretKeyIDKey = "keyId"
+)
+
 // CustomKeyParameters are custom parameters for Key.
 type CustomKeyParameters struct {
 	// Specifies whether the CMK is enabled.
 	Enabled *bool `json:"enabled,omitempty"`
 
+	// Specifies whether automatic rotation of the key material is enabled.
+	// Rotation is only supported for symmetric CMKs with key material
+	// generated by AWS KMS. It is not managed if not set.
+	// +optional
+	EnableKeyRotation *bool `json:"enableKeyRotation,omitempty"`
+
 	// Specifies how many days the Key is retained when scheduled for deletion. Defaults to 30 days.
 	PendingWindowInDays *int64 `json:"pendingWindowInDays,omitempty"`
 }
@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Event source mapping states.
+const (
+	EventSourceMappingStateCreating  = "Creating"
+	EventSourceMappingStateEnabling  = "Enabling"
+	EventSourceMappingStateEnabled   = "Enabled"
+	EventSourceMappingStateDisabling = "Disabling"
+	EventSourceMappingStateDisabled  = "Disabled"
+	EventSourceMappingStateUpdating  = "Updating"
+	EventSourceMappingStateDeleting  = "Deleting"
+)
+
+// EventSourceMappingParameters define the desired state of an AWS Lambda
+// event source mapping.
+type EventSourceMappingParameters struct {
+	// Region is the region you'd like your EventSourceMapping to be created
+	// in.
+	Region string `json:"region"`
+
+	// The name or ARN of the function that processes the events.
+	// +optional
+	FunctionName *string `json:"functionName,omitempty"`
+
+	// FunctionNameRef references a Function to retrieve its name.
+	// +optional
+	FunctionNameRef *xpv1.Reference `json:"functionNameRef,omitempty"`
+
+	// FunctionNameSelector selects a reference to a Function to retrieve its
+	// name.
+	// +optional
+	FunctionNameSelector *xpv1.Selector `json:"functionNameSelector,omitempty"`
+
+	// The ARN of the SQS queue or Kinesis stream the events are read from.
+	// +immutable
+	// +optional
+	EventSourceARN *string `json:"eventSourceArn,omitempty"`
+
+	// QueueARNRef references an SQS Queue to set the EventSourceARN.
+	// +optional
+	QueueARNRef *xpv1.Reference `json:"queueArnRef,omitempty"`
+
+	// QueueARNSelector selects a reference to an SQS Queue to set the
+	// EventSourceARN.
+	// +optional
+	QueueARNSelector *xpv1.Selector `json:"queueArnSelector,omitempty"`
+
+	// StreamARNRef references a Kinesis Stream to set the EventSourceARN.
+	// +optional
+	StreamARNRef *xpv1.Reference `json:"streamArnRef,omitempty"`
+
+	// StreamARNSelector selects a reference to a Kinesis Stream to set the
+	// EventSourceARN.
+	// +optional
+	StreamARNSelector *xpv1.Selector `json:"streamArnSelector,omitempty"`
+
+	// The maximum number of items sent to the function at once. Defaults to
+	// 10 for SQS queues and 100 for Kinesis streams.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10000
+	// +optional
+	BatchSize *int64 `json:"batchSize,omitempty"`
+
+	// The maximum time in seconds to gather items before the function is
+	// invoked.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=300
+	// +optional
+	MaximumBatchingWindowInSeconds *int64 `json:"maximumBatchingWindowInSeconds,omitempty"`
+
+	// Whether the function is invoked for the events. Defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// The position in a Kinesis stream to start reading from. Required for
+	// Kinesis streams and not allowed for SQS queues.
+	// +immutable
+	// +kubebuilder:validation:Enum=TRIM_HORIZON;LATEST
+	// +optional
+	StartingPosition *string `json:"startingPosition,omitempty"`
+}
+
+// An EventSourceMappingSpec defines the desired state of an
+// EventSourceMapping.
+type EventSourceMappingSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EventSourceMappingParameters `json:"forProvider"`
+}
+
+// EventSourceMappingObservation keeps the state for the external resource
+type EventSourceMappingObservation struct {
+	// The ARN of the function that processes the events.
+	FunctionARN string `json:"functionArn,omitempty"`
+
+	// The state of the event source mapping.
+	State string `json:"state,omitempty"`
+
+	// The reason for the last change of the state.
+	StateTransitionReason string `json:"stateTransitionReason,omitempty"`
+
+	// The result of the last invocation of the function.
+	LastProcessingResult string `json:"lastProcessingResult,omitempty"`
+}
+
+// An EventSourceMappingStatus represents the observed state of an
+// EventSourceMapping.
+type EventSourceMappingStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EventSourceMappingObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EventSourceMapping is a managed resource that represents an AWS Lambda
+// event source mapping, which invokes a function for the items of an SQS queue
+// or Kinesis stream.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type EventSourceMapping struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EventSourceMappingSpec   `json:"spec"`
+	Status EventSourceMappingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EventSourceMappingList contains a list of EventSourceMappings
+type EventSourceMappingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EventSourceMapping `json:"items"`
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+const (
+	// ResourceCredentialsSecretARNKey is the name of the key in the
+	// connection secret for the function ARN.
+	ResourceCredentialsSecretARNKey = "arn"
+)
+
+// Function states.
+const (
+	FunctionStatePending  = "Pending"
+	FunctionStateActive   = "Active"
+	FunctionStateInactive = "Inactive"
+	FunctionStateFailed   = "Failed"
+)
+
+// FunctionCode specifies the deployment package of a function.
+type FunctionCode struct {
+	// An Amazon S3 bucket in the same AWS Region as your function.
+	// +optional
+	S3Bucket *string `json:"s3Bucket,omitempty"`
+
+	// S3BucketRef references a Bucket to retrieve its name.
+	// +optional
+	S3BucketRef *xpv1.Reference `json:"s3BucketRef,omitempty"`
+
+	// S3BucketSelector selects a reference to a Bucket to retrieve its name.
+	// +optional
+	S3BucketSelector *xpv1.Selector `json:"s3BucketSelector,omitempty"`
+
+	// The Amazon S3 key of the deployment package.
+	S3Key string `json:"s3Key"`
+
+	// For versioned objects, the version of the deployment package object to
+	// use. The code of the function is updated whenever this changes.
+	// +optional
+	S3ObjectVersion *string `json:"s3ObjectVersion,omitempty"`
+}
+
+// FunctionParameters define the desired state of an AWS Lambda function.
+type FunctionParameters struct {
+	// Region is the region you'd like your Function to be created in.
+	Region string `json:"region"`
+
+	// The identifier of the function's runtime, e.g. go1.x or python3.8.
+	Runtime string `json:"runtime"`
+
+	// The name of the method within your code that Lambda calls to execute
+	// your function.
+	Handler string `json:"handler"`
+
+	// The Amazon Resource Name (ARN) of the function's execution role.
+	// +optional
+	Role *string `json:"role,omitempty"`
+
+	// RoleRef references an IAMRole to retrieve its ARN.
+	// +optional
+	RoleRef *xpv1.Reference `json:"roleRef,omitempty"`
+
+	// RoleSelector selects a reference to an IAMRole to retrieve its ARN.
+	// +optional
+	RoleSelector *xpv1.Selector `json:"roleSelector,omitempty"`
+
+	// The amount of memory that your function has access to, in MB.
+	// +kubebuilder:validation:Minimum=128
+	// +kubebuilder:validation:Maximum=10240
+	// +optional
+	MemorySize *int64 `json:"memorySize,omitempty"`
+
+	// The amount of time that Lambda allows a function to run before stopping
+	// it, in seconds.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=900
+	// +optional
+	Timeout *int64 `json:"timeout,omitempty"`
+
+	// A description of the function.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// The code for the function.
+	Code FunctionCode `json:"code"`
+}
+
+// A FunctionSpec defines the desired state of a Function.
+type FunctionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FunctionParameters `json:"forProvider"`
+}
+
+// FunctionObservation keeps the state for the external resource
+type FunctionObservation struct {
+	// The function's Amazon Resource Name (ARN).
+	FunctionARN string `json:"functionArn,omitempty"`
+
+	// The current state of the function.
+	State string `json:"state,omitempty"`
+
+	// The reason for the function's current state.
+	StateReason string `json:"stateReason,omitempty"`
+
+	// The status of the last update that was performed on the function.
+	LastUpdateStatus string `json:"lastUpdateStatus,omitempty"`
+
+	// The SHA256 hash of the function's deployment package.
+	CodeSHA256 string `json:"codeSha256,omitempty"`
+
+	// The version of the S3 object that was last deployed as the function
+	// code.
+	CodeS3ObjectVersion string `json:"codeS3ObjectVersion,omitempty"`
+}
+
+// A FunctionStatus represents the observed state of a Function.
+type FunctionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FunctionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Function is a managed resource that represents an AWS Lambda function.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Function struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FunctionSpec   `json:"spec"`
+	Status FunctionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FunctionList contains a list of Functions
+type FunctionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Function `json:"items"`
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	iamv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	kinesisv1alpha1 "github.com/crossplane/provider-aws/apis/kinesis/v1alpha1"
+	s3v1beta1 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	sqsv1beta1 "github.com/crossplane/provider-aws/apis/sqs/v1beta1"
+)
+
+// FunctionARN returns the status.atProvider.functionArn of a Function.
+func FunctionARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Function)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.FunctionARN
+	}
+}
+
+// ResolveReferences of this Function
+func (mg *Function) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.role
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Role),
+		Reference:    mg.Spec.ForProvider.RoleRef,
+		Selector:     mg.Spec.ForProvider.RoleSelector,
+		To:           reference.To{Managed: &iamv1beta1.IAMRole{}, List: &iamv1beta1.IAMRoleList{}},
+		Extract:      iamv1beta1.IAMRoleARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.role")
+	}
+	mg.Spec.ForProvider.Role = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RoleRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.code.s3Bucket
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Code.S3Bucket),
+		Reference:    mg.Spec.ForProvider.Code.S3BucketRef,
+		Selector:     mg.Spec.ForProvider.Code.S3BucketSelector,
+		To:           reference.To{Managed: &s3v1beta1.Bucket{}, List: &s3v1beta1.BucketList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.code.s3Bucket")
+	}
+	mg.Spec.ForProvider.Code.S3Bucket = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.Code.S3BucketRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this EventSourceMapping
+func (mg *EventSourceMapping) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.functionName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.FunctionName),
+		Reference:    mg.Spec.ForProvider.FunctionNameRef,
+		Selector:     mg.Spec.ForProvider.FunctionNameSelector,
+		To:           reference.To{Managed: &Function{}, List: &FunctionList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.functionName")
+	}
+	mg.Spec.ForProvider.FunctionName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.FunctionNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.eventSourceArn from an SQS Queue
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.EventSourceARN),
+		Reference:    mg.Spec.ForProvider.QueueARNRef,
+		Selector:     mg.Spec.ForProvider.QueueARNSelector,
+		To:           reference.To{Managed: &sqsv1beta1.Queue{}, List: &sqsv1beta1.QueueList{}},
+		Extract:      sqsv1beta1.QueueARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.eventSourceArn")
+	}
+	mg.Spec.ForProvider.EventSourceARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.QueueARNRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.eventSourceArn from a Kinesis Stream
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.EventSourceARN),
+		Reference:    mg.Spec.ForProvider.StreamARNRef,
+		Selector:     mg.Spec.ForProvider.StreamARNSelector,
+		To:           reference.To{Managed: &kinesisv1alpha1.Stream{}, List: &kinesisv1alpha1.StreamList{}},
+		Extract:      kinesisv1alpha1.StreamARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.eventSourceArn")
+	}
+	mg.Spec.ForProvider.EventSourceARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.StreamARNRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -18,6 +18,33 @@ package v1alpha1
 
 import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
+const (
+	// ResourceCredentialsSecretReaderEndpointKey is the name of the key in
+	// the connection secret for the reader endpoint of a DBCluster.
+	ResourceCredentialsSecretReaderEndpointKey = "readerEndpoint"
+)
+
+// DBCluster states.
+// See https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/accessing-monitoring.html#Aurora.Status
+const (
+	DBClusterStateAvailable         = "available"
+	DBClusterStateBackingUp         = "backing-up"
+	DBClusterStateBacktracking      = "backtracking"
+	DBClusterStateCreating          = "creating"
+	DBClusterStateDeleting          = "deleting"
+	DBClusterStateFailingOver       = "failing-over"
+	DBClusterStateMaintenance       = "maintenance"
+	DBClusterStateModifying         = "modifying"
+	DBClusterStateRenaming          = "renaming"
+	DBClusterStateResettingPassword = "resetting-master-credentials"
+	DBClusterStateStarting          = "starting"
+	DBClusterStateStopped           = "stopped"
+	DBClusterStateStopping          = "stopping"
+	DBClusterStateStorageOptimizing = "storage-optimization"
+	DBClusterStateUpdatingIAMDBAuth = "update-iam-db-auth"
+	DBClusterStateUpgrading         = "upgrading"
+)
+
 // CustomDBParameterGroupParameters are custom parameters for DBParameterGroup
 type CustomDBParameterGroupParameters struct {
 	// A list of parameters to associate with this DB parameter group
@@ -36,6 +36,12 @@ const (
 	StateFailed = "failed"
 )
 
+const (
+	// ResourceCredentialsSecretDatabaseKey is the name of the key in the
+	// connection secret for the name of the initial database.
+	ResourceCredentialsSecretDatabaseKey = "database"
+)
+
 // ClusterParameters define the parameters available for an AWS Redshift cluster
 type ClusterParameters struct {
 	// Region is the region you'd like the Cluster to be created in.
@@ -24,6 +24,7 @@ import (
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 
+	databasev1beta1 "github.com/crossplane/provider-aws/apis/database/v1beta1"
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 )
 
@@ -45,6 +46,27 @@ func (mg *ResourceRecordSet) ResolveReferences(ctx context.Context, c client.Rea
 	mg.Spec.ForProvider.ZoneID = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.ZoneIDRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.resourceRecords
+	if mg.Spec.ForProvider.RDSInstanceRef == nil && mg.Spec.ForProvider.RDSInstanceSelector == nil {
+		return nil
+	}
+	current := ""
+	if len(mg.Spec.ForProvider.ResourceRecords) == 1 {
+		current = mg.Spec.ForProvider.ResourceRecords[0].Value
+	}
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: current,
+		Reference:    mg.Spec.ForProvider.RDSInstanceRef,
+		Selector:     mg.Spec.ForProvider.RDSInstanceSelector,
+		To:           reference.To{Managed: &databasev1beta1.RDSInstance{}, List: &databasev1beta1.RDSInstanceList{}},
+		Extract:      databasev1beta1.RDSInstanceEndpointAddress(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceRecords")
+	}
+	mg.Spec.ForProvider.ResourceRecords = []ResourceRecord{{Value: rsp.ResolvedValue}}
+	mg.Spec.ForProvider.RDSInstanceRef = rsp.ResolvedReference
+
 	return nil
 }
 
@@ -290,6 +290,16 @@ type ResourceRecordSetParameters struct {
 	// If you're creating an alias resource record set, omit ResourceRecords.
 	ResourceRecords []ResourceRecord `json:"resourceRecords,omitempty"`
 
+	// RDSInstanceRef references an RDSInstance to use its endpoint address as
+	// the value of the single resource record of this set, e.g. for a CNAME.
+	// +optional
+	RDSInstanceRef *xpv1.Reference `json:"rdsInstanceRef,omitempty"`
+
+	// RDSInstanceSelector selects a reference to an RDSInstance to use its
+	// endpoint address as the value of the single resource record of this set.
+	// +optional
+	RDSInstanceSelector *xpv1.Selector `json:"rdsInstanceSelector,omitempty"`
+
 	// Resource record sets that have a routing policy other than simple: An identifier
 	// that differentiates among multiple resource record sets that have the same
 	// combination of name and type, such as multiple weighted resource record sets
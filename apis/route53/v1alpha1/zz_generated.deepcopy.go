@@ -393,6 +393,16 @@ func (in *ResourceRecordSetParameters) DeepCopyInto(out *ResourceRecordSetParame
 		*out = make([]ResourceRecord, len(*in))
 		copy(*out, *in)
 	}
+	if in.RDSInstanceRef != nil {
+		in, out := &in.RDSInstanceRef, &out.RDSInstanceRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.RDSInstanceSelector != nil {
+		in, out := &in.RDSInstanceSelector, &out.RDSInstanceSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SetIdentifier != nil {
 		in, out := &in.SetIdentifier, &out.SetIdentifier
 		*out = new(string)
@@ -16,6 +16,10 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
 // CustomSecretParameters contains the additional fields for SecretParameters.
 type CustomSecretParameters struct {
 	// StringSecretRef points to the Kubernetes Secret whose data will be sent
@@ -52,6 +56,26 @@ type CustomSecretParameters struct {
 	//
 	// This value can range from 7 to 30 days. The default value is 30.
 	RecoveryWindowInDays *int64 `json:"recoveryWindowInDays,omitempty"`
+
+	// (Optional) Specifies the ARN of the Lambda function that rotates the
+	// secret. Rotation is enabled when this is set and isn't managed
+	// otherwise. Once rotation is enabled, the value of the secret is owned by
+	// the rotation function and the referenced Kubernetes Secret is only used
+	// to create it.
+	RotationLambdaARN *string `json:"rotationLambdaARN,omitempty"`
+
+	// RotationLambdaARNRef references a Lambda Function to retrieve its ARN.
+	// +optional
+	RotationLambdaARNRef *xpv1.Reference `json:"rotationLambdaARNRef,omitempty"`
+
+	// RotationLambdaARNSelector selects a reference to a Lambda Function to
+	// retrieve its ARN.
+	// +optional
+	RotationLambdaARNSelector *xpv1.Selector `json:"rotationLambdaARNSelector,omitempty"`
+
+	// (Optional) Specifies the schedule of the rotation when
+	// RotationLambdaARN is set.
+	RotationRules *RotationRulesType `json:"rotationRules,omitempty"`
 }
 
 // A SecretReference is a reference to a secret in an arbitrary namespace.
@@ -23,10 +23,23 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	kms "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+	lambda "github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
 )
 
+// SecretARN returns the status.atProvider.arn of a Secret.
+func SecretARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Secret)
+		if !ok {
+			return ""
+		}
+		return reference.FromPtrValue(r.Status.AtProvider.ARN)
+	}
+}
+
 // ResolveReferences of this Secret
 func (mg *Secret) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -45,5 +58,19 @@ func (mg *Secret) ResolveReferences(ctx context.Context, c client.Reader) error
 
 	mg.Spec.ForProvider.KMSKeyID = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.KMSKeyIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.rotationLambdaARN
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.RotationLambdaARN),
+		Reference:    mg.Spec.ForProvider.RotationLambdaARNRef,
+		Selector:     mg.Spec.ForProvider.RotationLambdaARNSelector,
+		To:           reference.To{Managed: &lambda.Function{}, List: &lambda.FunctionList{}},
+		Extract:      lambda.FunctionARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.rotationLambdaARN")
+	}
+	mg.Spec.ForProvider.RotationLambdaARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RotationLambdaARNRef = rsp.ResolvedReference
 	return nil
 }
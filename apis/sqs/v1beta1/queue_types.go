@@ -38,6 +38,7 @@ const (
 	AttributeDelaySeconds                          string = "DelaySeconds"
 	AttributeReceiveMessageWaitTimeSeconds         string = "ReceiveMessageWaitTimeSeconds"
 	AttributeRedrivePolicy                         string = "RedrivePolicy"
+	AttributeRedriveAllowPolicy                    string = "RedriveAllowPolicy"
 	AttributeFifoQueue                             string = "FifoQueue"
 	AttributeContentBasedDeduplication             string = "ContentBasedDeduplication"
 	AttributeKmsMasterKeyID                        string = "KmsMasterKeyId"
@@ -63,6 +64,39 @@ type RedrivePolicy struct {
 	MaxReceiveCount int64 `json:"maxReceiveCount"`
 }
 
+// Enum values for RedriveAllowPolicy redrive permissions
+const (
+	RedrivePermissionAllowAll string = "allowAll"
+	RedrivePermissionDenyAll  string = "denyAll"
+	RedrivePermissionByQueue  string = "byQueue"
+)
+
+// RedriveAllowPolicy includes the parameters for the permissions for the
+// dead-letter queue redrive permission and which source queues can specify
+// dead-letter queues.
+type RedriveAllowPolicy struct {
+	// RedrivePermission defines which source queues can specify the current
+	// queue as the dead-letter queue. byQueue requires sourceQueueArns.
+	// +kubebuilder:validation:Enum=allowAll;denyAll;byQueue
+	RedrivePermission string `json:"redrivePermission"`
+
+	// SourceQueueARNs are the Amazon Resource Names (ARN)s of the source
+	// queues that can specify this queue as the dead-letter queue when
+	// redrivePermission is byQueue. You can specify no more than 10 source
+	// queue ARNs.
+	// +optional
+	SourceQueueARNs []string `json:"sourceQueueArns,omitempty"`
+
+	// SourceQueueARNRefs reference Queues to retrieve their ARNs.
+	// +optional
+	SourceQueueARNRefs []xpv1.Reference `json:"sourceQueueArnRefs,omitempty"`
+
+	// SourceQueueARNSelector selects references to Queues to retrieve their
+	// ARNs.
+	// +optional
+	SourceQueueARNSelector *xpv1.Selector `json:"sourceQueueArnSelector,omitempty"`
+}
+
 // QueueParameters define the desired state of an AWS Queue
 type QueueParameters struct {
 	// Region is the region you'd like your Queue to be created in.
@@ -106,6 +140,12 @@ type QueueParameters struct {
 	// +optional
 	RedrivePolicy *RedrivePolicy `json:"redrivePolicy,omitempty"`
 
+	// RedriveAllowPolicy includes the parameters for the permissions for the
+	// dead-letter queue redrive permission and which source queues can
+	// specify this queue as their dead-letter queue.
+	// +optional
+	RedriveAllowPolicy *RedriveAllowPolicy `json:"redriveAllowPolicy,omitempty"`
+
 	// VisibilityTimeout - The visibility timeout for the queue, in seconds.
 	// Valid values: an integer from 0 to 43,200 (12 hours). Default: 30. For
 	// more information about the visibility timeout, see Visibility Timeout
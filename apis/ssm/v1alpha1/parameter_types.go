@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Types of the value of an SSM parameter.
+const (
+	ParameterTypeString       = "String"
+	ParameterTypeStringList   = "StringList"
+	ParameterTypeSecureString = "SecureString"
+)
+
+// ParameterParameters define the desired state of an AWS Systems Manager
+// Parameter Store parameter.
+type ParameterParameters struct {
+	// Region is the region you'd like your Parameter to be created in.
+	Region string `json:"region"`
+
+	// The type of the parameter. StringList values are comma separated and
+	// SecureString values are encrypted with a KMS key.
+	// +kubebuilder:validation:Enum=String;StringList;SecureString
+	Type string `json:"type"`
+
+	// The value of the parameter. Either this or ValueSecretRef has to be
+	// given.
+	// +optional
+	Value *string `json:"value,omitempty"`
+
+	// ValueSecretRef points to the key of a Kubernetes Secret whose value is
+	// used as the value of the parameter. It takes precedence over Value.
+	// +optional
+	ValueSecretRef *xpv1.SecretKeySelector `json:"valueSecretRef,omitempty"`
+
+	// Information about the parameter.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// The ID of the KMS key that encrypts a SecureString parameter. The
+	// AWS managed key of Systems Manager is used if it's not given.
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// KMSKeyIDRef is a reference to a KMS Key used to set the KMSKeyID.
+	// +optional
+	KMSKeyIDRef *xpv1.Reference `json:"kmsKeyIdRef,omitempty"`
+
+	// KMSKeyIDSelector selects a reference to a KMS Key used to set the
+	// KMSKeyID.
+	// +optional
+	KMSKeyIDSelector *xpv1.Selector `json:"kmsKeyIdSelector,omitempty"`
+
+	// A regular expression the value of the parameter has to match, e.g.
+	// ^\d+$.
+	// +optional
+	AllowedPattern *string `json:"allowedPattern,omitempty"`
+
+	// The data type of a String parameter, either text or aws:ec2:image.
+	// +optional
+	// +kubebuilder:validation:Enum=text;"aws:ec2:image"
+	DataType *string `json:"dataType,omitempty"`
+
+	// The tier of the parameter. Advanced parameters cannot be moved back
+	// to the Standard tier.
+	// +optional
+	// +kubebuilder:validation:Enum=Standard;Advanced;Intelligent-Tiering
+	Tier *string `json:"tier,omitempty"`
+}
+
+// A ParameterSpec defines the desired state of a Parameter.
+type ParameterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ParameterParameters `json:"forProvider"`
+}
+
+// ParameterObservation keeps the state for the external resource
+type ParameterObservation struct {
+	// The ARN of the parameter.
+	ARN string `json:"arn,omitempty"`
+
+	// The version of the parameter, which is increased on every change of
+	// its value.
+	Version int64 `json:"version,omitempty"`
+
+	// The time the parameter was last changed.
+	LastModifiedDate *metav1.Time `json:"lastModifiedDate,omitempty"`
+}
+
+// A ParameterStatus represents the observed state of a Parameter.
+type ParameterStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ParameterObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Parameter is a managed resource that represents an AWS Systems Manager
+// Parameter Store parameter.
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="VERSION",type="integer",JSONPath=".status.atProvider.version"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Parameter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ParameterSpec   `json:"spec"`
+	Status ParameterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ParameterList contains a list of Parameters
+type ParameterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Parameter `json:"items"`
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	ec2 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	iam "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+)
+
+// ResolveReferences of this Server
+func (mg *Server) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.loggingRole
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.LoggingRole),
+		Reference:    mg.Spec.ForProvider.LoggingRoleRef,
+		Selector:     mg.Spec.ForProvider.LoggingRoleSelector,
+		To:           reference.To{Managed: &iam.IAMRole{}, List: &iam.IAMRoleList{}},
+		Extract:      iam.IAMRoleARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.loggingRole")
+	}
+	mg.Spec.ForProvider.LoggingRole = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.LoggingRoleRef = rsp.ResolvedReference
+
+	ed := mg.Spec.ForProvider.EndpointDetails
+	if ed == nil {
+		return nil
+	}
+
+	// Resolve spec.forProvider.endpointDetails.vpcId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(ed.VPCID),
+		Reference:    ed.VPCIDRef,
+		Selector:     ed.VPCIDSelector,
+		To:           reference.To{Managed: &ec2.VPC{}, List: &ec2.VPCList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.endpointDetails.vpcId")
+	}
+	ed.VPCID = reference.ToPtrValue(rsp.ResolvedValue)
+	ed.VPCIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.endpointDetails.subnetIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: ed.SubnetIDs,
+		References:    ed.SubnetIDRefs,
+		Selector:      ed.SubnetIDSelector,
+		To:            reference.To{Managed: &ec2.Subnet{}, List: &ec2.SubnetList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.endpointDetails.subnetIds")
+	}
+	ed.SubnetIDs = mrsp.ResolvedValues
+	ed.SubnetIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
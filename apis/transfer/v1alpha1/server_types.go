@@ -0,0 +1,214 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Server states.
+const (
+	ServerStateOnline      = "ONLINE"
+	ServerStateOffline     = "OFFLINE"
+	ServerStateStarting    = "STARTING"
+	ServerStateStopping    = "STOPPING"
+	ServerStateStartFailed = "START_FAILED"
+	ServerStateStopFailed  = "STOP_FAILED"
+)
+
+// A Tag is a key-value pair assigned to a server.
+type Tag struct {
+	// The key of the tag.
+	Key string `json:"key"`
+
+	// The value of the tag.
+	Value string `json:"value"`
+}
+
+// EndpointDetails configures the VPC endpoint of a server.
+type EndpointDetails struct {
+	// The IDs of the Elastic IP addresses to attach to the endpoint of a
+	// server whose endpoint type is VPC, which makes it reachable from the
+	// internet.
+	// +optional
+	AddressAllocationIDs []string `json:"addressAllocationIds,omitempty"`
+
+	// The IDs of the subnets to create the endpoint in. Only used when the
+	// endpoint type is VPC.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs references Subnets to retrieve their IDs.
+	// +optional
+	SubnetIDRefs []xpv1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to Subnets to retrieve their IDs.
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// The ID of an existing VPC endpoint. Only used when the endpoint type is
+	// VPC_ENDPOINT.
+	// +optional
+	VPCEndpointID *string `json:"vpcEndpointId,omitempty"`
+
+	// The ID of the VPC to create the endpoint in. Only used when the
+	// endpoint type is VPC.
+	// +optional
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its ID.
+	// +optional
+	VPCIDRef *xpv1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its ID.
+	// +optional
+	VPCIDSelector *xpv1.Selector `json:"vpcIdSelector,omitempty"`
+}
+
+// IdentityProviderDetails configures the custom identity provider that
+// authenticates the users of a server.
+type IdentityProviderDetails struct {
+	// The ARN of the IAM role that is used to invoke the API Gateway
+	// endpoint.
+	// +optional
+	InvocationRole *string `json:"invocationRole,omitempty"`
+
+	// The URL of the API Gateway endpoint that authenticates the users.
+	// +optional
+	URL *string `json:"url,omitempty"`
+}
+
+// ServerParameters define the desired state of an AWS Transfer Family server.
+type ServerParameters struct {
+	// Region is the region you'd like your Server to be created in.
+	Region string `json:"region"`
+
+	// The protocols that clients can use to connect to the server. SFTP is
+	// used if none is given.
+	// +optional
+	Protocols []string `json:"protocols,omitempty"`
+
+	// The ARN of the ACM certificate of the server. Required when FTPS is
+	// one of the protocols.
+	// +optional
+	Certificate *string `json:"certificate,omitempty"`
+
+	// The type of endpoint of the server. A public endpoint is used if none
+	// is given.
+	// +kubebuilder:validation:Enum=PUBLIC;VPC;VPC_ENDPOINT
+	// +optional
+	EndpointType *string `json:"endpointType,omitempty"`
+
+	// EndpointDetails configures the VPC endpoint of the server.
+	// +optional
+	EndpointDetails *EndpointDetails `json:"endpointDetails,omitempty"`
+
+	// The way users are authenticated. SERVICE_MANAGED stores the users and
+	// their SSH keys in the service, API_GATEWAY uses a custom identity
+	// provider.
+	// +kubebuilder:validation:Enum=SERVICE_MANAGED;API_GATEWAY
+	// +immutable
+	// +optional
+	IdentityProviderType *string `json:"identityProviderType,omitempty"`
+
+	// IdentityProviderDetails configures the custom identity provider when
+	// the identity provider type is API_GATEWAY.
+	// +optional
+	IdentityProviderDetails *IdentityProviderDetails `json:"identityProviderDetails,omitempty"`
+
+	// The ARN of the IAM role that allows the server to write user activity
+	// to CloudWatch Logs.
+	// +optional
+	LoggingRole *string `json:"loggingRole,omitempty"`
+
+	// LoggingRoleRef references an IAMRole to retrieve its ARN.
+	// +optional
+	LoggingRoleRef *xpv1.Reference `json:"loggingRoleRef,omitempty"`
+
+	// LoggingRoleSelector selects a reference to an IAMRole to retrieve its
+	// ARN.
+	// +optional
+	LoggingRoleSelector *xpv1.Selector `json:"loggingRoleSelector,omitempty"`
+
+	// Tags to assign to the server.
+	// +immutable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A ServerSpec defines the desired state of a Server.
+type ServerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServerParameters `json:"forProvider"`
+}
+
+// ServerObservation keeps the state for the external resource
+type ServerObservation struct {
+	// The ARN of the server.
+	ARN string `json:"arn,omitempty"`
+
+	// The ID of the server.
+	ServerID string `json:"serverId,omitempty"`
+
+	// The state of the server.
+	State string `json:"state,omitempty"`
+
+	// The hostname that clients connect to.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// The MD5 fingerprint of the host key of the server.
+	HostKeyFingerprint string `json:"hostKeyFingerprint,omitempty"`
+
+	// The number of users of the server.
+	UserCount int64 `json:"userCount,omitempty"`
+}
+
+// A ServerStatus represents the observed state of a Server.
+type ServerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Server is a managed resource that represents an AWS Transfer Family
+// server. The external name of the server is its ID, which is assigned by AWS.
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="ENDPOINT",type="string",JSONPath=".status.atProvider.endpoint"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Server struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServerSpec   `json:"spec"`
+	Status ServerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServerList contains a list of Servers
+type ServerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Server `json:"items"`
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package v1beta1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -26,6 +27,40 @@ import (
 type ProviderConfigSpec struct {
 	// Credentials required to authenticate to this provider.
 	Credentials ProviderCredentials `json:"credentials"`
+
+	// Endpoint overrides the AWS API endpoint used by all resources that use
+	// this ProviderConfig, e.g. to run against LocalStack. Endpoints configured
+	// via annotations on a managed resource take precedence.
+	// +optional
+	Endpoint *EndpointConfig `json:"endpoint,omitempty"`
+
+	// AssumeRole is the role that is assumed with the given credentials and
+	// whose credentials are used to talk to AWS, e.g. to manage resources in
+	// another account.
+	// +optional
+	AssumeRole *AssumeRoleConfig `json:"assumeRole,omitempty"`
+}
+
+// AssumeRoleConfig is an IAM role to assume.
+type AssumeRoleConfig struct {
+	// RoleARN is the ARN of the role to assume.
+	RoleARN string `json:"roleARN"`
+
+	// ExternalID is the external ID required by the trust policy of the role,
+	// if any.
+	// +optional
+	ExternalID *string `json:"externalID,omitempty"`
+}
+
+// EndpointConfig is a custom AWS API endpoint.
+type EndpointConfig struct {
+	// URL of the endpoint, e.g. http://localstack:4566
+	URL string `json:"url"`
+
+	// SigningRegion is the region used to sign the requests. The region of the
+	// managed resource is used if it's not given.
+	// +optional
+	SigningRegion *string `json:"signingRegion,omitempty"`
 }
 
 // ProviderCredentials required to authenticate.
@@ -37,6 +72,40 @@ type ProviderCredentials struct {
 	xpv1.CommonCredentialSelectors `json:",inline"`
 }
 
+// TypeCredentialsValid indicates whether the credentials of a ProviderConfig
+// were accepted by AWS when they were last checked.
+const TypeCredentialsValid xpv1.ConditionType = "CredentialsValid"
+
+// Reasons a ProviderConfig's credentials are or are not valid.
+const (
+	ReasonCredentialsValid   xpv1.ConditionReason = "ValidCredentials"
+	ReasonCredentialsInvalid xpv1.ConditionReason = "InvalidCredentials"
+)
+
+// CredentialsValid returns a condition that indicates the credentials of a
+// ProviderConfig authenticate as the given identity.
+func CredentialsValid(identity string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCredentialsValid,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCredentialsValid,
+		Message:            "Authenticated as " + identity,
+	}
+}
+
+// CredentialsInvalid returns a condition that indicates the credentials of a
+// ProviderConfig could not be used to authenticate to AWS.
+func CredentialsInvalid(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCredentialsValid,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCredentialsInvalid,
+		Message:            err.Error(),
+	}
+}
+
 // A ProviderConfigStatus represents the status of a ProviderConfig.
 type ProviderConfigStatus struct {
 	xpv1.ProviderConfigStatus `json:",inline"`
@@ -46,6 +115,7 @@ type ProviderConfigStatus struct {
 
 // A ProviderConfig configures how AWS controllers will connect to AWS API.
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="CREDENTIALS-VALID",type="string",JSONPath=".status.conditions[?(@.type=='CredentialsValid')].status"
 // +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.credentialsSecretRef.name",priority=1
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,provider,aws}
 // +kubebuilder:subresource:status
@@ -0,0 +1,49 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	elbv2 "github.com/crossplane/provider-aws/apis/elasticloadbalancing/v1alpha1"
+)
+
+// ResolveReferences of this WebACL
+func (mg *WebACL) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceArns
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.ResourceARNs,
+		References:    mg.Spec.ForProvider.ResourceARNRefs,
+		Selector:      mg.Spec.ForProvider.ResourceARNSelector,
+		To:            reference.To{Managed: &elbv2.LoadBalancer{}, List: &elbv2.LoadBalancerList{}},
+		Extract:       elbv2.LoadBalancerARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceArns")
+	}
+	mg.Spec.ForProvider.ResourceARNs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.ResourceARNRefs = mrsp.ResolvedReferences
+
+	return nil
+}
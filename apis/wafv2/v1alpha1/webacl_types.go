@@ -0,0 +1,268 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Scopes of a WebACL.
+const (
+	// ScopeRegional is the scope of WebACLs that protect regional resources,
+	// such as Application Load Balancers and API Gateway REST API stages.
+	ScopeRegional = "REGIONAL"
+
+	// ScopeCloudFront is the scope of WebACLs that protect CloudFront
+	// distributions. These have to be created in us-east-1.
+	ScopeCloudFront = "CLOUDFRONT"
+)
+
+// Actions of a WebACL or of one of its rules.
+const (
+	// ActionAllow allows the request.
+	ActionAllow = "Allow"
+
+	// ActionBlock blocks the request.
+	ActionBlock = "Block"
+
+	// ActionCount counts the request and lets the next rule evaluate it.
+	ActionCount = "Count"
+
+	// ActionNone keeps the actions of the rules in a rule group.
+	ActionNone = "None"
+)
+
+// VisibilityConfig defines the metrics and the sampled requests that are
+// recorded for a WebACL or one of its rules.
+type VisibilityConfig struct {
+	// Whether to record the requests that match the rules.
+	SampledRequestsEnabled bool `json:"sampledRequestsEnabled"`
+
+	// Whether to send metrics to CloudWatch.
+	CloudWatchMetricsEnabled bool `json:"cloudWatchMetricsEnabled"`
+
+	// The name of the CloudWatch metric.
+	MetricName string `json:"metricName"`
+}
+
+// ManagedRuleGroupStatement references a rule group that is managed by AWS
+// or by an AWS Marketplace seller.
+type ManagedRuleGroupStatement struct {
+	// The name of the vendor of the rule group, e.g. AWS.
+	VendorName string `json:"vendorName"`
+
+	// The name of the rule group, e.g. AWSManagedRulesCommonRuleSet.
+	Name string `json:"name"`
+
+	// The names of the rules in the rule group whose actions are set to
+	// Count.
+	// +optional
+	ExcludedRules []string `json:"excludedRules,omitempty"`
+}
+
+// RuleGroupReferenceStatement references a rule group that you own.
+type RuleGroupReferenceStatement struct {
+	// The ARN of the rule group.
+	ARN string `json:"arn"`
+
+	// The names of the rules in the rule group whose actions are set to
+	// Count.
+	// +optional
+	ExcludedRules []string `json:"excludedRules,omitempty"`
+}
+
+// IPSetReferenceStatement matches the requests that originate from the
+// addresses in an IP set.
+type IPSetReferenceStatement struct {
+	// The ARN of the IP set.
+	ARN string `json:"arn"`
+}
+
+// RateBasedStatement matches the requests of the addresses that exceed a
+// rate limit. Requests are aggregated by their originating address.
+type RateBasedStatement struct {
+	// The maximum number of requests from a single address in any five
+	// minute period.
+	// +kubebuilder:validation:Minimum=100
+	Limit int64 `json:"limit"`
+}
+
+// Statement is the inspection criteria of a rule. Exactly one of the
+// statements has to be given.
+type Statement struct {
+	// ManagedRuleGroup runs the rules of a managed rule group.
+	// +optional
+	ManagedRuleGroup *ManagedRuleGroupStatement `json:"managedRuleGroup,omitempty"`
+
+	// RuleGroupReference runs the rules of a rule group you own.
+	// +optional
+	RuleGroupReference *RuleGroupReferenceStatement `json:"ruleGroupReference,omitempty"`
+
+	// IPSetReference matches the addresses of an IP set.
+	// +optional
+	IPSetReference *IPSetReferenceStatement `json:"ipSetReference,omitempty"`
+
+	// RateBased matches the addresses that exceed a rate limit.
+	// +optional
+	RateBased *RateBasedStatement `json:"rateBased,omitempty"`
+}
+
+// Rule of a WebACL.
+type Rule struct {
+	// The name of the rule. It has to be unique within the WebACL.
+	Name string `json:"name"`
+
+	// The order in which the rules are evaluated, lowest first. It has to be
+	// unique within the WebACL.
+	// +kubebuilder:validation:Minimum=0
+	Priority int64 `json:"priority"`
+
+	// The action to take on requests that match the statement. Only valid
+	// for statements that do not reference a rule group.
+	// +optional
+	// +kubebuilder:validation:Enum=Allow;Block;Count
+	Action *string `json:"action,omitempty"`
+
+	// The action that overrides the actions of a referenced rule group.
+	// None keeps the actions of the rules in the group, Count only counts
+	// the requests they match. Only valid for statements that reference a
+	// rule group.
+	// +optional
+	// +kubebuilder:validation:Enum=None;Count
+	OverrideAction *string `json:"overrideAction,omitempty"`
+
+	// The inspection criteria of the rule.
+	Statement Statement `json:"statement"`
+
+	// The metrics and sampled requests that are recorded for the rule.
+	VisibilityConfig VisibilityConfig `json:"visibilityConfig"`
+}
+
+// Tag is a key-value pair that is attached to a WebACL.
+type Tag struct {
+	// The key of the tag.
+	Key string `json:"key"`
+
+	// The value of the tag.
+	Value string `json:"value"`
+}
+
+// WebACLParameters define the desired state of an AWS WAFv2 WebACL.
+type WebACLParameters struct {
+	// Region is the region you'd like your WebACL to be created in. WebACLs
+	// with the CLOUDFRONT scope have to be created in us-east-1.
+	// +immutable
+	Region string `json:"region"`
+
+	// Whether the WebACL protects regional resources or CloudFront
+	// distributions.
+	// +immutable
+	// +kubebuilder:validation:Enum=REGIONAL;CLOUDFRONT
+	Scope string `json:"scope"`
+
+	// A description of the WebACL.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// The action to take on requests that do not match any rule.
+	// +kubebuilder:validation:Enum=Allow;Block
+	DefaultAction string `json:"defaultAction"`
+
+	// The rules of the WebACL.
+	// +optional
+	Rules []Rule `json:"rules,omitempty"`
+
+	// The metrics and sampled requests that are recorded for the WebACL.
+	VisibilityConfig VisibilityConfig `json:"visibilityConfig"`
+
+	// The ARNs of the Application Load Balancers and API Gateway REST API
+	// stages the WebACL is associated with. Only valid for the REGIONAL
+	// scope. CloudFront distributions are associated on the distribution.
+	// +optional
+	ResourceARNs []string `json:"resourceArns,omitempty"`
+
+	// ResourceARNRefs are references to LoadBalancers used to set the
+	// ResourceARNs.
+	// +optional
+	ResourceARNRefs []xpv1.Reference `json:"resourceArnRefs,omitempty"`
+
+	// ResourceARNSelector selects references to LoadBalancers used to set
+	// the ResourceARNs.
+	// +optional
+	ResourceARNSelector *xpv1.Selector `json:"resourceArnSelector,omitempty"`
+
+	// Tags to add to the WebACL.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A WebACLSpec defines the desired state of a WebACL.
+type WebACLSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       WebACLParameters `json:"forProvider"`
+}
+
+// WebACLObservation keeps the state for the external resource
+type WebACLObservation struct {
+	// The ARN of the WebACL.
+	ARN string `json:"arn,omitempty"`
+
+	// The ID of the WebACL.
+	ID string `json:"id,omitempty"`
+
+	// The web ACL capacity units used by the rules of the WebACL.
+	Capacity int64 `json:"capacity,omitempty"`
+
+	// The ARNs of the resources the WebACL is associated with.
+	AssociatedResourceARNs []string `json:"associatedResourceArns,omitempty"`
+}
+
+// A WebACLStatus represents the observed state of a WebACL.
+type WebACLStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          WebACLObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A WebACL is a managed resource that represents an AWS WAFv2 web access
+// control list.
+// +kubebuilder:printcolumn:name="SCOPE",type="string",JSONPath=".spec.forProvider.scope"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type WebACL struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WebACLSpec   `json:"spec"`
+	Status WebACLStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WebACLList contains a list of WebACLs
+type WebACLList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WebACL `json:"items"`
+}
@@ -0,0 +1,349 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPSetReferenceStatement) DeepCopyInto(out *IPSetReferenceStatement) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPSetReferenceStatement.
+func (in *IPSetReferenceStatement) DeepCopy() *IPSetReferenceStatement {
+	if in == nil {
+		return nil
+	}
+	out := new(IPSetReferenceStatement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedRuleGroupStatement) DeepCopyInto(out *ManagedRuleGroupStatement) {
+	*out = *in
+	if in.ExcludedRules != nil {
+		in, out := &in.ExcludedRules, &out.ExcludedRules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedRuleGroupStatement.
+func (in *ManagedRuleGroupStatement) DeepCopy() *ManagedRuleGroupStatement {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedRuleGroupStatement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateBasedStatement) DeepCopyInto(out *RateBasedStatement) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateBasedStatement.
+func (in *RateBasedStatement) DeepCopy() *RateBasedStatement {
+	if in == nil {
+		return nil
+	}
+	out := new(RateBasedStatement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Rule) DeepCopyInto(out *Rule) {
+	*out = *in
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
+		*out = new(string)
+		**out = **in
+	}
+	if in.OverrideAction != nil {
+		in, out := &in.OverrideAction, &out.OverrideAction
+		*out = new(string)
+		**out = **in
+	}
+	in.Statement.DeepCopyInto(&out.Statement)
+	out.VisibilityConfig = in.VisibilityConfig
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Rule.
+func (in *Rule) DeepCopy() *Rule {
+	if in == nil {
+		return nil
+	}
+	out := new(Rule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleGroupReferenceStatement) DeepCopyInto(out *RuleGroupReferenceStatement) {
+	*out = *in
+	if in.ExcludedRules != nil {
+		in, out := &in.ExcludedRules, &out.ExcludedRules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleGroupReferenceStatement.
+func (in *RuleGroupReferenceStatement) DeepCopy() *RuleGroupReferenceStatement {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleGroupReferenceStatement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Statement) DeepCopyInto(out *Statement) {
+	*out = *in
+	if in.ManagedRuleGroup != nil {
+		in, out := &in.ManagedRuleGroup, &out.ManagedRuleGroup
+		*out = new(ManagedRuleGroupStatement)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RuleGroupReference != nil {
+		in, out := &in.RuleGroupReference, &out.RuleGroupReference
+		*out = new(RuleGroupReferenceStatement)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPSetReference != nil {
+		in, out := &in.IPSetReference, &out.IPSetReference
+		*out = new(IPSetReferenceStatement)
+		**out = **in
+	}
+	if in.RateBased != nil {
+		in, out := &in.RateBased, &out.RateBased
+		*out = new(RateBasedStatement)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Statement.
+func (in *Statement) DeepCopy() *Statement {
+	if in == nil {
+		return nil
+	}
+	out := new(Statement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tag) DeepCopyInto(out *Tag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
+	if in == nil {
+		return nil
+	}
+	out := new(Tag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VisibilityConfig) DeepCopyInto(out *VisibilityConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VisibilityConfig.
+func (in *VisibilityConfig) DeepCopy() *VisibilityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VisibilityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACL) DeepCopyInto(out *WebACL) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACL.
+func (in *WebACL) DeepCopy() *WebACL {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WebACL) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLList) DeepCopyInto(out *WebACLList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WebACL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLList.
+func (in *WebACLList) DeepCopy() *WebACLList {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WebACLList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLObservation) DeepCopyInto(out *WebACLObservation) {
+	*out = *in
+	if in.AssociatedResourceARNs != nil {
+		in, out := &in.AssociatedResourceARNs, &out.AssociatedResourceARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLObservation.
+func (in *WebACLObservation) DeepCopy() *WebACLObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLParameters) DeepCopyInto(out *WebACLParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]Rule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.VisibilityConfig = in.VisibilityConfig
+	if in.ResourceARNs != nil {
+		in, out := &in.ResourceARNs, &out.ResourceARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResourceARNRefs != nil {
+		in, out := &in.ResourceARNRefs, &out.ResourceARNRefs
+		*out = make([]v1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResourceARNSelector != nil {
+		in, out := &in.ResourceARNSelector, &out.ResourceARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLParameters.
+func (in *WebACLParameters) DeepCopy() *WebACLParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLSpec) DeepCopyInto(out *WebACLSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLSpec.
+func (in *WebACLSpec) DeepCopy() *WebACLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLStatus) DeepCopyInto(out *WebACLStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLStatus.
+func (in *WebACLStatus) DeepCopy() *WebACLStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -29,15 +29,30 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
 
 	"github.com/crossplane/provider-aws/apis"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/controller"
+	"github.com/crossplane/provider-aws/pkg/webhook"
 )
 
 func main() {
 	var (
 		app            = kingpin.New(filepath.Base(os.Args[0]), "AWS support for Crossplane.").DefaultEnvars()
-		debug          = app.Flag("debug", "Run with debug logging.").Short('d').Bool()
+		debug          = app.Flag("debug", "Run with debug logging, including the ID of every AWS API request.").Short('d').Bool()
 		syncPeriod     = app.Flag("sync", "Controller manager sync period duration such as 300ms, 1.5h or 2h45m").Short('s').Default("1h").Duration()
 		leaderElection = app.Flag("leader-election", "Use leader election for the conroller manager.").Short('l').Default("false").OverrideDefaultFromEnvar("LEADER_ELECTION").Bool()
+		apiRPS         = app.Flag("aws-api-rps", "Maximum number of requests per second sent to AWS by all controllers in total. Set to 0 for no limit.").Default("0").Float64()
+		apiBurst       = app.Flag("aws-api-burst", "Maximum number of requests sent to AWS at once when aws-api-rps is set.").Default("10").Int()
+		retryMode      = app.Flag("aws-retry-mode", "How AWS requests are retried when they fail. Adaptive mode never gives up retrying because of the client-side retry quota, backs off for up to a minute, and once an AWS API throttles requests limits the rate they are sent to it at until they are no longer throttled, which suits large numbers of managed resources. The mode only applies to AWS SDK v2 clients; the controllers that use the AWS SDK v1 ignore it and only honour aws-max-attempts.").Default(awsclient.RetryModeStandard).Enum(awsclient.RetryModeStandard, awsclient.RetryModeAdaptive)
+		maxAttempts    = app.Flag("aws-max-attempts", "Maximum number of attempts made for each AWS request, including the first one. Set to 0 to use the AWS SDK default.").Default("0").Int()
+		maxReconciles  = app.Flag("max-concurrent-reconciles", "Maximum number of managed resources of each kind that are reconciled in parallel. Higher values send more requests to AWS at once; consider aws-api-rps when raising it.").Default("1").Int()
+		reconcileTime  = app.Flag("reconcile-timeout", "How long all AWS calls made by a single reconcile of a managed resource may take together before they are cancelled, e.g. 1m.").Default("1m").Duration()
+		driftPoll      = app.Flag("drift-poll-interval", "How often managed resources whose spec hasn't changed since they were last observed as available are checked for drift in AWS, e.g. 10m. Until then they are reported as up to date without calling AWS, so changes made outside of Crossplane are noticed late. Set to 0, the default, to check them on every poll.").Default("0").Duration()
+		describeTTL    = app.Flag("describe-cache-ttl", "How long the last describe result of an RDS instance, including its tags, is reused by quick successive reconciles instead of describing it again, e.g. 5s. It is discarded whenever the instance is changed. Set to 0 to disable.").Default("0").Duration()
+		credsCheck     = app.Flag("credentials-check-interval", "How often the credentials of each ProviderConfig are checked with AWS and reported in its CredentialsValid condition, e.g. 10m. Set to 0 to disable the check.").Default("10m").Duration()
+		metricsAddress = app.Flag("metrics-bind-address", "Address to serve Prometheus metrics on, e.g. :8080. Set to 0 to disable.").Default(":8080").String()
+		webhookCertDir = app.Flag("webhook-tls-cert-dir", "Directory containing the tls.crt and tls.key the admission webhooks are served with. The webhooks, e.g. the one rejecting the deletion of RDSInstances in use by a claim, are disabled unless it is set. The provider package does not register them with the API server; see the ValidatingWebhookConfigurations in examples/database.").String()
+		webhookPort    = app.Flag("webhook-port", "Port the admission webhooks are served at when webhook-tls-cert-dir is set.").Default("9443").Int()
+		webhookTrusted = app.Flag("webhook-trusted-deleter", "User whose deletions the admission webhooks always allow, e.g. the service account Crossplane runs as. May be repeated; the Kubernetes garbage collector is always trusted.").Default("system:serviceaccount:crossplane-system:crossplane").Strings()
 	)
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
@@ -52,18 +67,40 @@ func main() {
 
 	log.Debug("Starting", "sync-period", syncPeriod.String())
 
+	o := awsclient.Options{
+		MaxConcurrentReconciles:  *maxReconciles,
+		ReconcileTimeout:         *reconcileTime,
+		DriftPollInterval:        *driftPoll,
+		DescribeCacheTTL:         *describeTTL,
+		CredentialsCheckInterval: *credsCheck,
+		API: awsclient.APIOptions{
+			Limiter:     awsclient.NewAPIRateLimiter(*apiRPS, *apiBurst),
+			Logger:      log,
+			RetryMode:   *retryMode,
+			MaxAttempts: *maxAttempts,
+		},
+	}
+
 	cfg, err := ctrl.GetConfig()
 	kingpin.FatalIfError(err, "Cannot get API server rest config")
 
 	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
-		LeaderElection:   *leaderElection,
-		LeaderElectionID: "crossplane-leader-election-provider-aws",
-		SyncPeriod:       syncPeriod,
+		LeaderElection:     *leaderElection,
+		LeaderElectionID:   "crossplane-leader-election-provider-aws",
+		SyncPeriod:         syncPeriod,
+		MetricsBindAddress: *metricsAddress,
+		CertDir:            *webhookCertDir,
+		Port:               *webhookPort,
 	})
 	kingpin.FatalIfError(err, "Cannot create controller manager")
 
 	kingpin.FatalIfError(apis.AddToScheme(mgr.GetScheme()), "Cannot add AWS APIs to scheme")
-	kingpin.FatalIfError(controller.Setup(mgr, log, ratelimiter.NewDefaultProviderRateLimiter(ratelimiter.DefaultProviderRPS)), "Cannot setup AWS controllers")
+	kingpin.FatalIfError(controller.Setup(mgr, log, ratelimiter.NewDefaultProviderRateLimiter(ratelimiter.DefaultProviderRPS), o), "Cannot setup AWS controllers")
+	if *webhookCertDir != "" {
+		kingpin.FatalIfError(webhook.Setup(mgr, log, *webhookTrusted), "Cannot setup AWS webhooks")
+	} else {
+		log.Info("Not serving admission webhooks because webhook-tls-cert-dir is not set. RDSInstances in use by a claim can be deleted, and invalid RDSInstance specs are only rejected by AWS.")
+	}
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 
 }
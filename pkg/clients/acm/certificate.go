@@ -74,12 +74,25 @@ func GenerateCreateCertificateInput(name string, p *v1alpha1.CertificateParamete
 
 // GenerateCertificateStatus is used to produce CertificateExternalStatus from acm.certificateStatus
 func GenerateCertificateStatus(certificate acm.CertificateDetail) v1alpha1.CertificateExternalStatus {
-	return v1alpha1.CertificateExternalStatus{
+	st := v1alpha1.CertificateExternalStatus{
 		CertificateARN:     aws.StringValue(certificate.CertificateArn),
 		RenewalEligibility: certificate.RenewalEligibility,
 		Status:             certificate.Status,
 		Type:               certificate.Type,
 	}
+	for _, dv := range certificate.DomainValidationOptions {
+		if dv.ResourceRecord == nil {
+			continue
+		}
+		st.DomainValidationRecords = append(st.DomainValidationRecords, v1alpha1.DomainValidationRecord{
+			DomainName:          aws.StringValue(dv.DomainName),
+			ValidationStatus:    string(dv.ValidationStatus),
+			ResourceRecordName:  aws.StringValue(dv.ResourceRecord.Name),
+			ResourceRecordType:  string(dv.ResourceRecord.Type),
+			ResourceRecordValue: aws.StringValue(dv.ResourceRecord.Value),
+		})
+	}
+	return st
 }
 
 // LateInitializeCertificate fills the empty fields in *v1beta1.CertificateParameters with
@@ -151,6 +151,36 @@ func TestGenerateCertificateStatus(t *testing.T) {
 				RenewalEligibility: acm.RenewalEligibilityEligible,
 			},
 		},
+		"DNSValidation": {
+			in: acm.CertificateDetail{
+				CertificateArn: aws.String(certificateArn),
+				DomainValidationOptions: []acm.DomainValidation{
+					{
+						DomainName:       aws.String("example.com"),
+						ValidationStatus: acm.DomainStatusPendingValidation,
+						ResourceRecord: &acm.ResourceRecord{
+							Name:  aws.String("_x.example.com."),
+							Type:  acm.RecordTypeCname,
+							Value: aws.String("_y.acm-validations.aws."),
+						},
+					},
+					{
+						DomainName:       aws.String("mail.example.com"),
+						ValidationMethod: acm.ValidationMethodEmail,
+					},
+				},
+			},
+			out: v1alpha1.CertificateExternalStatus{
+				CertificateARN: certificateArn,
+				DomainValidationRecords: []v1alpha1.DomainValidationRecord{{
+					DomainName:          "example.com",
+					ValidationStatus:    "PENDING_VALIDATION",
+					ResourceRecordName:  "_x.example.com.",
+					ResourceRecordType:  "CNAME",
+					ResourceRecordValue: "_y.acm-validations.aws.",
+				}},
+			},
+		},
 		"NoRoleId": {
 			in: acm.CertificateDetail{
 				CertificateArn:     nil,
@@ -0,0 +1,224 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"golang.org/x/time/rate"
+)
+
+const (
+	adaptiveWaitHandlerName   = "crossplane.AdaptiveWait"
+	adaptiveRecordHandlerName = "crossplane.AdaptiveRecord"
+)
+
+// Rates, in requests per second, of the client-side rate limiting of the
+// adaptive retry mode.
+const (
+	// adaptiveMinRate is the lowest rate requests are limited to, no matter
+	// how often they are throttled.
+	adaptiveMinRate = 0.5
+
+	// adaptiveMaxRate is the rate above which requests are no longer limited.
+	adaptiveMaxRate = 100
+
+	// adaptiveBackoff is the factor the rate is multiplied with when a
+	// request is throttled.
+	adaptiveBackoff = 0.7
+)
+
+// adaptiveCooldown is how long a throttled request doesn't lower the rate
+// again after it was lowered, so that the responses of requests that were
+// sent at once don't lower it more than once.
+const adaptiveCooldown = time.Second
+
+// throttleErrorCodes are the error codes with which AWS APIs reject requests
+// because too many were sent.
+var throttleErrorCodes = map[string]bool{
+	"Throttling":                             true,
+	"ThrottlingException":                    true,
+	"ThrottledException":                     true,
+	"RequestThrottledException":              true,
+	"TooManyRequestsException":               true,
+	"ProvisionedThroughputExceededException": true,
+	"RequestLimitExceeded":                   true,
+	"BandwidthLimitExceeded":                 true,
+	"LimitExceededException":                 true,
+	"RequestThrottled":                       true,
+	"SlowDown":                               true,
+	"EC2ThrottledException":                  true,
+}
+
+// adaptiveRateLimits are shared by all AWS clients in adaptive retry mode,
+// since the AWS API rate limits they run into are shared too.
+var adaptiveRateLimits = newAdaptiveRateLimiter(time.Now)
+
+// An adaptiveRateLimiter limits the rate of the requests sent to an AWS API
+// in a region once that API throttles them. The rate starts just below the
+// one the requests were sent at, is lowered further each time a request is
+// throttled and slowly raised with each request that succeeds, until it is
+// high enough not to be limited any more.
+type adaptiveRateLimiter struct {
+	now func() time.Time
+
+	mu   sync.Mutex
+	apis map[string]*adaptiveRate
+}
+
+// adaptiveRate is the state of the rate limit of a single API.
+type adaptiveRate struct {
+	// limiter limits the requests to the API. It's nil while the requests
+	// aren't limited.
+	limiter *rate.Limiter
+
+	// lowered is when the rate was last lowered.
+	lowered time.Time
+
+	// window is when the current second of measuring the rate started, and
+	// sent and lastSent are the number of requests completed in it and in
+	// the second before it.
+	window         time.Time
+	sent, lastSent int
+}
+
+func newAdaptiveRateLimiter(now func() time.Time) *adaptiveRateLimiter {
+	return &adaptiveRateLimiter{now: now, apis: map[string]*adaptiveRate{}}
+}
+
+// setAdaptiveRateLimit makes the requests sent with the given configuration
+// wait for the rate limit of their API and region, and adapts that limit to
+// how often the requests are throttled.
+func setAdaptiveRateLimit(cfg *aws.Config, a *adaptiveRateLimiter) *aws.Config {
+	// Sign handlers run once per attempt and stop the request when they set
+	// an error, and the complete attempt handlers run after each attempt.
+	cfg.Handlers.Sign.PushFrontNamed(aws.NamedHandler{Name: adaptiveWaitHandlerName, Fn: func(r *aws.Request) {
+		if err := a.wait(r.Context(), adaptiveKey(r)); err != nil {
+			r.Error = err
+		}
+	}})
+	cfg.Handlers.CompleteAttempt.PushBackNamed(aws.NamedHandler{Name: adaptiveRecordHandlerName, Fn: func(r *aws.Request) {
+		a.record(adaptiveKey(r), isThrottle(r))
+	}})
+	return cfg
+}
+
+func adaptiveKey(r *aws.Request) string {
+	return r.Metadata.ServiceName + "/" + r.Config.Region
+}
+
+func isThrottle(r *aws.Request) bool {
+	if r.HTTPResponse != nil && r.HTTPResponse.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if err, ok := r.Error.(awserr.Error); ok {
+		return throttleErrorCodes[err.Code()]
+	}
+	return false
+}
+
+// wait blocks until a request may be sent to the given API, or the context
+// is done.
+func (a *adaptiveRateLimiter) wait(ctx context.Context, api string) error {
+	a.mu.Lock()
+	var l *rate.Limiter
+	if s, ok := a.apis[api]; ok {
+		l = s.limiter
+	}
+	a.mu.Unlock()
+	if l == nil {
+		return nil
+	}
+	return l.Wait(ctx)
+}
+
+// record adapts the rate limit of the given API to a request that was sent to
+// it and was throttled or not.
+func (a *adaptiveRateLimiter) record(api string, throttled bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := a.now()
+	s, ok := a.apis[api]
+	if !ok {
+		s = &adaptiveRate{window: now}
+		a.apis[api] = s
+	}
+	switch d := now.Sub(s.window); {
+	case d >= 2*time.Second:
+		s.window, s.sent, s.lastSent = now, 0, 0
+	case d >= time.Second:
+		s.window, s.sent, s.lastSent = s.window.Add(time.Second), 0, s.sent
+	}
+	s.sent++
+
+	if throttled {
+		if now.Sub(s.lowered) < adaptiveCooldown {
+			return
+		}
+		s.lowered = now
+		// The requests that were sent before the first throttle give the
+		// rate the API accepted.
+		r := float64(s.lastSent)
+		if s.sent > s.lastSent {
+			r = float64(s.sent)
+		}
+		if s.limiter != nil {
+			r = float64(s.limiter.Limit())
+		}
+		r *= adaptiveBackoff
+		if r < adaptiveMinRate {
+			r = adaptiveMinRate
+		}
+		if s.limiter == nil {
+			s.limiter = rate.NewLimiter(rate.Limit(r), 1)
+			return
+		}
+		s.limiter.SetLimit(rate.Limit(r))
+		return
+	}
+
+	if s.limiter == nil {
+		return
+	}
+	// Raising the rate by its inverse on each success raises it by about
+	// one request per second each second the requests get through.
+	r := float64(s.limiter.Limit())
+	r += 1 / r
+	if r > adaptiveMaxRate {
+		s.limiter = nil
+		return
+	}
+	s.limiter.SetLimit(rate.Limit(r))
+}
+
+// limit returns the rate the requests to the given API are limited to, and
+// whether they are limited at all.
+func (a *adaptiveRateLimiter) limit(api string) (float64, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	s, ok := a.apis[api]
+	if !ok || s.limiter == nil {
+		return 0, false
+	}
+	return float64(s.limiter.Limit()), true
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+)
+
+const testAPI = "rds/us-east-1"
+
+// A response is n requests to the API completing at a point in time.
+type response struct {
+	// at is how long after the first response they complete.
+	at        time.Duration
+	n         int
+	throttled bool
+}
+
+func TestAdaptiveRateLimiter(t *testing.T) {
+	type want struct {
+		limit   float64
+		limited bool
+	}
+
+	cases := map[string]struct {
+		responses []response
+		want      want
+	}{
+		"NotThrottled": {
+			responses: []response{{n: 10}},
+			want:      want{},
+		},
+		"Throttled": {
+			responses: []response{{n: 10}, {at: time.Second, n: 1, throttled: true}},
+			want:      want{limit: 7, limited: true},
+		},
+		"ThrottledAtOnce": {
+			responses: []response{{n: 10}, {at: time.Second, n: 3, throttled: true}},
+			want:      want{limit: 7, limited: true},
+		},
+		"ThrottledAgain": {
+			responses: []response{{n: 10}, {at: time.Second, n: 1, throttled: true}, {at: 2 * time.Second, n: 1, throttled: true}},
+			want:      want{limit: 4.9, limited: true},
+		},
+		"MinRate": {
+			responses: []response{{n: 1, throttled: true}, {at: time.Second, n: 1, throttled: true}},
+			want:      want{limit: adaptiveMinRate, limited: true},
+		},
+		"Raised": {
+			responses: []response{{n: 1, throttled: true}, {at: time.Second, n: 1}},
+			want:      want{limit: 0.7 + 1/0.7, limited: true},
+		},
+		"Recovered": {
+			responses: []response{{n: 1, throttled: true}, {at: time.Second, n: 10000}},
+			want:      want{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			start := time.Now()
+			now := start
+			a := newAdaptiveRateLimiter(func() time.Time { return now })
+			for _, r := range tc.responses {
+				now = start.Add(r.at)
+				for i := 0; i < r.n; i++ {
+					a.record(testAPI, r.throttled)
+				}
+			}
+			limit, limited := a.limit(testAPI)
+			if limited != tc.want.limited {
+				t.Errorf("limit(...): want limited %t, got %t", tc.want.limited, limited)
+			}
+			if math.Abs(limit-tc.want.limit) > 1e-9 {
+				t.Errorf("limit(...): want %f, got %f", tc.want.limit, limit)
+			}
+			if _, limited := a.limit("ec2/us-east-1"); limited {
+				t.Errorf("limit(...): other APIs must not be limited")
+			}
+		})
+	}
+}
+
+func TestSetAdaptiveRateLimit(t *testing.T) {
+	a := newAdaptiveRateLimiter(time.Now)
+	cfg := setAdaptiveRateLimit(&aws.Config{}, a)
+
+	request := func(ctx context.Context) *aws.Request {
+		r := &aws.Request{
+			Config:      aws.Config{Region: "us-east-1"},
+			Metadata:    aws.Metadata{ServiceName: "rds"},
+			HTTPRequest: &http.Request{},
+		}
+		r.SetContext(ctx)
+		return r
+	}
+
+	// requests aren't limited until they are throttled
+	r := request(context.Background())
+	cfg.Handlers.Sign.Run(r)
+	if r.Error != nil {
+		t.Fatalf("Sign: %s", r.Error)
+	}
+	r.Error = awserr.New("Throttling", "Rate exceeded", nil)
+	cfg.Handlers.CompleteAttempt.Run(r)
+	if _, limited := a.limit(testAPI); !limited {
+		t.Fatalf("CompleteAttempt: a throttled request must limit its API")
+	}
+
+	// the first limited request uses the burst
+	r = request(context.Background())
+	cfg.Handlers.Sign.Run(r)
+	if r.Error != nil {
+		t.Fatalf("Sign: %s", r.Error)
+	}
+
+	// the second one has to wait, which it can't do with a cancelled context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r = request(ctx)
+	cfg.Handlers.Sign.Run(r)
+	if r.Error == nil {
+		t.Errorf("Sign: a limited request must wait for its API")
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/apigateway"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockRestAPIClient)(nil)
+
+// MockRestAPIClient is a type that implements all the methods for the RestAPI
+// Client interface
+type MockRestAPIClient struct {
+	MockCreate           func(*apigateway.CreateRestApiInput) apigateway.CreateRestApiRequest
+	MockGet              func(*apigateway.GetRestApiInput) apigateway.GetRestApiRequest
+	MockUpdate           func(*apigateway.UpdateRestApiInput) apigateway.UpdateRestApiRequest
+	MockPut              func(*apigateway.PutRestApiInput) apigateway.PutRestApiRequest
+	MockDelete           func(*apigateway.DeleteRestApiInput) apigateway.DeleteRestApiRequest
+	MockGetStages        func(*apigateway.GetStagesInput) apigateway.GetStagesRequest
+	MockCreateDeployment func(*apigateway.CreateDeploymentInput) apigateway.CreateDeploymentRequest
+}
+
+// CreateRestApiRequest mocks CreateRestApiRequest method
+func (m *MockRestAPIClient) CreateRestApiRequest(input *apigateway.CreateRestApiInput) apigateway.CreateRestApiRequest { //nolint:golint
+	return m.MockCreate(input)
+}
+
+// GetRestApiRequest mocks GetRestApiRequest method
+func (m *MockRestAPIClient) GetRestApiRequest(input *apigateway.GetRestApiInput) apigateway.GetRestApiRequest { //nolint:golint
+	return m.MockGet(input)
+}
+
+// UpdateRestApiRequest mocks UpdateRestApiRequest method
+func (m *MockRestAPIClient) UpdateRestApiRequest(input *apigateway.UpdateRestApiInput) apigateway.UpdateRestApiRequest { //nolint:golint
+	return m.MockUpdate(input)
+}
+
+// PutRestApiRequest mocks PutRestApiRequest method
+func (m *MockRestAPIClient) PutRestApiRequest(input *apigateway.PutRestApiInput) apigateway.PutRestApiRequest { //nolint:golint
+	return m.MockPut(input)
+}
+
+// DeleteRestApiRequest mocks DeleteRestApiRequest method
+func (m *MockRestAPIClient) DeleteRestApiRequest(input *apigateway.DeleteRestApiInput) apigateway.DeleteRestApiRequest { //nolint:golint
+	return m.MockDelete(input)
+}
+
+// GetStagesRequest mocks GetStagesRequest method
+func (m *MockRestAPIClient) GetStagesRequest(input *apigateway.GetStagesInput) apigateway.GetStagesRequest {
+	return m.MockGetStages(input)
+}
+
+// CreateDeploymentRequest mocks CreateDeploymentRequest method
+func (m *MockRestAPIClient) CreateDeploymentRequest(input *apigateway.CreateDeploymentInput) apigateway.CreateDeploymentRequest {
+	return m.MockCreateDeployment(input)
+}
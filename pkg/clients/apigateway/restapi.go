@@ -0,0 +1,230 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// Client defines API Gateway RestAPI client operations
+type Client interface {
+	CreateRestApiRequest(input *apigateway.CreateRestApiInput) apigateway.CreateRestApiRequest
+	GetRestApiRequest(input *apigateway.GetRestApiInput) apigateway.GetRestApiRequest
+	UpdateRestApiRequest(input *apigateway.UpdateRestApiInput) apigateway.UpdateRestApiRequest
+	PutRestApiRequest(input *apigateway.PutRestApiInput) apigateway.PutRestApiRequest
+	DeleteRestApiRequest(input *apigateway.DeleteRestApiInput) apigateway.DeleteRestApiRequest
+	GetStagesRequest(input *apigateway.GetStagesInput) apigateway.GetStagesRequest
+	CreateDeploymentRequest(input *apigateway.CreateDeploymentInput) apigateway.CreateDeploymentRequest
+}
+
+// NewClient creates new API Gateway Client with provided AWS
+// Configurations/Credentials
+func NewClient(cfg aws.Config) Client {
+	return apigateway.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the item doesn't exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == apigateway.ErrCodeNotFoundException
+	}
+	return false
+}
+
+func endpointType(c *apigateway.EndpointConfiguration) string {
+	if c == nil || len(c.Types) == 0 {
+		return ""
+	}
+	return string(c.Types[0])
+}
+
+// GenerateCreateRestAPIInput returns the input to create a RestAPI.
+func GenerateCreateRestAPIInput(p v1alpha1.RestAPIParameters) *apigateway.CreateRestApiInput {
+	in := &apigateway.CreateRestApiInput{
+		Name:        aws.String(p.Name),
+		Description: p.Description,
+	}
+	if p.EndpointType != nil {
+		in.EndpointConfiguration = &apigateway.EndpointConfiguration{
+			Types: []apigateway.EndpointType{apigateway.EndpointType(aws.StringValue(p.EndpointType))},
+		}
+	}
+	return in
+}
+
+// GeneratePatchOperations returns the operations that update the name,
+// description and endpoint type of the RestAPI to the desired ones.
+func GeneratePatchOperations(p v1alpha1.RestAPIParameters, api apigateway.GetRestApiOutput) []apigateway.PatchOperation {
+	var ops []apigateway.PatchOperation
+	if p.Name != aws.StringValue(api.Name) {
+		ops = append(ops, apigateway.PatchOperation{Op: apigateway.OpReplace, Path: aws.String("/name"), Value: aws.String(p.Name)})
+	}
+	if p.Description != nil && aws.StringValue(p.Description) != aws.StringValue(api.Description) {
+		ops = append(ops, apigateway.PatchOperation{Op: apigateway.OpReplace, Path: aws.String("/description"), Value: p.Description})
+	}
+	if current := endpointType(api.EndpointConfiguration); p.EndpointType != nil && current != "" && aws.StringValue(p.EndpointType) != current {
+		ops = append(ops, apigateway.PatchOperation{
+			Op:    apigateway.OpReplace,
+			Path:  aws.String("/endpointConfiguration/types/" + current),
+			Value: p.EndpointType,
+		})
+	}
+	return ops
+}
+
+// LateInitialize fills the empty fields in *v1alpha1.RestAPIParameters with
+// the values seen in apigateway.GetRestApiOutput.
+func LateInitialize(in *v1alpha1.RestAPIParameters, api *apigateway.GetRestApiOutput) {
+	if api == nil {
+		return
+	}
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, api.Description)
+	if t := endpointType(api.EndpointConfiguration); t != "" {
+		in.EndpointType = awsclients.LateInitializeStringPtr(in.EndpointType, aws.String(t))
+	}
+}
+
+// lambdaProxyURI returns the URI of the integration that invokes the given
+// Lambda function.
+func lambdaProxyURI(region, functionARN string) string {
+	partition := "aws"
+	if parts := strings.Split(functionARN, ":"); len(parts) > 1 {
+		partition = parts[1]
+	}
+	return fmt.Sprintf("arn:%s:apigateway:%s:lambda:path/2015-03-31/functions/%s/invocations", partition, region, functionARN)
+}
+
+// GenerateBody returns the OpenAPI definition of the RestAPI. That is the
+// given body or, if there is none, a definition that proxies all requests to
+// the given Lambda function. It is empty if neither is given.
+func GenerateBody(p v1alpha1.RestAPIParameters) ([]byte, error) {
+	if p.Body != nil {
+		return []byte(aws.StringValue(p.Body)), nil
+	}
+	if p.LambdaFunctionARN == nil {
+		return nil, nil
+	}
+	integration := map[string]interface{}{
+		"type":                "aws_proxy",
+		"httpMethod":          "POST",
+		"uri":                 lambdaProxyURI(p.Region, aws.StringValue(p.LambdaFunctionARN)),
+		"passthroughBehavior": "when_no_match",
+	}
+	return json.Marshal(map[string]interface{}{
+		"openapi": "3.0.1",
+		"info":    map[string]string{"title": p.Name, "version": "1.0"},
+		"paths": map[string]interface{}{
+			"/": map[string]interface{}{
+				"x-amazon-apigateway-any-method": map[string]interface{}{
+					"x-amazon-apigateway-integration": integration,
+				},
+			},
+			"/{proxy+}": map[string]interface{}{
+				"x-amazon-apigateway-any-method": map[string]interface{}{
+					"parameters": []map[string]interface{}{
+						{"name": "proxy", "in": "path", "required": true, "schema": map[string]string{"type": "string"}},
+					},
+					"x-amazon-apigateway-integration": integration,
+				},
+			},
+		},
+	})
+}
+
+// SHA256 returns the hex encoded SHA-256 checksum of the given definition.
+func SHA256(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+// InvokeURL returns the URL to invoke the given RestAPI at the given stage.
+func InvokeURL(id, region, stage string) string {
+	return fmt.Sprintf("https://%s.execute-api.%s.amazonaws.com/%s", id, region, stage)
+}
+
+// GenerateObservation is used to produce v1alpha1.RestAPIObservation from
+// apigateway.GetRestApiOutput and the stages of the RestAPI.
+func GenerateObservation(api apigateway.GetRestApiOutput, stages []apigateway.Stage, region string) v1alpha1.RestAPIObservation {
+	o := v1alpha1.RestAPIObservation{ID: aws.StringValue(api.Id)}
+	for _, s := range stages {
+		o.Stages = append(o.Stages, v1alpha1.StageObservation{
+			Name:         aws.StringValue(s.StageName),
+			DeploymentID: aws.StringValue(s.DeploymentId),
+			InvokeURL:    InvokeURL(aws.StringValue(api.Id), region, aws.StringValue(s.StageName)),
+		})
+	}
+	sort.Slice(o.Stages, func(i, j int) bool { return o.Stages[i].Name < o.Stages[j].Name })
+	return o
+}
+
+// MissingStages returns the names of the desired stages that do not exist.
+func MissingStages(p v1alpha1.RestAPIParameters, stages []apigateway.Stage) []string {
+	existing := map[string]bool{}
+	for _, s := range stages {
+		existing[aws.StringValue(s.StageName)] = true
+	}
+	var missing []string
+	for _, n := range p.StageNames {
+		if !existing[n] {
+			missing = append(missing, n)
+		}
+	}
+	return missing
+}
+
+// GetConnectionDetails returns the invoke URLs of the desired stages that
+// exist.
+func GetConnectionDetails(p v1alpha1.RestAPIParameters, o v1alpha1.RestAPIObservation) managed.ConnectionDetails {
+	desired := map[string]bool{}
+	for _, n := range p.StageNames {
+		desired[n] = true
+	}
+	cd := managed.ConnectionDetails{}
+	for _, s := range o.Stages {
+		if desired[s.Name] {
+			cd[v1alpha1.ResourceCredentialsSecretInvokeURLKeyPrefix+s.Name] = []byte(s.InvokeURL)
+		}
+	}
+	return cd
+}
+
+// IsUpToDate checks whether the RestAPI has the desired name, description,
+// endpoint type, definition and stages.
+func IsUpToDate(p v1alpha1.RestAPIParameters, o v1alpha1.RestAPIObservation, api apigateway.GetRestApiOutput, stages []apigateway.Stage) (bool, error) {
+	if len(GeneratePatchOperations(p, api)) != 0 || len(MissingStages(p, stages)) != 0 {
+		return false, nil
+	}
+	body, err := GenerateBody(p)
+	if err != nil {
+		return false, err
+	}
+	return len(body) == 0 || SHA256(body) == o.BodySHA256, nil
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+)
+
+var (
+	functionARN = "arn:aws:lambda:us-east-1:123456789012:function:some-function"
+)
+
+func TestGeneratePatchOperations(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.RestAPIParameters
+		api  apigateway.GetRestApiOutput
+		want []apigateway.PatchOperation
+	}{
+		"UpToDate": {
+			p: v1alpha1.RestAPIParameters{Name: "api", Description: aws.String("d"), EndpointType: aws.String("REGIONAL")},
+			api: apigateway.GetRestApiOutput{
+				Name:                  aws.String("api"),
+				Description:           aws.String("d"),
+				EndpointConfiguration: &apigateway.EndpointConfiguration{Types: []apigateway.EndpointType{apigateway.EndpointTypeRegional}},
+			},
+		},
+		"Changed": {
+			p: v1alpha1.RestAPIParameters{Name: "new", Description: aws.String("new"), EndpointType: aws.String("REGIONAL")},
+			api: apigateway.GetRestApiOutput{
+				Name:                  aws.String("api"),
+				Description:           aws.String("d"),
+				EndpointConfiguration: &apigateway.EndpointConfiguration{Types: []apigateway.EndpointType{apigateway.EndpointTypeEdge}},
+			},
+			want: []apigateway.PatchOperation{
+				{Op: apigateway.OpReplace, Path: aws.String("/name"), Value: aws.String("new")},
+				{Op: apigateway.OpReplace, Path: aws.String("/description"), Value: aws.String("new")},
+				{Op: apigateway.OpReplace, Path: aws.String("/endpointConfiguration/types/EDGE"), Value: aws.String("REGIONAL")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GeneratePatchOperations(tc.p, tc.api)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateBody(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.RestAPIParameters
+		want map[string]interface{}
+	}{
+		"NoDefinition": {
+			p: v1alpha1.RestAPIParameters{Name: "api"},
+		},
+		"Body": {
+			p:    v1alpha1.RestAPIParameters{Name: "api", Body: aws.String(`{"openapi":"3.0.1"}`), LambdaFunctionARN: aws.String(functionARN)},
+			want: map[string]interface{}{"openapi": "3.0.1"},
+		},
+		"LambdaProxy": {
+			p: v1alpha1.RestAPIParameters{Name: "api", Region: "us-east-1", LambdaFunctionARN: aws.String(functionARN)},
+			want: func() map[string]interface{} {
+				integration := map[string]interface{}{
+					"type":                "aws_proxy",
+					"httpMethod":          "POST",
+					"uri":                 "arn:aws:apigateway:us-east-1:lambda:path/2015-03-31/functions/" + functionARN + "/invocations",
+					"passthroughBehavior": "when_no_match",
+				}
+				return map[string]interface{}{
+					"openapi": "3.0.1",
+					"info":    map[string]interface{}{"title": "api", "version": "1.0"},
+					"paths": map[string]interface{}{
+						"/": map[string]interface{}{
+							"x-amazon-apigateway-any-method": map[string]interface{}{
+								"x-amazon-apigateway-integration": integration,
+							},
+						},
+						"/{proxy+}": map[string]interface{}{
+							"x-amazon-apigateway-any-method": map[string]interface{}{
+								"parameters": []interface{}{
+									map[string]interface{}{"name": "proxy", "in": "path", "required": true, "schema": map[string]interface{}{"type": "string"}},
+								},
+								"x-amazon-apigateway-integration": integration,
+							},
+						},
+					},
+				}
+			}(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			body, err := GenerateBody(tc.p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got map[string]interface{}
+			if len(body) != 0 {
+				if err := json.Unmarshal(body, &got); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	api := apigateway.GetRestApiOutput{Name: aws.String("api")}
+	body := `{"openapi":"3.0.1"}`
+
+	cases := map[string]struct {
+		p      v1alpha1.RestAPIParameters
+		o      v1alpha1.RestAPIObservation
+		stages []apigateway.Stage
+		want   bool
+	}{
+		"UpToDate": {
+			p:      v1alpha1.RestAPIParameters{Name: "api", Body: aws.String(body), StageNames: []string{"prod"}},
+			o:      v1alpha1.RestAPIObservation{BodySHA256: SHA256([]byte(body))},
+			stages: []apigateway.Stage{{StageName: aws.String("prod")}},
+			want:   true,
+		},
+		"BodyChanged": {
+			p:    v1alpha1.RestAPIParameters{Name: "api", Body: aws.String(body)},
+			o:    v1alpha1.RestAPIObservation{BodySHA256: SHA256([]byte(`{}`))},
+			want: false,
+		},
+		"MissingStage": {
+			p:    v1alpha1.RestAPIParameters{Name: "api", StageNames: []string{"prod"}},
+			want: false,
+		},
+		"DifferentName": {
+			p:    v1alpha1.RestAPIParameters{Name: "another"},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsUpToDate(tc.p, tc.o, api, tc.stages)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetConnectionDetails(t *testing.T) {
+	p := v1alpha1.RestAPIParameters{StageNames: []string{"prod"}}
+	o := GenerateObservation(apigateway.GetRestApiOutput{Id: aws.String("abc")}, []apigateway.Stage{
+		{StageName: aws.String("prod")},
+		{StageName: aws.String("test")},
+	}, "us-east-1")
+	want := managed.ConnectionDetails{
+		"invokeUrl.prod": []byte("https://abc.execute-api.us-east-1.amazonaws.com/prod"),
+	}
+	if diff := cmp.Diff(want, GetConnectionDetails(p, o)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/applicationautoscaling"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ScalableTargetClient = (*MockScalableTargetClient)(nil)
+
+// MockScalableTargetClient is a type that implements all the methods for the
+// ScalableTargetClient interface
+type MockScalableTargetClient struct {
+	MockRegister   func(*applicationautoscaling.RegisterScalableTargetInput) applicationautoscaling.RegisterScalableTargetRequest
+	MockDescribe   func(*applicationautoscaling.DescribeScalableTargetsInput) applicationautoscaling.DescribeScalableTargetsRequest
+	MockDeregister func(*applicationautoscaling.DeregisterScalableTargetInput) applicationautoscaling.DeregisterScalableTargetRequest
+}
+
+// RegisterScalableTargetRequest mocks RegisterScalableTargetRequest method
+func (m *MockScalableTargetClient) RegisterScalableTargetRequest(input *applicationautoscaling.RegisterScalableTargetInput) applicationautoscaling.RegisterScalableTargetRequest {
+	return m.MockRegister(input)
+}
+
+// DescribeScalableTargetsRequest mocks DescribeScalableTargetsRequest method
+func (m *MockScalableTargetClient) DescribeScalableTargetsRequest(input *applicationautoscaling.DescribeScalableTargetsInput) applicationautoscaling.DescribeScalableTargetsRequest {
+	return m.MockDescribe(input)
+}
+
+// DeregisterScalableTargetRequest mocks DeregisterScalableTargetRequest method
+func (m *MockScalableTargetClient) DeregisterScalableTargetRequest(input *applicationautoscaling.DeregisterScalableTargetInput) applicationautoscaling.DeregisterScalableTargetRequest {
+	return m.MockDeregister(input)
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/applicationautoscaling"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ScalingPolicyClient = (*MockScalingPolicyClient)(nil)
+
+// MockScalingPolicyClient is a type that implements all the methods for the
+// ScalingPolicyClient interface
+type MockScalingPolicyClient struct {
+	MockPut      func(*applicationautoscaling.PutScalingPolicyInput) applicationautoscaling.PutScalingPolicyRequest
+	MockDescribe func(*applicationautoscaling.DescribeScalingPoliciesInput) applicationautoscaling.DescribeScalingPoliciesRequest
+	MockDelete   func(*applicationautoscaling.DeleteScalingPolicyInput) applicationautoscaling.DeleteScalingPolicyRequest
+}
+
+// PutScalingPolicyRequest mocks PutScalingPolicyRequest method
+func (m *MockScalingPolicyClient) PutScalingPolicyRequest(input *applicationautoscaling.PutScalingPolicyInput) applicationautoscaling.PutScalingPolicyRequest {
+	return m.MockPut(input)
+}
+
+// DescribeScalingPoliciesRequest mocks DescribeScalingPoliciesRequest method
+func (m *MockScalingPolicyClient) DescribeScalingPoliciesRequest(input *applicationautoscaling.DescribeScalingPoliciesInput) applicationautoscaling.DescribeScalingPoliciesRequest {
+	return m.MockDescribe(input)
+}
+
+// DeleteScalingPolicyRequest mocks DeleteScalingPolicyRequest method
+func (m *MockScalingPolicyClient) DeleteScalingPolicyRequest(input *applicationautoscaling.DeleteScalingPolicyInput) applicationautoscaling.DeleteScalingPolicyRequest {
+	return m.MockDelete(input)
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationautoscaling
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+
+	"github.com/crossplane/provider-aws/apis/applicationautoscaling/v1alpha1"
+)
+
+// ScalableTargetClient defines Application Auto Scaling ScalableTarget client
+// operations
+type ScalableTargetClient interface {
+	RegisterScalableTargetRequest(input *applicationautoscaling.RegisterScalableTargetInput) applicationautoscaling.RegisterScalableTargetRequest
+	DescribeScalableTargetsRequest(input *applicationautoscaling.DescribeScalableTargetsInput) applicationautoscaling.DescribeScalableTargetsRequest
+	DeregisterScalableTargetRequest(input *applicationautoscaling.DeregisterScalableTargetInput) applicationautoscaling.DeregisterScalableTargetRequest
+}
+
+// NewScalableTargetClient creates new Application Auto Scaling Client with
+// provided AWS Configurations/Credentials
+func NewScalableTargetClient(cfg aws.Config) ScalableTargetClient {
+	return applicationautoscaling.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the item doesn't exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == applicationautoscaling.ErrCodeObjectNotFoundException
+	}
+	return false
+}
+
+// GenerateDescribeScalableTargetsInput returns the input to find the scalable
+// target described by p.
+func GenerateDescribeScalableTargetsInput(p v1alpha1.ScalableTargetParameters) *applicationautoscaling.DescribeScalableTargetsInput {
+	return &applicationautoscaling.DescribeScalableTargetsInput{
+		ServiceNamespace:  applicationautoscaling.ServiceNamespace(p.ServiceNamespace),
+		ResourceIds:       []string{aws.StringValue(p.ResourceID)},
+		ScalableDimension: applicationautoscaling.ScalableDimension(p.ScalableDimension),
+	}
+}
+
+// GenerateRegisterScalableTargetInput returns the input to register or update
+// the scalable target described by p.
+func GenerateRegisterScalableTargetInput(p v1alpha1.ScalableTargetParameters) *applicationautoscaling.RegisterScalableTargetInput {
+	return &applicationautoscaling.RegisterScalableTargetInput{
+		ServiceNamespace:  applicationautoscaling.ServiceNamespace(p.ServiceNamespace),
+		ResourceId:        p.ResourceID,
+		ScalableDimension: applicationautoscaling.ScalableDimension(p.ScalableDimension),
+		MinCapacity:       aws.Int64(p.MinCapacity),
+		MaxCapacity:       aws.Int64(p.MaxCapacity),
+		RoleARN:           p.RoleARN,
+	}
+}
+
+// GenerateScalableTargetObservation is used to produce
+// v1alpha1.ScalableTargetObservation from applicationautoscaling.ScalableTarget.
+func GenerateScalableTargetObservation(t applicationautoscaling.ScalableTarget) v1alpha1.ScalableTargetObservation {
+	return v1alpha1.ScalableTargetObservation{
+		RoleARN: aws.StringValue(t.RoleARN),
+	}
+}
+
+// IsScalableTargetUpToDate checks whether the observed scalable target matches
+// the desired parameters.
+func IsScalableTargetUpToDate(p v1alpha1.ScalableTargetParameters, t applicationautoscaling.ScalableTarget) bool {
+	if p.MinCapacity != aws.Int64Value(t.MinCapacity) || p.MaxCapacity != aws.Int64Value(t.MaxCapacity) {
+		return false
+	}
+	return p.RoleARN == nil || aws.StringValue(p.RoleARN) == aws.StringValue(t.RoleARN)
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationautoscaling
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/applicationautoscaling/v1alpha1"
+)
+
+var (
+	resourceID = "table/some-table"
+	roleARN    = "arn:aws:iam::123456789012:role/some-role"
+)
+
+func targetParams() v1alpha1.ScalableTargetParameters {
+	return v1alpha1.ScalableTargetParameters{
+		ServiceNamespace:  "dynamodb",
+		ResourceID:        aws.String(resourceID),
+		ScalableDimension: "dynamodb:table:ReadCapacityUnits",
+		MinCapacity:       5,
+		MaxCapacity:       100,
+	}
+}
+
+func TestGenerateRegisterScalableTargetInput(t *testing.T) {
+	want := &applicationautoscaling.RegisterScalableTargetInput{
+		ServiceNamespace:  applicationautoscaling.ServiceNamespaceDynamodb,
+		ResourceId:        aws.String(resourceID),
+		ScalableDimension: applicationautoscaling.ScalableDimensionDynamodbTableReadCapacityUnits,
+		MinCapacity:       aws.Int64(5),
+		MaxCapacity:       aws.Int64(100),
+	}
+	if diff := cmp.Diff(want, GenerateRegisterScalableTargetInput(targetParams())); diff != "" {
+		t.Errorf("GenerateRegisterScalableTargetInput(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsScalableTargetUpToDate(t *testing.T) {
+	observed := applicationautoscaling.ScalableTarget{
+		MinCapacity: aws.Int64(5),
+		MaxCapacity: aws.Int64(100),
+		RoleARN:     aws.String(roleARN),
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.ScalableTargetParameters
+		t    applicationautoscaling.ScalableTarget
+		want bool
+	}{
+		"UpToDate": {
+			p:    targetParams(),
+			t:    observed,
+			want: true,
+		},
+		"CapacityChanged": {
+			p: func() v1alpha1.ScalableTargetParameters {
+				p := targetParams()
+				p.MaxCapacity = 200
+				return p
+			}(),
+			t:    observed,
+			want: false,
+		},
+		"RoleChanged": {
+			p: func() v1alpha1.ScalableTargetParameters {
+				p := targetParams()
+				p.RoleARN = aws.String("arn:aws:iam::123456789012:role/other-role")
+				return p
+			}(),
+			t:    observed,
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsScalableTargetUpToDate(tc.p, tc.t)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsScalableTargetUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
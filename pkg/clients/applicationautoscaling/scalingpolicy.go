@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationautoscaling
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/applicationautoscaling/v1alpha1"
+)
+
+// ScalingPolicyClient defines Application Auto Scaling ScalingPolicy client
+// operations
+type ScalingPolicyClient interface {
+	PutScalingPolicyRequest(input *applicationautoscaling.PutScalingPolicyInput) applicationautoscaling.PutScalingPolicyRequest
+	DescribeScalingPoliciesRequest(input *applicationautoscaling.DescribeScalingPoliciesInput) applicationautoscaling.DescribeScalingPoliciesRequest
+	DeleteScalingPolicyRequest(input *applicationautoscaling.DeleteScalingPolicyInput) applicationautoscaling.DeleteScalingPolicyRequest
+}
+
+// NewScalingPolicyClient creates new Application Auto Scaling Client with
+// provided AWS Configurations/Credentials
+func NewScalingPolicyClient(cfg aws.Config) ScalingPolicyClient {
+	return applicationautoscaling.New(cfg)
+}
+
+// GenerateDescribeScalingPoliciesInput returns the input to find the scaling
+// policy with the given name.
+func GenerateDescribeScalingPoliciesInput(name string, p v1alpha1.ScalingPolicyParameters) *applicationautoscaling.DescribeScalingPoliciesInput {
+	return &applicationautoscaling.DescribeScalingPoliciesInput{
+		PolicyNames:       []string{name},
+		ServiceNamespace:  applicationautoscaling.ServiceNamespace(p.ServiceNamespace),
+		ResourceId:        p.ResourceID,
+		ScalableDimension: applicationautoscaling.ScalableDimension(p.ScalableDimension),
+	}
+}
+
+// GenerateTargetTrackingConfiguration returns the target tracking
+// configuration described by c.
+func GenerateTargetTrackingConfiguration(c v1alpha1.TargetTrackingConfiguration) *applicationautoscaling.TargetTrackingScalingPolicyConfiguration {
+	res := &applicationautoscaling.TargetTrackingScalingPolicyConfiguration{
+		TargetValue:      aws.Float64(c.TargetValue),
+		ScaleInCooldown:  c.ScaleInCooldown,
+		ScaleOutCooldown: c.ScaleOutCooldown,
+		DisableScaleIn:   c.DisableScaleIn,
+	}
+	if c.PredefinedMetricType != nil {
+		res.PredefinedMetricSpecification = &applicationautoscaling.PredefinedMetricSpecification{
+			PredefinedMetricType: applicationautoscaling.MetricType(aws.StringValue(c.PredefinedMetricType)),
+			ResourceLabel:        c.ResourceLabel,
+		}
+	}
+	if m := c.CustomizedMetricSpecification; m != nil {
+		res.CustomizedMetricSpecification = &applicationautoscaling.CustomizedMetricSpecification{
+			Namespace:  aws.String(m.Namespace),
+			MetricName: aws.String(m.MetricName),
+			Statistic:  applicationautoscaling.MetricStatistic(m.Statistic),
+			Unit:       m.Unit,
+		}
+		for _, d := range m.Dimensions {
+			res.CustomizedMetricSpecification.Dimensions = append(res.CustomizedMetricSpecification.Dimensions,
+				applicationautoscaling.MetricDimension{Name: aws.String(d.Name), Value: aws.String(d.Value)})
+		}
+	}
+	return res
+}
+
+// GeneratePutScalingPolicyInput returns the input to create or update the
+// target tracking scaling policy with the given name.
+func GeneratePutScalingPolicyInput(name string, p v1alpha1.ScalingPolicyParameters) *applicationautoscaling.PutScalingPolicyInput {
+	return &applicationautoscaling.PutScalingPolicyInput{
+		PolicyName:                               aws.String(name),
+		PolicyType:                               applicationautoscaling.PolicyTypeTargetTrackingScaling,
+		ServiceNamespace:                         applicationautoscaling.ServiceNamespace(p.ServiceNamespace),
+		ResourceId:                               p.ResourceID,
+		ScalableDimension:                        applicationautoscaling.ScalableDimension(p.ScalableDimension),
+		TargetTrackingScalingPolicyConfiguration: GenerateTargetTrackingConfiguration(p.TargetTrackingConfiguration),
+	}
+}
+
+// LateInitializeScalingPolicy fills the empty fields in *v1alpha1.ScalingPolicyParameters
+// with the values seen in applicationautoscaling.ScalingPolicy.
+func LateInitializeScalingPolicy(in *v1alpha1.ScalingPolicyParameters, p *applicationautoscaling.ScalingPolicy) {
+	if p == nil || p.TargetTrackingScalingPolicyConfiguration == nil {
+		return
+	}
+	c := &in.TargetTrackingConfiguration
+	o := p.TargetTrackingScalingPolicyConfiguration
+	if c.ScaleInCooldown == nil {
+		c.ScaleInCooldown = o.ScaleInCooldown
+	}
+	if c.ScaleOutCooldown == nil {
+		c.ScaleOutCooldown = o.ScaleOutCooldown
+	}
+	if c.DisableScaleIn == nil {
+		c.DisableScaleIn = o.DisableScaleIn
+	}
+}
+
+// GenerateScalingPolicyObservation is used to produce
+// v1alpha1.ScalingPolicyObservation from applicationautoscaling.ScalingPolicy.
+func GenerateScalingPolicyObservation(p applicationautoscaling.ScalingPolicy) v1alpha1.ScalingPolicyObservation {
+	o := v1alpha1.ScalingPolicyObservation{
+		PolicyARN: aws.StringValue(p.PolicyARN),
+	}
+	for _, a := range p.Alarms {
+		o.Alarms = append(o.Alarms, aws.StringValue(a.AlarmName))
+	}
+	return o
+}
+
+// IsScalingPolicyUpToDate checks whether the observed scaling policy matches
+// the desired parameters.
+func IsScalingPolicyUpToDate(p v1alpha1.ScalingPolicyParameters, sp applicationautoscaling.ScalingPolicy) bool {
+	if sp.PolicyType != applicationautoscaling.PolicyTypeTargetTrackingScaling {
+		return false
+	}
+	return cmp.Equal(GenerateTargetTrackingConfiguration(p.TargetTrackingConfiguration), sp.TargetTrackingScalingPolicyConfiguration)
+}
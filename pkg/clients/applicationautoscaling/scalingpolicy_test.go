@@ -0,0 +1,169 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationautoscaling
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/applicationautoscaling/v1alpha1"
+)
+
+var policyName = "some-policy"
+
+func policyParams() v1alpha1.ScalingPolicyParameters {
+	return v1alpha1.ScalingPolicyParameters{
+		ServiceNamespace:  "dynamodb",
+		ResourceID:        aws.String(resourceID),
+		ScalableDimension: "dynamodb:table:ReadCapacityUnits",
+		TargetTrackingConfiguration: v1alpha1.TargetTrackingConfiguration{
+			TargetValue:          70,
+			PredefinedMetricType: aws.String("DynamoDBReadCapacityUtilization"),
+		},
+	}
+}
+
+func TestGeneratePutScalingPolicyInput(t *testing.T) {
+	custom := policyParams()
+	custom.TargetTrackingConfiguration = v1alpha1.TargetTrackingConfiguration{
+		TargetValue: 50,
+		CustomizedMetricSpecification: &v1alpha1.CustomizedMetricSpecification{
+			Namespace:  "AWS/DynamoDB",
+			MetricName: "ConsumedReadCapacityUnits",
+			Dimensions: []v1alpha1.MetricDimension{{Name: "TableName", Value: "some-table"}},
+			Statistic:  "Sum",
+		},
+		ScaleInCooldown: aws.Int64(60),
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.ScalingPolicyParameters
+		want *applicationautoscaling.PutScalingPolicyInput
+	}{
+		"PredefinedMetric": {
+			p: policyParams(),
+			want: &applicationautoscaling.PutScalingPolicyInput{
+				PolicyName:        aws.String(policyName),
+				PolicyType:        applicationautoscaling.PolicyTypeTargetTrackingScaling,
+				ServiceNamespace:  applicationautoscaling.ServiceNamespaceDynamodb,
+				ResourceId:        aws.String(resourceID),
+				ScalableDimension: applicationautoscaling.ScalableDimensionDynamodbTableReadCapacityUnits,
+				TargetTrackingScalingPolicyConfiguration: &applicationautoscaling.TargetTrackingScalingPolicyConfiguration{
+					TargetValue: aws.Float64(70),
+					PredefinedMetricSpecification: &applicationautoscaling.PredefinedMetricSpecification{
+						PredefinedMetricType: applicationautoscaling.MetricTypeDynamoDbreadCapacityUtilization,
+					},
+				},
+			},
+		},
+		"CustomizedMetric": {
+			p: custom,
+			want: &applicationautoscaling.PutScalingPolicyInput{
+				PolicyName:        aws.String(policyName),
+				PolicyType:        applicationautoscaling.PolicyTypeTargetTrackingScaling,
+				ServiceNamespace:  applicationautoscaling.ServiceNamespaceDynamodb,
+				ResourceId:        aws.String(resourceID),
+				ScalableDimension: applicationautoscaling.ScalableDimensionDynamodbTableReadCapacityUnits,
+				TargetTrackingScalingPolicyConfiguration: &applicationautoscaling.TargetTrackingScalingPolicyConfiguration{
+					TargetValue:     aws.Float64(50),
+					ScaleInCooldown: aws.Int64(60),
+					CustomizedMetricSpecification: &applicationautoscaling.CustomizedMetricSpecification{
+						Namespace:  aws.String("AWS/DynamoDB"),
+						MetricName: aws.String("ConsumedReadCapacityUnits"),
+						Dimensions: []applicationautoscaling.MetricDimension{{Name: aws.String("TableName"), Value: aws.String("some-table")}},
+						Statistic:  applicationautoscaling.MetricStatisticSum,
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GeneratePutScalingPolicyInput(policyName, tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GeneratePutScalingPolicyInput(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsScalingPolicyUpToDate(t *testing.T) {
+	observed := func(target float64) applicationautoscaling.ScalingPolicy {
+		return applicationautoscaling.ScalingPolicy{
+			PolicyType: applicationautoscaling.PolicyTypeTargetTrackingScaling,
+			TargetTrackingScalingPolicyConfiguration: &applicationautoscaling.TargetTrackingScalingPolicyConfiguration{
+				TargetValue: aws.Float64(target),
+				PredefinedMetricSpecification: &applicationautoscaling.PredefinedMetricSpecification{
+					PredefinedMetricType: applicationautoscaling.MetricTypeDynamoDbreadCapacityUtilization,
+				},
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.ScalingPolicyParameters
+		sp   applicationautoscaling.ScalingPolicy
+		want bool
+	}{
+		"UpToDate": {
+			p:    policyParams(),
+			sp:   observed(70),
+			want: true,
+		},
+		"TargetChanged": {
+			p:    policyParams(),
+			sp:   observed(50),
+			want: false,
+		},
+		"StepScaling": {
+			p:    policyParams(),
+			sp:   applicationautoscaling.ScalingPolicy{PolicyType: applicationautoscaling.PolicyTypeStepScaling},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsScalingPolicyUpToDate(tc.p, tc.sp)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsScalingPolicyUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeScalingPolicy(t *testing.T) {
+	p := policyParams()
+	LateInitializeScalingPolicy(&p, &applicationautoscaling.ScalingPolicy{
+		TargetTrackingScalingPolicyConfiguration: &applicationautoscaling.TargetTrackingScalingPolicyConfiguration{
+			ScaleInCooldown:  aws.Int64(0),
+			ScaleOutCooldown: aws.Int64(0),
+			DisableScaleIn:   aws.Bool(false),
+		},
+	})
+	want := policyParams()
+	want.TargetTrackingConfiguration.ScaleInCooldown = aws.Int64(0)
+	want.TargetTrackingConfiguration.ScaleOutCooldown = aws.Int64(0)
+	want.TargetTrackingConfiguration.DisableScaleIn = aws.Bool(false)
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("LateInitializeScalingPolicy(...): -want, +got:\n%s", diff)
+	}
+}
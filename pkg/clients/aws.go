@@ -32,19 +32,26 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	"github.com/aws/aws-sdk-go-v2/aws/endpoints"
 	"github.com/aws/aws-sdk-go-v2/aws/external"
+	awsretry "github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/aws/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	awsv1 "github.com/aws/aws-sdk-go/aws"
+	clientv1 "github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	stscredsv1 "github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	endpointsv1 "github.com/aws/aws-sdk-go/aws/endpoints"
+	requestv1 "github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/go-ini/ini"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -72,14 +79,117 @@ const (
 	FieldRequired FieldOption = iota
 )
 
+const (
+	apiRateLimitHandlerName = "crossplane.APIRateLimit"
+	apiLogHandlerName       = "crossplane.APILog"
+)
+
+// Retry modes of the AWS clients.
+const (
+	// RetryModeStandard retries failed requests with exponential backoff
+	// until the retry quota of the client is exhausted.
+	RetryModeStandard = "standard"
+
+	// RetryModeAdaptive retries failed requests like RetryModeStandard, but
+	// never gives up because the retry quota is exhausted and backs off for
+	// up to a minute instead of 20 seconds. Once an AWS API throttles the
+	// requests, it also limits the rate they are sent to that API at in the
+	// same region, adapting the limit to how often they are still throttled.
+	// It suits high numbers of managed resources that share the same AWS API
+	// rate limits. Clients of the AWS SDK v1 ignore it.
+	RetryModeAdaptive = "adaptive"
+)
+
+// adaptiveMaxBackoff is the longest time a request waits before it is
+// retried in adaptive retry mode.
+const adaptiveMaxBackoff = time.Minute
+
+// SetRateLimit makes the requests sent with the given configuration wait for
+// the limiter of the given options, if any. Every attempt of a request counts
+// towards the limit, including retries.
+func SetRateLimit(cfg *aws.Config, o APIOptions) *aws.Config {
+	l := o.Limiter
+	if cfg == nil || l == nil {
+		return cfg
+	}
+	// Sign handlers run once per attempt and stop the request when they set
+	// an error.
+	cfg.Handlers.Sign.PushFrontNamed(aws.NamedHandler{Name: apiRateLimitHandlerName, Fn: func(r *aws.Request) {
+		if err := l.Wait(r.Context()); err != nil {
+			r.Error = err
+		}
+	}})
+	return cfg
+}
+
+// SetRequestLogging makes the requests sent with the given configuration log
+// their AWS request ID along with the managed resource they were sent for, if
+// the given options have a logger.
+func SetRequestLogging(cfg *aws.Config, mg resource.Managed, o APIOptions) *aws.Config {
+	l := o.Logger
+	if cfg == nil || l == nil {
+		return cfg
+	}
+	l = l.WithValues("name", mg.GetName(), "external-name", meta.GetExternalName(mg))
+	cfg.Handlers.Complete.PushBackNamed(aws.NamedHandler{Name: apiLogHandlerName, Fn: func(r *aws.Request) {
+		kv := []interface{}{"service", r.Metadata.ServiceName, "request-id", r.RequestID, "retries", r.RetryCount}
+		if r.Operation != nil {
+			kv = append(kv, "operation", r.Operation.Name)
+		}
+		if r.Error != nil {
+			kv = append(kv, "error", r.Error)
+		}
+		l.Debug("AWS API request", kv...)
+	}})
+	return cfg
+}
+
+// SetRetryer makes the requests sent with the given configuration retry as
+// set by the given options. The retryer of the configuration is left
+// untouched if the defaults of the AWS SDK are used.
+func SetRetryer(cfg *aws.Config, o APIOptions) *aws.Config {
+	if cfg == nil || (o.RetryMode != RetryModeAdaptive && o.MaxAttempts < 1) {
+		return cfg
+	}
+	mode, attempts := o.RetryMode, o.MaxAttempts
+	cfg.Retryer = awsretry.NewStandard(func(o *awsretry.StandardOptions) {
+		if attempts > 0 {
+			o.MaxAttempts = attempts
+		}
+		if mode == RetryModeAdaptive {
+			o.MaxBackoff = adaptiveMaxBackoff
+			o.RateLimiter = unlimitedRetryQuota{}
+		}
+	})
+	if mode == RetryModeAdaptive {
+		return setAdaptiveRateLimit(cfg, adaptiveRateLimits)
+	}
+	return cfg
+}
+
+// unlimitedRetryQuota is a retry quota that never runs out.
+type unlimitedRetryQuota struct{}
+
+func (unlimitedRetryQuota) GetToken(context.Context, uint) (func() error, error) {
+	return func() error { return nil }, nil
+}
+
+func (unlimitedRetryQuota) AddTokens(uint) error {
+	return nil
+}
+
 // GetConfig constructs an *aws.Config that can be used to authenticate to AWS
-// API by the AWS clients.
+// API by the AWS clients. The requests sent with it are configured with the
+// APIOptions of the context, if any.
 func GetConfig(ctx context.Context, c client.Client, mg resource.Managed, region string) (*aws.Config, error) {
+	o := apiOptionsFrom(ctx)
 	switch {
 	case mg.GetProviderConfigReference() != nil:
-		return UseProviderConfig(ctx, c, mg, region)
+		cfg, err := UseProviderConfig(ctx, c, mg, region)
+		return SetRequestLogging(SetRetryer(SetRateLimit(cfg, o), o), mg, o), err
 	case mg.GetProviderReference() != nil:
-		return UseProvider(ctx, c, mg, region)
+		cfg, err := UseProvider(ctx, c, mg, region)
+		return SetRequestLogging(SetRetryer(SetRateLimit(cfg, o), o), mg, o), err
 	default:
 		return nil, errors.New("neither providerConfigRef nor providerRef is given")
 	}
@@ -97,18 +207,64 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
 	}
 
+	cfg, err := UseProviderConfigSpec(ctx, c, pc, region)
+	if err != nil {
+		return nil, err
+	}
+	return SetResolver(ctx, mg, cfg), nil
+}
+
+// UseProviderConfigSpec produces a config that authenticates to AWS with the
+// credentials, endpoint and role of the given ProviderConfig.
+func UseProviderConfigSpec(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*aws.Config, error) {
+	var cfg *aws.Config
+	var err error
 	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
 	case xpv1.CredentialsSourceInjectedIdentity:
-		cfg, err := UsePodServiceAccount(ctx, []byte{}, DefaultSection, region)
-		return SetResolver(ctx, mg, cfg), err
+		cfg, err = UsePodServiceAccount(ctx, []byte{}, DefaultSection, region)
 	default:
-		data, err := resource.CommonCredentialExtractor(ctx, s, c, pc.Spec.Credentials.CommonCredentialSelectors)
+		var data []byte
+		data, err = resource.CommonCredentialExtractor(ctx, s, c, pc.Spec.Credentials.CommonCredentialSelectors)
 		if err != nil {
 			return nil, errors.Wrap(err, "cannot get credentials")
 		}
-		cfg, err := UseProviderSecret(ctx, data, DefaultSection, region)
-		return SetResolver(ctx, mg, cfg), err
+		cfg, err = UseProviderSecret(ctx, data, DefaultSection, region)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return SetAssumeRole(SetEndpoint(cfg, pc.Spec.Endpoint), pc.Spec.AssumeRole), nil
+}
+
+// SetAssumeRole makes the given configuration use the credentials of the given
+// role, which is assumed with the credentials the configuration already has.
+func SetAssumeRole(cfg *aws.Config, a *v1beta1.AssumeRoleConfig) *aws.Config {
+	if cfg == nil || a == nil {
+		return cfg
+	}
+	cfg.Credentials = stscreds.NewAssumeRoleProvider(sts.New(*cfg), a.RoleARN, func(o *stscreds.AssumeRoleProviderOptions) {
+		o.ExternalID = a.ExternalID
+	})
+	return cfg
+}
+
+// SetEndpoint makes the given configuration send the requests of all services
+// to the given endpoint, if any.
+func SetEndpoint(cfg *aws.Config, e *v1beta1.EndpointConfig) *aws.Config {
+	if cfg == nil || e == nil {
+		return cfg
 	}
+	cfg.EndpointResolver = aws.EndpointResolverFunc(func(_, region string) (aws.Endpoint, error) {
+		endpoint := aws.Endpoint{
+			URL:           e.URL,
+			SigningRegion: region,
+		}
+		if e.SigningRegion != nil {
+			endpoint.SigningRegion = *e.SigningRegion
+		}
+		return endpoint, nil
+	})
+	return cfg
 }
 
 // SetResolver parses annotations from the managed resource
@@ -123,7 +279,10 @@ func SetResolver(ctx context.Context, mg resource.Managed, cfg *aws.Config) *aws
 				endpoint.SigningRegion = Region
 			}
 
-			defaultResolver := endpoints.NewDefaultResolver()
+			defaultResolver := cfg.EndpointResolver
+			if defaultResolver == nil {
+				defaultResolver = endpoints.NewDefaultResolver()
+			}
 			endpointResolver := func(service, region string) (aws.Endpoint, error) {
 				if strings.Contains(ServiceID, service) {
 					return endpoint, nil
@@ -267,8 +426,10 @@ func UsePodServiceAccount(ctx context.Context, _ []byte, _, region string) (*aws
 // aws/aws-sdk-go-v2. These functions are implemented to be used by those controllers.
 
 // GetConfigV1 constructs an *awsv1.Config that can be used to authenticate to AWS
-// API by the AWSv1 clients.
+// API by the AWSv1 clients. The requests sent with it are configured with the
+// APIOptions of the context, if any.
 func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, region string) (*session.Session, error) { // nolint:gocyclo
+	o := apiOptionsFrom(ctx)
 	if mg.GetProviderConfigReference() == nil {
 		return nil, errors.New("providerConfigRef cannot be empty")
 	}
@@ -287,7 +448,8 @@ func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, regi
 		if err != nil {
 			return nil, errors.Wrap(err, "cannot use pod service account")
 		}
-		return session.NewSession(cfg)
+		sess, err := newSessionV1(SetResolverV1(ctx, mg, SetEndpointV1(cfg, pc.Spec.Endpoint)), pc.Spec.AssumeRole, o)
+		return SetRequestLoggingV1(sess, mg, o), err
 	default:
 		data, err := resource.CommonCredentialExtractor(ctx, s, c, pc.Spec.Credentials.CommonCredentialSelectors)
 		if err != nil {
@@ -297,8 +459,76 @@ func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, regi
 		if err != nil {
 			return nil, errors.Wrap(err, "cannot use secret")
 		}
-		return session.NewSession(cfg)
+		sess, err := newSessionV1(SetResolverV1(ctx, mg, SetEndpointV1(cfg, pc.Spec.Endpoint)), pc.Spec.AssumeRole, o)
+		return SetRequestLoggingV1(sess, mg, o), err
+	}
+}
+
+// newSessionV1 returns a session with the given configuration that uses the
+// credentials of the given role, if any.
+func newSessionV1(cfg *awsv1.Config, a *v1beta1.AssumeRoleConfig, o APIOptions) (*session.Session, error) {
+	cfg = SetRetryerV1(cfg, o)
+	sess, err := session.NewSession(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if a != nil {
+		sess, err = session.NewSession(cfg.Copy().WithCredentials(stscredsv1.NewCredentials(sess, a.RoleARN, func(p *stscredsv1.AssumeRoleProvider) {
+			p.ExternalID = a.ExternalID
+		})))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return SetRateLimitV1(sess, o), nil
+}
+
+// SetRateLimitV1 makes the requests sent with the given session wait for the
+// limiter of the given options, if any.
+func SetRateLimitV1(sess *session.Session, o APIOptions) *session.Session {
+	l := o.Limiter
+	if sess == nil || l == nil {
+		return sess
 	}
+	sess.Handlers.Sign.PushFrontNamed(requestv1.NamedHandler{Name: apiRateLimitHandlerName, Fn: func(r *requestv1.Request) {
+		if err := l.Wait(r.Context()); err != nil {
+			r.Error = err
+		}
+	}})
+	return sess
+}
+
+// SetRetryerV1 makes the requests sent with the given configuration retry as
+// many times as set by the given options. The AWS SDK v1 has no retry quota
+// and already backs off for minutes, so the retry mode makes no difference
+// here.
+func SetRetryerV1(cfg *awsv1.Config, o APIOptions) *awsv1.Config {
+	if cfg == nil || o.MaxAttempts < 1 {
+		return cfg
+	}
+	return requestv1.WithRetryer(cfg, clientv1.DefaultRetryer{NumMaxRetries: o.MaxAttempts - 1})
+}
+
+// SetRequestLoggingV1 makes the requests sent with the given session log
+// their AWS request ID along with the managed resource they were sent for, if
+// the given options have a logger.
+func SetRequestLoggingV1(sess *session.Session, mg resource.Managed, o APIOptions) *session.Session {
+	l := o.Logger
+	if sess == nil || l == nil {
+		return sess
+	}
+	l = l.WithValues("name", mg.GetName(), "external-name", meta.GetExternalName(mg))
+	sess.Handlers.Complete.PushBackNamed(requestv1.NamedHandler{Name: apiLogHandlerName, Fn: func(r *requestv1.Request) {
+		kv := []interface{}{"service", r.ClientInfo.ServiceName, "request-id", r.RequestID, "retries", r.RetryCount}
+		if r.Operation != nil {
+			kv = append(kv, "operation", r.Operation.Name)
+		}
+		if r.Error != nil {
+			kv = append(kv, "error", r.Error)
+		}
+		l.Debug("AWS API request", kv...)
+	}})
+	return sess
 }
 
 // UseProviderSecretV1 retrieves AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY from
@@ -379,12 +609,16 @@ func SetResolverV1(ctx context.Context, mg resource.Managed, cfg *awsv1.Config)
 				endpoint.SigningRegion = Region
 			}
 
+			defaultResolver := cfg.EndpointResolver
+			if defaultResolver == nil {
+				defaultResolver = endpointsv1.DefaultResolver()
+			}
 			endpointResolver := func(service, region string, optFns ...func(*endpointsv1.Options)) (endpointsv1.ResolvedEndpoint, error) {
 				if strings.Contains(ServiceID, service) {
 					return endpoint, nil
 				}
 
-				return endpointsv1.DefaultResolver().EndpointFor(service, region, optFns...)
+				return defaultResolver.EndpointFor(service, region, optFns...)
 			}
 			cfg.EndpointResolver = endpointsv1.ResolverFunc(endpointResolver)
 		}
@@ -392,6 +626,25 @@ func SetResolverV1(ctx context.Context, mg resource.Managed, cfg *awsv1.Config)
 	return cfg
 }
 
+// SetEndpointV1 makes the given V1 configuration send the requests of all
+// services to the given endpoint, if any.
+func SetEndpointV1(cfg *awsv1.Config, e *v1beta1.EndpointConfig) *awsv1.Config {
+	if cfg == nil || e == nil {
+		return cfg
+	}
+	cfg.EndpointResolver = endpointsv1.ResolverFunc(func(_, region string, _ ...func(*endpointsv1.Options)) (endpointsv1.ResolvedEndpoint, error) {
+		endpoint := endpointsv1.ResolvedEndpoint{
+			URL:           e.URL,
+			SigningRegion: region,
+		}
+		if e.SigningRegion != nil {
+			endpoint.SigningRegion = *e.SigningRegion
+		}
+		return endpoint, nil
+	})
+	return cfg
+}
+
 // TODO(muvaf): All the types that use CreateJSONPatch are known during
 // development time. In order to avoid unnecessary panic checks, we can generate
 // the code that creates a patch between two objects that share the same type.
@@ -578,7 +831,10 @@ func CompactAndEscapeJSON(s string) (string, error) {
 	return url.QueryEscape(buffer.String()), nil
 }
 
-// DiffTags returns tags that should be added or removed.
+// DiffTags returns tags that should be added or removed. It works on plain
+// key-value maps so that it can be shared by all resources regardless of the
+// tag type of their service. Keys whose value changed are returned in both
+// add and remove, so callers should remove tags before adding them.
 func DiffTags(local, remote map[string]string) (add map[string]string, remove []string) {
 	add = make(map[string]string, len(local))
 	remove = []string{}
@@ -666,3 +922,24 @@ func CleanError(err error) error {
 func Wrap(err error, msg string) error {
 	return errors.Wrap(CleanError(err), msg)
 }
+
+// UpdateSpec persists the metadata and spec of the managed resource. The API
+// server does not write the status with this call but its response replaces
+// the whole object in memory, which would drop the status observed during this
+// reconcile. The status is restored afterwards so that the managed reconciler
+// persists it through the status subresource.
+func UpdateSpec(ctx context.Context, kube client.Client, mg resource.Managed) error {
+	before, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+	if err != nil {
+		return err
+	}
+	if err := kube.Update(ctx, mg); err != nil {
+		return err
+	}
+	after, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+	if err != nil {
+		return err
+	}
+	after["status"] = before["status"]
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(after, mg)
+}
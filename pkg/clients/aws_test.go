@@ -19,13 +19,33 @@ package aws
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awsretry "github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/aws/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	requestv1 "github.com/aws/aws-sdk-go/aws/request"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	redshiftv1alpha1 "github.com/crossplane/provider-aws/apis/redshift/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 const (
@@ -76,6 +96,238 @@ func TestUseProviderSecret(t *testing.T) {
 	g.Expect(config).NotTo(BeNil())
 }
 
+func TestUseProviderConfig(t *testing.T) {
+	errBoom := errors.New("boom")
+	credentials := []byte(fmt.Sprintf(awsCredentialsFileFormat, DefaultSection, "testID", "testSecret"))
+	mg := &fake.Managed{
+		ObjectMeta:               metav1.ObjectMeta{Name: "cool-resource", UID: "cool-uid"},
+		ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: "cool-config"}},
+	}
+	get := func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		switch o := obj.(type) {
+		case *v1beta1.ProviderConfig:
+			o.Spec.Credentials.Source = xpv1.CredentialsSourceSecret
+			o.Spec.Credentials.SecretRef = &xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Name: "creds", Namespace: "crossplane-system"},
+				Key:             "credentials",
+			}
+		case *v1beta1.ProviderConfigUsage:
+			return kerrors.NewNotFound(schema.GroupResource{}, "")
+		case *corev1.Secret:
+			o.Data = map[string][]byte{"credentials": credentials}
+		}
+		return nil
+	}
+
+	type want struct {
+		usage *v1beta1.ProviderConfigUsage
+		err   error
+	}
+	cases := map[string]struct {
+		reason    string
+		createErr error
+		want      want
+	}{
+		"UsageTracked": {
+			reason: "A ProviderConfigUsage should be created for the managed resource using the ProviderConfig.",
+			want: want{
+				usage: &v1beta1.ProviderConfigUsage{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "cool-uid",
+						Labels:          map[string]string{xpv1.LabelKeyProviderName: "cool-config"},
+						OwnerReferences: []metav1.OwnerReference{meta.AsController(meta.TypedReferenceTo(mg, mg.GetObjectKind().GroupVersionKind()))},
+					},
+					ProviderConfigUsage: xpv1.ProviderConfigUsage{
+						ProviderConfigReference: xpv1.Reference{Name: "cool-config"},
+						ResourceReference:       xpv1.TypedReference{Name: "cool-resource"},
+					},
+				},
+			},
+		},
+		"TrackFailed": {
+			reason:    "The credentials should not be used if the usage cannot be tracked.",
+			createErr: errBoom,
+			want: want{
+				err: errors.Wrap(errors.Wrap(errors.Wrap(errBoom, "cannot create object"), "cannot apply ProviderConfigUsage"), "cannot track ProviderConfig usage"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var usage *v1beta1.ProviderConfigUsage
+			kube := &test.MockClient{
+				MockGet: get,
+				MockCreate: func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+					if tc.createErr != nil {
+						return tc.createErr
+					}
+					usage = obj.(*v1beta1.ProviderConfigUsage)
+					return nil
+				},
+			}
+			cfg, err := UseProviderConfig(context.Background(), kube, mg, "us-east-1")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUseProviderConfig(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.usage, usage); diff != "" {
+				t.Errorf("\n%s\nUseProviderConfig(...): -want usage, +got usage:\n%s", tc.reason, diff)
+			}
+			if tc.want.err == nil && cfg == nil {
+				t.Errorf("\n%s\nUseProviderConfig(...): expected a config", tc.reason)
+			}
+		})
+	}
+}
+
+func TestSetEndpoint(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	testRegion := "us-west-2"
+	localstack := "http://localstack:4566"
+	override := "http://s3.example.com"
+	credentials := []byte(fmt.Sprintf(awsCredentialsFileFormat, "default", "testID", "testSecret"))
+
+	cfg, err := UseProviderSecret(context.TODO(), credentials, "default", testRegion)
+	g.Expect(err).NotTo(HaveOccurred())
+	cfg = SetEndpoint(cfg, &v1beta1.EndpointConfig{URL: localstack})
+
+	e, err := cfg.EndpointResolver.ResolveEndpoint("rds", testRegion)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(e.URL).To(Equal(localstack))
+	g.Expect(e.SigningRegion).To(Equal(testRegion))
+
+	// annotations on the managed resource take precedence
+	mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+		"aws.alpha.crossplane.io/endpointServiceID": "s3",
+		"aws.alpha.crossplane.io/endpointURL":       override,
+	}}}
+	cfg = SetResolver(context.TODO(), mg, cfg)
+
+	e, err = cfg.EndpointResolver.ResolveEndpoint("s3", testRegion)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(e.URL).To(Equal(override))
+
+	e, err = cfg.EndpointResolver.ResolveEndpoint("rds", testRegion)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(e.URL).To(Equal(localstack))
+}
+
+func TestSetAssumeRole(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	credentials := []byte(fmt.Sprintf(awsCredentialsFileFormat, "default", "testID", "testSecret"))
+
+	cfg, err := UseProviderSecret(context.TODO(), credentials, "default", "us-west-2")
+	g.Expect(err).NotTo(HaveOccurred())
+	static := cfg.Credentials
+
+	// no role to assume
+	cfg = SetAssumeRole(cfg, nil)
+	g.Expect(cfg.Credentials).To(Equal(static))
+
+	cfg = SetAssumeRole(cfg, &v1beta1.AssumeRoleConfig{
+		RoleARN:    "arn:aws:iam::123456789012:role/crossplane",
+		ExternalID: aws.String("external"),
+	})
+	g.Expect(cfg.Credentials).To(BeAssignableToTypeOf(&stscreds.AssumeRoleProvider{}))
+}
+
+func TestSetRateLimit(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	// no limit by default
+	cfg := SetRateLimit(&aws.Config{}, APIOptions{})
+	g.Expect(cfg.Handlers.Sign.Len()).To(Equal(0))
+
+	cfg = SetRateLimit(&aws.Config{}, APIOptions{Limiter: NewAPIRateLimiter(1, 1)})
+	g.Expect(cfg.Handlers.Sign.Len()).To(Equal(1))
+
+	// the first request uses the burst
+	r := &aws.Request{HTTPRequest: &http.Request{}}
+	r.SetContext(context.Background())
+	cfg.Handlers.Sign.Run(r)
+	g.Expect(r.Error).NotTo(HaveOccurred())
+
+	// the second one has to wait, which it can't do with a cancelled context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r = &aws.Request{HTTPRequest: &http.Request{}}
+	r.SetContext(ctx)
+	cfg.Handlers.Sign.Run(r)
+	g.Expect(r.Error).To(HaveOccurred())
+}
+
+func TestSetRetryer(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	// the SDK defaults are kept by default
+	g.Expect(SetRetryer(&aws.Config{}, APIOptions{}).Retryer).To(BeNil())
+	g.Expect(SetRetryerV1(&awsv1.Config{}, APIOptions{}).Retryer).To(BeNil())
+
+	o := APIOptions{RetryMode: RetryModeStandard, MaxAttempts: 5}
+	g.Expect(SetRetryer(&aws.Config{}, o).Retryer.MaxAttempts()).To(Equal(5))
+	g.Expect(SetRetryerV1(&awsv1.Config{}, o).Retryer.(requestv1.Retryer).MaxRetries()).To(Equal(4))
+
+	o = APIOptions{RetryMode: RetryModeAdaptive}
+	cfg := SetRetryer(&aws.Config{}, o)
+	r := cfg.Retryer
+	g.Expect(r.MaxAttempts()).To(Equal(awsretry.DefaultMaxAttempts))
+	g.Expect(SetRetryerV1(&awsv1.Config{}, o).Retryer).To(BeNil())
+
+	// requests are rate limited on the client in adaptive mode
+	g.Expect(cfg.Handlers.Sign.Len()).To(Equal(1))
+	g.Expect(cfg.Handlers.CompleteAttempt.Len()).To(Equal(1))
+
+	// the retry quota never runs out in adaptive mode
+	for i := 0; i < 1000; i++ {
+		_, err := r.GetRetryToken(context.Background(), errors.New("boom"))
+		g.Expect(err).NotTo(HaveOccurred())
+	}
+}
+
+// recordingLogger records the key/value pairs of the debug messages it logs.
+type recordingLogger struct {
+	kv      []interface{}
+	entries *[][]interface{}
+}
+
+func (l recordingLogger) Info(msg string, kv ...interface{}) {}
+
+func (l recordingLogger) Debug(msg string, kv ...interface{}) {
+	*l.entries = append(*l.entries, append(append([]interface{}{}, l.kv...), kv...))
+}
+
+func (l recordingLogger) WithValues(kv ...interface{}) logging.Logger {
+	return recordingLogger{kv: append(append([]interface{}{}, l.kv...), kv...), entries: l.entries}
+}
+
+func TestSetRequestLogging(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "cool"}}
+	meta.SetExternalName(mg, "cool-ext")
+
+	// nothing is logged by default
+	cfg := SetRequestLogging(&aws.Config{}, mg, APIOptions{})
+	g.Expect(cfg.Handlers.Complete.Len()).To(Equal(0))
+
+	var entries [][]interface{}
+	cfg = SetRequestLogging(&aws.Config{}, mg, APIOptions{Logger: recordingLogger{entries: &entries}})
+	g.Expect(cfg.Handlers.Complete.Len()).To(Equal(1))
+
+	r := &aws.Request{
+		Metadata:  aws.Metadata{ServiceName: "ec2"},
+		Operation: &aws.Operation{Name: "DescribeVpcs"},
+		RequestID: "req-1",
+	}
+	cfg.Handlers.Complete.Run(r)
+	g.Expect(entries).To(Equal([][]interface{}{{
+		"name", "cool", "external-name", "cool-ext",
+		"service", "ec2", "request-id", "req-1", "retries", 0, "operation", "DescribeVpcs",
+	}}))
+}
+
 func TestDiffTags(t *testing.T) {
 	type args struct {
 		local  map[string]string
@@ -457,3 +709,57 @@ func TestDiffLabels(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateSpec(t *testing.T) {
+	errBoom := errors.New("boom")
+	status := redshiftv1alpha1.ClusterStatus{
+		AtProvider: redshiftv1alpha1.ClusterObservation{ClusterStatus: redshiftv1alpha1.StateAvailable},
+	}
+	status.SetConditions(xpv1.Available())
+
+	type want struct {
+		cr  *redshiftv1alpha1.Cluster
+		err error
+	}
+	cases := map[string]struct {
+		kube client.Client
+		want
+	}{
+		"StatusPreserved": {
+			kube: &test.MockClient{
+				MockUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+					// The API server returns the status it has stored.
+					cr := obj.(*redshiftv1alpha1.Cluster)
+					cr.Status = redshiftv1alpha1.ClusterStatus{}
+					cr.SetResourceVersion("2")
+					return nil
+				},
+			},
+			want: want{
+				cr: &redshiftv1alpha1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{ResourceVersion: "2"},
+					Status:     status,
+				},
+			},
+		},
+		"UpdateFailed": {
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			want: want{
+				cr:  &redshiftv1alpha1.Cluster{Status: status},
+				err: errBoom,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &redshiftv1alpha1.Cluster{Status: *status.DeepCopy()}
+			err := UpdateSpec(context.Background(), tc.kube, cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batch
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/batch"
+)
+
+// Client defines AWS Batch client operations for compute environments and
+// job queues.
+type Client interface {
+	CreateComputeEnvironmentWithContext(ctx context.Context, input *batch.CreateComputeEnvironmentInput, opts ...request.Option) (*batch.CreateComputeEnvironmentOutput, error)
+	DescribeComputeEnvironmentsWithContext(ctx context.Context, input *batch.DescribeComputeEnvironmentsInput, opts ...request.Option) (*batch.DescribeComputeEnvironmentsOutput, error)
+	UpdateComputeEnvironmentWithContext(ctx context.Context, input *batch.UpdateComputeEnvironmentInput, opts ...request.Option) (*batch.UpdateComputeEnvironmentOutput, error)
+	DeleteComputeEnvironmentWithContext(ctx context.Context, input *batch.DeleteComputeEnvironmentInput, opts ...request.Option) (*batch.DeleteComputeEnvironmentOutput, error)
+
+	CreateJobQueueWithContext(ctx context.Context, input *batch.CreateJobQueueInput, opts ...request.Option) (*batch.CreateJobQueueOutput, error)
+	DescribeJobQueuesWithContext(ctx context.Context, input *batch.DescribeJobQueuesInput, opts ...request.Option) (*batch.DescribeJobQueuesOutput, error)
+	UpdateJobQueueWithContext(ctx context.Context, input *batch.UpdateJobQueueInput, opts ...request.Option) (*batch.UpdateJobQueueOutput, error)
+	DeleteJobQueueWithContext(ctx context.Context, input *batch.DeleteJobQueueInput, opts ...request.Option) (*batch.DeleteJobQueueOutput, error)
+}
+
+// NewClient creates new AWS Batch Client with provided AWS session. The
+// session based SDK is used because the request based one does not support
+// Fargate compute resources.
+func NewClient(sess *session.Session) Client {
+	return batch.New(sess)
+}
+
+// Settled reports whether no change is in progress for a compute environment
+// or job queue with the given status. AWS Batch rejects updates and deletions
+// while a change is in progress.
+func Settled(status string) bool {
+	return status == batch.JQStatusValid || status == batch.JQStatusInvalid
+}
+
+// MatchesNameOrARN reports whether the given name or ARN refers to the
+// resource with the given ARN. AWS Batch accepts names wherever it accepts
+// ARNs but always reports ARNs.
+func MatchesNameOrARN(nameOrARN, arn string) bool {
+	return nameOrARN == arn || strings.HasSuffix(arn, "/"+nameOrARN)
+}
+
+// sameStrings reports whether a and b contain the same strings regardless of
+// their order.
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	x := append([]string{}, a...)
+	y := append([]string{}, b...)
+	sort.Strings(x)
+	sort.Strings(y)
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
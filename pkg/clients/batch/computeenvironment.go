@@ -0,0 +1,159 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batch
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+
+	"github.com/crossplane/provider-aws/apis/batch/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// GenerateCreateComputeEnvironmentInput returns the input to create a compute
+// environment with the given name and parameters.
+func GenerateCreateComputeEnvironmentInput(name string, p v1alpha1.ComputeEnvironmentParameters) *batch.CreateComputeEnvironmentInput {
+	in := &batch.CreateComputeEnvironmentInput{
+		ComputeEnvironmentName: aws.String(name),
+		ServiceRole:            p.ServiceRole,
+		State:                  p.State,
+		Type:                   aws.String(p.Type),
+	}
+	if len(p.Tags) != 0 {
+		in.Tags = aws.StringMap(p.Tags)
+	}
+	if r := p.ComputeResources; r != nil {
+		in.ComputeResources = &batch.ComputeResource{
+			AllocationStrategy: r.AllocationStrategy,
+			BidPercentage:      r.BidPercentage,
+			DesiredvCpus:       r.DesiredvCPUs,
+			Ec2KeyPair:         r.EC2KeyPair,
+			InstanceRole:       r.InstanceRole,
+			InstanceTypes:      aws.StringSlice(r.InstanceTypes),
+			MaxvCpus:           aws.Int64(r.MaxvCPUs),
+			MinvCpus:           r.MinvCPUs,
+			SecurityGroupIds:   aws.StringSlice(r.SecurityGroupIDs),
+			SpotIamFleetRole:   r.SpotIAMFleetRole,
+			Subnets:            aws.StringSlice(r.Subnets),
+			Type:               aws.String(r.Type),
+		}
+		if len(r.Tags) != 0 {
+			in.ComputeResources.Tags = aws.StringMap(r.Tags)
+		}
+	}
+	return in
+}
+
+// GenerateUpdateComputeEnvironmentInput returns the input to update the
+// compute environment with the given name to match the given parameters.
+func GenerateUpdateComputeEnvironmentInput(name string, p v1alpha1.ComputeEnvironmentParameters) *batch.UpdateComputeEnvironmentInput {
+	in := &batch.UpdateComputeEnvironmentInput{
+		ComputeEnvironment: aws.String(name),
+		ServiceRole:        p.ServiceRole,
+		State:              p.State,
+	}
+	if r := p.ComputeResources; r != nil {
+		in.ComputeResources = &batch.ComputeResourceUpdate{
+			DesiredvCpus:     r.DesiredvCPUs,
+			MaxvCpus:         aws.Int64(r.MaxvCPUs),
+			MinvCpus:         r.MinvCPUs,
+			SecurityGroupIds: aws.StringSlice(r.SecurityGroupIDs),
+			Subnets:          aws.StringSlice(r.Subnets),
+		}
+	}
+	return in
+}
+
+// LateInitializeComputeEnvironment fills the empty fields in
+// *v1alpha1.ComputeEnvironmentParameters with the values seen in
+// batch.ComputeEnvironmentDetail. The desired vCPUs are not late initialized
+// because AWS Batch changes them as it scales the compute environment, and
+// settings that Fargate compute resources do not accept are left empty for
+// them.
+func LateInitializeComputeEnvironment(in *v1alpha1.ComputeEnvironmentParameters, ce *batch.ComputeEnvironmentDetail) {
+	if ce == nil {
+		return
+	}
+	in.State = awsclients.LateInitializeStringPtr(in.State, ce.State)
+	in.ServiceRole = awsclients.LateInitializeStringPtr(in.ServiceRole, ce.ServiceRole)
+	r, o := in.ComputeResources, ce.ComputeResources
+	if r == nil || o == nil {
+		return
+	}
+	if len(r.SecurityGroupIDs) == 0 && len(o.SecurityGroupIds) != 0 {
+		r.SecurityGroupIDs = aws.StringValueSlice(o.SecurityGroupIds)
+	}
+	if IsFargate(r.Type) {
+		return
+	}
+	r.AllocationStrategy = awsclients.LateInitializeStringPtr(r.AllocationStrategy, o.AllocationStrategy)
+	r.MinvCPUs = awsclients.LateInitializeInt64Ptr(r.MinvCPUs, o.MinvCpus)
+	r.InstanceRole = awsclients.LateInitializeStringPtr(r.InstanceRole, o.InstanceRole)
+}
+
+// IsFargate reports whether compute resources of the given type run on AWS
+// Fargate rather than Amazon EC2 instances.
+func IsFargate(t string) bool {
+	return t == batch.CRTypeFargate || t == batch.CRTypeFargateSpot
+}
+
+// GenerateComputeEnvironmentObservation is used to produce
+// v1alpha1.ComputeEnvironmentObservation from batch.ComputeEnvironmentDetail.
+func GenerateComputeEnvironmentObservation(ce batch.ComputeEnvironmentDetail) v1alpha1.ComputeEnvironmentObservation {
+	return v1alpha1.ComputeEnvironmentObservation{
+		ARN:           aws.StringValue(ce.ComputeEnvironmentArn),
+		ECSClusterARN: aws.StringValue(ce.EcsClusterArn),
+		State:         aws.StringValue(ce.State),
+		Status:        aws.StringValue(ce.Status),
+		StatusReason:  aws.StringValue(ce.StatusReason),
+	}
+}
+
+// IsComputeEnvironmentUpToDate checks whether the observed compute
+// environment matches the desired parameters. Only the fields that can be
+// updated are compared.
+func IsComputeEnvironmentUpToDate(p v1alpha1.ComputeEnvironmentParameters, ce batch.ComputeEnvironmentDetail) bool { // nolint:gocyclo
+	if p.State != nil && aws.StringValue(p.State) != aws.StringValue(ce.State) {
+		return false
+	}
+	if p.ServiceRole != nil && !MatchesNameOrARN(aws.StringValue(p.ServiceRole), aws.StringValue(ce.ServiceRole)) {
+		return false
+	}
+	r, o := p.ComputeResources, ce.ComputeResources
+	if r == nil {
+		return true
+	}
+	if o == nil {
+		o = &batch.ComputeResource{}
+	}
+	if r.MaxvCPUs != aws.Int64Value(o.MaxvCpus) {
+		return false
+	}
+	if r.MinvCPUs != nil && aws.Int64Value(r.MinvCPUs) != aws.Int64Value(o.MinvCpus) {
+		return false
+	}
+	if r.DesiredvCPUs != nil && aws.Int64Value(r.DesiredvCPUs) != aws.Int64Value(o.DesiredvCpus) {
+		return false
+	}
+	if len(r.Subnets) != 0 && !sameStrings(r.Subnets, aws.StringValueSlice(o.Subnets)) {
+		return false
+	}
+	if len(r.SecurityGroupIDs) != 0 && !sameStrings(r.SecurityGroupIDs, aws.StringValueSlice(o.SecurityGroupIds)) {
+		return false
+	}
+	return true
+}
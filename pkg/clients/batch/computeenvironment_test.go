@@ -0,0 +1,170 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batch
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/batch/v1alpha1"
+)
+
+var (
+	roleARN = "arn:aws:iam::123456789012:role/batch-service"
+	subnet  = "subnet-0123"
+	sg      = "sg-0123"
+)
+
+func TestLateInitializeComputeEnvironment(t *testing.T) {
+	observed := &batch.ComputeEnvironmentDetail{
+		State:       aws.String(v1alpha1.StateEnabled),
+		ServiceRole: aws.String(roleARN),
+		ComputeResources: &batch.ComputeResource{
+			AllocationStrategy: aws.String(batch.CRAllocationStrategyBestFit),
+			MinvCpus:           aws.Int64(0),
+			DesiredvCpus:       aws.Int64(4),
+			InstanceRole:       aws.String("ecsInstanceRole"),
+			SecurityGroupIds:   aws.StringSlice([]string{sg}),
+		},
+	}
+
+	cases := map[string]struct {
+		in   v1alpha1.ComputeEnvironmentParameters
+		ce   *batch.ComputeEnvironmentDetail
+		want v1alpha1.ComputeEnvironmentParameters
+	}{
+		"NilObserved": {
+			in:   v1alpha1.ComputeEnvironmentParameters{Type: batch.CETypeManaged},
+			want: v1alpha1.ComputeEnvironmentParameters{Type: batch.CETypeManaged},
+		},
+		"EC2": {
+			in: v1alpha1.ComputeEnvironmentParameters{
+				ComputeResources: &v1alpha1.ComputeResource{Type: batch.CRTypeEc2},
+			},
+			ce: observed,
+			want: v1alpha1.ComputeEnvironmentParameters{
+				State:       aws.String(v1alpha1.StateEnabled),
+				ServiceRole: aws.String(roleARN),
+				ComputeResources: &v1alpha1.ComputeResource{
+					Type:               batch.CRTypeEc2,
+					AllocationStrategy: aws.String(batch.CRAllocationStrategyBestFit),
+					MinvCPUs:           aws.Int64(0),
+					InstanceRole:       aws.String("ecsInstanceRole"),
+					SecurityGroupIDs:   []string{sg},
+				},
+			},
+		},
+		"Fargate": {
+			in: v1alpha1.ComputeEnvironmentParameters{
+				ComputeResources: &v1alpha1.ComputeResource{Type: batch.CRTypeFargate},
+			},
+			ce: observed,
+			want: v1alpha1.ComputeEnvironmentParameters{
+				State:       aws.String(v1alpha1.StateEnabled),
+				ServiceRole: aws.String(roleARN),
+				ComputeResources: &v1alpha1.ComputeResource{
+					Type:             batch.CRTypeFargate,
+					SecurityGroupIDs: []string{sg},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeComputeEnvironment(&tc.in, tc.ce)
+			if diff := cmp.Diff(tc.want, tc.in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsComputeEnvironmentUpToDate(t *testing.T) {
+	observed := batch.ComputeEnvironmentDetail{
+		State:       aws.String(v1alpha1.StateEnabled),
+		ServiceRole: aws.String(roleARN),
+		ComputeResources: &batch.ComputeResource{
+			MinvCpus:         aws.Int64(0),
+			MaxvCpus:         aws.Int64(16),
+			DesiredvCpus:     aws.Int64(4),
+			Subnets:          aws.StringSlice([]string{subnet, "subnet-4567"}),
+			SecurityGroupIds: aws.StringSlice([]string{sg}),
+		},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.ComputeEnvironmentParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.ComputeEnvironmentParameters{
+				State:       aws.String(v1alpha1.StateEnabled),
+				ServiceRole: aws.String(roleARN),
+				ComputeResources: &v1alpha1.ComputeResource{
+					MinvCPUs:         aws.Int64(0),
+					MaxvCPUs:         16,
+					Subnets:          []string{"subnet-4567", subnet},
+					SecurityGroupIDs: []string{sg},
+				},
+			},
+			want: true,
+		},
+		"ServiceRoleByName": {
+			p: v1alpha1.ComputeEnvironmentParameters{
+				ServiceRole: aws.String("batch-service"),
+			},
+			want: true,
+		},
+		"StateChanged": {
+			p: v1alpha1.ComputeEnvironmentParameters{
+				State: aws.String(v1alpha1.StateDisabled),
+			},
+			want: false,
+		},
+		"MaxvCPUsChanged": {
+			p: v1alpha1.ComputeEnvironmentParameters{
+				ComputeResources: &v1alpha1.ComputeResource{MaxvCPUs: 32},
+			},
+			want: false,
+		},
+		"DesiredvCPUsChanged": {
+			p: v1alpha1.ComputeEnvironmentParameters{
+				ComputeResources: &v1alpha1.ComputeResource{MaxvCPUs: 16, DesiredvCPUs: aws.Int64(8)},
+			},
+			want: false,
+		},
+		"SubnetsChanged": {
+			p: v1alpha1.ComputeEnvironmentParameters{
+				ComputeResources: &v1alpha1.ComputeResource{MaxvCPUs: 16, Subnets: []string{subnet}},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsComputeEnvironmentUpToDate(tc.p, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/batch"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/batch"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockClient)(nil)
+
+// MockClient is a type that implements all the methods for the AWS Batch
+// Client interface
+type MockClient struct {
+	MockCreateComputeEnvironment    func(*batch.CreateComputeEnvironmentInput) (*batch.CreateComputeEnvironmentOutput, error)
+	MockDescribeComputeEnvironments func(*batch.DescribeComputeEnvironmentsInput) (*batch.DescribeComputeEnvironmentsOutput, error)
+	MockUpdateComputeEnvironment    func(*batch.UpdateComputeEnvironmentInput) (*batch.UpdateComputeEnvironmentOutput, error)
+	MockDeleteComputeEnvironment    func(*batch.DeleteComputeEnvironmentInput) (*batch.DeleteComputeEnvironmentOutput, error)
+
+	MockCreateJobQueue    func(*batch.CreateJobQueueInput) (*batch.CreateJobQueueOutput, error)
+	MockDescribeJobQueues func(*batch.DescribeJobQueuesInput) (*batch.DescribeJobQueuesOutput, error)
+	MockUpdateJobQueue    func(*batch.UpdateJobQueueInput) (*batch.UpdateJobQueueOutput, error)
+	MockDeleteJobQueue    func(*batch.DeleteJobQueueInput) (*batch.DeleteJobQueueOutput, error)
+}
+
+// CreateComputeEnvironmentWithContext mocks CreateComputeEnvironmentWithContext method
+func (m *MockClient) CreateComputeEnvironmentWithContext(_ context.Context, input *batch.CreateComputeEnvironmentInput, _ ...request.Option) (*batch.CreateComputeEnvironmentOutput, error) {
+	return m.MockCreateComputeEnvironment(input)
+}
+
+// DescribeComputeEnvironmentsWithContext mocks DescribeComputeEnvironmentsWithContext method
+func (m *MockClient) DescribeComputeEnvironmentsWithContext(_ context.Context, input *batch.DescribeComputeEnvironmentsInput, _ ...request.Option) (*batch.DescribeComputeEnvironmentsOutput, error) {
+	return m.MockDescribeComputeEnvironments(input)
+}
+
+// UpdateComputeEnvironmentWithContext mocks UpdateComputeEnvironmentWithContext method
+func (m *MockClient) UpdateComputeEnvironmentWithContext(_ context.Context, input *batch.UpdateComputeEnvironmentInput, _ ...request.Option) (*batch.UpdateComputeEnvironmentOutput, error) {
+	return m.MockUpdateComputeEnvironment(input)
+}
+
+// DeleteComputeEnvironmentWithContext mocks DeleteComputeEnvironmentWithContext method
+func (m *MockClient) DeleteComputeEnvironmentWithContext(_ context.Context, input *batch.DeleteComputeEnvironmentInput, _ ...request.Option) (*batch.DeleteComputeEnvironmentOutput, error) {
+	return m.MockDeleteComputeEnvironment(input)
+}
+
+// CreateJobQueueWithContext mocks CreateJobQueueWithContext method
+func (m *MockClient) CreateJobQueueWithContext(_ context.Context, input *batch.CreateJobQueueInput, _ ...request.Option) (*batch.CreateJobQueueOutput, error) {
+	return m.MockCreateJobQueue(input)
+}
+
+// DescribeJobQueuesWithContext mocks DescribeJobQueuesWithContext method
+func (m *MockClient) DescribeJobQueuesWithContext(_ context.Context, input *batch.DescribeJobQueuesInput, _ ...request.Option) (*batch.DescribeJobQueuesOutput, error) {
+	return m.MockDescribeJobQueues(input)
+}
+
+// UpdateJobQueueWithContext mocks UpdateJobQueueWithContext method
+func (m *MockClient) UpdateJobQueueWithContext(_ context.Context, input *batch.UpdateJobQueueInput, _ ...request.Option) (*batch.UpdateJobQueueOutput, error) {
+	return m.MockUpdateJobQueue(input)
+}
+
+// DeleteJobQueueWithContext mocks DeleteJobQueueWithContext method
+func (m *MockClient) DeleteJobQueueWithContext(_ context.Context, input *batch.DeleteJobQueueInput, _ ...request.Option) (*batch.DeleteJobQueueOutput, error) {
+	return m.MockDeleteJobQueue(input)
+}
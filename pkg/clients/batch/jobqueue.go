@@ -0,0 +1,110 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batch
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+
+	"github.com/crossplane/provider-aws/apis/batch/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// GenerateCreateJobQueueInput returns the input to create a job queue with the
+// given name and parameters.
+func GenerateCreateJobQueueInput(name string, p v1alpha1.JobQueueParameters) *batch.CreateJobQueueInput {
+	in := &batch.CreateJobQueueInput{
+		ComputeEnvironmentOrder: generateComputeEnvironmentOrder(p.ComputeEnvironmentOrder),
+		JobQueueName:            aws.String(name),
+		Priority:                aws.Int64(p.Priority),
+		State:                   p.State,
+	}
+	if len(p.Tags) != 0 {
+		in.Tags = aws.StringMap(p.Tags)
+	}
+	return in
+}
+
+// GenerateUpdateJobQueueInput returns the input to update the job queue with
+// the given name to match the given parameters.
+func GenerateUpdateJobQueueInput(name string, p v1alpha1.JobQueueParameters) *batch.UpdateJobQueueInput {
+	return &batch.UpdateJobQueueInput{
+		ComputeEnvironmentOrder: generateComputeEnvironmentOrder(p.ComputeEnvironmentOrder),
+		JobQueue:                aws.String(name),
+		Priority:                aws.Int64(p.Priority),
+		State:                   p.State,
+	}
+}
+
+// LateInitializeJobQueue fills the empty fields in
+// *v1alpha1.JobQueueParameters with the values seen in batch.JobQueueDetail.
+func LateInitializeJobQueue(in *v1alpha1.JobQueueParameters, jq *batch.JobQueueDetail) {
+	if jq == nil {
+		return
+	}
+	in.State = awsclients.LateInitializeStringPtr(in.State, jq.State)
+}
+
+// GenerateJobQueueObservation is used to produce v1alpha1.JobQueueObservation
+// from batch.JobQueueDetail.
+func GenerateJobQueueObservation(jq batch.JobQueueDetail) v1alpha1.JobQueueObservation {
+	return v1alpha1.JobQueueObservation{
+		ARN:          aws.StringValue(jq.JobQueueArn),
+		State:        aws.StringValue(jq.State),
+		Status:       aws.StringValue(jq.Status),
+		StatusReason: aws.StringValue(jq.StatusReason),
+	}
+}
+
+// IsJobQueueUpToDate checks whether the observed job queue matches the desired
+// parameters. Tags are only set at creation and not compared.
+func IsJobQueueUpToDate(p v1alpha1.JobQueueParameters, jq batch.JobQueueDetail) bool {
+	if p.Priority != aws.Int64Value(jq.Priority) {
+		return false
+	}
+	if p.State != nil && aws.StringValue(p.State) != aws.StringValue(jq.State) {
+		return false
+	}
+	if len(p.ComputeEnvironmentOrder) != len(jq.ComputeEnvironmentOrder) {
+		return false
+	}
+	observed := make(map[int64]string, len(jq.ComputeEnvironmentOrder))
+	for _, o := range jq.ComputeEnvironmentOrder {
+		observed[aws.Int64Value(o.Order)] = aws.StringValue(o.ComputeEnvironment)
+	}
+	for _, o := range p.ComputeEnvironmentOrder {
+		arn, ok := observed[o.Order]
+		if !ok || !MatchesNameOrARN(aws.StringValue(o.ComputeEnvironment), arn) {
+			return false
+		}
+	}
+	return true
+}
+
+func generateComputeEnvironmentOrder(in []v1alpha1.ComputeEnvironmentOrder) []*batch.ComputeEnvironmentOrder {
+	if len(in) == 0 {
+		return nil
+	}
+	res := make([]*batch.ComputeEnvironmentOrder, len(in))
+	for i, o := range in {
+		res[i] = &batch.ComputeEnvironmentOrder{
+			ComputeEnvironment: o.ComputeEnvironment,
+			Order:              aws.Int64(o.Order),
+		}
+	}
+	return res
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batch
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/batch/v1alpha1"
+)
+
+func TestIsJobQueueUpToDate(t *testing.T) {
+	ceARN := "arn:aws:batch:us-east-1:123456789012:compute-environment/spot"
+	observed := batch.JobQueueDetail{
+		Priority: aws.Int64(10),
+		State:    aws.String(v1alpha1.StateEnabled),
+		ComputeEnvironmentOrder: []*batch.ComputeEnvironmentOrder{
+			{Order: aws.Int64(1), ComputeEnvironment: aws.String(ceARN)},
+		},
+	}
+	order := func(ce string, o int64) []v1alpha1.ComputeEnvironmentOrder {
+		return []v1alpha1.ComputeEnvironmentOrder{{Order: o, ComputeEnvironment: aws.String(ce)}}
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.JobQueueParameters
+		want bool
+	}{
+		"UpToDate": {
+			p:    v1alpha1.JobQueueParameters{Priority: 10, ComputeEnvironmentOrder: order(ceARN, 1)},
+			want: true,
+		},
+		"ComputeEnvironmentByName": {
+			p:    v1alpha1.JobQueueParameters{Priority: 10, ComputeEnvironmentOrder: order("spot", 1)},
+			want: true,
+		},
+		"PriorityChanged": {
+			p:    v1alpha1.JobQueueParameters{Priority: 20, ComputeEnvironmentOrder: order(ceARN, 1)},
+			want: false,
+		},
+		"StateChanged": {
+			p:    v1alpha1.JobQueueParameters{Priority: 10, State: aws.String(v1alpha1.StateDisabled), ComputeEnvironmentOrder: order(ceARN, 1)},
+			want: false,
+		},
+		"OrderChanged": {
+			p:    v1alpha1.JobQueueParameters{Priority: 10, ComputeEnvironmentOrder: order(ceARN, 2)},
+			want: false,
+		},
+		"ComputeEnvironmentChanged": {
+			p:    v1alpha1.JobQueueParameters{Priority: 10, ComputeEnvironmentOrder: order("on-demand", 1)},
+			want: false,
+		},
+		"ComputeEnvironmentAdded": {
+			p: v1alpha1.JobQueueParameters{Priority: 10, ComputeEnvironmentOrder: append(order(ceARN, 1),
+				v1alpha1.ComputeEnvironmentOrder{Order: 2, ComputeEnvironment: aws.String("on-demand")})},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsJobQueueUpToDate(tc.p, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -28,9 +28,9 @@ import (
 
 // Client interface to perform CloudFormation operations
 type Client interface {
-	CreateStack(stackName *string, templateBody *string, parameters map[string]string) (stackID *string, err error)
-	GetStack(stackID *string) (stack *cf.Stack, err error)
-	DeleteStack(stackID *string) error
+	CreateStack(ctx context.Context, stackName *string, templateBody *string, parameters map[string]string) (stackID *string, err error)
+	GetStack(ctx context.Context, stackID *string) (stack *cf.Stack, err error)
+	DeleteStack(ctx context.Context, stackID *string) error
 }
 
 type cloudFormationClient struct {
@@ -43,7 +43,7 @@ func NewClient(config *aws.Config) Client {
 }
 
 // CreateStack - Creates a stack
-func (c *cloudFormationClient) CreateStack(stackName *string, templateBody *string, parameters map[string]string) (stackID *string, err error) {
+func (c *cloudFormationClient) CreateStack(ctx context.Context, stackName *string, templateBody *string, parameters map[string]string) (stackID *string, err error) {
 	cfParams := make([]cf.Parameter, 0)
 	for k, v := range parameters {
 		if v != "" {
@@ -51,7 +51,7 @@ func (c *cloudFormationClient) CreateStack(stackName *string, templateBody *stri
 		}
 	}
 
-	createStackResponse, err := c.cloudformation.CreateStackRequest(&cf.CreateStackInput{Capabilities: []cf.Capability{cf.CapabilityCapabilityIam}, StackName: stackName, TemplateBody: templateBody, Parameters: cfParams}).Send(context.TODO())
+	createStackResponse, err := c.cloudformation.CreateStackRequest(&cf.CreateStackInput{Capabilities: []cf.Capability{cf.CapabilityCapabilityIam}, StackName: stackName, TemplateBody: templateBody, Parameters: cfParams}).Send(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -59,8 +59,8 @@ func (c *cloudFormationClient) CreateStack(stackName *string, templateBody *stri
 }
 
 // GetStack info
-func (c *cloudFormationClient) GetStack(stackID *string) (stack *cf.Stack, err error) {
-	describeStackResponse, err := c.cloudformation.DescribeStacksRequest(&cf.DescribeStacksInput{StackName: stackID}).Send(context.TODO())
+func (c *cloudFormationClient) GetStack(ctx context.Context, stackID *string) (stack *cf.Stack, err error) {
+	describeStackResponse, err := c.cloudformation.DescribeStacksRequest(&cf.DescribeStacksInput{StackName: stackID}).Send(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -75,8 +75,8 @@ func (c *cloudFormationClient) GetStack(stackID *string) (stack *cf.Stack, err e
 }
 
 // DeleteStack deletes a stack
-func (c *cloudFormationClient) DeleteStack(stackID *string) error {
-	_, err := c.cloudformation.DeleteStackRequest(&cf.DeleteStackInput{StackName: stackID}).Send(context.TODO())
+func (c *cloudFormationClient) DeleteStack(ctx context.Context, stackID *string) error {
+	_, err := c.cloudformation.DeleteStackRequest(&cf.DeleteStackInput{StackName: stackID}).Send(ctx)
 	return err
 }
 
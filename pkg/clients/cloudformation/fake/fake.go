@@ -17,27 +17,29 @@ limitations under the License.
 package fake
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 )
 
 // MockCloudFormationClient mock
 type MockCloudFormationClient struct {
-	MockCreateStack func(stackName *string, templateBody *string, parameters map[string]string) (stackID *string, err error)
-	MockGetStack    func(stackID *string) (status *cloudformation.Stack, err error)
-	MockDeleteStack func(stackID *string) error
+	MockCreateStack func(ctx context.Context, stackName *string, templateBody *string, parameters map[string]string) (stackID *string, err error)
+	MockGetStack    func(ctx context.Context, stackID *string) (status *cloudformation.Stack, err error)
+	MockDeleteStack func(ctx context.Context, stackID *string) error
 }
 
 // CreateStack mock
-func (m *MockCloudFormationClient) CreateStack(stackName *string, templateBody *string, parameters map[string]string) (stackID *string, err error) {
-	return m.MockCreateStack(stackName, templateBody, parameters)
+func (m *MockCloudFormationClient) CreateStack(ctx context.Context, stackName *string, templateBody *string, parameters map[string]string) (stackID *string, err error) {
+	return m.MockCreateStack(ctx, stackName, templateBody, parameters)
 }
 
 // GetStack mock
-func (m *MockCloudFormationClient) GetStack(stackID *string) (status *cloudformation.Stack, err error) {
-	return m.MockGetStack(stackID)
+func (m *MockCloudFormationClient) GetStack(ctx context.Context, stackID *string) (status *cloudformation.Stack, err error) {
+	return m.MockGetStack(ctx, stackID)
 }
 
 // DeleteStack mock
-func (m *MockCloudFormationClient) DeleteStack(stackID *string) error {
-	return m.MockDeleteStack(stackID)
+func (m *MockCloudFormationClient) DeleteStack(ctx context.Context, stackID *string) error {
+	return m.MockDeleteStack(ctx, stackID)
 }
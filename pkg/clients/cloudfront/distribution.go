@@ -0,0 +1,306 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudfront
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+)
+
+// Defaults CloudFront would otherwise require in a DistributionConfig.
+const (
+	defaultHTTPPort             = 80
+	defaultHTTPSPort            = 443
+	defaultOriginProtocolPolicy = cloudfront.OriginProtocolPolicyHttpsOnly
+	defaultSSLSupportMethod     = cloudfront.SSLSupportMethodSniOnly
+	defaultMinimumProtocol      = cloudfront.MinimumProtocolVersionTlsv122018
+
+	s3DomainSuffix = ".s3.amazonaws.com"
+)
+
+var defaultMethods = []string{string(cloudfront.MethodGet), string(cloudfront.MethodHead)}
+
+// Client defines CloudFront Distribution client operations
+type Client interface {
+	CreateDistributionRequest(input *cloudfront.CreateDistributionInput) cloudfront.CreateDistributionRequest
+	GetDistributionRequest(input *cloudfront.GetDistributionInput) cloudfront.GetDistributionRequest
+	UpdateDistributionRequest(input *cloudfront.UpdateDistributionInput) cloudfront.UpdateDistributionRequest
+	DeleteDistributionRequest(input *cloudfront.DeleteDistributionInput) cloudfront.DeleteDistributionRequest
+}
+
+// NewClient creates new CloudFront Client with provided AWS
+// Configurations/Credentials
+func NewClient(cfg aws.Config) Client {
+	return cloudfront.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the item doesn't exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == cloudfront.ErrCodeNoSuchDistribution
+	}
+	return false
+}
+
+// sameItems returns true if both lists contain the same items, in any order.
+func sameItems(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sa := append([]string{}, a...)
+	sb := append([]string{}, b...)
+	sort.Strings(sa)
+	sort.Strings(sb)
+	return cmp.Equal(sa, sb)
+}
+
+func methodStrings(m []cloudfront.Method) []string {
+	res := make([]string, len(m))
+	for i, v := range m {
+		res[i] = string(v)
+	}
+	return res
+}
+
+func methods(m []string) []cloudfront.Method {
+	if len(m) == 0 {
+		m = defaultMethods
+	}
+	res := make([]cloudfront.Method, len(m))
+	for i, v := range m {
+		res[i] = cloudfront.Method(v)
+	}
+	return res
+}
+
+// GenerateOrigin returns the origin described by o. Settings of the observed
+// origin that cannot be configured through the spec are kept.
+func GenerateOrigin(o v1alpha1.Origin, observed *cloudfront.Origin) cloudfront.Origin {
+	res := cloudfront.Origin{}
+	if observed != nil {
+		res = *observed
+	}
+	res.Id = aws.String(o.ID)
+	res.OriginPath = aws.String(aws.StringValue(o.OriginPath))
+
+	if o.S3BucketName != nil {
+		res.DomainName = aws.String(aws.StringValue(o.S3BucketName) + s3DomainSuffix)
+		res.S3OriginConfig = &cloudfront.S3OriginConfig{
+			OriginAccessIdentity: aws.String(aws.StringValue(o.OriginAccessIdentity)),
+		}
+		res.CustomOriginConfig = nil
+		return res
+	}
+
+	res.DomainName = o.DomainName
+	res.S3OriginConfig = nil
+	c := cloudfront.CustomOriginConfig{}
+	if observed != nil && observed.CustomOriginConfig != nil {
+		c = *observed.CustomOriginConfig
+	}
+	c.HTTPPort = aws.Int64(defaultHTTPPort)
+	if o.HTTPPort != nil {
+		c.HTTPPort = o.HTTPPort
+	}
+	c.HTTPSPort = aws.Int64(defaultHTTPSPort)
+	if o.HTTPSPort != nil {
+		c.HTTPSPort = o.HTTPSPort
+	}
+	c.OriginProtocolPolicy = defaultOriginProtocolPolicy
+	if o.OriginProtocolPolicy != nil {
+		c.OriginProtocolPolicy = cloudfront.OriginProtocolPolicy(aws.StringValue(o.OriginProtocolPolicy))
+	}
+	if c.OriginSslProtocols == nil {
+		c.OriginSslProtocols = &cloudfront.OriginSslProtocols{
+			Items:    []cloudfront.SslProtocol{cloudfront.SslProtocolTlsv12},
+			Quantity: aws.Int64(1),
+		}
+	}
+	res.CustomOriginConfig = &c
+	return res
+}
+
+// GenerateDefaultCacheBehavior returns the default cache behavior described
+// by b. Settings of the observed behavior that cannot be configured through
+// the spec are kept.
+func GenerateDefaultCacheBehavior(b v1alpha1.DefaultCacheBehavior, observed *cloudfront.DefaultCacheBehavior) *cloudfront.DefaultCacheBehavior { // nolint:gocyclo
+	res := cloudfront.DefaultCacheBehavior{}
+	if observed != nil {
+		res = *observed
+	}
+	res.TargetOriginId = aws.String(b.TargetOriginID)
+	res.ViewerProtocolPolicy = cloudfront.ViewerProtocolPolicy(b.ViewerProtocolPolicy)
+	res.MinTTL = aws.Int64(aws.Int64Value(b.MinTTL))
+	if b.DefaultTTL != nil {
+		res.DefaultTTL = b.DefaultTTL
+	}
+	if b.MaxTTL != nil {
+		res.MaxTTL = b.MaxTTL
+	}
+	if b.Compress != nil || res.Compress == nil {
+		res.Compress = aws.Bool(aws.BoolValue(b.Compress))
+	}
+	if res.TrustedSigners == nil {
+		res.TrustedSigners = &cloudfront.TrustedSigners{Enabled: aws.Bool(false), Quantity: aws.Int64(0)}
+	}
+
+	// CloudFront does not keep the order of the methods.
+	allowed, cached := methods(b.AllowedMethods), methods(b.CachedMethods)
+	if o := res.AllowedMethods; o == nil || o.CachedMethods == nil ||
+		!sameItems(methodStrings(o.Items), methodStrings(allowed)) ||
+		!sameItems(methodStrings(o.CachedMethods.Items), methodStrings(cached)) {
+		res.AllowedMethods = &cloudfront.AllowedMethods{
+			Items:    allowed,
+			Quantity: aws.Int64(int64(len(allowed))),
+			CachedMethods: &cloudfront.CachedMethods{
+				Items:    cached,
+				Quantity: aws.Int64(int64(len(cached))),
+			},
+		}
+	}
+
+	fv := cloudfront.ForwardedValues{}
+	if res.ForwardedValues != nil {
+		fv = *res.ForwardedValues
+	}
+	fv.QueryString = aws.Bool(b.ForwardQueryString)
+	forward := cloudfront.ItemSelectionNone
+	if b.ForwardCookies != nil {
+		forward = cloudfront.ItemSelection(aws.StringValue(b.ForwardCookies))
+	}
+	if fv.Cookies == nil || fv.Cookies.Forward != forward {
+		fv.Cookies = &cloudfront.CookiePreference{Forward: forward}
+	}
+	if fv.Headers == nil || !sameItems(fv.Headers.Items, b.ForwardHeaders) {
+		fv.Headers = &cloudfront.Headers{Quantity: aws.Int64(int64(len(b.ForwardHeaders)))}
+		if len(b.ForwardHeaders) > 0 {
+			fv.Headers.Items = b.ForwardHeaders
+		}
+	}
+	res.ForwardedValues = &fv
+	return &res
+}
+
+// GenerateViewerCertificate returns the viewer certificate described by c. The
+// CloudFront default certificate is used if c is nil.
+func GenerateViewerCertificate(c *v1alpha1.ViewerCertificate, observed *cloudfront.ViewerCertificate) *cloudfront.ViewerCertificate {
+	if c == nil || c.ACMCertificateARN == nil {
+		if observed != nil && aws.BoolValue(observed.CloudFrontDefaultCertificate) {
+			return observed
+		}
+		return &cloudfront.ViewerCertificate{CloudFrontDefaultCertificate: aws.Bool(true)}
+	}
+	res := cloudfront.ViewerCertificate{}
+	if observed != nil && observed.ACMCertificateArn != nil {
+		res = *observed
+	}
+	res.ACMCertificateArn = c.ACMCertificateARN
+	res.CloudFrontDefaultCertificate = nil
+	res.SSLSupportMethod = defaultSSLSupportMethod
+	if c.SSLSupportMethod != nil {
+		res.SSLSupportMethod = cloudfront.SSLSupportMethod(aws.StringValue(c.SSLSupportMethod))
+	}
+	if c.MinimumProtocolVersion != nil {
+		res.MinimumProtocolVersion = cloudfront.MinimumProtocolVersion(aws.StringValue(c.MinimumProtocolVersion))
+	}
+	if res.MinimumProtocolVersion == "" {
+		res.MinimumProtocolVersion = defaultMinimumProtocol
+	}
+	return &res
+}
+
+// GenerateDistributionConfig returns the configuration described by p. The
+// settings are applied on top of the observed configuration, if any, so that
+// the defaults filled in by CloudFront are kept.
+func GenerateDistributionConfig(callerReference string, p v1alpha1.DistributionParameters, observed *cloudfront.DistributionConfig) *cloudfront.DistributionConfig {
+	res := cloudfront.DistributionConfig{CallerReference: aws.String(callerReference)}
+	if observed != nil {
+		res = *observed
+	}
+	res.Comment = aws.String(aws.StringValue(p.Comment))
+	res.Enabled = aws.Bool(true)
+	if p.Enabled != nil {
+		res.Enabled = p.Enabled
+	}
+	res.DefaultRootObject = aws.String(aws.StringValue(p.DefaultRootObject))
+	if p.PriceClass != nil {
+		res.PriceClass = cloudfront.PriceClass(aws.StringValue(p.PriceClass))
+	}
+	if p.IsIPV6Enabled != nil {
+		res.IsIPV6Enabled = p.IsIPV6Enabled
+	}
+	if res.Aliases == nil || !sameItems(res.Aliases.Items, p.Aliases) {
+		res.Aliases = &cloudfront.Aliases{Quantity: aws.Int64(int64(len(p.Aliases)))}
+		if len(p.Aliases) > 0 {
+			res.Aliases.Items = p.Aliases
+		}
+	}
+
+	observedOrigins := map[string]*cloudfront.Origin{}
+	if res.Origins != nil {
+		for i := range res.Origins.Items {
+			o := &res.Origins.Items[i]
+			observedOrigins[aws.StringValue(o.Id)] = o
+		}
+	}
+	origins := make([]cloudfront.Origin, len(p.Origins))
+	for i, o := range p.Origins {
+		origins[i] = GenerateOrigin(o, observedOrigins[o.ID])
+	}
+	res.Origins = &cloudfront.Origins{Items: origins, Quantity: aws.Int64(int64(len(origins)))}
+
+	res.DefaultCacheBehavior = GenerateDefaultCacheBehavior(p.DefaultCacheBehavior, res.DefaultCacheBehavior)
+	res.ViewerCertificate = GenerateViewerCertificate(p.ViewerCertificate, res.ViewerCertificate)
+	return &res
+}
+
+// GenerateObservation is used to produce v1alpha1.DistributionObservation from
+// a cloudfront.Distribution.
+func GenerateObservation(d cloudfront.Distribution) v1alpha1.DistributionObservation {
+	return v1alpha1.DistributionObservation{
+		ID:         aws.StringValue(d.Id),
+		ARN:        aws.StringValue(d.ARN),
+		DomainName: aws.StringValue(d.DomainName),
+		Status:     aws.StringValue(d.Status),
+	}
+}
+
+// IsUpToDate checks whether the observed Distribution matches the desired
+// parameters.
+func IsUpToDate(p v1alpha1.DistributionParameters, d cloudfront.Distribution) bool {
+	if d.DistributionConfig == nil {
+		return false
+	}
+	desired := GenerateDistributionConfig(aws.StringValue(d.DistributionConfig.CallerReference), p, d.DistributionConfig)
+	return cmp.Equal(desired, d.DistributionConfig)
+}
+
+// GetConnectionDetails returns the connection details of a Distribution.
+func GetConnectionDetails(d cloudfront.Distribution) managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		v1alpha1.ResourceCredentialsSecretDomainNameKey: []byte(aws.StringValue(d.DomainName)),
+		v1alpha1.ResourceCredentialsSecretIDKey:         []byte(aws.StringValue(d.Id)),
+	}
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudfront
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+)
+
+var (
+	callerReference = "some-uid"
+	certificateARN  = "arn:aws:acm:us-east-1:123456789012:certificate/some-cert"
+	lbDNSName       = "some-lb-123.us-east-1.elb.amazonaws.com"
+)
+
+func params() v1alpha1.DistributionParameters {
+	return v1alpha1.DistributionParameters{
+		Comment: aws.String("site"),
+		Origins: []v1alpha1.Origin{
+			{ID: "s3", S3BucketName: aws.String("some-bucket")},
+			{ID: "lb", DomainName: aws.String(lbDNSName)},
+		},
+		DefaultCacheBehavior: v1alpha1.DefaultCacheBehavior{
+			TargetOriginID:       "s3",
+			ViewerProtocolPolicy: "redirect-to-https",
+		},
+	}
+}
+
+func config() *cloudfront.DistributionConfig {
+	return &cloudfront.DistributionConfig{
+		CallerReference:   aws.String(callerReference),
+		Comment:           aws.String("site"),
+		Enabled:           aws.Bool(true),
+		DefaultRootObject: aws.String(""),
+		Aliases:           &cloudfront.Aliases{Quantity: aws.Int64(0)},
+		Origins: &cloudfront.Origins{
+			Quantity: aws.Int64(2),
+			Items: []cloudfront.Origin{
+				{
+					Id:             aws.String("s3"),
+					DomainName:     aws.String("some-bucket.s3.amazonaws.com"),
+					OriginPath:     aws.String(""),
+					S3OriginConfig: &cloudfront.S3OriginConfig{OriginAccessIdentity: aws.String("")},
+				},
+				{
+					Id:         aws.String("lb"),
+					DomainName: aws.String(lbDNSName),
+					OriginPath: aws.String(""),
+					CustomOriginConfig: &cloudfront.CustomOriginConfig{
+						HTTPPort:             aws.Int64(80),
+						HTTPSPort:            aws.Int64(443),
+						OriginProtocolPolicy: cloudfront.OriginProtocolPolicyHttpsOnly,
+						OriginSslProtocols: &cloudfront.OriginSslProtocols{
+							Items:    []cloudfront.SslProtocol{cloudfront.SslProtocolTlsv12},
+							Quantity: aws.Int64(1),
+						},
+					},
+				},
+			},
+		},
+		DefaultCacheBehavior: &cloudfront.DefaultCacheBehavior{
+			TargetOriginId:       aws.String("s3"),
+			ViewerProtocolPolicy: cloudfront.ViewerProtocolPolicyRedirectToHttps,
+			MinTTL:               aws.Int64(0),
+			Compress:             aws.Bool(false),
+			TrustedSigners:       &cloudfront.TrustedSigners{Enabled: aws.Bool(false), Quantity: aws.Int64(0)},
+			AllowedMethods: &cloudfront.AllowedMethods{
+				Items:    []cloudfront.Method{cloudfront.MethodGet, cloudfront.MethodHead},
+				Quantity: aws.Int64(2),
+				CachedMethods: &cloudfront.CachedMethods{
+					Items:    []cloudfront.Method{cloudfront.MethodGet, cloudfront.MethodHead},
+					Quantity: aws.Int64(2),
+				},
+			},
+			ForwardedValues: &cloudfront.ForwardedValues{
+				QueryString: aws.Bool(false),
+				Cookies:     &cloudfront.CookiePreference{Forward: cloudfront.ItemSelectionNone},
+				Headers:     &cloudfront.Headers{Quantity: aws.Int64(0)},
+			},
+		},
+		ViewerCertificate: &cloudfront.ViewerCertificate{CloudFrontDefaultCertificate: aws.Bool(true)},
+	}
+}
+
+func TestGenerateDistributionConfig(t *testing.T) {
+	withCert := params()
+	withCert.Aliases = []string{"www.example.com"}
+	withCert.ViewerCertificate = &v1alpha1.ViewerCertificate{ACMCertificateARN: aws.String(certificateARN)}
+	withCertConfig := config()
+	withCertConfig.Aliases = &cloudfront.Aliases{Items: []string{"www.example.com"}, Quantity: aws.Int64(1)}
+	withCertConfig.ViewerCertificate = &cloudfront.ViewerCertificate{
+		ACMCertificateArn:      aws.String(certificateARN),
+		SSLSupportMethod:       cloudfront.SSLSupportMethodSniOnly,
+		MinimumProtocolVersion: cloudfront.MinimumProtocolVersionTlsv122018,
+	}
+
+	cases := map[string]struct {
+		p        v1alpha1.DistributionParameters
+		observed *cloudfront.DistributionConfig
+		want     *cloudfront.DistributionConfig
+	}{
+		"New": {
+			p:    params(),
+			want: config(),
+		},
+		"WithCertificate": {
+			p:    withCert,
+			want: withCertConfig,
+		},
+		"KeepsObservedDefaults": {
+			p: params(),
+			observed: func() *cloudfront.DistributionConfig {
+				c := config()
+				c.Comment = aws.String("old")
+				c.HttpVersion = cloudfront.HttpVersionHttp2
+				c.DefaultCacheBehavior.DefaultTTL = aws.Int64(86400)
+				return c
+			}(),
+			want: func() *cloudfront.DistributionConfig {
+				c := config()
+				c.HttpVersion = cloudfront.HttpVersionHttp2
+				c.DefaultCacheBehavior.DefaultTTL = aws.Int64(86400)
+				return c
+			}(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateDistributionConfig(callerReference, tc.p, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateDistributionConfig(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.DistributionParameters
+		d    cloudfront.Distribution
+		want bool
+	}{
+		"UpToDate": {
+			p:    params(),
+			d:    cloudfront.Distribution{DistributionConfig: config()},
+			want: true,
+		},
+		"MethodsInDifferentOrder": {
+			p: params(),
+			d: cloudfront.Distribution{DistributionConfig: func() *cloudfront.DistributionConfig {
+				c := config()
+				c.DefaultCacheBehavior.AllowedMethods.Items = []cloudfront.Method{cloudfront.MethodHead, cloudfront.MethodGet}
+				return c
+			}()},
+			want: true,
+		},
+		"CommentChanged": {
+			p: func() v1alpha1.DistributionParameters {
+				p := params()
+				p.Comment = aws.String("new")
+				return p
+			}(),
+			d:    cloudfront.Distribution{DistributionConfig: config()},
+			want: false,
+		},
+		"OriginChanged": {
+			p: func() v1alpha1.DistributionParameters {
+				p := params()
+				p.Origins[1].HTTPSPort = aws.Int64(8443)
+				return p
+			}(),
+			d:    cloudfront.Distribution{DistributionConfig: config()},
+			want: false,
+		},
+		"NoConfig": {
+			p:    params(),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.p, tc.d)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetConnectionDetails(t *testing.T) {
+	d := cloudfront.Distribution{Id: aws.String("EDFDVBD6EXAMPLE"), DomainName: aws.String("d111111abcdef8.cloudfront.net")}
+	want := managed.ConnectionDetails{
+		v1alpha1.ResourceCredentialsSecretDomainNameKey: []byte("d111111abcdef8.cloudfront.net"),
+		v1alpha1.ResourceCredentialsSecretIDKey:         []byte("EDFDVBD6EXAMPLE"),
+	}
+	if diff := cmp.Diff(want, GetConnectionDetails(d)); diff != "" {
+		t.Errorf("GetConnectionDetails(...): -want, +got:\n%s", diff)
+	}
+}
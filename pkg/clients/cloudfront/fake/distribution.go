@@ -0,0 +1,55 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/cloudfront"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockDistributionClient)(nil)
+
+// MockDistributionClient is a type that implements all the methods for the
+// Distribution Client interface
+type MockDistributionClient struct {
+	MockCreate func(*cloudfront.CreateDistributionInput) cloudfront.CreateDistributionRequest
+	MockGet    func(*cloudfront.GetDistributionInput) cloudfront.GetDistributionRequest
+	MockUpdate func(*cloudfront.UpdateDistributionInput) cloudfront.UpdateDistributionRequest
+	MockDelete func(*cloudfront.DeleteDistributionInput) cloudfront.DeleteDistributionRequest
+}
+
+// CreateDistributionRequest mocks CreateDistributionRequest method
+func (m *MockDistributionClient) CreateDistributionRequest(input *cloudfront.CreateDistributionInput) cloudfront.CreateDistributionRequest {
+	return m.MockCreate(input)
+}
+
+// GetDistributionRequest mocks GetDistributionRequest method
+func (m *MockDistributionClient) GetDistributionRequest(input *cloudfront.GetDistributionInput) cloudfront.GetDistributionRequest {
+	return m.MockGet(input)
+}
+
+// UpdateDistributionRequest mocks UpdateDistributionRequest method
+func (m *MockDistributionClient) UpdateDistributionRequest(input *cloudfront.UpdateDistributionInput) cloudfront.UpdateDistributionRequest {
+	return m.MockUpdate(input)
+}
+
+// DeleteDistributionRequest mocks DeleteDistributionRequest method
+func (m *MockDistributionClient) DeleteDistributionRequest(input *cloudfront.DeleteDistributionInput) cloudfront.DeleteDistributionRequest {
+	return m.MockDelete(input)
+}
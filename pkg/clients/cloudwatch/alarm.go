@@ -0,0 +1,141 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudwatch
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-aws/apis/cloudwatch/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// Client defines CloudWatch Alarm client operations
+type Client interface {
+	PutMetricAlarmRequest(input *cloudwatch.PutMetricAlarmInput) cloudwatch.PutMetricAlarmRequest
+	DescribeAlarmsRequest(input *cloudwatch.DescribeAlarmsInput) cloudwatch.DescribeAlarmsRequest
+	DeleteAlarmsRequest(input *cloudwatch.DeleteAlarmsInput) cloudwatch.DeleteAlarmsRequest
+}
+
+// NewClient creates new CloudWatch Client with provided AWS
+// Configurations/Credentials
+func NewClient(cfg aws.Config) Client {
+	return cloudwatch.New(cfg)
+}
+
+// GeneratePutMetricAlarmInput returns the input to create or update the alarm
+// with the given name.
+func GeneratePutMetricAlarmInput(name string, p v1alpha1.AlarmParameters) *cloudwatch.PutMetricAlarmInput {
+	input := &cloudwatch.PutMetricAlarmInput{
+		AlarmName:               aws.String(name),
+		AlarmDescription:        p.AlarmDescription,
+		ActionsEnabled:          p.ActionsEnabled,
+		AlarmActions:            p.AlarmActions,
+		OKActions:               p.OKActions,
+		InsufficientDataActions: p.InsufficientDataActions,
+		Namespace:               aws.String(p.Namespace),
+		MetricName:              aws.String(p.MetricName),
+		Statistic:               cloudwatch.Statistic(p.Statistic),
+		Period:                  aws.Int64(p.Period),
+		EvaluationPeriods:       aws.Int64(p.EvaluationPeriods),
+		DatapointsToAlarm:       p.DatapointsToAlarm,
+		Threshold:               aws.Float64(p.Threshold),
+		ComparisonOperator:      cloudwatch.ComparisonOperator(p.ComparisonOperator),
+		TreatMissingData:        p.TreatMissingData,
+	}
+	if p.Unit != nil {
+		input.Unit = cloudwatch.StandardUnit(*p.Unit)
+	}
+	for _, d := range p.Dimensions {
+		input.Dimensions = append(input.Dimensions, cloudwatch.Dimension{Name: aws.String(d.Name), Value: aws.String(d.Value)})
+	}
+	for _, t := range p.Tags {
+		input.Tags = append(input.Tags, cloudwatch.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)})
+	}
+	return input
+}
+
+// GenerateObservation is used to produce v1alpha1.AlarmObservation from
+// cloudwatch.MetricAlarm.
+func GenerateObservation(a cloudwatch.MetricAlarm) v1alpha1.AlarmObservation {
+	return v1alpha1.AlarmObservation{
+		AlarmARN:    aws.StringValue(a.AlarmArn),
+		StateValue:  string(a.StateValue),
+		StateReason: aws.StringValue(a.StateReason),
+	}
+}
+
+// LateInitialize fills the empty fields in *v1alpha1.AlarmParameters with the
+// values seen in cloudwatch.MetricAlarm.
+func LateInitialize(in *v1alpha1.AlarmParameters, a *cloudwatch.MetricAlarm) {
+	if a == nil {
+		return
+	}
+	in.ActionsEnabled = awsclients.LateInitializeBoolPtr(in.ActionsEnabled, a.ActionsEnabled)
+	in.DatapointsToAlarm = awsclients.LateInitializeInt64Ptr(in.DatapointsToAlarm, a.DatapointsToAlarm)
+	in.TreatMissingData = awsclients.LateInitializeStringPtr(in.TreatMissingData, a.TreatMissingData)
+	in.Unit = awsclients.LateInitializeStringPtr(in.Unit, awsclients.String(string(a.Unit)))
+}
+
+// generateParameters returns the parameters that the given alarm was created
+// with, as far as they can be observed.
+func generateParameters(a cloudwatch.MetricAlarm) v1alpha1.AlarmParameters {
+	p := v1alpha1.AlarmParameters{
+		AlarmDescription:        a.AlarmDescription,
+		ActionsEnabled:          a.ActionsEnabled,
+		AlarmActions:            a.AlarmActions,
+		OKActions:               a.OKActions,
+		InsufficientDataActions: a.InsufficientDataActions,
+		Namespace:               aws.StringValue(a.Namespace),
+		MetricName:              aws.StringValue(a.MetricName),
+		Statistic:               string(a.Statistic),
+		Period:                  aws.Int64Value(a.Period),
+		Unit:                    awsclients.String(string(a.Unit)),
+		EvaluationPeriods:       aws.Int64Value(a.EvaluationPeriods),
+		DatapointsToAlarm:       a.DatapointsToAlarm,
+		Threshold:               aws.Float64Value(a.Threshold),
+		ComparisonOperator:      string(a.ComparisonOperator),
+		TreatMissingData:        a.TreatMissingData,
+	}
+	for _, d := range a.Dimensions {
+		p.Dimensions = append(p.Dimensions, v1alpha1.Dimension{Name: aws.StringValue(d.Name), Value: aws.StringValue(d.Value)})
+	}
+	return p
+}
+
+// IsUpToDate checks whether there is a change in any of the modifiable fields.
+func IsUpToDate(p v1alpha1.AlarmParameters, a cloudwatch.MetricAlarm) bool {
+	return cmp.Equal(generateParameters(a), p,
+		cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(x, y string) bool { return x < y }),
+		cmpopts.SortSlices(func(x, y v1alpha1.Dimension) bool { return x.Name < y.Name }),
+		cmpopts.IgnoreTypes([]xpv1.Reference{}, &xpv1.Selector{}),
+		cmpopts.IgnoreFields(v1alpha1.AlarmParameters{}, "Region", "Tags"))
+}
+
+// IsNotFound returns true if the error is because the alarm doesn't exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == cloudwatch.ErrCodeResourceNotFound
+	}
+	return false
+}
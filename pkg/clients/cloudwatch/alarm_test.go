@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudwatch
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/cloudwatch/v1alpha1"
+)
+
+func params() v1alpha1.AlarmParameters {
+	return v1alpha1.AlarmParameters{
+		Region:             "us-east-1",
+		AlarmActions:       []string{"arn:topic-b", "arn:topic-a"},
+		Namespace:          "AWS/RDS",
+		MetricName:         "CPUUtilization",
+		Dimensions:         []v1alpha1.Dimension{{Name: "DBInstanceIdentifier", Value: "db"}},
+		Statistic:          "Average",
+		Period:             300,
+		EvaluationPeriods:  3,
+		Threshold:          80,
+		ComparisonOperator: "GreaterThanThreshold",
+		Tags:               []v1alpha1.Tag{{Key: "k", Value: "v"}},
+	}
+}
+
+func alarm() cloudwatch.MetricAlarm {
+	return cloudwatch.MetricAlarm{
+		AlarmName:          aws.String("cpu"),
+		AlarmActions:       []string{"arn:topic-a", "arn:topic-b"},
+		Namespace:          aws.String("AWS/RDS"),
+		MetricName:         aws.String("CPUUtilization"),
+		Dimensions:         []cloudwatch.Dimension{{Name: aws.String("DBInstanceIdentifier"), Value: aws.String("db")}},
+		Statistic:          cloudwatch.StatisticAverage,
+		Period:             aws.Int64(300),
+		EvaluationPeriods:  aws.Int64(3),
+		Threshold:          aws.Float64(80),
+		ComparisonOperator: cloudwatch.ComparisonOperatorGreaterThanThreshold,
+	}
+}
+
+func TestGeneratePutMetricAlarmInput(t *testing.T) {
+	want := &cloudwatch.PutMetricAlarmInput{
+		AlarmName:          aws.String("cpu"),
+		AlarmActions:       []string{"arn:topic-b", "arn:topic-a"},
+		Namespace:          aws.String("AWS/RDS"),
+		MetricName:         aws.String("CPUUtilization"),
+		Dimensions:         []cloudwatch.Dimension{{Name: aws.String("DBInstanceIdentifier"), Value: aws.String("db")}},
+		Statistic:          cloudwatch.StatisticAverage,
+		Period:             aws.Int64(300),
+		EvaluationPeriods:  aws.Int64(3),
+		Threshold:          aws.Float64(80),
+		ComparisonOperator: cloudwatch.ComparisonOperatorGreaterThanThreshold,
+		Tags:               []cloudwatch.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+	}
+	got := GeneratePutMetricAlarmInput("cpu", params())
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	threshold := params()
+	threshold.Threshold = 90
+	ok := params()
+	ok.OKActions = []string{"arn:topic-a"}
+
+	cases := map[string]struct {
+		p    v1alpha1.AlarmParameters
+		a    cloudwatch.MetricAlarm
+		want bool
+	}{
+		"UpToDate": {
+			p:    params(),
+			a:    alarm(),
+			want: true,
+		},
+		"DifferentThreshold": {
+			p:    threshold,
+			a:    alarm(),
+			want: false,
+		},
+		"MissingOKAction": {
+			p:    ok,
+			a:    alarm(),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.p, tc.a)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	a := alarm()
+	a.ActionsEnabled = aws.Bool(true)
+	a.TreatMissingData = aws.String("missing")
+	a.Unit = cloudwatch.StandardUnitPercent
+
+	want := params()
+	want.ActionsEnabled = aws.Bool(true)
+	want.TreatMissingData = aws.String("missing")
+	want.Unit = aws.String("Percent")
+
+	got := params()
+	LateInitialize(&got, &a)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+	if !IsUpToDate(got, a) {
+		t.Errorf("IsUpToDate(...): want true after late initialization")
+	}
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/cloudwatchlogs"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.LogGroupClient = (*MockLogGroupClient)(nil)
+
+// MockLogGroupClient is a type that implements all the methods for the
+// LogGroupClient interface
+type MockLogGroupClient struct {
+	MockCreate             func(*cloudwatchlogs.CreateLogGroupInput) cloudwatchlogs.CreateLogGroupRequest
+	MockDescribe           func(*cloudwatchlogs.DescribeLogGroupsInput) cloudwatchlogs.DescribeLogGroupsRequest
+	MockPutRetentionPolicy func(*cloudwatchlogs.PutRetentionPolicyInput) cloudwatchlogs.PutRetentionPolicyRequest
+	MockAssociateKmsKey    func(*cloudwatchlogs.AssociateKmsKeyInput) cloudwatchlogs.AssociateKmsKeyRequest
+	MockDelete             func(*cloudwatchlogs.DeleteLogGroupInput) cloudwatchlogs.DeleteLogGroupRequest
+}
+
+// CreateLogGroupRequest mocks CreateLogGroupRequest method
+func (m *MockLogGroupClient) CreateLogGroupRequest(input *cloudwatchlogs.CreateLogGroupInput) cloudwatchlogs.CreateLogGroupRequest {
+	return m.MockCreate(input)
+}
+
+// DescribeLogGroupsRequest mocks DescribeLogGroupsRequest method
+func (m *MockLogGroupClient) DescribeLogGroupsRequest(input *cloudwatchlogs.DescribeLogGroupsInput) cloudwatchlogs.DescribeLogGroupsRequest {
+	return m.MockDescribe(input)
+}
+
+// PutRetentionPolicyRequest mocks PutRetentionPolicyRequest method
+func (m *MockLogGroupClient) PutRetentionPolicyRequest(input *cloudwatchlogs.PutRetentionPolicyInput) cloudwatchlogs.PutRetentionPolicyRequest {
+	return m.MockPutRetentionPolicy(input)
+}
+
+// AssociateKmsKeyRequest mocks AssociateKmsKeyRequest method
+func (m *MockLogGroupClient) AssociateKmsKeyRequest(input *cloudwatchlogs.AssociateKmsKeyInput) cloudwatchlogs.AssociateKmsKeyRequest {
+	return m.MockAssociateKmsKey(input)
+}
+
+// DeleteLogGroupRequest mocks DeleteLogGroupRequest method
+func (m *MockLogGroupClient) DeleteLogGroupRequest(input *cloudwatchlogs.DeleteLogGroupInput) cloudwatchlogs.DeleteLogGroupRequest {
+	return m.MockDelete(input)
+}
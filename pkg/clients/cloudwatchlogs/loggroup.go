@@ -0,0 +1,121 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cloudwatchlogs
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/cloudwatchlogs/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// LogGroupClient defines CloudWatch Logs client operations for log groups
+type LogGroupClient interface {
+	CreateLogGroupRequest(input *cloudwatchlogs.CreateLogGroupInput) cloudwatchlogs.CreateLogGroupRequest
+	DescribeLogGroupsRequest(input *cloudwatchlogs.DescribeLogGroupsInput) cloudwatchlogs.DescribeLogGroupsRequest
+	PutRetentionPolicyRequest(input *cloudwatchlogs.PutRetentionPolicyInput) cloudwatchlogs.PutRetentionPolicyRequest
+	AssociateKmsKeyRequest(input *cloudwatchlogs.AssociateKmsKeyInput) cloudwatchlogs.AssociateKmsKeyRequest
+	DeleteLogGroupRequest(input *cloudwatchlogs.DeleteLogGroupInput) cloudwatchlogs.DeleteLogGroupRequest
+}
+
+// NewLogGroupClient creates new CloudWatch Logs Client with provided AWS
+// Configurations/Credentials
+func NewLogGroupClient(cfg aws.Config) LogGroupClient {
+	return cloudwatchlogs.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the log group doesn't
+// exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == cloudwatchlogs.ErrCodeResourceNotFoundException
+	}
+	return false
+}
+
+// FindLogGroup returns the log group with exactly the given name from a page
+// of DescribeLogGroups, which lists every log group whose name starts with
+// the given prefix.
+func FindLogGroup(name string, groups []cloudwatchlogs.LogGroup) *cloudwatchlogs.LogGroup {
+	for i := range groups {
+		if aws.StringValue(groups[i].LogGroupName) == name {
+			return &groups[i]
+		}
+	}
+	return nil
+}
+
+// GenerateCreateLogGroupInput returns the input to create the log group with
+// the given name. The retention is set separately after the creation.
+func GenerateCreateLogGroupInput(name string, p v1alpha1.LogGroupParameters) *cloudwatchlogs.CreateLogGroupInput {
+	input := &cloudwatchlogs.CreateLogGroupInput{
+		LogGroupName: aws.String(name),
+		KmsKeyId:     p.KMSKeyID,
+	}
+	if len(p.Tags) != 0 {
+		input.Tags = p.Tags
+	}
+	return input
+}
+
+// GenerateObservation is used to produce v1alpha1.LogGroupObservation from
+// cloudwatchlogs.LogGroup.
+func GenerateObservation(lg cloudwatchlogs.LogGroup) v1alpha1.LogGroupObservation {
+	o := v1alpha1.LogGroupObservation{
+		ARN:               aws.StringValue(lg.Arn),
+		StoredBytes:       aws.Int64Value(lg.StoredBytes),
+		MetricFilterCount: aws.Int64Value(lg.MetricFilterCount),
+	}
+	// The creation time is given in milliseconds since the epoch.
+	if lg.CreationTime != nil {
+		t := metav1.NewTime(time.Unix(0, *lg.CreationTime*int64(time.Millisecond)))
+		o.CreationTime = &t
+	}
+	return o
+}
+
+// LateInitialize fills the empty fields in *v1alpha1.LogGroupParameters with
+// the values seen in cloudwatchlogs.LogGroup.
+func LateInitialize(in *v1alpha1.LogGroupParameters, lg *cloudwatchlogs.LogGroup) {
+	if lg == nil {
+		return
+	}
+	in.RetentionInDays = awsclients.LateInitializeInt64Ptr(in.RetentionInDays, lg.RetentionInDays)
+	in.KMSKeyID = awsclients.LateInitializeStringPtr(in.KMSKeyID, lg.KmsKeyId)
+}
+
+// IsRetentionUpToDate returns whether the log group keeps its log events for
+// the desired number of days.
+func IsRetentionUpToDate(p v1alpha1.LogGroupParameters, lg cloudwatchlogs.LogGroup) bool {
+	return p.RetentionInDays == nil || aws.Int64Value(p.RetentionInDays) == aws.Int64Value(lg.RetentionInDays)
+}
+
+// IsKMSKeyUpToDate returns whether the log events of the log group are
+// encrypted with the desired KMS key.
+func IsKMSKeyUpToDate(p v1alpha1.LogGroupParameters, lg cloudwatchlogs.LogGroup) bool {
+	return p.KMSKeyID == nil || aws.StringValue(p.KMSKeyID) == aws.StringValue(lg.KmsKeyId)
+}
+
+// IsUpToDate checks whether the retention and the KMS key of the log group
+// are the desired ones.
+func IsUpToDate(p v1alpha1.LogGroupParameters, lg cloudwatchlogs.LogGroup) bool {
+	return IsRetentionUpToDate(p, lg) && IsKMSKeyUpToDate(p, lg)
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cloudwatchlogs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/cloudwatchlogs/v1alpha1"
+)
+
+func TestGenerateObservation(t *testing.T) {
+	created := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	ct := metav1.NewTime(created)
+
+	got := GenerateObservation(cloudwatchlogs.LogGroup{
+		Arn:               aws.String("arn"),
+		CreationTime:      aws.Int64(created.UnixNano() / int64(time.Millisecond)),
+		StoredBytes:       aws.Int64(42),
+		MetricFilterCount: aws.Int64(2),
+	})
+	want := v1alpha1.LogGroupObservation{ARN: "arn", CreationTime: &ct, StoredBytes: 42, MetricFilterCount: 2}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.LogGroupParameters
+		lg   cloudwatchlogs.LogGroup
+		want bool
+	}{
+		"NothingDesired": {
+			lg:   cloudwatchlogs.LogGroup{RetentionInDays: aws.Int64(7), KmsKeyId: aws.String("key")},
+			want: true,
+		},
+		"SameRetentionAndKey": {
+			p:    v1alpha1.LogGroupParameters{RetentionInDays: aws.Int64(7), KMSKeyID: aws.String("key")},
+			lg:   cloudwatchlogs.LogGroup{RetentionInDays: aws.Int64(7), KmsKeyId: aws.String("key")},
+			want: true,
+		},
+		"InfiniteRetention": {
+			p:    v1alpha1.LogGroupParameters{RetentionInDays: aws.Int64(7)},
+			lg:   cloudwatchlogs.LogGroup{},
+			want: false,
+		},
+		"DifferentKey": {
+			p:    v1alpha1.LogGroupParameters{KMSKeyID: aws.String("key")},
+			lg:   cloudwatchlogs.LogGroup{KmsKeyId: aws.String("other")},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.p, tc.lg)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package dbproxy
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+)
+
+// Client is the external client used for DBProxy Custom Resource
+type Client interface {
+	CreateDBProxyRequest(input *rds.CreateDBProxyInput) rds.CreateDBProxyRequest
+	DescribeDBProxiesRequest(input *rds.DescribeDBProxiesInput) rds.DescribeDBProxiesRequest
+	ModifyDBProxyRequest(input *rds.ModifyDBProxyInput) rds.ModifyDBProxyRequest
+	DeleteDBProxyRequest(input *rds.DeleteDBProxyInput) rds.DeleteDBProxyRequest
+	DescribeDBProxyTargetsRequest(input *rds.DescribeDBProxyTargetsInput) rds.DescribeDBProxyTargetsRequest
+	RegisterDBProxyTargetsRequest(input *rds.RegisterDBProxyTargetsInput) rds.RegisterDBProxyTargetsRequest
+	DeregisterDBProxyTargetsRequest(input *rds.DeregisterDBProxyTargetsInput) rds.DeregisterDBProxyTargetsRequest
+}
+
+// NewClient returns a new client using AWS credentials as JSON encoded data.
+func NewClient(cfg aws.Config) Client {
+	return rds.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the proxy doesn't exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == rds.ErrCodeDBProxyNotFoundFault
+	}
+	return false
+}
+
+// GenerateAuth returns the authentication configuration of the proxy. Only
+// Secrets Manager secrets are supported by AWS.
+func GenerateAuth(p v1alpha1.DBProxyParameters) []rds.UserAuthConfig {
+	auth := make([]rds.UserAuthConfig, len(p.Auth))
+	for i, a := range p.Auth {
+		auth[i] = rds.UserAuthConfig{
+			AuthScheme:  rds.AuthSchemeSecrets,
+			Description: a.Description,
+			IAMAuth:     rds.IAMAuthMode(aws.StringValue(a.IAMAuth)),
+			SecretArn:   a.SecretARN,
+		}
+	}
+	return auth
+}
+
+// GenerateCreateDBProxyInput returns the input to create a proxy with the
+// given name.
+func GenerateCreateDBProxyInput(name string, p v1alpha1.DBProxyParameters) *rds.CreateDBProxyInput {
+	return &rds.CreateDBProxyInput{
+		DBProxyName:         aws.String(name),
+		Auth:                GenerateAuth(p),
+		DebugLogging:        p.DebugLogging,
+		EngineFamily:        rds.EngineFamily(p.EngineFamily),
+		IdleClientTimeout:   p.IdleClientTimeout,
+		RequireTLS:          p.RequireTLS,
+		RoleArn:             p.RoleARN,
+		VpcSecurityGroupIds: p.VPCSecurityGroupIDs,
+		VpcSubnetIds:        p.VPCSubnetIDs,
+	}
+}
+
+// GenerateModifyDBProxyInput returns the input to bring the proxy with the
+// given name to the desired state.
+func GenerateModifyDBProxyInput(name string, p v1alpha1.DBProxyParameters) *rds.ModifyDBProxyInput {
+	return &rds.ModifyDBProxyInput{
+		DBProxyName:       aws.String(name),
+		Auth:              GenerateAuth(p),
+		DebugLogging:      p.DebugLogging,
+		IdleClientTimeout: p.IdleClientTimeout,
+		RequireTLS:        p.RequireTLS,
+		RoleArn:           p.RoleARN,
+		SecurityGroups:    p.VPCSecurityGroupIDs,
+	}
+}
+
+// GenerateObservation is used to produce v1alpha1.DBProxyObservation from
+// rds.DBProxy.
+func GenerateObservation(proxy rds.DBProxy) v1alpha1.DBProxyObservation {
+	return v1alpha1.DBProxyObservation{
+		DBProxyARN: aws.StringValue(proxy.DBProxyArn),
+		Endpoint:   aws.StringValue(proxy.Endpoint),
+		Status:     string(proxy.Status),
+	}
+}
+
+// LateInitialize fills the empty fields in *v1alpha1.DBProxyParameters with
+// the values seen in rds.DBProxy.
+func LateInitialize(p *v1alpha1.DBProxyParameters, proxy rds.DBProxy) {
+	if p.IdleClientTimeout == nil {
+		p.IdleClientTimeout = proxy.IdleClientTimeout
+	}
+	if p.RequireTLS == nil {
+		p.RequireTLS = proxy.RequireTLS
+	}
+	if p.DebugLogging == nil {
+		p.DebugLogging = proxy.DebugLogging
+	}
+	if len(p.VPCSecurityGroupIDs) == 0 {
+		p.VPCSecurityGroupIDs = proxy.VpcSecurityGroupIds
+	}
+	if len(p.VPCSubnetIDs) == 0 {
+		p.VPCSubnetIDs = proxy.VpcSubnetIds
+	}
+	if len(p.Auth) != len(proxy.Auth) {
+		return
+	}
+	for i := range p.Auth {
+		if p.Auth[i].IAMAuth == nil && proxy.Auth[i].IAMAuth != "" {
+			p.Auth[i].IAMAuth = aws.String(string(proxy.Auth[i].IAMAuth))
+		}
+	}
+}
+
+// isAuthUpToDate checks whether the proxy uses the desired secrets. Fields
+// that are not given are not compared, since AWS fills them with defaults.
+func isAuthUpToDate(p v1alpha1.DBProxyParameters, proxy rds.DBProxy) bool {
+	if len(p.Auth) != len(proxy.Auth) {
+		return false
+	}
+	current := make(map[string]rds.UserAuthConfigInfo, len(proxy.Auth))
+	for _, a := range proxy.Auth {
+		current[aws.StringValue(a.SecretArn)] = a
+	}
+	for _, a := range p.Auth {
+		c, ok := current[aws.StringValue(a.SecretARN)]
+		if !ok {
+			return false
+		}
+		if a.IAMAuth != nil && aws.StringValue(a.IAMAuth) != string(c.IAMAuth) {
+			return false
+		}
+		if a.Description != nil && aws.StringValue(a.Description) != aws.StringValue(c.Description) {
+			return false
+		}
+	}
+	return true
+}
+
+// isSameSet checks whether the given lists hold the same strings, regardless
+// of their order.
+func isSameSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := make(map[string]bool, len(a))
+	for _, s := range a {
+		set[s] = true
+	}
+	for _, s := range b {
+		if !set[s] {
+			return false
+		}
+	}
+	return true
+}
+
+// IsUpToDate checks whether the proxy is configured as desired.
+func IsUpToDate(p v1alpha1.DBProxyParameters, proxy rds.DBProxy) bool {
+	switch {
+	case p.IdleClientTimeout != nil && aws.Int64Value(p.IdleClientTimeout) != aws.Int64Value(proxy.IdleClientTimeout),
+		p.RequireTLS != nil && aws.BoolValue(p.RequireTLS) != aws.BoolValue(proxy.RequireTLS),
+		p.DebugLogging != nil && aws.BoolValue(p.DebugLogging) != aws.BoolValue(proxy.DebugLogging),
+		p.RoleARN != nil && aws.StringValue(p.RoleARN) != aws.StringValue(proxy.RoleArn),
+		len(p.VPCSecurityGroupIDs) != 0 && !isSameSet(p.VPCSecurityGroupIDs, proxy.VpcSecurityGroupIds):
+		return false
+	}
+	return isAuthUpToDate(p, proxy)
+}
+
+// DiffTargets returns the identifiers of the DB instances that have to be
+// registered and deregistered so that the given instance is the only target
+// of the proxy. If no instance is given, the targets are left alone.
+func DiffTargets(instanceID *string, targets []rds.DBProxyTarget) ([]string, []string) {
+	if instanceID == nil {
+		return nil, nil
+	}
+	var register, deregister []string
+	found := false
+	for _, t := range targets {
+		if t.Type != rds.TargetTypeRdsInstance {
+			continue
+		}
+		if aws.StringValue(t.RdsResourceId) == aws.StringValue(instanceID) {
+			found = true
+			continue
+		}
+		deregister = append(deregister, aws.StringValue(t.RdsResourceId))
+	}
+	if !found {
+		register = []string{aws.StringValue(instanceID)}
+	}
+	sort.Strings(deregister)
+	return register, deregister
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package dbproxy
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+)
+
+var (
+	secretARN = "arn:aws:secretsmanager:us-east-1:123456789012:secret:db-creds"
+	roleARN   = "arn:aws:iam::123456789012:role/proxy"
+	instance  = "my-instance"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p     v1alpha1.DBProxyParameters
+		proxy rds.DBProxy
+		want  bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.DBProxyParameters{
+				Auth:                []v1alpha1.DBProxyAuth{{SecretARN: &secretARN}},
+				RoleARN:             &roleARN,
+				IdleClientTimeout:   aws.Int64(900),
+				VPCSecurityGroupIDs: []string{"sg-1", "sg-2"},
+			},
+			proxy: rds.DBProxy{
+				Auth:                []rds.UserAuthConfigInfo{{SecretArn: &secretARN, IAMAuth: rds.IAMAuthModeDisabled}},
+				RoleArn:             &roleARN,
+				IdleClientTimeout:   aws.Int64(900),
+				RequireTLS:          aws.Bool(false),
+				VpcSecurityGroupIds: []string{"sg-2", "sg-1"},
+			},
+			want: true,
+		},
+		"IdleClientTimeoutChanged": {
+			p:     v1alpha1.DBProxyParameters{IdleClientTimeout: aws.Int64(300)},
+			proxy: rds.DBProxy{IdleClientTimeout: aws.Int64(1800)},
+			want:  false,
+		},
+		"SecurityGroupAdded": {
+			p:     v1alpha1.DBProxyParameters{VPCSecurityGroupIDs: []string{"sg-1", "sg-2"}},
+			proxy: rds.DBProxy{VpcSecurityGroupIds: []string{"sg-1"}},
+			want:  false,
+		},
+		"SecretChanged": {
+			p: v1alpha1.DBProxyParameters{Auth: []v1alpha1.DBProxyAuth{{SecretARN: aws.String("other")}}},
+			proxy: rds.DBProxy{
+				Auth: []rds.UserAuthConfigInfo{{SecretArn: &secretARN}},
+			},
+			want: false,
+		},
+		"IAMAuthChanged": {
+			p: v1alpha1.DBProxyParameters{Auth: []v1alpha1.DBProxyAuth{{SecretARN: &secretARN, IAMAuth: aws.String("REQUIRED")}}},
+			proxy: rds.DBProxy{
+				Auth: []rds.UserAuthConfigInfo{{SecretArn: &secretARN, IAMAuth: rds.IAMAuthModeDisabled}},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.p, tc.proxy)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffTargets(t *testing.T) {
+	type want struct {
+		register   []string
+		deregister []string
+	}
+
+	cases := map[string]struct {
+		instanceID *string
+		targets    []rds.DBProxyTarget
+		want       want
+	}{
+		"NoInstance": {
+			targets: []rds.DBProxyTarget{{Type: rds.TargetTypeRdsInstance, RdsResourceId: aws.String("other")}},
+		},
+		"Registered": {
+			instanceID: &instance,
+			targets: []rds.DBProxyTarget{
+				{Type: rds.TargetTypeRdsInstance, RdsResourceId: &instance},
+			},
+		},
+		"Register": {
+			instanceID: &instance,
+			want: want{
+				register: []string{instance},
+			},
+		},
+		"Replace": {
+			instanceID: &instance,
+			targets: []rds.DBProxyTarget{
+				{Type: rds.TargetTypeRdsInstance, RdsResourceId: aws.String("old")},
+				{Type: rds.TargetTypeTrackedCluster, RdsResourceId: aws.String("cluster")},
+			},
+			want: want{
+				register:   []string{instance},
+				deregister: []string{"old"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			register, deregister := DiffTargets(tc.instanceID, tc.targets)
+			if diff := cmp.Diff(tc.want.register, register); diff != "" {
+				t.Errorf("register: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deregister, deregister); diff != "" {
+				t.Errorf("deregister: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
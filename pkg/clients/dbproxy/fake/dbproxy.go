@@ -0,0 +1,72 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/dbproxy"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockDBProxyClient)(nil)
+
+// MockDBProxyClient is a type that implements all the methods for the DBProxy
+// Client interface
+type MockDBProxyClient struct {
+	MockCreate            func(*rds.CreateDBProxyInput) rds.CreateDBProxyRequest
+	MockDescribe          func(*rds.DescribeDBProxiesInput) rds.DescribeDBProxiesRequest
+	MockModify            func(*rds.ModifyDBProxyInput) rds.ModifyDBProxyRequest
+	MockDelete            func(*rds.DeleteDBProxyInput) rds.DeleteDBProxyRequest
+	MockDescribeTargets   func(*rds.DescribeDBProxyTargetsInput) rds.DescribeDBProxyTargetsRequest
+	MockRegisterTargets   func(*rds.RegisterDBProxyTargetsInput) rds.RegisterDBProxyTargetsRequest
+	MockDeregisterTargets func(*rds.DeregisterDBProxyTargetsInput) rds.DeregisterDBProxyTargetsRequest
+}
+
+// CreateDBProxyRequest mocks CreateDBProxyRequest method
+func (m *MockDBProxyClient) CreateDBProxyRequest(input *rds.CreateDBProxyInput) rds.CreateDBProxyRequest {
+	return m.MockCreate(input)
+}
+
+// DescribeDBProxiesRequest mocks DescribeDBProxiesRequest method
+func (m *MockDBProxyClient) DescribeDBProxiesRequest(input *rds.DescribeDBProxiesInput) rds.DescribeDBProxiesRequest {
+	return m.MockDescribe(input)
+}
+
+// ModifyDBProxyRequest mocks ModifyDBProxyRequest method
+func (m *MockDBProxyClient) ModifyDBProxyRequest(input *rds.ModifyDBProxyInput) rds.ModifyDBProxyRequest {
+	return m.MockModify(input)
+}
+
+// DeleteDBProxyRequest mocks DeleteDBProxyRequest method
+func (m *MockDBProxyClient) DeleteDBProxyRequest(input *rds.DeleteDBProxyInput) rds.DeleteDBProxyRequest {
+	return m.MockDelete(input)
+}
+
+// DescribeDBProxyTargetsRequest mocks DescribeDBProxyTargetsRequest method
+func (m *MockDBProxyClient) DescribeDBProxyTargetsRequest(input *rds.DescribeDBProxyTargetsInput) rds.DescribeDBProxyTargetsRequest {
+	return m.MockDescribeTargets(input)
+}
+
+// RegisterDBProxyTargetsRequest mocks RegisterDBProxyTargetsRequest method
+func (m *MockDBProxyClient) RegisterDBProxyTargetsRequest(input *rds.RegisterDBProxyTargetsInput) rds.RegisterDBProxyTargetsRequest {
+	return m.MockRegisterTargets(input)
+}
+
+// DeregisterDBProxyTargetsRequest mocks DeregisterDBProxyTargetsRequest method
+func (m *MockDBProxyClient) DeregisterDBProxyTargetsRequest(input *rds.DeregisterDBProxyTargetsInput) rds.DeregisterDBProxyTargetsRequest {
+	return m.MockDeregisterTargets(input)
+}
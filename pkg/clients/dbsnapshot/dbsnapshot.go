@@ -0,0 +1,132 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package dbsnapshot
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+)
+
+const errSourceFmt = "exactly one of dbInstanceIdentifier and sourceDBSnapshotIdentifier must be given, got %d"
+
+// Client is the external client used for DBSnapshot Custom Resource
+type Client interface {
+	CreateDBSnapshotRequest(input *rds.CreateDBSnapshotInput) rds.CreateDBSnapshotRequest
+	CopyDBSnapshotRequest(input *rds.CopyDBSnapshotInput) rds.CopyDBSnapshotRequest
+	DescribeDBSnapshotsRequest(input *rds.DescribeDBSnapshotsInput) rds.DescribeDBSnapshotsRequest
+	DeleteDBSnapshotRequest(input *rds.DeleteDBSnapshotInput) rds.DeleteDBSnapshotRequest
+}
+
+// NewClient returns a new client using AWS credentials as JSON encoded data.
+func NewClient(cfg aws.Config) Client {
+	return rds.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the snapshot doesn't exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == rds.ErrCodeDBSnapshotNotFoundFault
+	}
+	return false
+}
+
+// IsCopy returns true if the snapshot is copied from another snapshot rather
+// than taken of a DB instance.
+func IsCopy(p v1alpha1.DBSnapshotParameters) bool {
+	return p.SourceDBSnapshotIdentifier != nil
+}
+
+// ValidateSource checks that the snapshot is either taken of a DB instance or
+// copied from another snapshot.
+func ValidateSource(p v1alpha1.DBSnapshotParameters) error {
+	n := 0
+	if p.DBInstanceIdentifier != nil {
+		n++
+	}
+	if p.SourceDBSnapshotIdentifier != nil {
+		n++
+	}
+	if n != 1 {
+		return errors.Errorf(errSourceFmt, n)
+	}
+	return nil
+}
+
+func generateTags(tags []v1alpha1.DBSnapshotTag) []rds.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]rds.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = rds.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	}
+	return res
+}
+
+// GenerateCreateDBSnapshotInput returns the input that takes a snapshot of the
+// DB instance given in the parameters.
+func GenerateCreateDBSnapshotInput(name string, p v1alpha1.DBSnapshotParameters) *rds.CreateDBSnapshotInput {
+	return &rds.CreateDBSnapshotInput{
+		DBSnapshotIdentifier: aws.String(name),
+		DBInstanceIdentifier: p.DBInstanceIdentifier,
+		Tags:                 generateTags(p.Tags),
+	}
+}
+
+// GenerateCopyDBSnapshotInput returns the input that copies the source
+// snapshot given in the parameters. The SDK signs the request for the source
+// region when SourceRegion is set, which is required for copies across
+// regions.
+func GenerateCopyDBSnapshotInput(name string, p v1alpha1.DBSnapshotParameters) *rds.CopyDBSnapshotInput {
+	in := &rds.CopyDBSnapshotInput{
+		TargetDBSnapshotIdentifier: aws.String(name),
+		SourceDBSnapshotIdentifier: p.SourceDBSnapshotIdentifier,
+		KmsKeyId:                   p.KMSKeyID,
+		CopyTags:                   p.CopyTags,
+		OptionGroupName:            p.OptionGroupName,
+		Tags:                       generateTags(p.Tags),
+	}
+	if p.SourceRegion != nil && *p.SourceRegion != p.Region {
+		in.SourceRegion = p.SourceRegion
+	}
+	return in
+}
+
+// GenerateObservation is used to produce v1alpha1.DBSnapshotObservation from
+// rds.DBSnapshot.
+func GenerateObservation(s rds.DBSnapshot) v1alpha1.DBSnapshotObservation {
+	o := v1alpha1.DBSnapshotObservation{
+		DBSnapshotARN:              aws.StringValue(s.DBSnapshotArn),
+		Status:                     aws.StringValue(s.Status),
+		PercentProgress:            aws.Int64Value(s.PercentProgress),
+		SourceDBSnapshotIdentifier: aws.StringValue(s.SourceDBSnapshotIdentifier),
+		SourceRegion:               aws.StringValue(s.SourceRegion),
+		Engine:                     aws.StringValue(s.Engine),
+		EngineVersion:              aws.StringValue(s.EngineVersion),
+		Encrypted:                  aws.BoolValue(s.Encrypted),
+		KMSKeyID:                   aws.StringValue(s.KmsKeyId),
+	}
+	if s.SnapshotCreateTime != nil {
+		t := metav1.NewTime(*s.SnapshotCreateTime)
+		o.SnapshotCreateTime = &t
+	}
+	return o
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package dbsnapshot
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+)
+
+func TestValidateSource(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.DBSnapshotParameters
+		want error
+	}{
+		"Instance": {
+			p: v1alpha1.DBSnapshotParameters{DBInstanceIdentifier: aws.String("db")},
+		},
+		"Snapshot": {
+			p: v1alpha1.DBSnapshotParameters{SourceDBSnapshotIdentifier: aws.String("snap")},
+		},
+		"Neither": {
+			p:    v1alpha1.DBSnapshotParameters{},
+			want: errors.Errorf(errSourceFmt, 0),
+		},
+		"Both": {
+			p:    v1alpha1.DBSnapshotParameters{DBInstanceIdentifier: aws.String("db"), SourceDBSnapshotIdentifier: aws.String("snap")},
+			want: errors.Errorf(errSourceFmt, 2),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateSource(tc.p)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateCopyDBSnapshotInput(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.DBSnapshotParameters
+		want *rds.CopyDBSnapshotInput
+	}{
+		"CrossRegion": {
+			p: v1alpha1.DBSnapshotParameters{
+				Region:                     "eu-west-1",
+				SourceDBSnapshotIdentifier: aws.String("arn:aws:rds:us-east-1:123456789012:snapshot:snap"),
+				SourceRegion:               aws.String("us-east-1"),
+				KMSKeyID:                   aws.String("alias/dr"),
+				CopyTags:                   aws.Bool(true),
+				Tags:                       []v1alpha1.DBSnapshotTag{{Key: "k", Value: "v"}},
+			},
+			want: &rds.CopyDBSnapshotInput{
+				TargetDBSnapshotIdentifier: aws.String("copy"),
+				SourceDBSnapshotIdentifier: aws.String("arn:aws:rds:us-east-1:123456789012:snapshot:snap"),
+				SourceRegion:               aws.String("us-east-1"),
+				KmsKeyId:                   aws.String("alias/dr"),
+				CopyTags:                   aws.Bool(true),
+				Tags:                       []rds.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+			},
+		},
+		"SameRegion": {
+			p: v1alpha1.DBSnapshotParameters{
+				Region:                     "us-east-1",
+				SourceDBSnapshotIdentifier: aws.String("snap"),
+				SourceRegion:               aws.String("us-east-1"),
+			},
+			want: &rds.CopyDBSnapshotInput{
+				TargetDBSnapshotIdentifier: aws.String("copy"),
+				SourceDBSnapshotIdentifier: aws.String("snap"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCopyDBSnapshotInput("copy", tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/dbsnapshot"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockDBSnapshotClient)(nil)
+
+// MockDBSnapshotClient is a type that implements all the methods for the
+// DBSnapshot Client interface
+type MockDBSnapshotClient struct {
+	MockCreate   func(*rds.CreateDBSnapshotInput) rds.CreateDBSnapshotRequest
+	MockCopy     func(*rds.CopyDBSnapshotInput) rds.CopyDBSnapshotRequest
+	MockDescribe func(*rds.DescribeDBSnapshotsInput) rds.DescribeDBSnapshotsRequest
+	MockDelete   func(*rds.DeleteDBSnapshotInput) rds.DeleteDBSnapshotRequest
+}
+
+// CreateDBSnapshotRequest mocks CreateDBSnapshotRequest method
+func (m *MockDBSnapshotClient) CreateDBSnapshotRequest(input *rds.CreateDBSnapshotInput) rds.CreateDBSnapshotRequest {
+	return m.MockCreate(input)
+}
+
+// CopyDBSnapshotRequest mocks CopyDBSnapshotRequest method
+func (m *MockDBSnapshotClient) CopyDBSnapshotRequest(input *rds.CopyDBSnapshotInput) rds.CopyDBSnapshotRequest {
+	return m.MockCopy(input)
+}
+
+// DescribeDBSnapshotsRequest mocks DescribeDBSnapshotsRequest method
+func (m *MockDBSnapshotClient) DescribeDBSnapshotsRequest(input *rds.DescribeDBSnapshotsInput) rds.DescribeDBSnapshotsRequest {
+	return m.MockDescribe(input)
+}
+
+// DeleteDBSnapshotRequest mocks DeleteDBSnapshotRequest method
+func (m *MockDBSnapshotClient) DeleteDBSnapshotRequest(input *rds.DeleteDBSnapshotInput) rds.DeleteDBSnapshotRequest {
+	return m.MockDelete(input)
+}
@@ -23,9 +23,19 @@ type AddressClient interface {
 	AllocateAddressRequest(input *ec2.AllocateAddressInput) ec2.AllocateAddressRequest
 	DescribeAddressesRequest(input *ec2.DescribeAddressesInput) ec2.DescribeAddressesRequest
 	ReleaseAddressRequest(input *ec2.ReleaseAddressInput) ec2.ReleaseAddressRequest
+	DisassociateAddressRequest(input *ec2.DisassociateAddressInput) ec2.DisassociateAddressRequest
 	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
 }
 
+// IsAddressAssociationNotFoundErr returns true if the error is because the
+// association doesn't exist
+func IsAddressAssociationNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == AssociationIDNotFound
+	}
+	return false
+}
+
 // IsAddressNotFoundErr returns true if the error is because the address doesn't exist
 func IsAddressNotFoundErr(err error) bool {
 	if awsErr, ok := err.(awserr.Error); ok {
@@ -25,6 +25,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/onsi/gomega"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
@@ -273,6 +274,81 @@ func Test_IsVPCNotFoundErr(t *testing.T) {
 	}
 }
 
+func Test_IsDependencyViolationErr(t *testing.T) {
+
+	testCases := []struct {
+		name string
+		got  error
+		want bool
+	}{
+		{
+			"nil error is not",
+			nil,
+			false,
+		},
+		{
+			"other error is not",
+			errors.New("some error"),
+			false,
+		},
+		{
+			"DependencyViolation is",
+			awserr.New(DependencyViolation, "", nil),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			if diff := cmp.Diff(tc.want, IsDependencyViolationErr(tc.got), test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_IgnoreDependencyViolation(t *testing.T) {
+	errBoom := errors.New("boom")
+	errDependency := awserr.New(DependencyViolation, "has dependencies", nil)
+
+	testCases := map[string]struct {
+		err  error
+		want error
+		cr   *v1beta1.VPC
+	}{
+		"NilError": {
+			cr: &v1beta1.VPC{},
+		},
+		"OtherError": {
+			err:  errBoom,
+			want: errBoom,
+			cr:   &v1beta1.VPC{},
+		},
+		"DependencyViolation": {
+			err: errDependency,
+			cr: func() *v1beta1.VPC {
+				cr := &v1beta1.VPC{}
+				cr.SetConditions(xpv1.Deleting().WithMessage(errWaitingForDependents + ": " + errDependency.Error()))
+				return cr
+			}(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			cr := &v1beta1.VPC{}
+			err := IgnoreDependencyViolation(cr, tc.err)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.cr, cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func Test_SecurityGroup_BuildEC2Permissions(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 
@@ -29,6 +29,7 @@ var _ clientset.AddressClient = (*MockAddressClient)(nil)
 type MockAddressClient struct {
 	MockAllocate          func(*ec2.AllocateAddressInput) ec2.AllocateAddressRequest
 	MockRelease           func(*ec2.ReleaseAddressInput) ec2.ReleaseAddressRequest
+	MockDisassociate      func(*ec2.DisassociateAddressInput) ec2.DisassociateAddressRequest
 	MockDescribe          func(*ec2.DescribeAddressesInput) ec2.DescribeAddressesRequest
 	MockCreateTagsRequest func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
 }
@@ -43,6 +44,11 @@ func (m *MockAddressClient) ReleaseAddressRequest(input *ec2.ReleaseAddressInput
 	return m.MockRelease(input)
 }
 
+// DisassociateAddressRequest mocks DisassociateAddressRequest method
+func (m *MockAddressClient) DisassociateAddressRequest(input *ec2.DisassociateAddressInput) ec2.DisassociateAddressRequest {
+	return m.MockDisassociate(input)
+}
+
 // DescribeAddressesRequest mocks DescribeAddressesRequest method
 func (m *MockAddressClient) DescribeAddressesRequest(input *ec2.DescribeAddressesInput) ec2.DescribeAddressesRequest {
 	return m.MockDescribe(input)
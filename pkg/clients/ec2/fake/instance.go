@@ -0,0 +1,74 @@
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.InstanceClient = (*MockInstanceClient)(nil)
+
+// MockInstanceClient is a type that implements all the methods for InstanceClient interface
+type MockInstanceClient struct {
+	MockRun                     func(*ec2.RunInstancesInput) ec2.RunInstancesRequest
+	MockDescribe                func(*ec2.DescribeInstancesInput) ec2.DescribeInstancesRequest
+	MockTerminate               func(*ec2.TerminateInstancesInput) ec2.TerminateInstancesRequest
+	MockStart                   func(*ec2.StartInstancesInput) ec2.StartInstancesRequest
+	MockStop                    func(*ec2.StopInstancesInput) ec2.StopInstancesRequest
+	MockModifyInstanceAttribute func(*ec2.ModifyInstanceAttributeInput) ec2.ModifyInstanceAttributeRequest
+	MockDescribeAddresses       func(*ec2.DescribeAddressesInput) ec2.DescribeAddressesRequest
+	MockAssociateAddress        func(*ec2.AssociateAddressInput) ec2.AssociateAddressRequest
+	MockCreateTags              func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockDeleteTags              func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// RunInstancesRequest mocks RunInstancesRequest method
+func (m *MockInstanceClient) RunInstancesRequest(input *ec2.RunInstancesInput) ec2.RunInstancesRequest {
+	return m.MockRun(input)
+}
+
+// DescribeInstancesRequest mocks DescribeInstancesRequest method
+func (m *MockInstanceClient) DescribeInstancesRequest(input *ec2.DescribeInstancesInput) ec2.DescribeInstancesRequest {
+	return m.MockDescribe(input)
+}
+
+// TerminateInstancesRequest mocks TerminateInstancesRequest method
+func (m *MockInstanceClient) TerminateInstancesRequest(input *ec2.TerminateInstancesInput) ec2.TerminateInstancesRequest {
+	return m.MockTerminate(input)
+}
+
+// StartInstancesRequest mocks StartInstancesRequest method
+func (m *MockInstanceClient) StartInstancesRequest(input *ec2.StartInstancesInput) ec2.StartInstancesRequest {
+	return m.MockStart(input)
+}
+
+// StopInstancesRequest mocks StopInstancesRequest method
+func (m *MockInstanceClient) StopInstancesRequest(input *ec2.StopInstancesInput) ec2.StopInstancesRequest {
+	return m.MockStop(input)
+}
+
+// ModifyInstanceAttributeRequest mocks ModifyInstanceAttributeRequest method
+func (m *MockInstanceClient) ModifyInstanceAttributeRequest(input *ec2.ModifyInstanceAttributeInput) ec2.ModifyInstanceAttributeRequest {
+	return m.MockModifyInstanceAttribute(input)
+}
+
+// DescribeAddressesRequest mocks DescribeAddressesRequest method
+func (m *MockInstanceClient) DescribeAddressesRequest(input *ec2.DescribeAddressesInput) ec2.DescribeAddressesRequest {
+	return m.MockDescribeAddresses(input)
+}
+
+// AssociateAddressRequest mocks AssociateAddressRequest method
+func (m *MockInstanceClient) AssociateAddressRequest(input *ec2.AssociateAddressInput) ec2.AssociateAddressRequest {
+	return m.MockAssociateAddress(input)
+}
+
+// CreateTagsRequest mocks CreateTagsRequest method
+func (m *MockInstanceClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return m.MockCreateTags(input)
+}
+
+// DeleteTagsRequest mocks DeleteTagsRequest method
+func (m *MockInstanceClient) DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+	return m.MockDeleteTags(input)
+}
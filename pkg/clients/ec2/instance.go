@@ -0,0 +1,198 @@
+package ec2
+
+import (
+	"encoding/base64"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// InstanceIDNotFound is the code that is returned by ec2 when the given InstanceID is not valid
+	InstanceIDNotFound = "InvalidInstanceID.NotFound"
+)
+
+// InstanceClient is the external client used for Instance Custom Resource
+type InstanceClient interface {
+	RunInstancesRequest(*ec2.RunInstancesInput) ec2.RunInstancesRequest
+	DescribeInstancesRequest(*ec2.DescribeInstancesInput) ec2.DescribeInstancesRequest
+	TerminateInstancesRequest(*ec2.TerminateInstancesInput) ec2.TerminateInstancesRequest
+	StartInstancesRequest(*ec2.StartInstancesInput) ec2.StartInstancesRequest
+	StopInstancesRequest(*ec2.StopInstancesInput) ec2.StopInstancesRequest
+	ModifyInstanceAttributeRequest(*ec2.ModifyInstanceAttributeInput) ec2.ModifyInstanceAttributeRequest
+	DescribeAddressesRequest(*ec2.DescribeAddressesInput) ec2.DescribeAddressesRequest
+	AssociateAddressRequest(*ec2.AssociateAddressInput) ec2.AssociateAddressRequest
+	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewInstanceClient returns a new client using AWS credentials as JSON encoded data.
+func NewInstanceClient(cfg aws.Config) InstanceClient {
+	return ec2.New(cfg)
+}
+
+// IsInstanceNotFoundErr returns true if the error is because the item doesn't exist
+func IsInstanceNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == InstanceIDNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateRunInstancesInput returns the input to launch a single instance
+// with the given parameters.
+func GenerateRunInstancesInput(p v1alpha1.InstanceParameters) *ec2.RunInstancesInput {
+	in := &ec2.RunInstancesInput{
+		ImageId:          aws.String(p.ImageID),
+		InstanceType:     ec2.InstanceType(p.InstanceType),
+		MinCount:         aws.Int64(1),
+		MaxCount:         aws.Int64(1),
+		SubnetId:         p.SubnetID,
+		SecurityGroupIds: p.SecurityGroupIDs,
+		KeyName:          p.KeyName,
+	}
+	if p.UserData != nil {
+		in.UserData = aws.String(base64.StdEncoding.EncodeToString([]byte(*p.UserData)))
+	}
+	if len(p.Tags) != 0 {
+		in.TagSpecifications = []ec2.TagSpecification{
+			{
+				ResourceType: ec2.ResourceTypeInstance,
+				Tags:         GenerateEC2Tags(p.Tags),
+			},
+		}
+	}
+	return in
+}
+
+// GenerateInstanceObservation is used to produce v1alpha1.InstanceObservation
+// from ec2.Instance.
+func GenerateInstanceObservation(i ec2.Instance) v1alpha1.InstanceObservation {
+	o := v1alpha1.InstanceObservation{
+		InstanceID:       aws.StringValue(i.InstanceId),
+		PrivateIPAddress: aws.StringValue(i.PrivateIpAddress),
+		PublicIPAddress:  aws.StringValue(i.PublicIpAddress),
+		PrivateDNSName:   aws.StringValue(i.PrivateDnsName),
+		PublicDNSName:    aws.StringValue(i.PublicDnsName),
+		VPCID:            aws.StringValue(i.VpcId),
+	}
+	if i.State != nil {
+		o.State = string(i.State.Name)
+	}
+	if i.LaunchTime != nil {
+		o.LaunchTime = &metav1.Time{Time: *i.LaunchTime}
+	}
+	return o
+}
+
+// LateInitializeInstance fills the empty fields in *v1alpha1.InstanceParameters
+// with the values seen in ec2.Instance.
+func LateInitializeInstance(in *v1alpha1.InstanceParameters, i *ec2.Instance) {
+	if i == nil {
+		return
+	}
+	in.SubnetID = awsclients.LateInitializeStringPtr(in.SubnetID, i.SubnetId)
+	in.KeyName = awsclients.LateInitializeStringPtr(in.KeyName, i.KeyName)
+	if len(in.SecurityGroupIDs) == 0 && len(i.SecurityGroups) != 0 {
+		in.SecurityGroupIDs = instanceSecurityGroupIDs(i)
+	}
+	if len(in.Tags) == 0 && len(i.Tags) != 0 {
+		in.Tags = BuildFromEC2Tags(i.Tags)
+	}
+}
+
+// GetInstancePower returns the desired power state of the instance.
+func GetInstancePower(p v1alpha1.InstanceParameters) string {
+	if p.Power == nil {
+		return v1alpha1.InstancePowerRunning
+	}
+	return *p.Power
+}
+
+// IsInstancePowerUpToDate returns true if the instance is in, or is moving
+// to, the desired power state.
+func IsInstancePowerUpToDate(p v1alpha1.InstanceParameters, state string) bool {
+	switch GetInstancePower(p) {
+	case v1alpha1.InstancePowerStopped:
+		return state == v1alpha1.InstanceStateStopping || state == v1alpha1.InstanceStateStopped
+	default:
+		return state == v1alpha1.InstanceStatePending || state == v1alpha1.InstanceStateRunning
+	}
+}
+
+// IsInstanceSecurityGroupsUpToDate returns true if the instance has exactly
+// the desired security groups.
+func IsInstanceSecurityGroupsUpToDate(p v1alpha1.InstanceParameters, i ec2.Instance) bool {
+	current := instanceSecurityGroupIDs(&i)
+	if len(current) != len(p.SecurityGroupIDs) {
+		return false
+	}
+	desired := make([]string, len(p.SecurityGroupIDs))
+	copy(desired, p.SecurityGroupIDs)
+	sort.Strings(desired)
+	for k := range desired {
+		if desired[k] != current[k] {
+			return false
+		}
+	}
+	return true
+}
+
+// IsInstanceUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsInstanceUpToDate(p v1alpha1.InstanceParameters, i ec2.Instance) bool {
+	state := ""
+	if i.State != nil {
+		state = string(i.State.Name)
+	}
+	return IsInstancePowerUpToDate(p, state) &&
+		IsInstanceSecurityGroupsUpToDate(p, i) &&
+		CompareTags(p.Tags, i.Tags)
+}
+
+// FindInstanceAddress returns the address with the given allocation ID, or
+// nil if there is none.
+func FindInstanceAddress(allocationID string, addresses []ec2.Address) *ec2.Address {
+	for i := range addresses {
+		if aws.StringValue(addresses[i].AllocationId) == allocationID {
+			return &addresses[i]
+		}
+	}
+	return nil
+}
+
+// GetInstanceConnectionDetails returns the connection details of the
+// instance.
+func GetInstanceConnectionDetails(o v1alpha1.InstanceObservation) managed.ConnectionDetails {
+	conn := managed.ConnectionDetails{
+		v1alpha1.ResourceCredentialsSecretInstanceIDKey: []byte(o.InstanceID),
+	}
+	if o.PrivateIPAddress != "" {
+		conn[v1alpha1.ResourceCredentialsSecretPrivateIPKey] = []byte(o.PrivateIPAddress)
+	}
+	if o.PublicIPAddress != "" {
+		conn[v1alpha1.ResourceCredentialsSecretPublicIPKey] = []byte(o.PublicIPAddress)
+	}
+	return conn
+}
+
+// instanceSecurityGroupIDs returns the sorted IDs of the security groups of
+// the instance.
+func instanceSecurityGroupIDs(i *ec2.Instance) []string {
+	ids := make([]string, len(i.SecurityGroups))
+	for k, g := range i.SecurityGroups {
+		ids[k] = aws.StringValue(g.GroupId)
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,219 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	imageID         = "ami-123"
+	instanceType    = "t3.micro"
+	instanceSubnet  = "subnet-1"
+	instanceKeyName = "key"
+	sg1             = "sg-1"
+	sg2             = "sg-2"
+)
+
+func TestGenerateRunInstancesInput(t *testing.T) {
+	cases := map[string]struct {
+		in  v1alpha1.InstanceParameters
+		out *ec2.RunInstancesInput
+	}{
+		"AllFilled": {
+			in: v1alpha1.InstanceParameters{
+				ImageID:          imageID,
+				InstanceType:     instanceType,
+				SubnetID:         aws.String(instanceSubnet),
+				SecurityGroupIDs: []string{sg1},
+				KeyName:          aws.String(instanceKeyName),
+				UserData:         aws.String("#!/bin/sh"),
+				Tags:             []v1beta1.Tag{v1beta1Tag},
+			},
+			out: &ec2.RunInstancesInput{
+				ImageId:          aws.String(imageID),
+				InstanceType:     ec2.InstanceType(instanceType),
+				MinCount:         aws.Int64(1),
+				MaxCount:         aws.Int64(1),
+				SubnetId:         aws.String(instanceSubnet),
+				SecurityGroupIds: []string{sg1},
+				KeyName:          aws.String(instanceKeyName),
+				UserData:         aws.String("IyEvYmluL3No"),
+				TagSpecifications: []ec2.TagSpecification{
+					{ResourceType: ec2.ResourceTypeInstance, Tags: []ec2.Tag{ec2tag}},
+				},
+			},
+		},
+		"Minimal": {
+			in: v1alpha1.InstanceParameters{
+				ImageID:      imageID,
+				InstanceType: instanceType,
+			},
+			out: &ec2.RunInstancesInput{
+				ImageId:      aws.String(imageID),
+				InstanceType: ec2.InstanceType(instanceType),
+				MinCount:     aws.Int64(1),
+				MaxCount:     aws.Int64(1),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := GenerateRunInstancesInput(tc.in)
+			if diff := cmp.Diff(tc.out, r); diff != "" {
+				t.Errorf("GenerateRunInstancesInput(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeInstance(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.InstanceParameters
+		instance *ec2.Instance
+		out      v1alpha1.InstanceParameters
+	}{
+		"FillsEmpty": {
+			in: v1alpha1.InstanceParameters{},
+			instance: &ec2.Instance{
+				SubnetId: aws.String(instanceSubnet),
+				KeyName:  aws.String(instanceKeyName),
+				SecurityGroups: []ec2.GroupIdentifier{
+					{GroupId: aws.String(sg2)},
+					{GroupId: aws.String(sg1)},
+				},
+				Tags: []ec2.Tag{ec2tag},
+			},
+			out: v1alpha1.InstanceParameters{
+				SubnetID:         aws.String(instanceSubnet),
+				KeyName:          aws.String(instanceKeyName),
+				SecurityGroupIDs: []string{sg1, sg2},
+				Tags:             []v1beta1.Tag{v1beta1Tag},
+			},
+		},
+		"KeepsSpec": {
+			in: v1alpha1.InstanceParameters{
+				SecurityGroupIDs: []string{sg2},
+			},
+			instance: &ec2.Instance{
+				SecurityGroups: []ec2.GroupIdentifier{{GroupId: aws.String(sg1)}},
+			},
+			out: v1alpha1.InstanceParameters{
+				SecurityGroupIDs: []string{sg2},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeInstance(&tc.in, tc.instance)
+			if diff := cmp.Diff(tc.out, tc.in); diff != "" {
+				t.Errorf("LateInitializeInstance(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsInstanceUpToDate(t *testing.T) {
+	stopped := v1alpha1.InstancePowerStopped
+
+	cases := map[string]struct {
+		p        v1alpha1.InstanceParameters
+		instance ec2.Instance
+		want     bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.InstanceParameters{SecurityGroupIDs: []string{sg2, sg1}},
+			instance: ec2.Instance{
+				State:          &ec2.InstanceState{Name: ec2.InstanceStateNameRunning},
+				SecurityGroups: []ec2.GroupIdentifier{{GroupId: aws.String(sg1)}, {GroupId: aws.String(sg2)}},
+			},
+			want: true,
+		},
+		"Stopping": {
+			p: v1alpha1.InstanceParameters{Power: &stopped},
+			instance: ec2.Instance{
+				State: &ec2.InstanceState{Name: ec2.InstanceStateNameStopping},
+			},
+			want: true,
+		},
+		"PowerChanged": {
+			p: v1alpha1.InstanceParameters{Power: &stopped},
+			instance: ec2.Instance{
+				State: &ec2.InstanceState{Name: ec2.InstanceStateNameRunning},
+			},
+			want: false,
+		},
+		"SecurityGroupsChanged": {
+			p: v1alpha1.InstanceParameters{SecurityGroupIDs: []string{sg2}},
+			instance: ec2.Instance{
+				State:          &ec2.InstanceState{Name: ec2.InstanceStateNameRunning},
+				SecurityGroups: []ec2.GroupIdentifier{{GroupId: aws.String(sg1)}},
+			},
+			want: false,
+		},
+		"TagsChanged": {
+			p: v1alpha1.InstanceParameters{Tags: []v1beta1.Tag{v1beta1Tag}},
+			instance: ec2.Instance{
+				State: &ec2.InstanceState{Name: ec2.InstanceStateNameRunning},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsInstanceUpToDate(tc.p, tc.instance)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsInstanceUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetInstanceConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		in  v1alpha1.InstanceObservation
+		out managed.ConnectionDetails
+	}{
+		"PrivateOnly": {
+			in: v1alpha1.InstanceObservation{
+				InstanceID:       instanceID,
+				PrivateIPAddress: testIPAddress,
+			},
+			out: managed.ConnectionDetails{
+				v1alpha1.ResourceCredentialsSecretInstanceIDKey: []byte(instanceID),
+				v1alpha1.ResourceCredentialsSecretPrivateIPKey:  []byte(testIPAddress),
+			},
+		},
+		"PublicAndPrivate": {
+			in: v1alpha1.InstanceObservation{
+				InstanceID:       instanceID,
+				PrivateIPAddress: testIPAddress,
+				PublicIPAddress:  testIPAddress,
+			},
+			out: managed.ConnectionDetails{
+				v1alpha1.ResourceCredentialsSecretInstanceIDKey: []byte(instanceID),
+				v1alpha1.ResourceCredentialsSecretPrivateIPKey:  []byte(testIPAddress),
+				v1alpha1.ResourceCredentialsSecretPublicIPKey:   []byte(testIPAddress),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := GetInstanceConnectionDetails(tc.in)
+			if diff := cmp.Diff(tc.out, r); diff != "" {
+				t.Errorf("GetInstanceConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// NetworkACLIDNotFound is the code that is returned by ec2 when the given NetworkACLID is invalid
+	NetworkACLIDNotFound = "InvalidNetworkAclID.NotFound"
+
+	// DefaultNetworkACLRuleNumber is the rule number of the catch-all deny
+	// entries that AWS adds to every network ACL. They cannot be modified.
+	DefaultNetworkACLRuleNumber = 32767
+
+	protocolICMP   = "1"
+	protocolTCP    = "6"
+	protocolUDP    = "17"
+	protocolICMPv6 = "58"
+)
+
+// NetworkACLClient is the external client used for NetworkACL Custom Resource
+type NetworkACLClient interface {
+	CreateNetworkAclRequest(*ec2.CreateNetworkAclInput) ec2.CreateNetworkAclRequest
+	DeleteNetworkAclRequest(*ec2.DeleteNetworkAclInput) ec2.DeleteNetworkAclRequest
+	DescribeNetworkAclsRequest(*ec2.DescribeNetworkAclsInput) ec2.DescribeNetworkAclsRequest
+	CreateNetworkAclEntryRequest(*ec2.CreateNetworkAclEntryInput) ec2.CreateNetworkAclEntryRequest
+	ReplaceNetworkAclEntryRequest(*ec2.ReplaceNetworkAclEntryInput) ec2.ReplaceNetworkAclEntryRequest
+	DeleteNetworkAclEntryRequest(*ec2.DeleteNetworkAclEntryInput) ec2.DeleteNetworkAclEntryRequest
+	ReplaceNetworkAclAssociationRequest(*ec2.ReplaceNetworkAclAssociationInput) ec2.ReplaceNetworkAclAssociationRequest
+	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewNetworkACLClient returns a new client using AWS credentials as JSON encoded data.
+func NewNetworkACLClient(cfg aws.Config) NetworkACLClient {
+	return ec2.New(cfg)
+}
+
+// IsNetworkACLNotFoundErr returns true if the error is because the network ACL doesn't exist
+func IsNetworkACLNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == NetworkACLIDNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateNetworkACLObservation is used to produce v1alpha1.NetworkACLObservation
+// from ec2.NetworkAcl.
+func GenerateNetworkACLObservation(acl ec2.NetworkAcl) v1alpha1.NetworkACLObservation {
+	o := v1alpha1.NetworkACLObservation{
+		OwnerID:      aws.StringValue(acl.OwnerId),
+		NetworkACLID: aws.StringValue(acl.NetworkAclId),
+		IsDefault:    aws.BoolValue(acl.IsDefault),
+	}
+	if len(acl.Associations) > 0 {
+		o.Associations = make([]v1alpha1.NetworkACLAssociationState, len(acl.Associations))
+		for i, asc := range acl.Associations {
+			o.Associations[i] = v1alpha1.NetworkACLAssociationState{
+				AssociationID: aws.StringValue(asc.NetworkAclAssociationId),
+				SubnetID:      aws.StringValue(asc.SubnetId),
+			}
+		}
+	}
+	return o
+}
+
+// LateInitializeNetworkACL fills the empty fields in *v1alpha1.NetworkACLParameters
+// with the values seen in ec2.NetworkAcl.
+func LateInitializeNetworkACL(in *v1alpha1.NetworkACLParameters, acl *ec2.NetworkAcl) {
+	if acl == nil {
+		return
+	}
+	in.VPCID = awsclients.LateInitializeStringPtr(in.VPCID, acl.VpcId)
+	if len(in.Tags) == 0 && len(acl.Tags) != 0 {
+		in.Tags = v1beta1.BuildFromEC2Tags(acl.Tags)
+	}
+}
+
+// GenerateNetworkACLEntry converts the given ec2.NetworkAclEntry into its
+// v1alpha1.NetworkACLEntry representation.
+func GenerateNetworkACLEntry(e ec2.NetworkAclEntry) v1alpha1.NetworkACLEntry {
+	o := v1alpha1.NetworkACLEntry{
+		RuleNumber:    aws.Int64Value(e.RuleNumber),
+		Egress:        aws.BoolValue(e.Egress),
+		Protocol:      aws.StringValue(e.Protocol),
+		RuleAction:    string(e.RuleAction),
+		CIDRBlock:     e.CidrBlock,
+		IPv6CIDRBlock: e.Ipv6CidrBlock,
+	}
+	if e.PortRange != nil {
+		o.FromPort = e.PortRange.From
+		o.ToPort = e.PortRange.To
+	}
+	if e.IcmpTypeCode != nil {
+		o.ICMPType = e.IcmpTypeCode.Type
+		o.ICMPCode = e.IcmpTypeCode.Code
+	}
+	return normalizeNetworkACLEntry(o)
+}
+
+// GenerateCreateNetworkACLEntryInput returns the input to create the given
+// entry in the network ACL with the given ID.
+func GenerateCreateNetworkACLEntryInput(id string, e v1alpha1.NetworkACLEntry) *ec2.CreateNetworkAclEntryInput {
+	in := &ec2.CreateNetworkAclEntryInput{
+		NetworkAclId:  aws.String(id),
+		RuleNumber:    aws.Int64(e.RuleNumber),
+		Egress:        aws.Bool(e.Egress),
+		Protocol:      aws.String(e.Protocol),
+		RuleAction:    ec2.RuleAction(e.RuleAction),
+		CidrBlock:     e.CIDRBlock,
+		Ipv6CidrBlock: e.IPv6CIDRBlock,
+	}
+	if e.FromPort != nil || e.ToPort != nil {
+		in.PortRange = &ec2.PortRange{From: e.FromPort, To: e.ToPort}
+	}
+	if e.ICMPType != nil || e.ICMPCode != nil {
+		in.IcmpTypeCode = &ec2.IcmpTypeCode{Type: e.ICMPType, Code: e.ICMPCode}
+	}
+	return in
+}
+
+// GenerateReplaceNetworkACLEntryInput returns the input to replace the entry
+// with the same rule number and direction in the network ACL with the given
+// ID.
+func GenerateReplaceNetworkACLEntryInput(id string, e v1alpha1.NetworkACLEntry) *ec2.ReplaceNetworkAclEntryInput {
+	c := GenerateCreateNetworkACLEntryInput(id, e)
+	return &ec2.ReplaceNetworkAclEntryInput{
+		NetworkAclId:  c.NetworkAclId,
+		RuleNumber:    c.RuleNumber,
+		Egress:        c.Egress,
+		Protocol:      c.Protocol,
+		RuleAction:    c.RuleAction,
+		CidrBlock:     c.CidrBlock,
+		Ipv6CidrBlock: c.Ipv6CidrBlock,
+		PortRange:     c.PortRange,
+		IcmpTypeCode:  c.IcmpTypeCode,
+	}
+}
+
+// normalizeNetworkACLEntry drops the port range and ICMP fields when the
+// protocol of the entry does not use them, since AWS ignores them as well.
+func normalizeNetworkACLEntry(e v1alpha1.NetworkACLEntry) v1alpha1.NetworkACLEntry {
+	if e.Protocol != protocolTCP && e.Protocol != protocolUDP {
+		e.FromPort = nil
+		e.ToPort = nil
+	}
+	if e.Protocol != protocolICMP && e.Protocol != protocolICMPv6 {
+		e.ICMPType = nil
+		e.ICMPCode = nil
+	}
+	return e
+}
+
+type networkACLEntryKey struct {
+	ruleNumber int64
+	egress     bool
+}
+
+// DiffNetworkACLEntries returns the entries that need to be created, replaced
+// and deleted so that the observed entries match the desired ones. The
+// default entries managed by AWS are ignored.
+func DiffNetworkACLEntries(desired []v1alpha1.NetworkACLEntry, observed []ec2.NetworkAclEntry) (create, replace, remove []v1alpha1.NetworkACLEntry) {
+	current := map[networkACLEntryKey]v1alpha1.NetworkACLEntry{}
+	for _, e := range observed {
+		if aws.Int64Value(e.RuleNumber) == DefaultNetworkACLRuleNumber {
+			continue
+		}
+		o := GenerateNetworkACLEntry(e)
+		current[networkACLEntryKey{ruleNumber: o.RuleNumber, egress: o.Egress}] = o
+	}
+	wanted := map[networkACLEntryKey]bool{}
+	for _, e := range desired {
+		k := networkACLEntryKey{ruleNumber: e.RuleNumber, egress: e.Egress}
+		wanted[k] = true
+		o, ok := current[k]
+		switch {
+		case !ok:
+			create = append(create, e)
+		case !cmp.Equal(normalizeNetworkACLEntry(e), o):
+			replace = append(replace, e)
+		}
+	}
+	for _, e := range observed {
+		o := GenerateNetworkACLEntry(e)
+		if o.RuleNumber == DefaultNetworkACLRuleNumber || wanted[networkACLEntryKey{ruleNumber: o.RuleNumber, egress: o.Egress}] {
+			continue
+		}
+		remove = append(remove, o)
+	}
+	return create, replace, remove
+}
+
+// DiffNetworkACLAssociations returns the IDs of the subnets that need to be
+// associated with the network ACL and the associations that need to be moved
+// back to the default network ACL of the VPC.
+func DiffNetworkACLAssociations(desired []v1alpha1.NetworkACLAssociation, observed []ec2.NetworkAclAssociation) (add []string, remove []ec2.NetworkAclAssociation) {
+	current := map[string]bool{}
+	for _, a := range observed {
+		current[aws.StringValue(a.SubnetId)] = true
+	}
+	wanted := map[string]bool{}
+	for _, a := range desired {
+		id := aws.StringValue(a.SubnetID)
+		wanted[id] = true
+		if !current[id] {
+			add = append(add, id)
+		}
+	}
+	for _, a := range observed {
+		if !wanted[aws.StringValue(a.SubnetId)] {
+			remove = append(remove, a)
+		}
+	}
+	return add, remove
+}
+
+// IsNetworkACLUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsNetworkACLUpToDate(p v1alpha1.NetworkACLParameters, acl ec2.NetworkAcl) bool {
+	create, replace, remove := DiffNetworkACLEntries(p.Entries, acl.Entries)
+	if len(create) != 0 || len(replace) != 0 || len(remove) != 0 {
+		return false
+	}
+	add, disassociate := DiffNetworkACLAssociations(p.Associations, acl.Associations)
+	if len(add) != 0 || len(disassociate) != 0 {
+		return false
+	}
+	tags := make([]v1beta1.Tag, len(p.Tags))
+	copy(tags, p.Tags)
+	return v1beta1.CompareTags(tags, acl.Tags)
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+var (
+	aclCIDR     = "10.0.0.0/16"
+	aclSubnetID = "some subnet"
+)
+
+func aclDefaultEntries() []ec2.NetworkAclEntry {
+	return []ec2.NetworkAclEntry{
+		{RuleNumber: aws.Int64(DefaultNetworkACLRuleNumber), Egress: aws.Bool(false), Protocol: aws.String("-1"), RuleAction: ec2.RuleActionDeny, CidrBlock: aws.String("0.0.0.0/0")},
+		{RuleNumber: aws.Int64(DefaultNetworkACLRuleNumber), Egress: aws.Bool(true), Protocol: aws.String("-1"), RuleAction: ec2.RuleActionDeny, CidrBlock: aws.String("0.0.0.0/0")},
+	}
+}
+
+func aclHTTPSEntry() ec2.NetworkAclEntry {
+	return ec2.NetworkAclEntry{
+		RuleNumber: aws.Int64(100),
+		Egress:     aws.Bool(false),
+		Protocol:   aws.String("6"),
+		RuleAction: ec2.RuleActionAllow,
+		CidrBlock:  aws.String(aclCIDR),
+		PortRange:  &ec2.PortRange{From: aws.Int64(443), To: aws.Int64(443)},
+	}
+}
+
+func specHTTPSEntry() v1alpha1.NetworkACLEntry {
+	return v1alpha1.NetworkACLEntry{
+		RuleNumber: 100,
+		Protocol:   "6",
+		RuleAction: "allow",
+		CIDRBlock:  aws.String(aclCIDR),
+		FromPort:   aws.Int64(443),
+		ToPort:     aws.Int64(443),
+	}
+}
+
+func TestDiffNetworkACLEntries(t *testing.T) {
+	type want struct {
+		create  []v1alpha1.NetworkACLEntry
+		replace []v1alpha1.NetworkACLEntry
+		remove  []v1alpha1.NetworkACLEntry
+	}
+
+	denyAll := specHTTPSEntry()
+	denyAll.RuleAction = "deny"
+
+	allTraffic := v1alpha1.NetworkACLEntry{
+		RuleNumber: 200,
+		Egress:     true,
+		Protocol:   "-1",
+		RuleAction: "allow",
+		CIDRBlock:  aws.String(aclCIDR),
+		FromPort:   aws.Int64(0),
+		ToPort:     aws.Int64(65535),
+	}
+
+	cases := map[string]struct {
+		desired  []v1alpha1.NetworkACLEntry
+		observed []ec2.NetworkAclEntry
+		want     want
+	}{
+		"DefaultEntriesIgnored": {
+			observed: aclDefaultEntries(),
+		},
+		"InSync": {
+			desired:  []v1alpha1.NetworkACLEntry{specHTTPSEntry()},
+			observed: append(aclDefaultEntries(), aclHTTPSEntry()),
+		},
+		"Create": {
+			desired:  []v1alpha1.NetworkACLEntry{specHTTPSEntry()},
+			observed: aclDefaultEntries(),
+			want: want{
+				create: []v1alpha1.NetworkACLEntry{specHTTPSEntry()},
+			},
+		},
+		"Replace": {
+			desired:  []v1alpha1.NetworkACLEntry{denyAll},
+			observed: []ec2.NetworkAclEntry{aclHTTPSEntry()},
+			want: want{
+				replace: []v1alpha1.NetworkACLEntry{denyAll},
+			},
+		},
+		"Remove": {
+			observed: append(aclDefaultEntries(), aclHTTPSEntry()),
+			want: want{
+				remove: []v1alpha1.NetworkACLEntry{specHTTPSEntry()},
+			},
+		},
+		"SameRuleNumberDifferentDirection": {
+			desired: []v1alpha1.NetworkACLEntry{func() v1alpha1.NetworkACLEntry {
+				e := specHTTPSEntry()
+				e.Egress = true
+				return e
+			}()},
+			observed: []ec2.NetworkAclEntry{aclHTTPSEntry()},
+			want: want{
+				create: []v1alpha1.NetworkACLEntry{func() v1alpha1.NetworkACLEntry {
+					e := specHTTPSEntry()
+					e.Egress = true
+					return e
+				}()},
+				remove: []v1alpha1.NetworkACLEntry{specHTTPSEntry()},
+			},
+		},
+		"PortsIgnoredForAllProtocols": {
+			desired: []v1alpha1.NetworkACLEntry{allTraffic},
+			observed: []ec2.NetworkAclEntry{{
+				RuleNumber: aws.Int64(200),
+				Egress:     aws.Bool(true),
+				Protocol:   aws.String("-1"),
+				RuleAction: ec2.RuleActionAllow,
+				CidrBlock:  aws.String(aclCIDR),
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			create, replace, remove := DiffNetworkACLEntries(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.create, create); diff != "" {
+				t.Errorf("create: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.replace, replace); diff != "" {
+				t.Errorf("replace: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsNetworkACLUpToDate(t *testing.T) {
+	type args struct {
+		acl ec2.NetworkAcl
+		p   v1alpha1.NetworkACLParameters
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"SameFields": {
+			args: args{
+				acl: ec2.NetworkAcl{
+					Entries:      append(aclDefaultEntries(), aclHTTPSEntry()),
+					Associations: []ec2.NetworkAclAssociation{{SubnetId: aws.String(aclSubnetID)}},
+					Tags:         []ec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+				},
+				p: v1alpha1.NetworkACLParameters{
+					Entries:      []v1alpha1.NetworkACLEntry{specHTTPSEntry()},
+					Associations: []v1alpha1.NetworkACLAssociation{{SubnetID: aws.String(aclSubnetID)}},
+					Tags:         []v1beta1.Tag{{Key: "k", Value: "v"}},
+				},
+			},
+			want: true,
+		},
+		"DifferentEntries": {
+			args: args{
+				acl: ec2.NetworkAcl{
+					Entries: aclDefaultEntries(),
+				},
+				p: v1alpha1.NetworkACLParameters{
+					Entries: []v1alpha1.NetworkACLEntry{specHTTPSEntry()},
+				},
+			},
+			want: false,
+		},
+		"DifferentAssociations": {
+			args: args{
+				acl: ec2.NetworkAcl{},
+				p: v1alpha1.NetworkACLParameters{
+					Associations: []v1alpha1.NetworkACLAssociation{{SubnetID: aws.String(aclSubnetID)}},
+				},
+			},
+			want: false,
+		},
+		"DifferentTags": {
+			args: args{
+				acl: ec2.NetworkAcl{
+					Tags: []ec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+				},
+				p: v1alpha1.NetworkACLParameters{},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsNetworkACLUpToDate(tc.args.p, tc.args.acl)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
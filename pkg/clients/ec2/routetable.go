@@ -90,16 +90,17 @@ func GenerateRTObservation(rt ec2.RouteTable) v1beta1.RouteTableObservation {
 		o.Routes = make([]v1beta1.RouteState, len(rt.Routes))
 		for i, rt := range rt.Routes {
 			o.Routes[i] = v1beta1.RouteState{
-				State:                    string(rt.State),
-				DestinationCIDRBlock:     aws.StringValue(rt.DestinationCidrBlock),
-				DestinationIPV6CIDRBlock: aws.StringValue(rt.DestinationIpv6CidrBlock),
-				GatewayID:                aws.StringValue(rt.GatewayId),
-				InstanceID:               aws.StringValue(rt.InstanceId),
-				LocalGatewayID:           aws.StringValue(rt.LocalGatewayId),
-				NatGatewayID:             aws.StringValue(rt.NatGatewayId),
-				NetworkInterfaceID:       aws.StringValue(rt.NetworkInterfaceId),
-				TransitGatewayID:         aws.StringValue(rt.TransitGatewayId),
-				VpcPeeringConnectionID:   aws.StringValue(rt.VpcPeeringConnectionId),
+				State:                       string(rt.State),
+				DestinationCIDRBlock:        aws.StringValue(rt.DestinationCidrBlock),
+				DestinationIPV6CIDRBlock:    aws.StringValue(rt.DestinationIpv6CidrBlock),
+				EgressOnlyInternetGatewayID: aws.StringValue(rt.EgressOnlyInternetGatewayId),
+				GatewayID:                   aws.StringValue(rt.GatewayId),
+				InstanceID:                  aws.StringValue(rt.InstanceId),
+				LocalGatewayID:              aws.StringValue(rt.LocalGatewayId),
+				NatGatewayID:                aws.StringValue(rt.NatGatewayId),
+				NetworkInterfaceID:          aws.StringValue(rt.NetworkInterfaceId),
+				TransitGatewayID:            aws.StringValue(rt.TransitGatewayId),
+				VpcPeeringConnectionID:      aws.StringValue(rt.VpcPeeringConnectionId),
 			}
 		}
 	}
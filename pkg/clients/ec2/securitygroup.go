@@ -2,6 +2,7 @@ package ec2
 
 import (
 	"encoding/json"
+	"fmt"
 	"sort"
 	"strings"
 
@@ -33,6 +34,7 @@ type SecurityGroupClient interface {
 	DescribeSecurityGroupsRequest(input *ec2.DescribeSecurityGroupsInput) ec2.DescribeSecurityGroupsRequest
 	AuthorizeSecurityGroupIngressRequest(input *ec2.AuthorizeSecurityGroupIngressInput) ec2.AuthorizeSecurityGroupIngressRequest
 	AuthorizeSecurityGroupEgressRequest(input *ec2.AuthorizeSecurityGroupEgressInput) ec2.AuthorizeSecurityGroupEgressRequest
+	RevokeSecurityGroupIngressRequest(input *ec2.RevokeSecurityGroupIngressInput) ec2.RevokeSecurityGroupIngressRequest
 	RevokeSecurityGroupEgressRequest(input *ec2.RevokeSecurityGroupEgressInput) ec2.RevokeSecurityGroupEgressRequest
 	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
 	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
@@ -324,15 +326,128 @@ func CreateSGPatch(in ec2.SecurityGroup, target v1beta1.SecurityGroupParameters)
 }
 
 // IsSGUpToDate checks whether there is a change in any of the modifiable fields.
+// Rules are compared as sets the same way Update applies them, so their order
+// does not matter. Rules that are not given are not managed, while an empty
+// list of rules means that all observed rules have to be revoked.
 func IsSGUpToDate(p v1beta1.SecurityGroupParameters, sg ec2.SecurityGroup) (bool, error) {
 	patch, err := CreateSGPatch(sg, p)
 	if err != nil {
 		return false, err
 	}
-	return cmp.Equal(&v1beta1.SecurityGroupParameters{}, patch,
+	if !cmp.Equal(&v1beta1.SecurityGroupParameters{}, patch,
 		cmpopts.IgnoreTypes(&xpv1.Reference{}, &xpv1.Selector{}),
-		cmpopts.IgnoreFields(v1beta1.SecurityGroupParameters{}, "Region"),
-		InsensitiveCases()), nil
+		cmpopts.IgnoreFields(v1beta1.SecurityGroupParameters{}, "Region", "Ingress", "Egress"),
+		InsensitiveCases()) {
+		return false, nil
+	}
+	return !permissionsChanged(p.Ingress, sg.IpPermissions) && !permissionsChanged(p.Egress, sg.IpPermissionsEgress), nil
+}
+
+// permissionsChanged returns whether the observed permissions have to be
+// changed to match the desired ones. Nil desired permissions are not managed.
+func permissionsChanged(desired []v1beta1.IPPermission, observed []ec2.IpPermission) bool {
+	if desired == nil {
+		return false
+	}
+	add, remove := DiffPermissions(GenerateEC2Permissions(desired), observed)
+	return len(add) != 0 || len(remove) != 0
+}
+
+// DiffPermissions returns the permissions that need to be authorized and the
+// ones that need to be revoked in order to turn the observed permissions into
+// the desired ones. AWS merges rules that share protocol and ports, so both
+// sides are compared rule by rule, i.e. one source per permission.
+func DiffPermissions(desired, observed []ec2.IpPermission) (add, remove []ec2.IpPermission) {
+	want := map[string]ec2.IpPermission{}
+	for _, p := range splitPermissions(desired) {
+		want[permissionKey(p)] = p
+	}
+	have := map[string]ec2.IpPermission{}
+	for _, p := range splitPermissions(observed) {
+		have[permissionKey(p)] = p
+	}
+	for k, p := range want {
+		if _, ok := have[k]; !ok {
+			add = append(add, p)
+		}
+	}
+	for k, p := range have {
+		if _, ok := want[k]; !ok {
+			remove = append(remove, p)
+		}
+	}
+	sortPermissions(add)
+	sortPermissions(remove)
+	return add, remove
+}
+
+// splitPermissions returns a list of permissions in which every item has
+// exactly one source.
+func splitPermissions(perms []ec2.IpPermission) []ec2.IpPermission {
+	var result []ec2.IpPermission
+	for _, p := range perms {
+		base := ec2.IpPermission{
+			FromPort:   p.FromPort,
+			IpProtocol: p.IpProtocol,
+			ToPort:     p.ToPort,
+		}
+		for _, r := range p.IpRanges {
+			single := base
+			single.IpRanges = []ec2.IpRange{r}
+			result = append(result, single)
+		}
+		for _, r := range p.Ipv6Ranges {
+			single := base
+			single.Ipv6Ranges = []ec2.Ipv6Range{r}
+			result = append(result, single)
+		}
+		for _, r := range p.PrefixListIds {
+			single := base
+			single.PrefixListIds = []ec2.PrefixListId{r}
+			result = append(result, single)
+		}
+		for _, r := range p.UserIdGroupPairs {
+			single := base
+			single.UserIdGroupPairs = []ec2.UserIdGroupPair{r}
+			result = append(result, single)
+		}
+	}
+	return result
+}
+
+// permissionKey returns a string that identifies a permission with a single
+// source. Descriptions are not part of the identity of a rule.
+func permissionKey(p ec2.IpPermission) string {
+	protocol := strings.ToLower(aws.StringValue(p.IpProtocol))
+	ports := ""
+	// AWS does not return ports for rules that apply to all
+	// protocols even if -1 was sent.
+	if protocol != "-1" {
+		ports = fmt.Sprintf("%d-%d", aws.Int64Value(p.FromPort), aws.Int64Value(p.ToPort))
+	}
+	source := ""
+	switch {
+	case len(p.IpRanges) == 1:
+		source = "ipv4/" + aws.StringValue(p.IpRanges[0].CidrIp)
+	case len(p.Ipv6Ranges) == 1:
+		source = "ipv6/" + strings.ToLower(aws.StringValue(p.Ipv6Ranges[0].CidrIpv6))
+	case len(p.PrefixListIds) == 1:
+		source = "pl/" + aws.StringValue(p.PrefixListIds[0].PrefixListId)
+	case len(p.UserIdGroupPairs) == 1:
+		pair := p.UserIdGroupPairs[0]
+		group := aws.StringValue(pair.GroupId)
+		if group == "" {
+			group = aws.StringValue(pair.GroupName)
+		}
+		source = "sg/" + group
+	}
+	return strings.Join([]string{protocol, ports, source}, "|")
+}
+
+func sortPermissions(perms []ec2.IpPermission) {
+	sort.Slice(perms, func(i, j int) bool {
+		return permissionKey(perms[i]) < permissionKey(perms[j])
+	})
 }
 
 // TODO(muvaf): We needed this for IPProtocol field; even if you send "TCP", AWS
@@ -96,6 +96,64 @@ func TestIsSGUpToDate(t *testing.T) {
 			},
 			want: true,
 		},
+		"SameRulesReordered": {
+			args: args{
+				sg: ec2.SecurityGroup{
+					Description: aws.String(sgDesc),
+					GroupName:   aws.String(sgName),
+					VpcId:       aws.String(sgVpc),
+					IpPermissions: []ec2.IpPermission{{
+						FromPort:   aws.Int64(80),
+						ToPort:     aws.Int64(80),
+						IpProtocol: aws.String(sgProtocol),
+						IpRanges:   []ec2.IpRange{{CidrIp: aws.String("10.0.0.0/8")}, {CidrIp: aws.String(sgCidr)}},
+					}},
+				},
+				p: v1beta1.SecurityGroupParameters{
+					Description: sgDesc,
+					GroupName:   sgName,
+					VPCID:       aws.String(sgVpc),
+					Ingress: []v1beta1.IPPermission{
+						{FromPort: aws.Int64(80), ToPort: aws.Int64(80), IPProtocol: "tcp", IPRanges: []v1beta1.IPRange{{CIDRIP: sgCidr}}},
+						{FromPort: aws.Int64(80), ToPort: aws.Int64(80), IPProtocol: "tcp", IPRanges: []v1beta1.IPRange{{CIDRIP: "10.0.0.0/8"}}},
+					},
+				},
+			},
+			want: true,
+		},
+		"IngressNotManaged": {
+			args: args{
+				sg: ec2.SecurityGroup{
+					Description:   aws.String(sgDesc),
+					GroupName:     aws.String(sgName),
+					VpcId:         aws.String(sgVpc),
+					IpPermissions: sgIPPermission(80),
+				},
+				p: v1beta1.SecurityGroupParameters{
+					Description: sgDesc,
+					GroupName:   sgName,
+					VPCID:       aws.String(sgVpc),
+				},
+			},
+			want: true,
+		},
+		"EmptyIngress": {
+			args: args{
+				sg: ec2.SecurityGroup{
+					Description:   aws.String(sgDesc),
+					GroupName:     aws.String(sgName),
+					VpcId:         aws.String(sgVpc),
+					IpPermissions: sgIPPermission(80),
+				},
+				p: v1beta1.SecurityGroupParameters{
+					Description: sgDesc,
+					GroupName:   sgName,
+					VPCID:       aws.String(sgVpc),
+					Ingress:     []v1beta1.IPPermission{},
+				},
+			},
+			want: false,
+		},
 		"DifferentFields": {
 			args: args{
 				sg: ec2.SecurityGroup{
@@ -251,3 +309,95 @@ func TestCreateSGPatch(t *testing.T) {
 		})
 	}
 }
+
+func TestDiffPermissions(t *testing.T) {
+	type args struct {
+		desired  []ec2.IpPermission
+		observed []ec2.IpPermission
+	}
+
+	type want struct {
+		add    []ec2.IpPermission
+		remove []ec2.IpPermission
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Same": {
+			args: args{
+				desired:  sgIPPermission(80, 100),
+				observed: sgIPPermission(100, 80),
+			},
+			want: want{},
+		},
+		"DescriptionIgnored": {
+			args: args{
+				desired: sgIPPermission(80),
+				observed: []ec2.IpPermission{{
+					FromPort:   aws.Int64(80),
+					ToPort:     aws.Int64(80),
+					IpProtocol: aws.String("TCP"),
+					IpRanges:   []ec2.IpRange{{CidrIp: aws.String(sgCidr), Description: aws.String(sgDesc)}},
+				}},
+			},
+			want: want{},
+		},
+		"MergedRules": {
+			args: args{
+				desired: append(sgIPPermission(80), ec2.IpPermission{
+					FromPort:         aws.Int64(80),
+					ToPort:           aws.Int64(80),
+					IpProtocol:       aws.String(sgProtocol),
+					UserIdGroupPairs: []ec2.UserIdGroupPair{{GroupId: aws.String(sgID)}},
+				}),
+				observed: []ec2.IpPermission{{
+					FromPort:         aws.Int64(80),
+					ToPort:           aws.Int64(80),
+					IpProtocol:       aws.String(sgProtocol),
+					IpRanges:         []ec2.IpRange{{CidrIp: aws.String(sgCidr)}},
+					UserIdGroupPairs: []ec2.UserIdGroupPair{{GroupId: aws.String(sgID), UserId: aws.String(sgOwner)}},
+				}},
+			},
+			want: want{},
+		},
+		"AllProtocolsIgnorePorts": {
+			args: args{
+				desired: []ec2.IpPermission{{
+					FromPort:   aws.Int64(-1),
+					ToPort:     aws.Int64(-1),
+					IpProtocol: aws.String("-1"),
+					IpRanges:   []ec2.IpRange{{CidrIp: aws.String(sgCidr)}},
+				}},
+				observed: []ec2.IpPermission{{
+					IpProtocol: aws.String("-1"),
+					IpRanges:   []ec2.IpRange{{CidrIp: aws.String(sgCidr)}},
+				}},
+			},
+			want: want{},
+		},
+		"AddAndRemove": {
+			args: args{
+				desired:  sgIPPermission(80, 443),
+				observed: sgIPPermission(80, 100),
+			},
+			want: want{
+				add:    sgIPPermission(443),
+				remove: sgIPPermission(100),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffPermissions(tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -4,6 +4,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
@@ -12,6 +16,12 @@ import (
 const (
 	// VPCIDNotFound is the code that is returned by ec2 when the given VPCID is not valid
 	VPCIDNotFound = "InvalidVpcID.NotFound"
+
+	// DependencyViolation is the code that is returned by ec2 when a resource
+	// cannot be deleted because other resources still depend on it
+	DependencyViolation = "DependencyViolation"
+
+	errWaitingForDependents = "waiting for dependent resources to be deleted"
 )
 
 // VPCClient is the external client used for VPC Custom Resource
@@ -41,6 +51,30 @@ func IsVPCNotFoundErr(err error) bool {
 	return false
 }
 
+// IsDependencyViolationErr returns true if the error is because the resource
+// still has dependents
+func IsDependencyViolationErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == DependencyViolation {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IgnoreDependencyViolation returns nil if the error is because the resource
+// still has dependents, and marks the resource as waiting for them instead.
+// The reconciler then retries the deletion without reporting a failure, so
+// tearing down related resources together does not flood them with errors.
+func IgnoreDependencyViolation(cr resource.Conditioned, err error) error {
+	if !IsDependencyViolationErr(err) {
+		return err
+	}
+	cr.SetConditions(xpv1.Deleting().WithMessage(errors.Wrap(err, errWaitingForDependents).Error()))
+	return nil
+}
+
 // IsVpcUpToDate returns true if there is no update-able difference between desired
 // and observed state of the resource.
 func IsVpcUpToDate(spec v1beta1.VPCParameters, vpc ec2.Vpc, attributes ec2.DescribeVpcAttributeOutput) bool {
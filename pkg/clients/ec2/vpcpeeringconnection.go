@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// VPCPeeringConnectionIDNotFound is the code that is returned by ec2 when the given VPCPeeringConnectionID is invalid
+	VPCPeeringConnectionIDNotFound = "InvalidVpcPeeringConnectionID.NotFound"
+)
+
+// VPCPeeringConnectionClient is the external client used for VPCPeeringConnection Custom Resource
+type VPCPeeringConnectionClient interface {
+	CreateVpcPeeringConnectionRequest(*ec2.CreateVpcPeeringConnectionInput) ec2.CreateVpcPeeringConnectionRequest
+	DescribeVpcPeeringConnectionsRequest(*ec2.DescribeVpcPeeringConnectionsInput) ec2.DescribeVpcPeeringConnectionsRequest
+	AcceptVpcPeeringConnectionRequest(*ec2.AcceptVpcPeeringConnectionInput) ec2.AcceptVpcPeeringConnectionRequest
+	DeleteVpcPeeringConnectionRequest(*ec2.DeleteVpcPeeringConnectionInput) ec2.DeleteVpcPeeringConnectionRequest
+	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewVPCPeeringConnectionClient returns a new client using AWS credentials as JSON encoded data.
+func NewVPCPeeringConnectionClient(cfg aws.Config) VPCPeeringConnectionClient {
+	return ec2.New(cfg)
+}
+
+// IsVPCPeeringConnectionNotFoundErr returns true if the error is because the
+// VPC peering connection doesn't exist
+func IsVPCPeeringConnectionNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == VPCPeeringConnectionIDNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+func generateVPCPeeringConnectionVPCInfo(in *ec2.VpcPeeringConnectionVpcInfo) v1alpha1.VPCPeeringConnectionVPCInfo {
+	if in == nil {
+		return v1alpha1.VPCPeeringConnectionVPCInfo{}
+	}
+	return v1alpha1.VPCPeeringConnectionVPCInfo{
+		CIDRBlock: aws.StringValue(in.CidrBlock),
+		OwnerID:   aws.StringValue(in.OwnerId),
+		Region:    aws.StringValue(in.Region),
+		VPCID:     aws.StringValue(in.VpcId),
+	}
+}
+
+// GenerateVPCPeeringConnectionObservation is used to produce
+// v1alpha1.VPCPeeringConnectionObservation from ec2.VpcPeeringConnection.
+func GenerateVPCPeeringConnectionObservation(pc ec2.VpcPeeringConnection) v1alpha1.VPCPeeringConnectionObservation {
+	o := v1alpha1.VPCPeeringConnectionObservation{
+		VPCPeeringConnectionID: aws.StringValue(pc.VpcPeeringConnectionId),
+		AccepterVPCInfo:        generateVPCPeeringConnectionVPCInfo(pc.AccepterVpcInfo),
+		RequesterVPCInfo:       generateVPCPeeringConnectionVPCInfo(pc.RequesterVpcInfo),
+	}
+	if pc.Status != nil {
+		o.Status = string(pc.Status.Code)
+		o.StatusMessage = aws.StringValue(pc.Status.Message)
+	}
+	if pc.ExpirationTime != nil {
+		o.ExpirationTime = &metav1.Time{Time: *pc.ExpirationTime}
+	}
+	return o
+}
+
+// LateInitializeVPCPeeringConnection fills the empty fields in
+// *v1alpha1.VPCPeeringConnectionParameters with the values seen in
+// ec2.VpcPeeringConnection.
+func LateInitializeVPCPeeringConnection(in *v1alpha1.VPCPeeringConnectionParameters, pc *ec2.VpcPeeringConnection) {
+	if pc == nil {
+		return
+	}
+	if pc.RequesterVpcInfo != nil {
+		in.VPCID = awsclients.LateInitializeStringPtr(in.VPCID, pc.RequesterVpcInfo.VpcId)
+	}
+	if pc.AccepterVpcInfo != nil {
+		in.PeerVPCID = awsclients.LateInitializeStringPtr(in.PeerVPCID, pc.AccepterVpcInfo.VpcId)
+		in.PeerOwnerID = awsclients.LateInitializeStringPtr(in.PeerOwnerID, pc.AccepterVpcInfo.OwnerId)
+		in.PeerRegion = awsclients.LateInitializeStringPtr(in.PeerRegion, pc.AccepterVpcInfo.Region)
+	}
+	if len(in.Tags) == 0 && len(pc.Tags) != 0 {
+		in.Tags = v1beta1.BuildFromEC2Tags(pc.Tags)
+	}
+}
+
+// IsVPCPeeringConnectionPendingAcceptance returns true if the VPC peering
+// connection is waiting to be accepted by the owner of the peer VPC.
+func IsVPCPeeringConnectionPendingAcceptance(pc ec2.VpcPeeringConnection) bool {
+	return pc.Status != nil && pc.Status.Code == ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance
+}
+
+// IsVPCPeeringConnectionUpToDate checks whether there is a change in any of
+// the modifiable fields.
+func IsVPCPeeringConnectionUpToDate(p v1alpha1.VPCPeeringConnectionParameters, pc ec2.VpcPeeringConnection) bool {
+	if p.AutoAccept && IsVPCPeeringConnectionPendingAcceptance(pc) {
+		return false
+	}
+	tags := make([]v1beta1.Tag, len(p.Tags))
+	copy(tags, p.Tags)
+	return v1beta1.CompareTags(tags, pc.Tags)
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+var (
+	pcVPCID     = "some vpc"
+	pcPeerVPCID = "peer vpc"
+	pcOwner     = "123456789012"
+	pcRegion    = "us-west-2"
+)
+
+func pcStatus(c ec2.VpcPeeringConnectionStateReasonCode) *ec2.VpcPeeringConnectionStateReason {
+	return &ec2.VpcPeeringConnectionStateReason{Code: c}
+}
+
+func TestIsVPCPeeringConnectionUpToDate(t *testing.T) {
+	type args struct {
+		pc ec2.VpcPeeringConnection
+		p  v1alpha1.VPCPeeringConnectionParameters
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"Active": {
+			args: args{
+				pc: ec2.VpcPeeringConnection{Status: pcStatus(ec2.VpcPeeringConnectionStateReasonCodeActive)},
+				p:  v1alpha1.VPCPeeringConnectionParameters{AutoAccept: true},
+			},
+			want: true,
+		},
+		"PendingAcceptanceWithAutoAccept": {
+			args: args{
+				pc: ec2.VpcPeeringConnection{Status: pcStatus(ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance)},
+				p:  v1alpha1.VPCPeeringConnectionParameters{AutoAccept: true},
+			},
+			want: false,
+		},
+		"PendingAcceptanceWithoutAutoAccept": {
+			args: args{
+				pc: ec2.VpcPeeringConnection{Status: pcStatus(ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance)},
+				p:  v1alpha1.VPCPeeringConnectionParameters{},
+			},
+			want: true,
+		},
+		"DifferentTags": {
+			args: args{
+				pc: ec2.VpcPeeringConnection{
+					Status: pcStatus(ec2.VpcPeeringConnectionStateReasonCodeActive),
+					Tags:   []ec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+				},
+				p: v1alpha1.VPCPeeringConnectionParameters{Tags: []v1beta1.Tag{{Key: "k", Value: "other"}}},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsVPCPeeringConnectionUpToDate(tc.args.p, tc.args.pc)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeVPCPeeringConnection(t *testing.T) {
+	type args struct {
+		p  *v1alpha1.VPCPeeringConnectionParameters
+		pc *ec2.VpcPeeringConnection
+	}
+
+	cases := map[string]struct {
+		args args
+		want *v1alpha1.VPCPeeringConnectionParameters
+	}{
+		"AllFilled": {
+			args: args{
+				p: &v1alpha1.VPCPeeringConnectionParameters{},
+				pc: &ec2.VpcPeeringConnection{
+					RequesterVpcInfo: &ec2.VpcPeeringConnectionVpcInfo{VpcId: aws.String(pcVPCID)},
+					AccepterVpcInfo: &ec2.VpcPeeringConnectionVpcInfo{
+						VpcId:   aws.String(pcPeerVPCID),
+						OwnerId: aws.String(pcOwner),
+						Region:  aws.String(pcRegion),
+					},
+				},
+			},
+			want: &v1alpha1.VPCPeeringConnectionParameters{
+				VPCID:       aws.String(pcVPCID),
+				PeerVPCID:   aws.String(pcPeerVPCID),
+				PeerOwnerID: aws.String(pcOwner),
+				PeerRegion:  aws.String(pcRegion),
+			},
+		},
+		"NoOverride": {
+			args: args{
+				p: &v1alpha1.VPCPeeringConnectionParameters{PeerRegion: aws.String("us-east-1")},
+				pc: &ec2.VpcPeeringConnection{
+					AccepterVpcInfo: &ec2.VpcPeeringConnectionVpcInfo{Region: aws.String(pcRegion)},
+				},
+			},
+			want: &v1alpha1.VPCPeeringConnectionParameters{PeerRegion: aws.String("us-east-1")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeVPCPeeringConnection(tc.args.p, tc.args.pc)
+			if diff := cmp.Diff(tc.want, tc.args.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -35,6 +35,8 @@ type MockRepositoryClient struct {
 	MockUntag                 func(*ecr.UntagResourceInput) ecr.UntagResourceRequest
 	MockPutImageScan          func(*ecr.PutImageScanningConfigurationInput) ecr.PutImageScanningConfigurationRequest
 	MockPutImageTagMutability func(*ecr.PutImageTagMutabilityInput) ecr.PutImageTagMutabilityRequest
+	MockGetLifecyclePolicy    func(*ecr.GetLifecyclePolicyInput) ecr.GetLifecyclePolicyRequest
+	MockPutLifecyclePolicy    func(*ecr.PutLifecyclePolicyInput) ecr.PutLifecyclePolicyRequest
 }
 
 // CreateRepositoryRequest mocks CreateRepositoryRequest method
@@ -76,3 +78,13 @@ func (m *MockRepositoryClient) PutImageTagMutabilityRequest(input *ecr.PutImageT
 func (m *MockRepositoryClient) PutImageScanningConfigurationRequest(input *ecr.PutImageScanningConfigurationInput) ecr.PutImageScanningConfigurationRequest {
 	return m.MockPutImageScan(input)
 }
+
+// GetLifecyclePolicyRequest mocks GetLifecyclePolicyRequest method
+func (m *MockRepositoryClient) GetLifecyclePolicyRequest(input *ecr.GetLifecyclePolicyInput) ecr.GetLifecyclePolicyRequest {
+	return m.MockGetLifecyclePolicy(input)
+}
+
+// PutLifecyclePolicyRequest mocks PutLifecyclePolicyRequest method
+func (m *MockRepositoryClient) PutLifecyclePolicyRequest(input *ecr.PutLifecyclePolicyInput) ecr.PutLifecyclePolicyRequest {
+	return m.MockPutLifecyclePolicy(input)
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
 	"github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 )
@@ -23,6 +25,8 @@ const (
 	RepositoryNotEmptyException = "RepositoryNotEmptyException"
 	// RepositoryNotFoundException ECR was not found
 	RepositoryNotFoundException = "RepositoryNotFoundException"
+	// LifecyclePolicyNotFoundException the lifecycle policy was not found
+	LifecyclePolicyNotFoundException = "LifecyclePolicyNotFoundException"
 )
 
 // RepositoryClient is the external client used for ECR Custom Resource
@@ -35,6 +39,8 @@ type RepositoryClient interface {
 	PutImageTagMutabilityRequest(*ecr.PutImageTagMutabilityInput) ecr.PutImageTagMutabilityRequest
 	PutImageScanningConfigurationRequest(*ecr.PutImageScanningConfigurationInput) ecr.PutImageScanningConfigurationRequest
 	UntagResourceRequest(*ecr.UntagResourceInput) ecr.UntagResourceRequest
+	GetLifecyclePolicyRequest(*ecr.GetLifecyclePolicyInput) ecr.GetLifecyclePolicyRequest
+	PutLifecyclePolicyRequest(*ecr.PutLifecyclePolicyInput) ecr.PutLifecyclePolicyRequest
 }
 
 // GenerateRepositoryObservation is used to produce v1alpha1.RepositoryObservation from
@@ -112,6 +118,39 @@ func IsRepoNotFoundErr(err error) bool {
 	return false
 }
 
+// IsLifecyclePolicyNotFoundErr returns true if the error is because the
+// repository has no lifecycle policy
+func IsLifecyclePolicyNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == LifecyclePolicyNotFoundException {
+			return true
+		}
+	}
+	return false
+}
+
+// IsLifecyclePolicyUpToDate checks whether the observed lifecycle policy
+// matches the desired one. An omitted desired policy is always up to date.
+func IsLifecyclePolicyUpToDate(desired, observed *string) bool {
+	if desired == nil {
+		return true
+	}
+	if observed == nil {
+		return false
+	}
+	return IsRepositoryPolicyUpToDate(desired, observed)
+}
+
+// GetConnectionDetails returns the connection details of the repository.
+func GetConnectionDetails(in v1alpha1.Repository) managed.ConnectionDetails {
+	if in.Status.AtProvider.RepositoryURI == "" {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		v1alpha1.ResourceCredentialsSecretRepositoryURIKey: []byte(in.Status.AtProvider.RepositoryURI),
+	}
+}
+
 // GenerateCreateRepositoryInput Generates the CreateRepositoryInput from the RepositoryParameters
 func GenerateCreateRepositoryInput(name string, params *v1alpha1.RepositoryParameters) *ecr.CreateRepositoryInput {
 	c := &ecr.CreateRepositoryInput{
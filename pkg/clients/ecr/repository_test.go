@@ -308,3 +308,42 @@ func TestDiffTags(t *testing.T) {
 		})
 	}
 }
+
+func TestIsLifecyclePolicyUpToDate(t *testing.T) {
+	policy := `{"rules":[{"rulePriority":1,"action":{"type":"expire"}}]}`
+	reformatted := `{ "rules": [ { "action": { "type": "expire" }, "rulePriority": 1 } ] }`
+
+	cases := map[string]struct {
+		desired  *string
+		observed *string
+		want     bool
+	}{
+		"NotManaged": {
+			observed: &policy,
+			want:     true,
+		},
+		"Missing": {
+			desired: &policy,
+			want:    false,
+		},
+		"SamePolicy": {
+			desired:  &reformatted,
+			observed: &policy,
+			want:     true,
+		},
+		"DifferentPolicy": {
+			desired:  aws.String(`{"rules":[]}`),
+			observed: &policy,
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsLifecyclePolicyUpToDate(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package ecs
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+
+	"github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+)
+
+// ClusterClient defines ECS Cluster client operations
+type ClusterClient interface {
+	CreateClusterRequest(input *ecs.CreateClusterInput) ecs.CreateClusterRequest
+	DescribeClustersRequest(input *ecs.DescribeClustersInput) ecs.DescribeClustersRequest
+	UpdateClusterSettingsRequest(input *ecs.UpdateClusterSettingsInput) ecs.UpdateClusterSettingsRequest
+	DeleteClusterRequest(input *ecs.DeleteClusterInput) ecs.DeleteClusterRequest
+}
+
+// NewClusterClient creates new ECS Client with provided AWS
+// Configurations/Credentials
+func NewClusterClient(cfg aws.Config) ClusterClient {
+	return ecs.New(cfg)
+}
+
+// IsClusterNotFound returns true if the error is because the cluster doesn't
+// exist.
+func IsClusterNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == ecs.ErrCodeClusterNotFoundException
+	}
+	return false
+}
+
+// GenerateCreateClusterInput returns the input to create the cluster with
+// the given name.
+func GenerateCreateClusterInput(name string, p v1alpha1.ECSClusterParameters) *ecs.CreateClusterInput {
+	return &ecs.CreateClusterInput{
+		ClusterName: aws.String(name),
+		Settings:    GenerateClusterSettings(p),
+		Tags:        generateTags(p.Tags),
+	}
+}
+
+// GenerateClusterSettings returns the cluster settings set in the given
+// parameters.
+func GenerateClusterSettings(p v1alpha1.ECSClusterParameters) []ecs.ClusterSetting {
+	if p.ContainerInsights == nil {
+		return nil
+	}
+	return []ecs.ClusterSetting{{
+		Name:  ecs.ClusterSettingNameContainerInsights,
+		Value: p.ContainerInsights,
+	}}
+}
+
+// LateInitializeCluster fills the empty fields in
+// *v1alpha1.ECSClusterParameters with the values seen in ecs.Cluster.
+func LateInitializeCluster(in *v1alpha1.ECSClusterParameters, c *ecs.Cluster) {
+	if c == nil {
+		return
+	}
+	if in.ContainerInsights == nil {
+		in.ContainerInsights = containerInsights(*c)
+	}
+}
+
+// GenerateClusterObservation is used to produce
+// v1alpha1.ECSClusterObservation from ecs.Cluster.
+func GenerateClusterObservation(c ecs.Cluster) v1alpha1.ECSClusterObservation {
+	return v1alpha1.ECSClusterObservation{
+		ClusterARN:                        aws.StringValue(c.ClusterArn),
+		Status:                            aws.StringValue(c.Status),
+		ActiveServicesCount:               aws.Int64Value(c.ActiveServicesCount),
+		RunningTasksCount:                 aws.Int64Value(c.RunningTasksCount),
+		PendingTasksCount:                 aws.Int64Value(c.PendingTasksCount),
+		RegisteredContainerInstancesCount: aws.Int64Value(c.RegisteredContainerInstancesCount),
+	}
+}
+
+// IsClusterUpToDate checks whether the observed cluster matches the desired
+// parameters. Tags are only set at creation and not compared.
+func IsClusterUpToDate(p v1alpha1.ECSClusterParameters, c ecs.Cluster) bool {
+	if p.ContainerInsights == nil {
+		return true
+	}
+	return aws.StringValue(p.ContainerInsights) == aws.StringValue(containerInsights(c))
+}
+
+func containerInsights(c ecs.Cluster) *string {
+	for _, s := range c.Settings {
+		if s.Name == ecs.ClusterSettingNameContainerInsights {
+			return s.Value
+		}
+	}
+	return nil
+}
+
+func generateTags(tags []v1alpha1.Tag) []ecs.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]ecs.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = ecs.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	}
+	return res
+}
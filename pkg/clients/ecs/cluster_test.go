@@ -0,0 +1,67 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+)
+
+func TestIsClusterUpToDate(t *testing.T) {
+	enabled := ecs.Cluster{Settings: []ecs.ClusterSetting{{
+		Name:  ecs.ClusterSettingNameContainerInsights,
+		Value: aws.String("enabled"),
+	}}}
+
+	cases := map[string]struct {
+		p    v1alpha1.ECSClusterParameters
+		c    ecs.Cluster
+		want bool
+	}{
+		"NothingDesired": {
+			c:    enabled,
+			want: true,
+		},
+		"SameSetting": {
+			p:    v1alpha1.ECSClusterParameters{ContainerInsights: aws.String("enabled")},
+			c:    enabled,
+			want: true,
+		},
+		"SettingChanged": {
+			p:    v1alpha1.ECSClusterParameters{ContainerInsights: aws.String("disabled")},
+			c:    enabled,
+			want: false,
+		},
+		"SettingMissing": {
+			p:    v1alpha1.ECSClusterParameters{ContainerInsights: aws.String("enabled")},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsClusterUpToDate(tc.p, tc.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ecs"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ClusterClient = (*MockClusterClient)(nil)
+
+// MockClusterClient is a type that implements all the methods for the
+// Cluster Client interface
+type MockClusterClient struct {
+	MockCreate         func(*ecs.CreateClusterInput) ecs.CreateClusterRequest
+	MockDescribe       func(*ecs.DescribeClustersInput) ecs.DescribeClustersRequest
+	MockUpdateSettings func(*ecs.UpdateClusterSettingsInput) ecs.UpdateClusterSettingsRequest
+	MockDelete         func(*ecs.DeleteClusterInput) ecs.DeleteClusterRequest
+}
+
+// CreateClusterRequest mocks CreateClusterRequest method
+func (m *MockClusterClient) CreateClusterRequest(input *ecs.CreateClusterInput) ecs.CreateClusterRequest {
+	return m.MockCreate(input)
+}
+
+// DescribeClustersRequest mocks DescribeClustersRequest method
+func (m *MockClusterClient) DescribeClustersRequest(input *ecs.DescribeClustersInput) ecs.DescribeClustersRequest {
+	return m.MockDescribe(input)
+}
+
+// UpdateClusterSettingsRequest mocks UpdateClusterSettingsRequest method
+func (m *MockClusterClient) UpdateClusterSettingsRequest(input *ecs.UpdateClusterSettingsInput) ecs.UpdateClusterSettingsRequest {
+	return m.MockUpdateSettings(input)
+}
+
+// DeleteClusterRequest mocks DeleteClusterRequest method
+func (m *MockClusterClient) DeleteClusterRequest(input *ecs.DeleteClusterInput) ecs.DeleteClusterRequest {
+	return m.MockDelete(input)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ecs"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ServiceClient = (*MockServiceClient)(nil)
+
+// MockServiceClient is a type that implements all the methods for the
+// Service Client interface
+type MockServiceClient struct {
+	MockCreate   func(*ecs.CreateServiceInput) ecs.CreateServiceRequest
+	MockDescribe func(*ecs.DescribeServicesInput) ecs.DescribeServicesRequest
+	MockUpdate   func(*ecs.UpdateServiceInput) ecs.UpdateServiceRequest
+	MockDelete   func(*ecs.DeleteServiceInput) ecs.DeleteServiceRequest
+}
+
+// CreateServiceRequest mocks CreateServiceRequest method
+func (m *MockServiceClient) CreateServiceRequest(input *ecs.CreateServiceInput) ecs.CreateServiceRequest {
+	return m.MockCreate(input)
+}
+
+// DescribeServicesRequest mocks DescribeServicesRequest method
+func (m *MockServiceClient) DescribeServicesRequest(input *ecs.DescribeServicesInput) ecs.DescribeServicesRequest {
+	return m.MockDescribe(input)
+}
+
+// UpdateServiceRequest mocks UpdateServiceRequest method
+func (m *MockServiceClient) UpdateServiceRequest(input *ecs.UpdateServiceInput) ecs.UpdateServiceRequest {
+	return m.MockUpdate(input)
+}
+
+// DeleteServiceRequest mocks DeleteServiceRequest method
+func (m *MockServiceClient) DeleteServiceRequest(input *ecs.DeleteServiceInput) ecs.DeleteServiceRequest {
+	return m.MockDelete(input)
+}
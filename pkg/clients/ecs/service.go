@@ -0,0 +1,243 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package ecs
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+)
+
+// ServiceClient defines ECS Service client operations
+type ServiceClient interface {
+	CreateServiceRequest(input *ecs.CreateServiceInput) ecs.CreateServiceRequest
+	DescribeServicesRequest(input *ecs.DescribeServicesInput) ecs.DescribeServicesRequest
+	UpdateServiceRequest(input *ecs.UpdateServiceInput) ecs.UpdateServiceRequest
+	DeleteServiceRequest(input *ecs.DeleteServiceInput) ecs.DeleteServiceRequest
+}
+
+// NewServiceClient creates new ECS Client with provided AWS
+// Configurations/Credentials
+func NewServiceClient(cfg aws.Config) ServiceClient {
+	return ecs.New(cfg)
+}
+
+// IsServiceNotFound returns true if the error is because the service or the
+// cluster it runs on doesn't exist.
+func IsServiceNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == ecs.ErrCodeServiceNotFoundException ||
+			awsErr.Code() == ecs.ErrCodeClusterNotFoundException
+	}
+	return false
+}
+
+// GenerateCreateServiceInput returns the input to create the service with the
+// given name.
+func GenerateCreateServiceInput(name string, p v1alpha1.ServiceParameters) *ecs.CreateServiceInput {
+	in := &ecs.CreateServiceInput{
+		ServiceName:                   aws.String(name),
+		Cluster:                       p.Cluster,
+		TaskDefinition:                aws.String(p.TaskDefinition),
+		DesiredCount:                  p.DesiredCount,
+		LaunchType:                    ecs.LaunchType(aws.StringValue(p.LaunchType)),
+		PlatformVersion:               p.PlatformVersion,
+		DeploymentConfiguration:       generateDeploymentConfiguration(p.DeploymentConfiguration),
+		HealthCheckGracePeriodSeconds: p.HealthCheckGracePeriodSeconds,
+		NetworkConfiguration:          generateNetworkConfiguration(p.NetworkConfiguration),
+		Role:                          p.Role,
+		Tags:                          generateTags(p.Tags),
+	}
+	for _, lb := range p.LoadBalancers {
+		in.LoadBalancers = append(in.LoadBalancers, ecs.LoadBalancer{
+			TargetGroupArn: aws.String(lb.TargetGroupARN),
+			ContainerName:  aws.String(lb.ContainerName),
+			ContainerPort:  aws.Int64(lb.ContainerPort),
+		})
+	}
+	return in
+}
+
+// GenerateUpdateServiceInput returns the input to update the mutable fields
+// of the service with the given name.
+func GenerateUpdateServiceInput(name string, p v1alpha1.ServiceParameters) *ecs.UpdateServiceInput {
+	return &ecs.UpdateServiceInput{
+		Service:                       aws.String(name),
+		Cluster:                       p.Cluster,
+		TaskDefinition:                aws.String(p.TaskDefinition),
+		DesiredCount:                  p.DesiredCount,
+		PlatformVersion:               p.PlatformVersion,
+		DeploymentConfiguration:       generateDeploymentConfiguration(p.DeploymentConfiguration),
+		HealthCheckGracePeriodSeconds: p.HealthCheckGracePeriodSeconds,
+		NetworkConfiguration:          generateNetworkConfiguration(p.NetworkConfiguration),
+	}
+}
+
+func generateDeploymentConfiguration(dc *v1alpha1.DeploymentConfiguration) *ecs.DeploymentConfiguration {
+	if dc == nil {
+		return nil
+	}
+	return &ecs.DeploymentConfiguration{
+		MaximumPercent:        dc.MaximumPercent,
+		MinimumHealthyPercent: dc.MinimumHealthyPercent,
+	}
+}
+
+func generateNetworkConfiguration(nc *v1alpha1.NetworkConfiguration) *ecs.NetworkConfiguration {
+	if nc == nil {
+		return nil
+	}
+	return &ecs.NetworkConfiguration{
+		AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+			Subnets:        nc.Subnets,
+			SecurityGroups: nc.SecurityGroups,
+			AssignPublicIp: ecs.AssignPublicIp(aws.StringValue(nc.AssignPublicIP)),
+		},
+	}
+}
+
+// LateInitializeService fills the empty fields in
+// *v1alpha1.ServiceParameters with the values seen in ecs.Service.
+func LateInitializeService(in *v1alpha1.ServiceParameters, svc *ecs.Service) {
+	if svc == nil {
+		return
+	}
+	if in.DesiredCount == nil {
+		in.DesiredCount = svc.DesiredCount
+	}
+	if in.LaunchType == nil && svc.LaunchType != "" {
+		in.LaunchType = aws.String(string(svc.LaunchType))
+	}
+	if in.DeploymentConfiguration == nil && svc.DeploymentConfiguration != nil {
+		in.DeploymentConfiguration = &v1alpha1.DeploymentConfiguration{
+			MaximumPercent:        svc.DeploymentConfiguration.MaximumPercent,
+			MinimumHealthyPercent: svc.DeploymentConfiguration.MinimumHealthyPercent,
+		}
+	}
+}
+
+// GenerateServiceObservation is used to produce v1alpha1.ServiceObservation
+// from ecs.Service.
+func GenerateServiceObservation(svc ecs.Service) v1alpha1.ServiceObservation {
+	o := v1alpha1.ServiceObservation{
+		ServiceARN:   aws.StringValue(svc.ServiceArn),
+		ClusterARN:   aws.StringValue(svc.ClusterArn),
+		Status:       aws.StringValue(svc.Status),
+		RunningCount: aws.Int64Value(svc.RunningCount),
+		PendingCount: aws.Int64Value(svc.PendingCount),
+	}
+	for _, d := range svc.Deployments {
+		o.Deployments = append(o.Deployments, v1alpha1.ServiceDeployment{
+			ID:             aws.StringValue(d.Id),
+			Status:         aws.StringValue(d.Status),
+			TaskDefinition: aws.StringValue(d.TaskDefinition),
+			DesiredCount:   aws.Int64Value(d.DesiredCount),
+			RunningCount:   aws.Int64Value(d.RunningCount),
+		})
+	}
+	if svc.CreatedAt != nil {
+		t := metav1.NewTime(*svc.CreatedAt)
+		o.CreatedAt = &t
+	}
+	return o
+}
+
+// IsSteadyState returns true if the service is active, has finished all of
+// its deployments and runs as many tasks as desired. This is the same
+// condition the ECS services-stable waiter uses.
+func IsSteadyState(svc ecs.Service) bool {
+	return aws.StringValue(svc.Status) == v1alpha1.ServiceStatusActive &&
+		len(svc.Deployments) == 1 &&
+		aws.Int64Value(svc.RunningCount) == aws.Int64Value(svc.DesiredCount)
+}
+
+// IsTaskDefinitionUpToDate returns true if the task definition the service
+// runs, which is always a full ARN, matches the desired one. The desired task
+// definition may also be given as family:revision, or as a bare family in
+// which case any revision of that family is accepted.
+func IsTaskDefinitionUpToDate(desired, observed string) bool {
+	if desired == observed {
+		return true
+	}
+	// arn:aws:ecs:region:account:task-definition/family:revision
+	observed = observed[strings.LastIndex(observed, "/")+1:]
+	if desired == observed {
+		return true
+	}
+	if !strings.Contains(desired, ":") {
+		return strings.SplitN(observed, ":", 2)[0] == desired
+	}
+	return false
+}
+
+// IsServiceUpToDate checks whether the observed service matches the desired
+// parameters. Fields that are left empty in the parameters are not compared.
+func IsServiceUpToDate(p v1alpha1.ServiceParameters, svc ecs.Service) bool { // nolint:gocyclo
+	if p.DesiredCount != nil && aws.Int64Value(p.DesiredCount) != aws.Int64Value(svc.DesiredCount) {
+		return false
+	}
+	if !IsTaskDefinitionUpToDate(p.TaskDefinition, aws.StringValue(svc.TaskDefinition)) {
+		return false
+	}
+	if p.PlatformVersion != nil && aws.StringValue(p.PlatformVersion) != aws.StringValue(svc.PlatformVersion) {
+		return false
+	}
+	if p.HealthCheckGracePeriodSeconds != nil && aws.Int64Value(p.HealthCheckGracePeriodSeconds) != aws.Int64Value(svc.HealthCheckGracePeriodSeconds) {
+		return false
+	}
+	if dc := p.DeploymentConfiguration; dc != nil {
+		obs := svc.DeploymentConfiguration
+		if obs == nil {
+			obs = &ecs.DeploymentConfiguration{}
+		}
+		if dc.MaximumPercent != nil && aws.Int64Value(dc.MaximumPercent) != aws.Int64Value(obs.MaximumPercent) {
+			return false
+		}
+		if dc.MinimumHealthyPercent != nil && aws.Int64Value(dc.MinimumHealthyPercent) != aws.Int64Value(obs.MinimumHealthyPercent) {
+			return false
+		}
+	}
+	if p.NetworkConfiguration != nil {
+		return isNetworkConfigurationUpToDate(*p.NetworkConfiguration, svc.NetworkConfiguration)
+	}
+	return true
+}
+
+func isNetworkConfigurationUpToDate(nc v1alpha1.NetworkConfiguration, obs *ecs.NetworkConfiguration) bool {
+	vpc := &ecs.AwsVpcConfiguration{}
+	if obs != nil && obs.AwsvpcConfiguration != nil {
+		vpc = obs.AwsvpcConfiguration
+	}
+	publicIP := aws.StringValue(nc.AssignPublicIP)
+	if publicIP == "" {
+		publicIP = string(ecs.AssignPublicIpDisabled)
+	}
+	observedPublicIP := string(vpc.AssignPublicIp)
+	if observedPublicIP == "" {
+		observedPublicIP = string(ecs.AssignPublicIpDisabled)
+	}
+	sortStrings := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+	return publicIP == observedPublicIP &&
+		cmp.Equal(nc.Subnets, vpc.Subnets, sortStrings, cmpopts.EquateEmpty()) &&
+		cmp.Equal(nc.SecurityGroups, vpc.SecurityGroups, sortStrings, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+)
+
+const taskDefinitionARN = "arn:aws:ecs:us-east-1:123456789012:task-definition/web:3"
+
+func TestIsTaskDefinitionUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		desired string
+		want    bool
+	}{
+		"SameARN":           {desired: taskDefinitionARN, want: true},
+		"SameRevision":      {desired: "web:3", want: true},
+		"OtherRevision":     {desired: "web:4", want: false},
+		"FamilyOnly":        {desired: "web", want: true},
+		"OtherFamily":       {desired: "api", want: false},
+		"OtherFamilyPrefix": {desired: "we", want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsTaskDefinitionUpToDate(tc.desired, taskDefinitionARN)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsSteadyState(t *testing.T) {
+	cases := map[string]struct {
+		svc  ecs.Service
+		want bool
+	}{
+		"Steady": {
+			svc: ecs.Service{
+				Status:       aws.String(v1alpha1.ServiceStatusActive),
+				DesiredCount: aws.Int64(2),
+				RunningCount: aws.Int64(2),
+				Deployments:  []ecs.Deployment{{Status: aws.String("PRIMARY")}},
+			},
+			want: true,
+		},
+		"RollingOut": {
+			svc: ecs.Service{
+				Status:       aws.String(v1alpha1.ServiceStatusActive),
+				DesiredCount: aws.Int64(2),
+				RunningCount: aws.Int64(2),
+				Deployments:  []ecs.Deployment{{Status: aws.String("PRIMARY")}, {Status: aws.String("ACTIVE")}},
+			},
+			want: false,
+		},
+		"Scaling": {
+			svc: ecs.Service{
+				Status:       aws.String(v1alpha1.ServiceStatusActive),
+				DesiredCount: aws.Int64(3),
+				RunningCount: aws.Int64(2),
+				Deployments:  []ecs.Deployment{{Status: aws.String("PRIMARY")}},
+			},
+			want: false,
+		},
+		"Draining": {
+			svc: ecs.Service{
+				Status:      aws.String(v1alpha1.ServiceStatusDraining),
+				Deployments: []ecs.Deployment{{Status: aws.String("PRIMARY")}},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsSteadyState(tc.svc)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsServiceUpToDate(t *testing.T) {
+	svc := ecs.Service{
+		TaskDefinition: aws.String(taskDefinitionARN),
+		DesiredCount:   aws.Int64(2),
+		DeploymentConfiguration: &ecs.DeploymentConfiguration{
+			MaximumPercent:        aws.Int64(200),
+			MinimumHealthyPercent: aws.Int64(100),
+		},
+		NetworkConfiguration: &ecs.NetworkConfiguration{AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+			Subnets:        []string{"subnet-b", "subnet-a"},
+			SecurityGroups: []string{"sg-a"},
+			AssignPublicIp: ecs.AssignPublicIpDisabled,
+		}},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.ServiceParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.ServiceParameters{
+				TaskDefinition:          "web:3",
+				DesiredCount:            aws.Int64(2),
+				DeploymentConfiguration: &v1alpha1.DeploymentConfiguration{MinimumHealthyPercent: aws.Int64(100)},
+				NetworkConfiguration: &v1alpha1.NetworkConfiguration{
+					Subnets:        []string{"subnet-a", "subnet-b"},
+					SecurityGroups: []string{"sg-a"},
+				},
+			},
+			want: true,
+		},
+		"DesiredCountChanged": {
+			p:    v1alpha1.ServiceParameters{TaskDefinition: "web:3", DesiredCount: aws.Int64(3)},
+			want: false,
+		},
+		"TaskDefinitionChanged": {
+			p:    v1alpha1.ServiceParameters{TaskDefinition: "web:4"},
+			want: false,
+		},
+		"DeploymentConfigurationChanged": {
+			p: v1alpha1.ServiceParameters{
+				TaskDefinition:          "web:3",
+				DeploymentConfiguration: &v1alpha1.DeploymentConfiguration{MaximumPercent: aws.Int64(150)},
+			},
+			want: false,
+		},
+		"SubnetsChanged": {
+			p: v1alpha1.ServiceParameters{
+				TaskDefinition: "web:3",
+				NetworkConfiguration: &v1alpha1.NetworkConfiguration{
+					Subnets:        []string{"subnet-a"},
+					SecurityGroups: []string{"sg-a"},
+				},
+			},
+			want: false,
+		},
+		"PublicIPChanged": {
+			p: v1alpha1.ServiceParameters{
+				TaskDefinition: "web:3",
+				NetworkConfiguration: &v1alpha1.NetworkConfiguration{
+					Subnets:        []string{"subnet-a", "subnet-b"},
+					SecurityGroups: []string{"sg-a"},
+					AssignPublicIP: aws.String("ENABLED"),
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsServiceUpToDate(tc.p, svc)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeService(t *testing.T) {
+	svc := &ecs.Service{
+		DesiredCount: aws.Int64(2),
+		LaunchType:   ecs.LaunchTypeFargate,
+		DeploymentConfiguration: &ecs.DeploymentConfiguration{
+			MaximumPercent:        aws.Int64(200),
+			MinimumHealthyPercent: aws.Int64(100),
+		},
+	}
+	cases := map[string]struct {
+		in   v1alpha1.ServiceParameters
+		want v1alpha1.ServiceParameters
+	}{
+		"AllEmpty": {
+			want: v1alpha1.ServiceParameters{
+				DesiredCount: aws.Int64(2),
+				LaunchType:   aws.String("FARGATE"),
+				DeploymentConfiguration: &v1alpha1.DeploymentConfiguration{
+					MaximumPercent:        aws.Int64(200),
+					MinimumHealthyPercent: aws.Int64(100),
+				},
+			},
+		},
+		"DesiredCountSet": {
+			in: v1alpha1.ServiceParameters{
+				DesiredCount:            aws.Int64(0),
+				DeploymentConfiguration: &v1alpha1.DeploymentConfiguration{},
+			},
+			want: v1alpha1.ServiceParameters{
+				DesiredCount:            aws.Int64(0),
+				LaunchType:              aws.String("FARGATE"),
+				DeploymentConfiguration: &v1alpha1.DeploymentConfiguration{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeService(&tc.in, svc)
+			if diff := cmp.Diff(tc.want, tc.in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+)
+
+// MockClient for testing.
+type MockClient struct {
+	MockCreate                 func(*efs.CreateMountTargetInput) efs.CreateMountTargetRequest
+	MockDescribe               func(*efs.DescribeMountTargetsInput) efs.DescribeMountTargetsRequest
+	MockDescribeSecurityGroups func(*efs.DescribeMountTargetSecurityGroupsInput) efs.DescribeMountTargetSecurityGroupsRequest
+	MockModifySecurityGroups   func(*efs.ModifyMountTargetSecurityGroupsInput) efs.ModifyMountTargetSecurityGroupsRequest
+	MockDelete                 func(*efs.DeleteMountTargetInput) efs.DeleteMountTargetRequest
+}
+
+// CreateMountTargetRequest calls the underlying MockCreate method.
+func (m *MockClient) CreateMountTargetRequest(i *efs.CreateMountTargetInput) efs.CreateMountTargetRequest {
+	return m.MockCreate(i)
+}
+
+// DescribeMountTargetsRequest calls the underlying MockDescribe method.
+func (m *MockClient) DescribeMountTargetsRequest(i *efs.DescribeMountTargetsInput) efs.DescribeMountTargetsRequest {
+	return m.MockDescribe(i)
+}
+
+// DescribeMountTargetSecurityGroupsRequest calls the underlying
+// MockDescribeSecurityGroups method.
+func (m *MockClient) DescribeMountTargetSecurityGroupsRequest(i *efs.DescribeMountTargetSecurityGroupsInput) efs.DescribeMountTargetSecurityGroupsRequest {
+	return m.MockDescribeSecurityGroups(i)
+}
+
+// ModifyMountTargetSecurityGroupsRequest calls the underlying
+// MockModifySecurityGroups method.
+func (m *MockClient) ModifyMountTargetSecurityGroupsRequest(i *efs.ModifyMountTargetSecurityGroupsInput) efs.ModifyMountTargetSecurityGroupsRequest {
+	return m.MockModifySecurityGroups(i)
+}
+
+// DeleteMountTargetRequest calls the underlying MockDelete method.
+func (m *MockClient) DeleteMountTargetRequest(i *efs.DeleteMountTargetInput) efs.DeleteMountTargetRequest {
+	return m.MockDelete(i)
+}
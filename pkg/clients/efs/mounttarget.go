@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package efs
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+
+	"github.com/crossplane/provider-aws/apis/efs/v1alpha1"
+)
+
+// Client defines EFS client operations for mount targets.
+type Client interface {
+	CreateMountTargetRequest(input *efs.CreateMountTargetInput) efs.CreateMountTargetRequest
+	DescribeMountTargetsRequest(input *efs.DescribeMountTargetsInput) efs.DescribeMountTargetsRequest
+	DescribeMountTargetSecurityGroupsRequest(input *efs.DescribeMountTargetSecurityGroupsInput) efs.DescribeMountTargetSecurityGroupsRequest
+	ModifyMountTargetSecurityGroupsRequest(input *efs.ModifyMountTargetSecurityGroupsInput) efs.ModifyMountTargetSecurityGroupsRequest
+	DeleteMountTargetRequest(input *efs.DeleteMountTargetInput) efs.DeleteMountTargetRequest
+}
+
+// NewClient creates new EFS Client with provided AWS Configurations/Credentials
+func NewClient(cfg aws.Config) Client {
+	return efs.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the mount target doesn't
+// exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == efs.ErrCodeMountTargetNotFound
+	}
+	return false
+}
+
+// GetMountTarget returns the mount target with the given ID along with its
+// security groups.
+func GetMountTarget(ctx context.Context, c Client, id string) (*efs.MountTargetDescription, []string, error) {
+	rsp, err := c.DescribeMountTargetsRequest(&efs.DescribeMountTargetsInput{MountTargetId: aws.String(id)}).Send(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(rsp.MountTargets) == 0 {
+		return nil, nil, awserr.New(efs.ErrCodeMountTargetNotFound, "", nil)
+	}
+	mt := rsp.MountTargets[0]
+	// Security groups of a deleted mount target cannot be described.
+	if mt.LifeCycleState == efs.LifeCycleStateDeleted {
+		return &mt, nil, nil
+	}
+	sg, err := c.DescribeMountTargetSecurityGroupsRequest(&efs.DescribeMountTargetSecurityGroupsInput{MountTargetId: aws.String(id)}).Send(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &mt, sg.SecurityGroups, nil
+}
+
+// GenerateCreateMountTargetInput returns the input for creating a mount
+// target from the given parameters.
+func GenerateCreateMountTargetInput(p v1alpha1.MountTargetParameters) *efs.CreateMountTargetInput {
+	return &efs.CreateMountTargetInput{
+		FileSystemId:   p.FileSystemID,
+		SubnetId:       p.SubnetID,
+		IpAddress:      p.IPAddress,
+		SecurityGroups: p.SecurityGroups,
+	}
+}
+
+// GenerateObservation is used to produce v1alpha1.MountTargetObservation from
+// efs.MountTargetDescription.
+func GenerateObservation(mt efs.MountTargetDescription) v1alpha1.MountTargetObservation {
+	return v1alpha1.MountTargetObservation{
+		MountTargetID:        aws.StringValue(mt.MountTargetId),
+		LifeCycleState:       string(mt.LifeCycleState),
+		IPAddress:            aws.StringValue(mt.IpAddress),
+		AvailabilityZoneName: aws.StringValue(mt.AvailabilityZoneName),
+		NetworkInterfaceID:   aws.StringValue(mt.NetworkInterfaceId),
+	}
+}
+
+// LateInitialize fills the empty fields in *v1alpha1.MountTargetParameters
+// with the values seen in efs.MountTargetDescription.
+func LateInitialize(p *v1alpha1.MountTargetParameters, mt efs.MountTargetDescription, securityGroups []string) {
+	if p.IPAddress == nil {
+		p.IPAddress = mt.IpAddress
+	}
+	if len(p.SecurityGroups) == 0 && len(securityGroups) != 0 {
+		p.SecurityGroups = securityGroups
+	}
+}
+
+// IsUpToDate checks whether the mount target has the desired security
+// groups. The order of the groups does not matter.
+func IsUpToDate(p v1alpha1.MountTargetParameters, securityGroups []string) bool {
+	if len(p.SecurityGroups) != len(securityGroups) {
+		return false
+	}
+	desired := append([]string{}, p.SecurityGroups...)
+	current := append([]string{}, securityGroups...)
+	sort.Strings(desired)
+	sort.Strings(current)
+	for i := range desired {
+		if desired[i] != current[i] {
+			return false
+		}
+	}
+	return true
+}
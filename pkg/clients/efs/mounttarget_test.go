@@ -0,0 +1,179 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package efs
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/efs/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/efs/fake"
+)
+
+var (
+	mountTargetID = "fsmt-12345678"
+	ipAddress     = "10.0.0.10"
+	sg1           = "sg-1"
+	sg2           = "sg-2"
+
+	errBoom = errors.New("boom")
+)
+
+func TestGetMountTarget(t *testing.T) {
+	type args struct {
+		mt  []efs.MountTargetDescription
+		err error
+	}
+	type want struct {
+		mt  *efs.MountTargetDescription
+		sg  []string
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				mt: []efs.MountTargetDescription{{MountTargetId: aws.String(mountTargetID), LifeCycleState: efs.LifeCycleStateAvailable}},
+			},
+			want: want{
+				mt: &efs.MountTargetDescription{MountTargetId: aws.String(mountTargetID), LifeCycleState: efs.LifeCycleStateAvailable},
+				sg: []string{sg1},
+			},
+		},
+		"Deleted": {
+			args: args{
+				mt: []efs.MountTargetDescription{{MountTargetId: aws.String(mountTargetID), LifeCycleState: efs.LifeCycleStateDeleted}},
+			},
+			want: want{
+				mt: &efs.MountTargetDescription{MountTargetId: aws.String(mountTargetID), LifeCycleState: efs.LifeCycleStateDeleted},
+			},
+		},
+		"Empty": {
+			want: want{
+				err: awserr.New(efs.ErrCodeMountTargetNotFound, "", nil),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				err: errBoom,
+			},
+			want: want{
+				err: errBoom,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &fake.MockClient{
+				MockDescribe: func(_ *efs.DescribeMountTargetsInput) efs.DescribeMountTargetsRequest {
+					return efs.DescribeMountTargetsRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &efs.DescribeMountTargetsOutput{MountTargets: tc.args.mt}, Error: tc.args.err},
+					}
+				},
+				MockDescribeSecurityGroups: func(_ *efs.DescribeMountTargetSecurityGroupsInput) efs.DescribeMountTargetSecurityGroupsRequest {
+					return efs.DescribeMountTargetSecurityGroupsRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &efs.DescribeMountTargetSecurityGroupsOutput{SecurityGroups: []string{sg1}}},
+					}
+				},
+			}
+			mt, sg, err := GetMountTarget(context.Background(), c, mountTargetID)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mt, mt); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.sg, sg); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	type args struct {
+		p  *v1alpha1.MountTargetParameters
+		mt efs.MountTargetDescription
+		sg []string
+	}
+
+	cases := map[string]struct {
+		args
+		want *v1alpha1.MountTargetParameters
+	}{
+		"AllFilled": {
+			args: args{
+				p:  &v1alpha1.MountTargetParameters{IPAddress: aws.String("10.0.0.20"), SecurityGroups: []string{sg2}},
+				mt: efs.MountTargetDescription{IpAddress: aws.String(ipAddress)},
+				sg: []string{sg1},
+			},
+			want: &v1alpha1.MountTargetParameters{IPAddress: aws.String("10.0.0.20"), SecurityGroups: []string{sg2}},
+		},
+		"AllEmpty": {
+			args: args{
+				p:  &v1alpha1.MountTargetParameters{},
+				mt: efs.MountTargetDescription{IpAddress: aws.String(ipAddress)},
+				sg: []string{sg1},
+			},
+			want: &v1alpha1.MountTargetParameters{IPAddress: aws.String(ipAddress), SecurityGroups: []string{sg1}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitialize(tc.args.p, tc.args.mt, tc.args.sg)
+			if diff := cmp.Diff(tc.want, tc.args.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		desired []string
+		current []string
+		want    bool
+	}{
+		"Same":            {desired: []string{sg1, sg2}, current: []string{sg1, sg2}, want: true},
+		"DifferentOrder":  {desired: []string{sg2, sg1}, current: []string{sg1, sg2}, want: true},
+		"DifferentLength": {desired: []string{sg1}, current: []string{sg1, sg2}, want: false},
+		"DifferentGroups": {desired: []string{sg1, "sg-3"}, current: []string{sg1, sg2}, want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(v1alpha1.MountTargetParameters{SecurityGroups: tc.desired}, tc.current)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -40,6 +40,10 @@ import (
 
 const errCheckUpToDate = "unable to determine if external resource is up to date"
 
+// ReaderEndpointKey is the connection secret key under which the reader
+// endpoint of a "cluster disabled" Replication Group is published.
+const ReaderEndpointKey = "readerEndpoint"
+
 // A Client handles CRUD operations for ElastiCache resources. This interface is
 // compatible with the upstream AWS redis client.
 type Client elasticacheiface.ClientAPI
@@ -313,9 +317,11 @@ func GenerateObservation(rg elasticache.ReplicationGroup) v1beta1.ReplicationGro
 
 func generateNodeGroup(ng elasticache.NodeGroup) v1beta1.NodeGroup {
 	r := v1beta1.NodeGroup{
-		NodeGroupID: clients.StringValue(ng.NodeGroupId),
-		Slots:       clients.StringValue(ng.Slots),
-		Status:      clients.StringValue(ng.Status),
+		NodeGroupID:     clients.StringValue(ng.NodeGroupId),
+		PrimaryEndpoint: newEndpoint(ng.PrimaryEndpoint),
+		ReaderEndpoint:  newEndpoint(ng.ReaderEndpoint),
+		Slots:           clients.StringValue(ng.Slots),
+		Status:          clients.StringValue(ng.Status),
 	}
 	if len(ng.NodeGroupMembers) != 0 {
 		r.NodeGroupMembers = make([]v1beta1.NodeGroupMember, len(ng.NodeGroupMembers))
@@ -375,16 +381,20 @@ func ConnectionEndpoint(rg elasticache.ReplicationGroup) managed.ConnectionDetai
 	}
 
 	// "Cluster disabled" Replication Groups have a single node group, with a
-	// primary endpoint that should be used for write. Any node's endpoint can
-	// be used for read, but we support only a single endpoint so we return the
-	// primary's.
+	// primary endpoint that should be used for write and a reader endpoint
+	// that balances reads across the replicas. The reader endpoint is only
+	// published when AWS reports one.
 	if len(rg.NodeGroups) > 0 &&
 		rg.NodeGroups[0].PrimaryEndpoint != nil &&
 		rg.NodeGroups[0].PrimaryEndpoint.Address != nil {
-		return managed.ConnectionDetails{
+		cd := managed.ConnectionDetails{
 			xpv1.ResourceCredentialsSecretEndpointKey: []byte(aws.StringValue(rg.NodeGroups[0].PrimaryEndpoint.Address)),
 			xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(int(aws.Int64Value(rg.NodeGroups[0].PrimaryEndpoint.Port)))),
 		}
+		if rg.NodeGroups[0].ReaderEndpoint != nil && rg.NodeGroups[0].ReaderEndpoint.Address != nil {
+			cd[ReaderEndpointKey] = []byte(aws.StringValue(rg.NodeGroups[0].ReaderEndpoint.Address))
+		}
+		return cd
 	}
 
 	// If the AWS API docs are to be believed we should never get here.
@@ -403,6 +413,12 @@ func IsSubnetGroupNotFound(err error) bool {
 	return isErrorCodeEqual(elasticache.ErrCodeCacheSubnetGroupNotFoundFault, err)
 }
 
+// IsParameterGroupNotFound returns true if the supplied error indicates a Cache
+// Parameter Group was not found.
+func IsParameterGroupNotFound(err error) bool {
+	return isErrorCodeEqual(elasticache.ErrCodeCacheParameterGroupNotFoundFault, err)
+}
+
 // IsAlreadyExists returns true if the supplied error indicates a Replication Group
 // already exists.
 func IsAlreadyExists(err error) bool {
@@ -443,6 +459,54 @@ func IsSubnetGroupUpToDate(p cachev1alpha1.CacheSubnetGroupParameters, sg elasti
 	return true
 }
 
+// ParameterSourceUser is the source of cache parameters whose values were
+// changed from the engine defaults.
+const ParameterSourceUser = "user"
+
+// GenerateParameterGroupObservation is used to produce
+// v1alpha1.CacheParameterGroupObservation from elasticache.CacheParameterGroup.
+func GenerateParameterGroupObservation(pg elasticache.CacheParameterGroup) cachev1alpha1.CacheParameterGroupObservation {
+	return cachev1alpha1.CacheParameterGroupObservation{
+		ARN:      aws.StringValue(pg.ARN),
+		IsGlobal: aws.BoolValue(pg.IsGlobal),
+	}
+}
+
+// GenerateParameterChanges returns the parameters that have to be modified and
+// the ones that have to be reset to their defaults so that the user-defined
+// parameters of a group match the desired ones. The observed parameters are
+// expected to be the ones whose source is user.
+func GenerateParameterChanges(desired []cachev1alpha1.CacheParameter, observed []elasticache.Parameter) (modify, reset []elasticache.ParameterNameValue) {
+	current := make(map[string]string, len(observed))
+	for _, p := range observed {
+		current[aws.StringValue(p.ParameterName)] = aws.StringValue(p.ParameterValue)
+	}
+	want := make(map[string]bool, len(desired))
+	for _, p := range desired {
+		want[p.Name] = true
+		if v, ok := current[p.Name]; ok && v == p.Value {
+			continue
+		}
+		modify = append(modify, elasticache.ParameterNameValue{
+			ParameterName:  aws.String(p.Name),
+			ParameterValue: aws.String(p.Value),
+		})
+	}
+	for _, p := range observed {
+		if !want[aws.StringValue(p.ParameterName)] {
+			reset = append(reset, elasticache.ParameterNameValue{ParameterName: p.ParameterName})
+		}
+	}
+	return modify, reset
+}
+
+// IsParameterGroupUpToDate checks whether the user-defined parameters of a
+// group match the desired ones.
+func IsParameterGroupUpToDate(p cachev1alpha1.CacheParameterGroupParameters, observed []elasticache.Parameter) bool {
+	modify, reset := GenerateParameterChanges(p.Parameters, observed)
+	return len(modify) == 0 && len(reset) == 0
+}
+
 // GenerateCreateCacheClusterInput returns Cache Cluster creation input
 func GenerateCreateCacheClusterInput(p cachev1alpha1.CacheClusterParameters, id string) *elasticache.CreateCacheClusterInput {
 	c := &elasticache.CreateCacheClusterInput{
@@ -874,6 +874,26 @@ func TestConnectionEndpoint(t *testing.T) {
 				xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(port)),
 			},
 		},
+		{
+			name: "ClusterModeDisabledWithReaderEndpoint",
+			rg: elasticache.ReplicationGroup{
+				NodeGroups: []elasticache.NodeGroup{{
+					PrimaryEndpoint: &elasticache.Endpoint{
+						Address: aws.String(host),
+						Port:    aws.Int64(port),
+					},
+					ReaderEndpoint: &elasticache.Endpoint{
+						Address: aws.String("ro." + host),
+						Port:    aws.Int64(port),
+					}},
+				},
+			},
+			want: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey: []byte(host),
+				xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(port)),
+				ReaderEndpointKey:                         []byte("ro." + host),
+			},
+		},
 		{
 			name: "ClusterModeDisabledMissingPrimaryEndpoint",
 			rg:   elasticache.ReplicationGroup{NodeGroups: []elasticache.NodeGroup{{}}},
@@ -1023,3 +1043,54 @@ func TestVersionMatches(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateParameterChanges(t *testing.T) {
+	param := func(name, value string) elasticache.Parameter {
+		return elasticache.Parameter{ParameterName: aws.String(name), ParameterValue: aws.String(value)}
+	}
+	type want struct {
+		modify []elasticache.ParameterNameValue
+		reset  []elasticache.ParameterNameValue
+	}
+	cases := map[string]struct {
+		desired  []cachev1alpha1.CacheParameter
+		observed []elasticache.Parameter
+		want     want
+	}{
+		"NoChanges": {
+			desired:  []cachev1alpha1.CacheParameter{{Name: "timeout", Value: "300"}},
+			observed: []elasticache.Parameter{param("timeout", "300")},
+		},
+		"Added": {
+			desired: []cachev1alpha1.CacheParameter{{Name: "timeout", Value: "300"}},
+			want: want{
+				modify: []elasticache.ParameterNameValue{{ParameterName: aws.String("timeout"), ParameterValue: aws.String("300")}},
+			},
+		},
+		"Changed": {
+			desired:  []cachev1alpha1.CacheParameter{{Name: "timeout", Value: "300"}},
+			observed: []elasticache.Parameter{param("timeout", "0")},
+			want: want{
+				modify: []elasticache.ParameterNameValue{{ParameterName: aws.String("timeout"), ParameterValue: aws.String("300")}},
+			},
+		},
+		"Removed": {
+			observed: []elasticache.Parameter{param("timeout", "300")},
+			want: want{
+				reset: []elasticache.ParameterNameValue{{ParameterName: aws.String("timeout")}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			modify, reset := GenerateParameterChanges(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.modify, modify); diff != "" {
+				t.Errorf("modify: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.reset, reset); diff != "" {
+				t.Errorf("reset: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -37,6 +37,13 @@ type MockClient struct {
 	MockModifyCacheSubnetGroupRequest    func(*elasticache.ModifyCacheSubnetGroupInput) elasticache.ModifyCacheSubnetGroupRequest
 	MockDeleteCacheSubnetGroupRequest    func(*elasticache.DeleteCacheSubnetGroupInput) elasticache.DeleteCacheSubnetGroupRequest
 
+	MockDescribeCacheParameterGroupsRequest func(*elasticache.DescribeCacheParameterGroupsInput) elasticache.DescribeCacheParameterGroupsRequest
+	MockDescribeCacheParametersRequest      func(*elasticache.DescribeCacheParametersInput) elasticache.DescribeCacheParametersRequest
+	MockCreateCacheParameterGroupRequest    func(*elasticache.CreateCacheParameterGroupInput) elasticache.CreateCacheParameterGroupRequest
+	MockModifyCacheParameterGroupRequest    func(*elasticache.ModifyCacheParameterGroupInput) elasticache.ModifyCacheParameterGroupRequest
+	MockResetCacheParameterGroupRequest     func(*elasticache.ResetCacheParameterGroupInput) elasticache.ResetCacheParameterGroupRequest
+	MockDeleteCacheParameterGroupRequest    func(*elasticache.DeleteCacheParameterGroupInput) elasticache.DeleteCacheParameterGroupRequest
+
 	MockDescribeCacheClustersRequest func(*elasticache.DescribeCacheClustersInput) elasticache.DescribeCacheClustersRequest
 	MockCreateCacheClusterRequest    func(*elasticache.CreateCacheClusterInput) elasticache.CreateCacheClusterRequest
 	MockDeleteCacheClusterRequest    func(*elasticache.DeleteCacheClusterInput) elasticache.DeleteCacheClusterRequest
@@ -114,3 +121,39 @@ func (c *MockClient) DeleteCacheClusterRequest(i *elasticache.DeleteCacheCluster
 func (c *MockClient) ModifyCacheClusterRequest(i *elasticache.ModifyCacheClusterInput) elasticache.ModifyCacheClusterRequest {
 	return c.MockModifyCacheClusterRequest(i)
 }
+
+// DescribeCacheParameterGroupsRequest calls the underlying
+// MockDescribeCacheParameterGroupsRequest method.
+func (c *MockClient) DescribeCacheParameterGroupsRequest(i *elasticache.DescribeCacheParameterGroupsInput) elasticache.DescribeCacheParameterGroupsRequest {
+	return c.MockDescribeCacheParameterGroupsRequest(i)
+}
+
+// DescribeCacheParametersRequest calls the underlying
+// MockDescribeCacheParametersRequest method.
+func (c *MockClient) DescribeCacheParametersRequest(i *elasticache.DescribeCacheParametersInput) elasticache.DescribeCacheParametersRequest {
+	return c.MockDescribeCacheParametersRequest(i)
+}
+
+// CreateCacheParameterGroupRequest calls the underlying
+// MockCreateCacheParameterGroupRequest method.
+func (c *MockClient) CreateCacheParameterGroupRequest(i *elasticache.CreateCacheParameterGroupInput) elasticache.CreateCacheParameterGroupRequest {
+	return c.MockCreateCacheParameterGroupRequest(i)
+}
+
+// ModifyCacheParameterGroupRequest calls the underlying
+// MockModifyCacheParameterGroupRequest method.
+func (c *MockClient) ModifyCacheParameterGroupRequest(i *elasticache.ModifyCacheParameterGroupInput) elasticache.ModifyCacheParameterGroupRequest {
+	return c.MockModifyCacheParameterGroupRequest(i)
+}
+
+// ResetCacheParameterGroupRequest calls the underlying
+// MockResetCacheParameterGroupRequest method.
+func (c *MockClient) ResetCacheParameterGroupRequest(i *elasticache.ResetCacheParameterGroupInput) elasticache.ResetCacheParameterGroupRequest {
+	return c.MockResetCacheParameterGroupRequest(i)
+}
+
+// DeleteCacheParameterGroupRequest calls the underlying
+// MockDeleteCacheParameterGroupRequest method.
+func (c *MockClient) DeleteCacheParameterGroupRequest(i *elasticache.DeleteCacheParameterGroupInput) elasticache.DeleteCacheParameterGroupRequest {
+	return c.MockDeleteCacheParameterGroupRequest(i)
+}
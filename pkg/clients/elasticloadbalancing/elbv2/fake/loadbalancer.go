@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/elasticloadbalancingv2iface"
+)
+
+var _ elasticloadbalancingv2iface.ClientAPI = &MockClient{}
+
+// MockClient is a fake implementation of elasticloadbalancingv2iface.ClientAPI.
+type MockClient struct {
+	elasticloadbalancingv2iface.ClientAPI
+
+	MockDescribeLoadBalancersRequest func(*elbv2.DescribeLoadBalancersInput) elbv2.DescribeLoadBalancersRequest
+	MockCreateLoadBalancerRequest    func(*elbv2.CreateLoadBalancerInput) elbv2.CreateLoadBalancerRequest
+	MockDeleteLoadBalancerRequest    func(*elbv2.DeleteLoadBalancerInput) elbv2.DeleteLoadBalancerRequest
+	MockSetSubnetsRequest            func(*elbv2.SetSubnetsInput) elbv2.SetSubnetsRequest
+	MockSetSecurityGroupsRequest     func(*elbv2.SetSecurityGroupsInput) elbv2.SetSecurityGroupsRequest
+	MockSetIpAddressTypeRequest      func(*elbv2.SetIpAddressTypeInput) elbv2.SetIpAddressTypeRequest
+	MockDescribeListenersRequest     func(*elbv2.DescribeListenersInput) elbv2.DescribeListenersRequest
+	MockCreateListenerRequest        func(*elbv2.CreateListenerInput) elbv2.CreateListenerRequest
+	MockModifyListenerRequest        func(*elbv2.ModifyListenerInput) elbv2.ModifyListenerRequest
+	MockDeleteListenerRequest        func(*elbv2.DeleteListenerInput) elbv2.DeleteListenerRequest
+	MockDescribeTagsRequest          func(*elbv2.DescribeTagsInput) elbv2.DescribeTagsRequest
+	MockAddTagsRequest               func(*elbv2.AddTagsInput) elbv2.AddTagsRequest
+	MockRemoveTagsRequest            func(*elbv2.RemoveTagsInput) elbv2.RemoveTagsRequest
+}
+
+// DescribeLoadBalancersRequest calls the underlying
+// MockDescribeLoadBalancersRequest method.
+func (c *MockClient) DescribeLoadBalancersRequest(i *elbv2.DescribeLoadBalancersInput) elbv2.DescribeLoadBalancersRequest {
+	return c.MockDescribeLoadBalancersRequest(i)
+}
+
+// CreateLoadBalancerRequest calls the underlying
+// MockCreateLoadBalancerRequest method.
+func (c *MockClient) CreateLoadBalancerRequest(i *elbv2.CreateLoadBalancerInput) elbv2.CreateLoadBalancerRequest {
+	return c.MockCreateLoadBalancerRequest(i)
+}
+
+// DeleteLoadBalancerRequest calls the underlying
+// MockDeleteLoadBalancerRequest method.
+func (c *MockClient) DeleteLoadBalancerRequest(i *elbv2.DeleteLoadBalancerInput) elbv2.DeleteLoadBalancerRequest {
+	return c.MockDeleteLoadBalancerRequest(i)
+}
+
+// SetSubnetsRequest calls the underlying MockSetSubnetsRequest method.
+func (c *MockClient) SetSubnetsRequest(i *elbv2.SetSubnetsInput) elbv2.SetSubnetsRequest {
+	return c.MockSetSubnetsRequest(i)
+}
+
+// SetSecurityGroupsRequest calls the underlying MockSetSecurityGroupsRequest
+// method.
+func (c *MockClient) SetSecurityGroupsRequest(i *elbv2.SetSecurityGroupsInput) elbv2.SetSecurityGroupsRequest {
+	return c.MockSetSecurityGroupsRequest(i)
+}
+
+// SetIpAddressTypeRequest calls the underlying MockSetIpAddressTypeRequest
+// method.
+func (c *MockClient) SetIpAddressTypeRequest(i *elbv2.SetIpAddressTypeInput) elbv2.SetIpAddressTypeRequest {
+	return c.MockSetIpAddressTypeRequest(i)
+}
+
+// DescribeListenersRequest calls the underlying MockDescribeListenersRequest
+// method.
+func (c *MockClient) DescribeListenersRequest(i *elbv2.DescribeListenersInput) elbv2.DescribeListenersRequest {
+	return c.MockDescribeListenersRequest(i)
+}
+
+// CreateListenerRequest calls the underlying MockCreateListenerRequest method.
+func (c *MockClient) CreateListenerRequest(i *elbv2.CreateListenerInput) elbv2.CreateListenerRequest {
+	return c.MockCreateListenerRequest(i)
+}
+
+// ModifyListenerRequest calls the underlying MockModifyListenerRequest method.
+func (c *MockClient) ModifyListenerRequest(i *elbv2.ModifyListenerInput) elbv2.ModifyListenerRequest {
+	return c.MockModifyListenerRequest(i)
+}
+
+// DeleteListenerRequest calls the underlying MockDeleteListenerRequest method.
+func (c *MockClient) DeleteListenerRequest(i *elbv2.DeleteListenerInput) elbv2.DeleteListenerRequest {
+	return c.MockDeleteListenerRequest(i)
+}
+
+// DescribeTagsRequest calls the underlying MockDescribeTagsRequest method.
+func (c *MockClient) DescribeTagsRequest(i *elbv2.DescribeTagsInput) elbv2.DescribeTagsRequest {
+	return c.MockDescribeTagsRequest(i)
+}
+
+// AddTagsRequest calls the underlying MockAddTagsRequest method.
+func (c *MockClient) AddTagsRequest(i *elbv2.AddTagsInput) elbv2.AddTagsRequest {
+	return c.MockAddTagsRequest(i)
+}
+
+// RemoveTagsRequest calls the underlying MockRemoveTagsRequest method.
+func (c *MockClient) RemoveTagsRequest(i *elbv2.RemoveTagsInput) elbv2.RemoveTagsRequest {
+	return c.MockRemoveTagsRequest(i)
+}
@@ -0,0 +1,333 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elbv2
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/elasticloadbalancingv2iface"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/elasticloadbalancing/v1alpha1"
+	clients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// A Client handles CRUD operations for Application Load Balancers.
+type Client elasticloadbalancingv2iface.ClientAPI
+
+// NewClient returns a new Elastic Load Balancing v2 client. Credentials must
+// be passed as JSON encoded data.
+func NewClient(cfg aws.Config) Client {
+	return elbv2.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the load balancer doesn't
+// exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := errors.Cause(err).(awserr.Error); ok && awsErr.Code() == elbv2.ErrCodeLoadBalancerNotFoundException {
+		return true
+	}
+	return false
+}
+
+// GenerateCreateLoadBalancerInput returns the input to create an Application
+// Load Balancer with the given name and parameters.
+func GenerateCreateLoadBalancerInput(name string, p v1alpha1.LoadBalancerParameters) *elbv2.CreateLoadBalancerInput {
+	input := &elbv2.CreateLoadBalancerInput{
+		Name:           aws.String(name),
+		Type:           elbv2.LoadBalancerTypeEnumApplication,
+		Subnets:        p.SubnetIDs,
+		SecurityGroups: p.SecurityGroupIDs,
+		Tags:           GenerateTags(p.Tags),
+	}
+	if p.Scheme != nil {
+		input.Scheme = elbv2.LoadBalancerSchemeEnum(*p.Scheme)
+	}
+	if p.IPAddressType != nil {
+		input.IpAddressType = elbv2.IpAddressType(*p.IPAddressType)
+	}
+	return input
+}
+
+// GenerateAction returns the elbv2.Action of the given v1alpha1.ListenerAction.
+func GenerateAction(a v1alpha1.ListenerAction) elbv2.Action {
+	out := elbv2.Action{
+		Type:           elbv2.ActionTypeEnum(a.Type),
+		TargetGroupArn: a.TargetGroupARN,
+	}
+	if a.FixedResponse != nil {
+		out.FixedResponseConfig = &elbv2.FixedResponseActionConfig{
+			StatusCode:  aws.String(a.FixedResponse.StatusCode),
+			ContentType: a.FixedResponse.ContentType,
+			MessageBody: a.FixedResponse.MessageBody,
+		}
+	}
+	if a.Redirect != nil {
+		out.RedirectConfig = &elbv2.RedirectActionConfig{
+			StatusCode: elbv2.RedirectActionStatusCodeEnum(a.Redirect.StatusCode),
+			Protocol:   a.Redirect.Protocol,
+			Port:       a.Redirect.Port,
+			Host:       a.Redirect.Host,
+			Path:       a.Redirect.Path,
+			Query:      a.Redirect.Query,
+		}
+	}
+	return out
+}
+
+func generateCertificates(l v1alpha1.LoadBalancerListener) []elbv2.Certificate {
+	if l.CertificateARN == nil {
+		return nil
+	}
+	return []elbv2.Certificate{{CertificateArn: l.CertificateARN}}
+}
+
+// GenerateCreateListenerInput returns the input to create the given listener
+// on the load balancer with the given ARN.
+func GenerateCreateListenerInput(lbARN string, l v1alpha1.LoadBalancerListener) *elbv2.CreateListenerInput {
+	return &elbv2.CreateListenerInput{
+		LoadBalancerArn: aws.String(lbARN),
+		Port:            aws.Int64(l.Port),
+		Protocol:        elbv2.ProtocolEnum(l.Protocol),
+		Certificates:    generateCertificates(l),
+		SslPolicy:       l.SSLPolicy,
+		DefaultActions:  []elbv2.Action{GenerateAction(l.DefaultAction)},
+	}
+}
+
+// GenerateModifyListenerInput returns the input to make the listener with the
+// given ARN match the given listener.
+func GenerateModifyListenerInput(listenerARN string, l v1alpha1.LoadBalancerListener) *elbv2.ModifyListenerInput {
+	return &elbv2.ModifyListenerInput{
+		ListenerArn:    aws.String(listenerARN),
+		Port:           aws.Int64(l.Port),
+		Protocol:       elbv2.ProtocolEnum(l.Protocol),
+		Certificates:   generateCertificates(l),
+		SslPolicy:      l.SSLPolicy,
+		DefaultActions: []elbv2.Action{GenerateAction(l.DefaultAction)},
+	}
+}
+
+// GenerateTags returns the elbv2.Tags of the given v1alpha1.Tags.
+func GenerateTags(tags []v1alpha1.Tag) []elbv2.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	out := make([]elbv2.Tag, len(tags))
+	for i, t := range tags {
+		out[i] = elbv2.Tag{Key: aws.String(t.Key), Value: t.Value}
+	}
+	return out
+}
+
+// GenerateObservation is used to produce v1alpha1.LoadBalancerObservation
+// from elbv2.LoadBalancer.
+func GenerateObservation(lb elbv2.LoadBalancer) v1alpha1.LoadBalancerObservation {
+	o := v1alpha1.LoadBalancerObservation{
+		LoadBalancerARN:       aws.StringValue(lb.LoadBalancerArn),
+		DNSName:               aws.StringValue(lb.DNSName),
+		CanonicalHostedZoneID: aws.StringValue(lb.CanonicalHostedZoneId),
+		VPCID:                 aws.StringValue(lb.VpcId),
+	}
+	if lb.State != nil {
+		o.State = string(lb.State.Code)
+	}
+	return o
+}
+
+func subnetIDs(lb elbv2.LoadBalancer) []string {
+	out := make([]string, 0, len(lb.AvailabilityZones))
+	for _, az := range lb.AvailabilityZones {
+		if az.SubnetId != nil {
+			out = append(out, *az.SubnetId)
+		}
+	}
+	return out
+}
+
+// LateInitialize fills the empty fields in *v1alpha1.LoadBalancerParameters
+// with the values seen in elbv2.LoadBalancer.
+func LateInitialize(in *v1alpha1.LoadBalancerParameters, lb *elbv2.LoadBalancer) {
+	if lb == nil {
+		return
+	}
+	in.Scheme = clients.LateInitializeStringPtr(in.Scheme, clients.String(string(lb.Scheme)))
+	in.IPAddressType = clients.LateInitializeStringPtr(in.IPAddressType, clients.String(string(lb.IpAddressType)))
+	if len(in.SecurityGroupIDs) == 0 && len(lb.SecurityGroups) != 0 {
+		in.SecurityGroupIDs = lb.SecurityGroups
+	}
+	if len(in.SubnetIDs) == 0 && len(lb.AvailabilityZones) != 0 {
+		in.SubnetIDs = subnetIDs(*lb)
+	}
+}
+
+// IsSubnetsUpToDate returns true if the load balancer is attached to exactly
+// the desired subnets.
+func IsSubnetsUpToDate(p v1alpha1.LoadBalancerParameters, lb elbv2.LoadBalancer) bool {
+	return sameStrings(p.SubnetIDs, subnetIDs(lb))
+}
+
+// IsSecurityGroupsUpToDate returns true if the load balancer has exactly the
+// desired security groups.
+func IsSecurityGroupsUpToDate(p v1alpha1.LoadBalancerParameters, lb elbv2.LoadBalancer) bool {
+	return sameStrings(p.SecurityGroupIDs, lb.SecurityGroups)
+}
+
+// IsIPAddressTypeUpToDate returns true if the load balancer uses the desired
+// IP address type.
+func IsIPAddressTypeUpToDate(p v1alpha1.LoadBalancerParameters, lb elbv2.LoadBalancer) bool {
+	return p.IPAddressType == nil || *p.IPAddressType == string(lb.IpAddressType)
+}
+
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	ac := append([]string{}, a...)
+	bc := append([]string{}, b...)
+	sort.Strings(ac)
+	sort.Strings(bc)
+	for i := range ac {
+		if ac[i] != bc[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// IsListenerUpToDate returns true if the observed listener matches the
+// desired one.
+func IsListenerUpToDate(l v1alpha1.LoadBalancerListener, o elbv2.Listener) bool { // nolint:gocyclo
+	if aws.Int64Value(o.Port) != l.Port || string(o.Protocol) != l.Protocol {
+		return false
+	}
+	if l.SSLPolicy != nil && aws.StringValue(l.SSLPolicy) != aws.StringValue(o.SslPolicy) {
+		return false
+	}
+	cert := ""
+	if len(o.Certificates) != 0 {
+		cert = aws.StringValue(o.Certificates[0].CertificateArn)
+	}
+	if aws.StringValue(l.CertificateARN) != cert {
+		return false
+	}
+	if len(o.DefaultActions) != 1 {
+		return false
+	}
+	return isActionUpToDate(GenerateAction(l.DefaultAction), o.DefaultActions[0])
+}
+
+func isActionUpToDate(want, got elbv2.Action) bool { // nolint:gocyclo
+	if want.Type != got.Type {
+		return false
+	}
+	switch want.Type { // nolint:exhaustive
+	case elbv2.ActionTypeEnumForward:
+		return aws.StringValue(want.TargetGroupArn) == aws.StringValue(got.TargetGroupArn)
+	case elbv2.ActionTypeEnumFixedResponse:
+		w, g := want.FixedResponseConfig, got.FixedResponseConfig
+		if w == nil || g == nil {
+			return w == g
+		}
+		return aws.StringValue(w.StatusCode) == aws.StringValue(g.StatusCode) &&
+			aws.StringValue(w.ContentType) == aws.StringValue(g.ContentType) &&
+			aws.StringValue(w.MessageBody) == aws.StringValue(g.MessageBody)
+	case elbv2.ActionTypeEnumRedirect:
+		w, g := want.RedirectConfig, got.RedirectConfig
+		if w == nil || g == nil {
+			return w == g
+		}
+		// AWS fills in the fields that are not set with placeholders that
+		// keep the original value, e.g. #{host}, so only set fields count.
+		return w.StatusCode == g.StatusCode &&
+			(w.Protocol == nil || aws.StringValue(w.Protocol) == aws.StringValue(g.Protocol)) &&
+			(w.Port == nil || aws.StringValue(w.Port) == aws.StringValue(g.Port)) &&
+			(w.Host == nil || aws.StringValue(w.Host) == aws.StringValue(g.Host)) &&
+			(w.Path == nil || aws.StringValue(w.Path) == aws.StringValue(g.Path)) &&
+			(w.Query == nil || aws.StringValue(w.Query) == aws.StringValue(g.Query))
+	}
+	return true
+}
+
+// DiffListeners returns the listeners that have to be created, the listeners
+// that have to be modified keyed by their ARN and the ARNs of the listeners
+// that have to be deleted. Listeners are matched by port.
+func DiffListeners(desired []v1alpha1.LoadBalancerListener, observed []elbv2.Listener) (create []v1alpha1.LoadBalancerListener, modify map[string]v1alpha1.LoadBalancerListener, remove []string) {
+	byPort := make(map[int64]elbv2.Listener, len(observed))
+	for _, o := range observed {
+		byPort[aws.Int64Value(o.Port)] = o
+	}
+	modify = map[string]v1alpha1.LoadBalancerListener{}
+	for _, l := range desired {
+		o, ok := byPort[l.Port]
+		if !ok {
+			create = append(create, l)
+			continue
+		}
+		delete(byPort, l.Port)
+		if !IsListenerUpToDate(l, o) {
+			modify[aws.StringValue(o.ListenerArn)] = l
+		}
+	}
+	for _, o := range byPort {
+		remove = append(remove, aws.StringValue(o.ListenerArn))
+	}
+	sort.Strings(remove)
+	return create, modify, remove
+}
+
+// DiffTags returns the tags that have to be added and the keys of the tags
+// that have to be removed.
+func DiffTags(local []v1alpha1.Tag, remote []elbv2.Tag) (add []elbv2.Tag, remove []string) {
+	l := make(map[string]string, len(local))
+	for _, t := range local {
+		l[t.Key] = aws.StringValue(t.Value)
+	}
+	r := make(map[string]string, len(remote))
+	for _, t := range remote {
+		r[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	for k, v := range l {
+		if rv, ok := r[k]; !ok || rv != v {
+			add = append(add, elbv2.Tag{Key: aws.String(k), Value: aws.String(v)})
+		}
+	}
+	for k := range r {
+		if _, ok := l[k]; !ok {
+			remove = append(remove, k)
+		}
+	}
+	sort.Slice(add, func(i, j int) bool { return aws.StringValue(add[i].Key) < aws.StringValue(add[j].Key) })
+	sort.Strings(remove)
+	return add, remove
+}
+
+// IsUpToDate checks whether there is a change in any of the modifiable fields.
+func IsUpToDate(p v1alpha1.LoadBalancerParameters, lb elbv2.LoadBalancer, listeners []elbv2.Listener, tags []elbv2.Tag) bool {
+	if !IsSubnetsUpToDate(p, lb) || !IsSecurityGroupsUpToDate(p, lb) || !IsIPAddressTypeUpToDate(p, lb) {
+		return false
+	}
+	create, modify, remove := DiffListeners(p.Listeners, listeners)
+	if len(create) != 0 || len(modify) != 0 || len(remove) != 0 {
+		return false
+	}
+	add, removeTags := DiffTags(p.Tags, tags)
+	return len(add) == 0 && len(removeTags) == 0
+}
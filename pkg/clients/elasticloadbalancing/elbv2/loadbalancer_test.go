@@ -0,0 +1,237 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elbv2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/elasticloadbalancing/v1alpha1"
+)
+
+var (
+	redirectListener = v1alpha1.LoadBalancerListener{
+		Port:     80,
+		Protocol: "HTTP",
+		DefaultAction: v1alpha1.ListenerAction{
+			Type:     "redirect",
+			Redirect: &v1alpha1.RedirectAction{StatusCode: "HTTP_301", Protocol: aws.String("HTTPS"), Port: aws.String("443")},
+		},
+	}
+	awsRedirectListener = elbv2.Listener{
+		ListenerArn: aws.String("arn:80"),
+		Port:        aws.Int64(80),
+		Protocol:    elbv2.ProtocolEnumHttp,
+		DefaultActions: []elbv2.Action{{
+			Type: elbv2.ActionTypeEnumRedirect,
+			RedirectConfig: &elbv2.RedirectActionConfig{
+				StatusCode: elbv2.RedirectActionStatusCodeEnumHttp301,
+				Protocol:   aws.String("HTTPS"),
+				Port:       aws.String("443"),
+				Host:       aws.String("#{host}"),
+				Path:       aws.String("/#{path}"),
+				Query:      aws.String("#{query}"),
+			},
+		}},
+	}
+	forwardListener = v1alpha1.LoadBalancerListener{
+		Port:           443,
+		Protocol:       "HTTPS",
+		CertificateARN: aws.String("arn:cert"),
+		DefaultAction:  v1alpha1.ListenerAction{Type: "forward", TargetGroupARN: aws.String("arn:tg")},
+	}
+	awsForwardListener = elbv2.Listener{
+		ListenerArn:  aws.String("arn:443"),
+		Port:         aws.Int64(443),
+		Protocol:     elbv2.ProtocolEnumHttps,
+		SslPolicy:    aws.String("ELBSecurityPolicy-2016-08"),
+		Certificates: []elbv2.Certificate{{CertificateArn: aws.String("arn:cert")}},
+		DefaultActions: []elbv2.Action{{
+			Type:           elbv2.ActionTypeEnumForward,
+			TargetGroupArn: aws.String("arn:tg"),
+			ForwardConfig:  &elbv2.ForwardActionConfig{TargetGroups: []elbv2.TargetGroupTuple{{TargetGroupArn: aws.String("arn:tg")}}},
+		}},
+	}
+)
+
+func TestIsListenerUpToDate(t *testing.T) {
+	otherCert := forwardListener
+	otherCert.CertificateARN = aws.String("arn:other")
+
+	cases := map[string]struct {
+		l    v1alpha1.LoadBalancerListener
+		o    elbv2.Listener
+		want bool
+	}{
+		"RedirectWithDefaults": {
+			l:    redirectListener,
+			o:    awsRedirectListener,
+			want: true,
+		},
+		"ForwardWithDefaultSSLPolicy": {
+			l:    forwardListener,
+			o:    awsForwardListener,
+			want: true,
+		},
+		"DifferentCertificate": {
+			l:    otherCert,
+			o:    awsForwardListener,
+			want: false,
+		},
+		"DifferentAction": {
+			l:    redirectListener,
+			o:    elbv2.Listener{Port: aws.Int64(80), Protocol: elbv2.ProtocolEnumHttp, DefaultActions: awsForwardListener.DefaultActions},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsListenerUpToDate(tc.l, tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffListeners(t *testing.T) {
+	changed := forwardListener
+	changed.DefaultAction.TargetGroupARN = aws.String("arn:tg2")
+	extra := v1alpha1.LoadBalancerListener{Port: 8080, Protocol: "HTTP", DefaultAction: v1alpha1.ListenerAction{Type: "forward", TargetGroupARN: aws.String("arn:tg")}}
+
+	type want struct {
+		create []v1alpha1.LoadBalancerListener
+		modify map[string]v1alpha1.LoadBalancerListener
+		remove []string
+	}
+	cases := map[string]struct {
+		desired  []v1alpha1.LoadBalancerListener
+		observed []elbv2.Listener
+		want     want
+	}{
+		"UpToDate": {
+			desired:  []v1alpha1.LoadBalancerListener{redirectListener, forwardListener},
+			observed: []elbv2.Listener{awsRedirectListener, awsForwardListener},
+			want:     want{modify: map[string]v1alpha1.LoadBalancerListener{}},
+		},
+		"CreateModifyRemove": {
+			desired:  []v1alpha1.LoadBalancerListener{changed, extra},
+			observed: []elbv2.Listener{awsRedirectListener, awsForwardListener},
+			want: want{
+				create: []v1alpha1.LoadBalancerListener{extra},
+				modify: map[string]v1alpha1.LoadBalancerListener{"arn:443": changed},
+				remove: []string{"arn:80"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			create, modify, remove := DiffListeners(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want, want{create: create, modify: modify, remove: remove}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	type want struct {
+		add    []elbv2.Tag
+		remove []string
+	}
+	cases := map[string]struct {
+		local  []v1alpha1.Tag
+		remote []elbv2.Tag
+		want   want
+	}{
+		"Same": {
+			local:  []v1alpha1.Tag{{Key: "k", Value: aws.String("v")}},
+			remote: []elbv2.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+		},
+		"AddUpdateRemove": {
+			local: []v1alpha1.Tag{{Key: "k", Value: aws.String("new")}, {Key: "a", Value: aws.String("b")}},
+			remote: []elbv2.Tag{
+				{Key: aws.String("k"), Value: aws.String("v")},
+				{Key: aws.String("old"), Value: aws.String("v")},
+			},
+			want: want{
+				add:    []elbv2.Tag{{Key: aws.String("a"), Value: aws.String("b")}, {Key: aws.String("k"), Value: aws.String("new")}},
+				remove: []string{"old"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffTags(tc.local, tc.remote)
+			if diff := cmp.Diff(tc.want, want{add: add, remove: remove}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.LoadBalancerParameters
+		lb   elbv2.LoadBalancer
+		want v1alpha1.LoadBalancerParameters
+	}{
+		"AllFields": {
+			lb: elbv2.LoadBalancer{
+				Scheme:            elbv2.LoadBalancerSchemeEnumInternal,
+				IpAddressType:     elbv2.IpAddressTypeIpv4,
+				SecurityGroups:    []string{"sg-1"},
+				AvailabilityZones: []elbv2.AvailabilityZone{{SubnetId: aws.String("subnet-1")}},
+			},
+			want: v1alpha1.LoadBalancerParameters{
+				Scheme:           aws.String("internal"),
+				IPAddressType:    aws.String("ipv4"),
+				SecurityGroupIDs: []string{"sg-1"},
+				SubnetIDs:        []string{"subnet-1"},
+			},
+		},
+		"DontOverwrite": {
+			in: v1alpha1.LoadBalancerParameters{
+				Scheme:    aws.String("internet-facing"),
+				SubnetIDs: []string{"subnet-2"},
+			},
+			lb: elbv2.LoadBalancer{
+				Scheme:            elbv2.LoadBalancerSchemeEnumInternal,
+				AvailabilityZones: []elbv2.AvailabilityZone{{SubnetId: aws.String("subnet-1")}},
+			},
+			want: v1alpha1.LoadBalancerParameters{
+				Scheme:    aws.String("internet-facing"),
+				SubnetIDs: []string{"subnet-2"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitialize(&tc.in, &tc.lb)
+			if diff := cmp.Diff(tc.want, tc.in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticsearch
+
+import (
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/elasticsearchservice"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/elasticsearch/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// vpcEndpointKey is the key of the endpoint of a domain that is placed in a
+// VPC.
+const vpcEndpointKey = "vpc"
+
+// Client defines Elasticsearch Service client operations
+type Client interface {
+	CreateElasticsearchDomainRequest(input *elasticsearchservice.CreateElasticsearchDomainInput) elasticsearchservice.CreateElasticsearchDomainRequest
+	DescribeElasticsearchDomainRequest(input *elasticsearchservice.DescribeElasticsearchDomainInput) elasticsearchservice.DescribeElasticsearchDomainRequest
+	UpdateElasticsearchDomainConfigRequest(input *elasticsearchservice.UpdateElasticsearchDomainConfigInput) elasticsearchservice.UpdateElasticsearchDomainConfigRequest
+	DeleteElasticsearchDomainRequest(input *elasticsearchservice.DeleteElasticsearchDomainInput) elasticsearchservice.DeleteElasticsearchDomainRequest
+}
+
+// NewClient creates new Elasticsearch Service Client with provided AWS
+// Configurations/Credentials
+func NewClient(cfg aws.Config) Client {
+	return elasticsearchservice.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the domain doesn't exist
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == elasticsearchservice.ErrCodeResourceNotFoundException
+	}
+	return false
+}
+
+// GenerateCreateDomainInput returns the create input for the domain with the
+// given name.
+func GenerateCreateDomainInput(name string, p v1alpha1.DomainParameters) *elasticsearchservice.CreateElasticsearchDomainInput {
+	return &elasticsearchservice.CreateElasticsearchDomainInput{
+		DomainName:                 aws.String(name),
+		ElasticsearchVersion:       p.ElasticsearchVersion,
+		ElasticsearchClusterConfig: generateClusterConfig(p.ElasticsearchClusterConfig),
+		EBSOptions:                 generateEBSOptions(p.EBSOptions),
+		AccessPolicies:             p.AccessPolicies,
+	}
+}
+
+// GenerateUpdateDomainConfigInput returns the update input for the domain with
+// the given name.
+func GenerateUpdateDomainConfigInput(name string, p v1alpha1.DomainParameters) *elasticsearchservice.UpdateElasticsearchDomainConfigInput {
+	return &elasticsearchservice.UpdateElasticsearchDomainConfigInput{
+		DomainName:                 aws.String(name),
+		ElasticsearchClusterConfig: generateClusterConfig(p.ElasticsearchClusterConfig),
+		EBSOptions:                 generateEBSOptions(p.EBSOptions),
+		AccessPolicies:             p.AccessPolicies,
+	}
+}
+
+func generateClusterConfig(c *v1alpha1.ElasticsearchClusterConfig) *elasticsearchservice.ElasticsearchClusterConfig {
+	if c == nil {
+		return nil
+	}
+	return &elasticsearchservice.ElasticsearchClusterConfig{
+		InstanceType:  elasticsearchservice.ESPartitionInstanceType(aws.StringValue(c.InstanceType)),
+		InstanceCount: c.InstanceCount,
+	}
+}
+
+func generateEBSOptions(o *v1alpha1.EBSOptions) *elasticsearchservice.EBSOptions {
+	if o == nil {
+		return nil
+	}
+	return &elasticsearchservice.EBSOptions{
+		EBSEnabled: o.EBSEnabled,
+		VolumeSize: o.VolumeSize,
+		VolumeType: elasticsearchservice.VolumeType(aws.StringValue(o.VolumeType)),
+		Iops:       o.IOPS,
+	}
+}
+
+// GenerateObservation is used to produce v1alpha1.DomainObservation from
+// elasticsearchservice.ElasticsearchDomainStatus.
+func GenerateObservation(s elasticsearchservice.ElasticsearchDomainStatus) v1alpha1.DomainObservation {
+	o := v1alpha1.DomainObservation{
+		ARN:        aws.StringValue(s.ARN),
+		DomainID:   aws.StringValue(s.DomainId),
+		Endpoint:   aws.StringValue(s.Endpoint),
+		Processing: aws.BoolValue(s.Processing),
+		Created:    aws.BoolValue(s.Created),
+		Deleted:    aws.BoolValue(s.Deleted),
+	}
+	// Domains placed in a VPC don't have a public endpoint.
+	if o.Endpoint == "" {
+		o.Endpoint = s.Endpoints[vpcEndpointKey]
+	}
+	return o
+}
+
+// LateInitialize fills the empty fields in *v1alpha1.DomainParameters with
+// the values seen in elasticsearchservice.ElasticsearchDomainStatus.
+func LateInitialize(in *v1alpha1.DomainParameters, s *elasticsearchservice.ElasticsearchDomainStatus) {
+	if s == nil {
+		return
+	}
+	in.ElasticsearchVersion = awsclients.LateInitializeStringPtr(in.ElasticsearchVersion, s.ElasticsearchVersion)
+	in.AccessPolicies = awsclients.LateInitializeStringPtr(in.AccessPolicies, awsclients.String(aws.StringValue(s.AccessPolicies)))
+	if s.ElasticsearchClusterConfig != nil {
+		if in.ElasticsearchClusterConfig == nil {
+			in.ElasticsearchClusterConfig = &v1alpha1.ElasticsearchClusterConfig{}
+		}
+		c := in.ElasticsearchClusterConfig
+		c.InstanceType = awsclients.LateInitializeStringPtr(c.InstanceType, awsclients.String(string(s.ElasticsearchClusterConfig.InstanceType)))
+		c.InstanceCount = awsclients.LateInitializeInt64Ptr(c.InstanceCount, s.ElasticsearchClusterConfig.InstanceCount)
+	}
+	if s.EBSOptions != nil {
+		if in.EBSOptions == nil {
+			in.EBSOptions = &v1alpha1.EBSOptions{}
+		}
+		o := in.EBSOptions
+		o.EBSEnabled = awsclients.LateInitializeBoolPtr(o.EBSEnabled, s.EBSOptions.EBSEnabled)
+		o.VolumeSize = awsclients.LateInitializeInt64Ptr(o.VolumeSize, s.EBSOptions.VolumeSize)
+		o.VolumeType = awsclients.LateInitializeStringPtr(o.VolumeType, awsclients.String(string(s.EBSOptions.VolumeType)))
+		o.IOPS = awsclients.LateInitializeInt64Ptr(o.IOPS, s.EBSOptions.Iops)
+	}
+}
+
+// IsUpToDate checks whether there is a change in any of the modifiable fields.
+func IsUpToDate(p v1alpha1.DomainParameters, s elasticsearchservice.ElasticsearchDomainStatus) (bool, error) {
+	ok, err := isPolicyUpToDate(p.AccessPolicies, s.AccessPolicies)
+	if err != nil || !ok {
+		return false, err
+	}
+	current := &v1alpha1.DomainParameters{}
+	LateInitialize(current, &s)
+	return cmp.Equal(current, &p, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.DomainParameters{}, "Region", "ElasticsearchVersion", "AccessPolicies")), nil
+}
+
+// isPolicyUpToDate compares the access policies as JSON documents since AWS
+// doesn't preserve the formatting of the submitted policy.
+func isPolicyUpToDate(desired, observed *string) (bool, error) {
+	if aws.StringValue(desired) == "" || aws.StringValue(observed) == "" {
+		return aws.StringValue(desired) == aws.StringValue(observed), nil
+	}
+	var d, o interface{}
+	if err := json.Unmarshal([]byte(*desired), &d); err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal([]byte(*observed), &o); err != nil {
+		return false, err
+	}
+	return cmp.Equal(d, o), nil
+}
+
+// GetConnectionDetails returns the connection details of the domain.
+func GetConnectionDetails(in v1alpha1.Domain) managed.ConnectionDetails {
+	if in.Status.AtProvider.Endpoint == "" {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(in.Status.AtProvider.Endpoint),
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticsearch
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticsearchservice"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/elasticsearch/v1alpha1"
+)
+
+var (
+	policy         = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"es:*"}]}`
+	policyReformat = `{ "Statement": [ { "Action": "es:*", "Effect": "Allow", "Principal": { "AWS": "*" } } ], "Version": "2012-10-17" }`
+)
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		p v1alpha1.DomainParameters
+		s elasticsearchservice.ElasticsearchDomainStatus
+	}
+	type want struct {
+		upToDate bool
+		err      bool
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SameFields": {
+			args: args{
+				p: v1alpha1.DomainParameters{
+					Region:         "us-east-1",
+					AccessPolicies: aws.String(policyReformat),
+					EBSOptions:     &v1alpha1.EBSOptions{EBSEnabled: aws.Bool(true), VolumeSize: aws.Int64(10)},
+				},
+				s: elasticsearchservice.ElasticsearchDomainStatus{
+					AccessPolicies: aws.String(policy),
+					EBSOptions:     &elasticsearchservice.EBSOptions{EBSEnabled: aws.Bool(true), VolumeSize: aws.Int64(10)},
+				},
+			},
+			want: want{upToDate: true},
+		},
+		"DifferentVolumeSize": {
+			args: args{
+				p: v1alpha1.DomainParameters{
+					EBSOptions: &v1alpha1.EBSOptions{EBSEnabled: aws.Bool(true), VolumeSize: aws.Int64(20)},
+				},
+				s: elasticsearchservice.ElasticsearchDomainStatus{
+					EBSOptions: &elasticsearchservice.EBSOptions{EBSEnabled: aws.Bool(true), VolumeSize: aws.Int64(10)},
+				},
+			},
+			want: want{upToDate: false},
+		},
+		"DifferentPolicy": {
+			args: args{
+				p: v1alpha1.DomainParameters{AccessPolicies: aws.String(`{"Version":"2012-10-17"}`)},
+				s: elasticsearchservice.ElasticsearchDomainStatus{AccessPolicies: aws.String(policy)},
+			},
+			want: want{upToDate: false},
+		},
+		"InvalidPolicy": {
+			args: args{
+				p: v1alpha1.DomainParameters{AccessPolicies: aws.String(`{`)},
+				s: elasticsearchservice.ElasticsearchDomainStatus{AccessPolicies: aws.String(policy)},
+			},
+			want: want{upToDate: false, err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			upToDate, err := IsUpToDate(tc.args.p, tc.args.s)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, upToDate); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	cases := map[string]struct {
+		in   elasticsearchservice.ElasticsearchDomainStatus
+		want v1alpha1.DomainObservation
+	}{
+		"PublicEndpoint": {
+			in: elasticsearchservice.ElasticsearchDomainStatus{
+				ARN:      aws.String("arn"),
+				DomainId: aws.String("id"),
+				Endpoint: aws.String("public"),
+				Created:  aws.Bool(true),
+			},
+			want: v1alpha1.DomainObservation{ARN: "arn", DomainID: "id", Endpoint: "public", Created: true},
+		},
+		"VPCEndpoint": {
+			in: elasticsearchservice.ElasticsearchDomainStatus{
+				Endpoints:  map[string]string{"vpc": "private"},
+				Processing: aws.Bool(true),
+			},
+			want: v1alpha1.DomainObservation{Endpoint: "private", Processing: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateObservation(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
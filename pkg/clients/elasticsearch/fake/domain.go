@@ -0,0 +1,49 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/elasticsearchservice"
+)
+
+// MockClient for testing.
+type MockClient struct {
+	MockCreate   func(*elasticsearchservice.CreateElasticsearchDomainInput) elasticsearchservice.CreateElasticsearchDomainRequest
+	MockDescribe func(*elasticsearchservice.DescribeElasticsearchDomainInput) elasticsearchservice.DescribeElasticsearchDomainRequest
+	MockUpdate   func(*elasticsearchservice.UpdateElasticsearchDomainConfigInput) elasticsearchservice.UpdateElasticsearchDomainConfigRequest
+	MockDelete   func(*elasticsearchservice.DeleteElasticsearchDomainInput) elasticsearchservice.DeleteElasticsearchDomainRequest
+}
+
+// CreateElasticsearchDomainRequest calls the underlying MockCreate method.
+func (m *MockClient) CreateElasticsearchDomainRequest(i *elasticsearchservice.CreateElasticsearchDomainInput) elasticsearchservice.CreateElasticsearchDomainRequest {
+	return m.MockCreate(i)
+}
+
+// DescribeElasticsearchDomainRequest calls the underlying MockDescribe method.
+func (m *MockClient) DescribeElasticsearchDomainRequest(i *elasticsearchservice.DescribeElasticsearchDomainInput) elasticsearchservice.DescribeElasticsearchDomainRequest {
+	return m.MockDescribe(i)
+}
+
+// UpdateElasticsearchDomainConfigRequest calls the underlying MockUpdate method.
+func (m *MockClient) UpdateElasticsearchDomainConfigRequest(i *elasticsearchservice.UpdateElasticsearchDomainConfigInput) elasticsearchservice.UpdateElasticsearchDomainConfigRequest {
+	return m.MockUpdate(i)
+}
+
+// DeleteElasticsearchDomainRequest calls the underlying MockDelete method.
+func (m *MockClient) DeleteElasticsearchDomainRequest(i *elasticsearchservice.DeleteElasticsearchDomainInput) elasticsearchservice.DeleteElasticsearchDomainRequest {
+	return m.MockDelete(i)
+}
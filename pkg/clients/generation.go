@@ -0,0 +1,175 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package aws
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// observedGeneration is the state of a managed resource when it was last
+// observed as available and up to date.
+type observedGeneration struct {
+	generation  int64
+	annotations map[string]string
+	time        time.Time
+	conn        managed.ConnectionDetails
+}
+
+// A generationTracker connects to AWS only for managed resources whose spec
+// changed since they were last observed as available and up to date, or that
+// were last observed longer than the drift poll interval ago.
+type generationTracker struct {
+	managed.ExternalConnecter
+
+	interval time.Duration
+	now      func() time.Time
+
+	mu       sync.Mutex
+	observed map[types.UID]observedGeneration
+}
+
+// TrackGeneration returns an ExternalConnecter that skips observing managed
+// resources in AWS as long as their metadata.generation and annotations are
+// the ones they were last observed as available and up to date with, until
+// the given interval passes. Annotations are compared because they don't
+// change the generation, but may still ask controllers to act, e.g. to rotate
+// credentials. It returns c as is if the interval is not positive.
+func TrackGeneration(c managed.ExternalConnecter, interval time.Duration) managed.ExternalConnecter {
+	if interval <= 0 {
+		return c
+	}
+	return &generationTracker{
+		ExternalConnecter: c,
+		interval:          interval,
+		now:               time.Now,
+		observed:          map[types.UID]observedGeneration{},
+	}
+}
+
+// Connect returns an ExternalClient that reports the last observation of the
+// managed resource if it hasn't changed since, and otherwise the client of the
+// wrapped ExternalConnecter.
+func (t *generationTracker) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if o, ok := t.unchanged(mg); ok {
+		return &unchangedExternal{conn: o.conn}, nil
+	}
+	e, err := t.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &trackingExternal{ExternalClient: e, tracker: t}, nil
+}
+
+func (t *generationTracker) unchanged(mg resource.Managed) (observedGeneration, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	o, ok := t.observed[mg.GetUID()]
+	if !ok {
+		return o, false
+	}
+	// The last reconcile has to have succeeded, e.g. the connection details
+	// may not have been published otherwise.
+	ready, synced := mg.GetCondition(xpv1.TypeReady), mg.GetCondition(xpv1.TypeSynced)
+	if meta.WasDeleted(mg) || o.generation != mg.GetGeneration() || !equalAnnotations(o.annotations, mg.GetAnnotations()) ||
+		t.now().Sub(o.time) >= t.interval ||
+		ready.Reason != xpv1.ReasonAvailable || synced.Status != corev1.ConditionTrue {
+		delete(t.observed, mg.GetUID())
+		return o, false
+	}
+	return o, true
+}
+
+func (t *generationTracker) record(mg resource.Managed, obs managed.ExternalObservation) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !obs.ResourceExists || !obs.ResourceUpToDate || mg.GetCondition(xpv1.TypeReady).Reason != xpv1.ReasonAvailable {
+		delete(t.observed, mg.GetUID())
+		return
+	}
+	conn := make(managed.ConnectionDetails, len(obs.ConnectionDetails))
+	for k, v := range obs.ConnectionDetails {
+		conn[k] = v
+	}
+	var annotations map[string]string
+	if a := mg.GetAnnotations(); len(a) != 0 {
+		annotations = make(map[string]string, len(a))
+		for k, v := range a {
+			annotations[k] = v
+		}
+	}
+	t.observed[mg.GetUID()] = observedGeneration{generation: mg.GetGeneration(), annotations: annotations, time: t.now(), conn: conn}
+}
+
+func equalAnnotations(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if w, ok := b[k]; !ok || v != w {
+			return false
+		}
+	}
+	return true
+}
+
+// trackingExternal records the observations of the wrapped ExternalClient.
+type trackingExternal struct {
+	managed.ExternalClient
+	tracker *generationTracker
+}
+
+func (e *trackingExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	obs, err := e.ExternalClient.Observe(ctx, mg)
+	if err != nil {
+		e.tracker.record(mg, managed.ExternalObservation{})
+		return obs, err
+	}
+	e.tracker.record(mg, obs)
+	return obs, nil
+}
+
+// unchangedExternal reports a managed resource that hasn't changed since its
+// last observation as up to date. The reconciler neither creates, updates nor
+// deletes up to date resources, so only Observe is ever called.
+type unchangedExternal struct {
+	conn managed.ConnectionDetails
+}
+
+func (e *unchangedExternal) Observe(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: e.conn}, nil
+}
+
+func (e *unchangedExternal) Create(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *unchangedExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *unchangedExternal) Delete(_ context.Context, _ resource.Managed) error {
+	return nil
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+var (
+	trackedUID  = types.UID("some-uid")
+	trackedConn = managed.ConnectionDetails{"endpoint": []byte("some-endpoint")}
+	lastObserve = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+type trackedModifier func(*fake.Managed)
+
+func withGeneration(g int64) trackedModifier {
+	return func(mg *fake.Managed) { mg.SetGeneration(g) }
+}
+
+func withAnnotations(a map[string]string) trackedModifier {
+	return func(mg *fake.Managed) { mg.SetAnnotations(a) }
+}
+
+func withTrackedConditions(c ...xpv1.Condition) trackedModifier {
+	return func(mg *fake.Managed) { mg.Conditions = c }
+}
+
+func withDeletionTimestamp() trackedModifier {
+	return func(mg *fake.Managed) {
+		now := metav1.Now()
+		mg.SetDeletionTimestamp(&now)
+	}
+}
+
+func tracked(m ...trackedModifier) *fake.Managed {
+	mg := &fake.Managed{}
+	mg.SetUID(trackedUID)
+	mg.SetGeneration(1)
+	mg.SetConditions(xpv1.Available(), xpv1.ReconcileSuccess())
+	for _, f := range m {
+		f(mg)
+	}
+	return mg
+}
+
+func TestGenerationTrackerConnect(t *testing.T) {
+	observed := map[types.UID]observedGeneration{
+		trackedUID: {generation: 1, time: lastObserve, conn: trackedConn},
+	}
+
+	type want struct {
+		connected bool
+		obs       managed.ExternalObservation
+	}
+
+	cases := map[string]struct {
+		observed map[types.UID]observedGeneration
+		now      time.Time
+		mg       resource.Managed
+		want     want
+	}{
+		"Unchanged": {
+			observed: observed,
+			now:      lastObserve.Add(time.Minute),
+			mg:       tracked(),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: trackedConn},
+			},
+		},
+		"NeverObserved": {
+			observed: map[types.UID]observedGeneration{},
+			now:      lastObserve.Add(time.Minute),
+			mg:       tracked(),
+			want:     want{connected: true},
+		},
+		"GenerationChanged": {
+			observed: observed,
+			now:      lastObserve.Add(time.Minute),
+			mg:       tracked(withGeneration(2)),
+			want:     want{connected: true},
+		},
+		"AnnotationsChanged": {
+			observed: observed,
+			now:      lastObserve.Add(time.Minute),
+			mg:       tracked(withAnnotations(map[string]string{"some": "annotation"})),
+			want:     want{connected: true},
+		},
+		"DriftPollIntervalPassed": {
+			observed: observed,
+			now:      lastObserve.Add(10 * time.Minute),
+			mg:       tracked(),
+			want:     want{connected: true},
+		},
+		"NotAvailable": {
+			observed: observed,
+			now:      lastObserve.Add(time.Minute),
+			mg:       tracked(withTrackedConditions(xpv1.Unavailable(), xpv1.ReconcileSuccess())),
+			want:     want{connected: true},
+		},
+		"LastReconcileFailed": {
+			observed: observed,
+			now:      lastObserve.Add(time.Minute),
+			mg:       tracked(withTrackedConditions(xpv1.Available(), xpv1.ReconcileError(errors.New("boom")))),
+			want:     want{connected: true},
+		},
+		"Deleted": {
+			observed: observed,
+			now:      lastObserve.Add(time.Minute),
+			mg:       tracked(withDeletionTimestamp()),
+			want:     want{connected: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			connected := false
+			tr := &generationTracker{
+				ExternalConnecter: managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+					connected = true
+					return managed.ExternalClientFns{}, nil
+				}),
+				interval: 10 * time.Minute,
+				now:      func() time.Time { return tc.now },
+				observed: map[types.UID]observedGeneration{},
+			}
+			for k, v := range tc.observed {
+				tr.observed[k] = v
+			}
+			e, err := tr.Connect(context.Background(), tc.mg)
+			if err != nil {
+				t.Fatalf("Connect(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want.connected, connected); diff != "" {
+				t.Errorf("connected: -want, +got:\n%s", diff)
+			}
+			if connected {
+				return
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(nil, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerationTrackerRecord(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		obs  managed.ExternalObservation
+		err  error
+		want map[types.UID]observedGeneration
+	}{
+		"AvailableAndUpToDate": {
+			mg:  tracked(withGeneration(2), withAnnotations(map[string]string{"some": "annotation"})),
+			obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: trackedConn},
+			want: map[types.UID]observedGeneration{
+				trackedUID: {generation: 2, annotations: map[string]string{"some": "annotation"}, time: lastObserve, conn: trackedConn},
+			},
+		},
+		"NotUpToDate": {
+			mg:   tracked(),
+			obs:  managed.ExternalObservation{ResourceExists: true},
+			want: map[types.UID]observedGeneration{},
+		},
+		"NotAvailable": {
+			mg:   tracked(withTrackedConditions(xpv1.Creating())),
+			obs:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			want: map[types.UID]observedGeneration{},
+		},
+		"ObserveFailed": {
+			mg:   tracked(),
+			err:  errBoom,
+			want: map[types.UID]observedGeneration{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tr := &generationTracker{
+				ExternalConnecter: managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+					return managed.ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+							return tc.obs, tc.err
+						},
+					}, nil
+				}),
+				interval: 10 * time.Minute,
+				now:      func() time.Time { return lastObserve },
+				observed: map[types.UID]observedGeneration{
+					trackedUID: {generation: 1, time: lastObserve.Add(-time.Hour)},
+				},
+			}
+			e, err := tr.Connect(context.Background(), tc.mg)
+			if err != nil {
+				t.Fatalf("Connect(...): %s", err)
+			}
+			_, err = e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tr.observed, cmp.AllowUnexported(observedGeneration{})); diff != "" {
+				t.Errorf("observed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
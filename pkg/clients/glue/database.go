@@ -0,0 +1,96 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package glue
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/glue/v1alpha1"
+)
+
+// DatabaseClient defines Glue Database client operations
+type DatabaseClient interface {
+	GetDatabaseRequest(input *glue.GetDatabaseInput) glue.GetDatabaseRequest
+	CreateDatabaseRequest(input *glue.CreateDatabaseInput) glue.CreateDatabaseRequest
+	UpdateDatabaseRequest(input *glue.UpdateDatabaseInput) glue.UpdateDatabaseRequest
+	DeleteDatabaseRequest(input *glue.DeleteDatabaseInput) glue.DeleteDatabaseRequest
+}
+
+// NewDatabaseClient creates new Glue Client with provided AWS
+// Configurations/Credentials
+func NewDatabaseClient(cfg aws.Config) DatabaseClient {
+	return glue.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the item doesn't exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == glue.ErrCodeEntityNotFoundException
+	}
+	return false
+}
+
+// GenerateDatabaseInput returns the input to create or update the database
+// with the given name.
+func GenerateDatabaseInput(name string, p v1alpha1.DatabaseParameters) *glue.DatabaseInput {
+	return &glue.DatabaseInput{
+		Name:        aws.String(name),
+		Description: p.Description,
+		LocationUri: p.LocationURI,
+		Parameters:  p.Parameters,
+	}
+}
+
+// LateInitializeDatabase fills the empty fields in
+// *v1alpha1.DatabaseParameters with the values seen in glue.Database.
+func LateInitializeDatabase(in *v1alpha1.DatabaseParameters, db *glue.Database) {
+	if db == nil {
+		return
+	}
+	if in.Description == nil {
+		in.Description = db.Description
+	}
+	if in.LocationURI == nil {
+		in.LocationURI = db.LocationUri
+	}
+	if in.Parameters == nil && len(db.Parameters) != 0 {
+		in.Parameters = db.Parameters
+	}
+}
+
+// GenerateDatabaseObservation is used to produce
+// v1alpha1.DatabaseObservation from glue.Database.
+func GenerateDatabaseObservation(db glue.Database) v1alpha1.DatabaseObservation {
+	o := v1alpha1.DatabaseObservation{}
+	if db.CreateTime != nil {
+		t := metav1.NewTime(*db.CreateTime)
+		o.CreateTime = &t
+	}
+	return o
+}
+
+// IsDatabaseUpToDate checks whether the observed database matches the desired
+// parameters.
+func IsDatabaseUpToDate(p v1alpha1.DatabaseParameters, db glue.Database) bool {
+	return aws.StringValue(p.Description) == aws.StringValue(db.Description) &&
+		aws.StringValue(p.LocationURI) == aws.StringValue(db.LocationUri) &&
+		cmp.Equal(p.Parameters, db.Parameters, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package glue
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/glue/v1alpha1"
+)
+
+func TestLateInitializeDatabase(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.DatabaseParameters
+		db   *glue.Database
+		want v1alpha1.DatabaseParameters
+	}{
+		"AllEmpty": {
+			in: v1alpha1.DatabaseParameters{},
+			db: &glue.Database{
+				Description: aws.String("desc"),
+				LocationUri: aws.String("s3://bucket"),
+				Parameters:  map[string]string{"k": "v"},
+			},
+			want: v1alpha1.DatabaseParameters{
+				Description: aws.String("desc"),
+				LocationURI: aws.String("s3://bucket"),
+				Parameters:  map[string]string{"k": "v"},
+			},
+		},
+		"NoOverride": {
+			in: v1alpha1.DatabaseParameters{Description: aws.String("mine")},
+			db: &glue.Database{Description: aws.String("desc")},
+			want: v1alpha1.DatabaseParameters{
+				Description: aws.String("mine"),
+			},
+		},
+		"NilDatabase": {
+			in:   v1alpha1.DatabaseParameters{},
+			want: v1alpha1.DatabaseParameters{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeDatabase(&tc.in, tc.db)
+			if diff := cmp.Diff(tc.want, tc.in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsDatabaseUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.DatabaseParameters
+		db   glue.Database
+		want bool
+	}{
+		"UpToDate": {
+			p:    v1alpha1.DatabaseParameters{Description: aws.String("desc")},
+			db:   glue.Database{Description: aws.String("desc"), Parameters: map[string]string{}},
+			want: true,
+		},
+		"DifferentDescription": {
+			p:    v1alpha1.DatabaseParameters{Description: aws.String("desc")},
+			db:   glue.Database{Description: aws.String("other")},
+			want: false,
+		},
+		"DifferentLocation": {
+			p:    v1alpha1.DatabaseParameters{LocationURI: aws.String("s3://bucket/a")},
+			db:   glue.Database{LocationUri: aws.String("s3://bucket/b")},
+			want: false,
+		},
+		"DifferentParameters": {
+			p:    v1alpha1.DatabaseParameters{Parameters: map[string]string{"k": "v"}},
+			db:   glue.Database{Parameters: map[string]string{"k": "w"}},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsDatabaseUpToDate(tc.p, tc.db)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/glue"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.DatabaseClient = (*MockDatabaseClient)(nil)
+
+// MockDatabaseClient is a type that implements all the methods for the
+// Database Client interface
+type MockDatabaseClient struct {
+	MockGet    func(*glue.GetDatabaseInput) glue.GetDatabaseRequest
+	MockCreate func(*glue.CreateDatabaseInput) glue.CreateDatabaseRequest
+	MockUpdate func(*glue.UpdateDatabaseInput) glue.UpdateDatabaseRequest
+	MockDelete func(*glue.DeleteDatabaseInput) glue.DeleteDatabaseRequest
+}
+
+// GetDatabaseRequest mocks GetDatabaseRequest method
+func (m *MockDatabaseClient) GetDatabaseRequest(input *glue.GetDatabaseInput) glue.GetDatabaseRequest {
+	return m.MockGet(input)
+}
+
+// CreateDatabaseRequest mocks CreateDatabaseRequest method
+func (m *MockDatabaseClient) CreateDatabaseRequest(input *glue.CreateDatabaseInput) glue.CreateDatabaseRequest {
+	return m.MockCreate(input)
+}
+
+// UpdateDatabaseRequest mocks UpdateDatabaseRequest method
+func (m *MockDatabaseClient) UpdateDatabaseRequest(input *glue.UpdateDatabaseInput) glue.UpdateDatabaseRequest {
+	return m.MockUpdate(input)
+}
+
+// DeleteDatabaseRequest mocks DeleteDatabaseRequest method
+func (m *MockDatabaseClient) DeleteDatabaseRequest(input *glue.DeleteDatabaseInput) glue.DeleteDatabaseRequest {
+	return m.MockDelete(input)
+}
@@ -22,13 +22,13 @@ const (
 // Client defines IAM Client operations
 // mockery -case snake -name Client -output fake -outpkg fake
 type Client interface {
-	CreateUser(username string) (*iam.AccessKey, error)
-	DeleteUser(username string) error
-	CreatePolicyAndAttach(username string, policyName string, policyDocument string) (string, error)
-	GetPolicyVersion(policyName string) (string, error)
-	UpdatePolicy(policyName string, policyDocument string) (string, error)
-	DeletePolicyAndDetach(username string, policyName string) error
-	GetAccountID() (string, error)
+	CreateUser(ctx context.Context, username string) (*iam.AccessKey, error)
+	DeleteUser(ctx context.Context, username string) error
+	CreatePolicyAndAttach(ctx context.Context, username string, policyName string, policyDocument string) (string, error)
+	GetPolicyVersion(ctx context.Context, policyName string) (string, error)
+	UpdatePolicy(ctx context.Context, policyName string, policyDocument string) (string, error)
+	DeletePolicyAndDetach(ctx context.Context, username string, policyName string) error
+	GetAccountID(ctx context.Context) (string, error)
 }
 
 type iamClient struct {
@@ -42,13 +42,13 @@ func NewClient(config aws.Config) Client {
 }
 
 // CreateUser - Creates an IAM User, a policy, binds user to policy and returns an access key and policy version for the user.
-func (c *iamClient) CreateUser(username string) (*iam.AccessKey, error) {
-	err := c.createUser(username)
+func (c *iamClient) CreateUser(ctx context.Context, username string) (*iam.AccessKey, error) {
+	err := c.createUser(ctx, username)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create user, %s", err)
 	}
 
-	key, err := c.createAccessKey(username)
+	key, err := c.createAccessKey(ctx, username)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create access key, %s", err)
 	}
@@ -57,13 +57,13 @@ func (c *iamClient) CreateUser(username string) (*iam.AccessKey, error) {
 }
 
 // CreatePolicyAndAttach - Creates the IAM policy and attaches it to the username
-func (c *iamClient) CreatePolicyAndAttach(username string, policyName string, policyDocument string) (string, error) {
-	currentVersion, err := c.createPolicy(username, policyDocument)
+func (c *iamClient) CreatePolicyAndAttach(ctx context.Context, username string, policyName string, policyDocument string) (string, error) {
+	currentVersion, err := c.createPolicy(ctx, username, policyDocument)
 	if err != nil {
 		return "", fmt.Errorf("failed to create policy, %s", err)
 	}
 
-	err = c.attachPolicyToUser(username, username)
+	err = c.attachPolicyToUser(ctx, username, username)
 	if err != nil {
 		return "", fmt.Errorf("failed to attach policy, %s", err)
 	}
@@ -72,15 +72,15 @@ func (c *iamClient) CreatePolicyAndAttach(username string, policyName string, po
 }
 
 // GetPolicyVersion get the policy document for the IAM user
-func (c *iamClient) GetPolicyVersion(username string) (string, error) {
-	policyARN, err := c.getPolicyARN(username)
+func (c *iamClient) GetPolicyVersion(ctx context.Context, username string) (string, error) {
+	policyARN, err := c.getPolicyARN(ctx, username)
 	if err != nil {
 		return "", err
 	}
 
 	policyResponse, err := c.iam.GetPolicyRequest(&iam.GetPolicyInput{
 		PolicyArn: aws.String(policyARN),
-	}).Send(context.TODO())
+	}).Send(ctx)
 
 	if err != nil {
 		return "", err
@@ -90,27 +90,27 @@ func (c *iamClient) GetPolicyVersion(username string) (string, error) {
 }
 
 // UpdatePolicy - updates the policy document for the IAM user and return current policy version
-func (c *iamClient) UpdatePolicy(policyName string, policyDocument string) (string, error) {
-	policyARN, err := c.getPolicyARN(policyName)
+func (c *iamClient) UpdatePolicy(ctx context.Context, policyName string, policyDocument string) (string, error) {
+	policyARN, err := c.getPolicyARN(ctx, policyName)
 	if err != nil {
 		return "", err
 	}
 	// Create a new policy version
-	policyVersionResponse, err := c.iam.CreatePolicyVersionRequest(&iam.CreatePolicyVersionInput{PolicyArn: aws.String(policyARN), PolicyDocument: aws.String(policyDocument), SetAsDefault: aws.Bool(true)}).Send(context.TODO())
+	policyVersionResponse, err := c.iam.CreatePolicyVersionRequest(&iam.CreatePolicyVersionInput{PolicyArn: aws.String(policyARN), PolicyDocument: aws.String(policyDocument), SetAsDefault: aws.Bool(true)}).Send(ctx)
 	if err != nil {
 		return "", err
 	}
 
 	currentPolicyVersion := policyVersionResponse.PolicyVersion.VersionId
 	// Delete old versions of policy - Max 5 allowed
-	policyVersions, err := c.iam.ListPolicyVersionsRequest(&iam.ListPolicyVersionsInput{PolicyArn: aws.String(policyARN)}).Send(context.TODO())
+	policyVersions, err := c.iam.ListPolicyVersionsRequest(&iam.ListPolicyVersionsInput{PolicyArn: aws.String(policyARN)}).Send(ctx)
 	if err != nil {
 		return "", err
 	}
 
 	for _, policy := range policyVersions.Versions {
 		if aws.StringValue(policy.VersionId) != aws.StringValue(currentPolicyVersion) {
-			_, err := c.iam.DeletePolicyVersionRequest(&iam.DeletePolicyVersionInput{PolicyArn: aws.String(policyARN), VersionId: policy.VersionId}).Send(context.TODO())
+			_, err := c.iam.DeletePolicyVersionRequest(&iam.DeletePolicyVersionInput{PolicyArn: aws.String(policyARN), VersionId: policy.VersionId}).Send(ctx)
 			if err != nil {
 				return "", err
 			}
@@ -121,8 +121,8 @@ func (c *iamClient) UpdatePolicy(policyName string, policyDocument string) (stri
 }
 
 // DeletePolicyAndDetach delete the policy of PolicyName and detach it from the username provided
-func (c *iamClient) DeletePolicyAndDetach(username string, policyName string) error {
-	policyARN, err := c.getPolicyARN(username)
+func (c *iamClient) DeletePolicyAndDetach(ctx context.Context, username string, policyName string) error {
+	policyARN, err := c.getPolicyARN(ctx, username)
 	if resource.Ignore(IsErrorNotFound, err) != nil {
 		return err
 	}
@@ -130,38 +130,38 @@ func (c *iamClient) DeletePolicyAndDetach(username string, policyName string) er
 		return nil
 	}
 
-	_, err = c.iam.DetachUserPolicyRequest(&iam.DetachUserPolicyInput{PolicyArn: aws.String(policyARN), UserName: aws.String(username)}).Send(context.TODO())
+	_, err = c.iam.DetachUserPolicyRequest(&iam.DetachUserPolicyInput{PolicyArn: aws.String(policyARN), UserName: aws.String(username)}).Send(ctx)
 	if resource.Ignore(IsErrorNotFound, err) != nil {
 		return err
 	}
 
-	_, err = c.iam.DeletePolicyRequest(&iam.DeletePolicyInput{PolicyArn: aws.String(policyARN)}).Send(context.TODO())
+	_, err = c.iam.DeletePolicyRequest(&iam.DeletePolicyInput{PolicyArn: aws.String(policyARN)}).Send(ctx)
 	return resource.Ignore(IsErrorNotFound, err)
 }
 
 // DeleteUser Policy and IAM User
-func (c *iamClient) DeleteUser(username string) error {
-	keys, err := c.iam.ListAccessKeysRequest(&iam.ListAccessKeysInput{UserName: aws.String(username)}).Send(context.TODO())
+func (c *iamClient) DeleteUser(ctx context.Context, username string) error {
+	keys, err := c.iam.ListAccessKeysRequest(&iam.ListAccessKeysInput{UserName: aws.String(username)}).Send(ctx)
 	if resource.Ignore(IsErrorNotFound, err) != nil {
 		return err
 	}
 	if keys != nil {
 		for _, key := range keys.AccessKeyMetadata {
-			_, err = c.iam.DeleteAccessKeyRequest(&iam.DeleteAccessKeyInput{AccessKeyId: key.AccessKeyId, UserName: aws.String(username)}).Send(context.TODO())
+			_, err = c.iam.DeleteAccessKeyRequest(&iam.DeleteAccessKeyInput{AccessKeyId: key.AccessKeyId, UserName: aws.String(username)}).Send(ctx)
 			if resource.Ignore(IsErrorNotFound, err) != nil {
 				return err
 			}
 		}
 	}
 
-	_, err = c.iam.DeleteUserRequest(&iam.DeleteUserInput{UserName: aws.String(username)}).Send(context.TODO())
+	_, err = c.iam.DeleteUserRequest(&iam.DeleteUserInput{UserName: aws.String(username)}).Send(ctx)
 	return resource.Ignore(IsErrorNotFound, err)
 }
 
 // GetAccountID - Gets the accountID of the authenticated session.
-func (c *iamClient) GetAccountID() (string, error) {
+func (c *iamClient) GetAccountID(ctx context.Context) (string, error) {
 	if c.accountID == nil {
-		user, err := c.iam.GetUserRequest(&iam.GetUserInput{}).Send(context.TODO())
+		user, err := c.iam.GetUserRequest(&iam.GetUserInput{}).Send(ctx)
 		if err != nil {
 			return "", err
 		}
@@ -176,8 +176,8 @@ func (c *iamClient) GetAccountID() (string, error) {
 	return aws.StringValue(c.accountID), nil
 }
 
-func (c *iamClient) getPolicyARN(policyName string) (string, error) {
-	accountID, err := c.GetAccountID()
+func (c *iamClient) getPolicyARN(ctx context.Context, policyName string) (string, error) {
+	accountID, err := c.GetAccountID(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -185,16 +185,16 @@ func (c *iamClient) getPolicyARN(policyName string) (string, error) {
 	return policyARN, nil
 }
 
-func (c *iamClient) createUser(username string) error {
-	_, err := c.iam.CreateUserRequest(&iam.CreateUserInput{UserName: aws.String(username)}).Send(context.TODO())
-	if err != nil && isErrorAlreadyExists(err) {
+func (c *iamClient) createUser(ctx context.Context, username string) error {
+	_, err := c.iam.CreateUserRequest(&iam.CreateUserInput{UserName: aws.String(username)}).Send(ctx)
+	if err != nil && IsErrorAlreadyExists(err) {
 		return nil
 	}
 	return err
 }
 
-func (c *iamClient) createAccessKey(username string) (*iam.AccessKey, error) {
-	keysResponse, err := c.iam.CreateAccessKeyRequest(&iam.CreateAccessKeyInput{UserName: aws.String(username)}).Send(context.TODO())
+func (c *iamClient) createAccessKey(ctx context.Context, username string) (*iam.AccessKey, error) {
+	keysResponse, err := c.iam.CreateAccessKeyRequest(&iam.CreateAccessKeyInput{UserName: aws.String(username)}).Send(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -202,27 +202,29 @@ func (c *iamClient) createAccessKey(username string) (*iam.AccessKey, error) {
 	return keysResponse.AccessKey, nil
 }
 
-func (c *iamClient) createPolicy(policyName string, policyDocument string) (string, error) {
-	response, err := c.iam.CreatePolicyRequest(&iam.CreatePolicyInput{PolicyName: aws.String(policyName), PolicyDocument: aws.String(policyDocument)}).Send(context.TODO())
+func (c *iamClient) createPolicy(ctx context.Context, policyName string, policyDocument string) (string, error) {
+	response, err := c.iam.CreatePolicyRequest(&iam.CreatePolicyInput{PolicyName: aws.String(policyName), PolicyDocument: aws.String(policyDocument)}).Send(ctx)
 	if err != nil {
-		if isErrorAlreadyExists(err) {
-			return c.UpdatePolicy(policyName, policyDocument)
+		if IsErrorAlreadyExists(err) {
+			return c.UpdatePolicy(ctx, policyName, policyDocument)
 		}
 		return "", err
 	}
 	return aws.StringValue(response.Policy.DefaultVersionId), nil
 }
 
-func (c *iamClient) attachPolicyToUser(policyName string, username string) error {
-	policyArn, err := c.getPolicyARN(policyName)
+func (c *iamClient) attachPolicyToUser(ctx context.Context, policyName string, username string) error {
+	policyArn, err := c.getPolicyARN(ctx, policyName)
 	if err != nil {
 		return err
 	}
-	_, err = c.iam.AttachUserPolicyRequest(&iam.AttachUserPolicyInput{PolicyArn: aws.String(policyArn), UserName: aws.String(username)}).Send(context.TODO())
+	_, err = c.iam.AttachUserPolicyRequest(&iam.AttachUserPolicyInput{PolicyArn: aws.String(policyArn), UserName: aws.String(username)}).Send(ctx)
 	return err
 }
 
-func isErrorAlreadyExists(err error) bool {
+// IsErrorAlreadyExists returns true if the error code indicates that the item
+// already exists.
+func IsErrorAlreadyExists(err error) bool {
 	if iamErr, ok := err.(awserr.Error); ok && iamErr.Code() == iam.ErrCodeEntityAlreadyExistsException {
 		return true
 	}
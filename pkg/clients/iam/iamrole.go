@@ -10,6 +10,7 @@ import (
 	"github.com/mitchellh/copystructure"
 	"github.com/pkg/errors"
 
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-aws/apis/identity/v1beta1"
@@ -69,6 +70,16 @@ func GenerateRoleObservation(role iam.Role) v1beta1.IAMRoleExternalStatus {
 	}
 }
 
+// GetRoleConnectionDetails returns the connection details of the given role.
+func GetRoleConnectionDetails(role iam.Role) managed.ConnectionDetails {
+	if role.Arn == nil {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		v1beta1.ResourceCredentialsSecretARNKey: []byte(aws.StringValue(role.Arn)),
+	}
+}
+
 // GenerateIAMRole assigns the in IAMRoleParamters to role.
 func GenerateIAMRole(in v1beta1.IAMRoleParameters, role *iam.Role) error {
 
@@ -0,0 +1,64 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+)
+
+// MockClient for testing.
+type MockClient struct {
+	MockCreate            func(*kinesis.CreateStreamInput) kinesis.CreateStreamRequest
+	MockDescribeSummary   func(*kinesis.DescribeStreamSummaryInput) kinesis.DescribeStreamSummaryRequest
+	MockUpdateShardCount  func(*kinesis.UpdateShardCountInput) kinesis.UpdateShardCountRequest
+	MockIncreaseRetention func(*kinesis.IncreaseStreamRetentionPeriodInput) kinesis.IncreaseStreamRetentionPeriodRequest
+	MockDecreaseRetention func(*kinesis.DecreaseStreamRetentionPeriodInput) kinesis.DecreaseStreamRetentionPeriodRequest
+	MockDelete            func(*kinesis.DeleteStreamInput) kinesis.DeleteStreamRequest
+}
+
+// CreateStreamRequest calls the underlying MockCreate method.
+func (m *MockClient) CreateStreamRequest(i *kinesis.CreateStreamInput) kinesis.CreateStreamRequest {
+	return m.MockCreate(i)
+}
+
+// DescribeStreamSummaryRequest calls the underlying MockDescribeSummary
+// method.
+func (m *MockClient) DescribeStreamSummaryRequest(i *kinesis.DescribeStreamSummaryInput) kinesis.DescribeStreamSummaryRequest {
+	return m.MockDescribeSummary(i)
+}
+
+// UpdateShardCountRequest calls the underlying MockUpdateShardCount method.
+func (m *MockClient) UpdateShardCountRequest(i *kinesis.UpdateShardCountInput) kinesis.UpdateShardCountRequest {
+	return m.MockUpdateShardCount(i)
+}
+
+// IncreaseStreamRetentionPeriodRequest calls the underlying
+// MockIncreaseRetention method.
+func (m *MockClient) IncreaseStreamRetentionPeriodRequest(i *kinesis.IncreaseStreamRetentionPeriodInput) kinesis.IncreaseStreamRetentionPeriodRequest {
+	return m.MockIncreaseRetention(i)
+}
+
+// DecreaseStreamRetentionPeriodRequest calls the underlying
+// MockDecreaseRetention method.
+func (m *MockClient) DecreaseStreamRetentionPeriodRequest(i *kinesis.DecreaseStreamRetentionPeriodInput) kinesis.DecreaseStreamRetentionPeriodRequest {
+	return m.MockDecreaseRetention(i)
+}
+
+// DeleteStreamRequest calls the underlying MockDelete method.
+func (m *MockClient) DeleteStreamRequest(i *kinesis.DeleteStreamInput) kinesis.DeleteStreamRequest {
+	return m.MockDelete(i)
+}
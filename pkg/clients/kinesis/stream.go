@@ -0,0 +1,89 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kinesis
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/kinesis/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// Client defines Kinesis Stream client operations
+type Client interface {
+	CreateStreamRequest(input *kinesis.CreateStreamInput) kinesis.CreateStreamRequest
+	DescribeStreamSummaryRequest(input *kinesis.DescribeStreamSummaryInput) kinesis.DescribeStreamSummaryRequest
+	UpdateShardCountRequest(input *kinesis.UpdateShardCountInput) kinesis.UpdateShardCountRequest
+	IncreaseStreamRetentionPeriodRequest(input *kinesis.IncreaseStreamRetentionPeriodInput) kinesis.IncreaseStreamRetentionPeriodRequest
+	DecreaseStreamRetentionPeriodRequest(input *kinesis.DecreaseStreamRetentionPeriodInput) kinesis.DecreaseStreamRetentionPeriodRequest
+	DeleteStreamRequest(input *kinesis.DeleteStreamInput) kinesis.DeleteStreamRequest
+}
+
+// NewClient creates new Kinesis Client with provided AWS
+// Configurations/Credentials
+func NewClient(cfg aws.Config) Client {
+	return kinesis.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the stream doesn't exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == kinesis.ErrCodeResourceNotFoundException
+	}
+	return false
+}
+
+// GenerateObservation is used to produce v1alpha1.StreamObservation from
+// kinesis.StreamDescriptionSummary.
+func GenerateObservation(s kinesis.StreamDescriptionSummary) v1alpha1.StreamObservation {
+	return v1alpha1.StreamObservation{
+		StreamARN:            aws.StringValue(s.StreamARN),
+		StreamStatus:         string(s.StreamStatus),
+		OpenShardCount:       aws.Int64Value(s.OpenShardCount),
+		RetentionPeriodHours: aws.Int64Value(s.RetentionPeriodHours),
+	}
+}
+
+// LateInitialize fills the empty fields in *v1alpha1.StreamParameters with
+// the values seen in kinesis.StreamDescriptionSummary.
+func LateInitialize(in *v1alpha1.StreamParameters, s *kinesis.StreamDescriptionSummary) {
+	if s == nil {
+		return
+	}
+	in.RetentionPeriodHours = awsclients.LateInitializeInt64Ptr(in.RetentionPeriodHours, s.RetentionPeriodHours)
+}
+
+// IsUpToDate checks whether the stream has the desired shard count and
+// retention period.
+func IsUpToDate(p v1alpha1.StreamParameters, s kinesis.StreamDescriptionSummary) bool {
+	if p.ShardCount != aws.Int64Value(s.OpenShardCount) {
+		return false
+	}
+	return p.RetentionPeriodHours == nil || aws.Int64Value(p.RetentionPeriodHours) == aws.Int64Value(s.RetentionPeriodHours)
+}
+
+// GetConnectionDetails returns the connection details of the given stream.
+func GetConnectionDetails(name string, s kinesis.StreamDescriptionSummary) managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		v1alpha1.ResourceCredentialsSecretARNKey:  []byte(aws.StringValue(s.StreamARN)),
+		v1alpha1.ResourceCredentialsSecretNameKey: []byte(name),
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kinesis
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/kinesis/v1alpha1"
+)
+
+func TestLateInitialize(t *testing.T) {
+	cases := map[string]struct {
+		in   *v1alpha1.StreamParameters
+		s    *kinesis.StreamDescriptionSummary
+		want *v1alpha1.StreamParameters
+	}{
+		"NilSummary": {
+			in:   &v1alpha1.StreamParameters{ShardCount: 1},
+			want: &v1alpha1.StreamParameters{ShardCount: 1},
+		},
+		"Empty": {
+			in:   &v1alpha1.StreamParameters{ShardCount: 1},
+			s:    &kinesis.StreamDescriptionSummary{RetentionPeriodHours: aws.Int64(24)},
+			want: &v1alpha1.StreamParameters{ShardCount: 1, RetentionPeriodHours: aws.Int64(24)},
+		},
+		"AlreadySet": {
+			in:   &v1alpha1.StreamParameters{ShardCount: 1, RetentionPeriodHours: aws.Int64(48)},
+			s:    &kinesis.StreamDescriptionSummary{RetentionPeriodHours: aws.Int64(24)},
+			want: &v1alpha1.StreamParameters{ShardCount: 1, RetentionPeriodHours: aws.Int64(48)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitialize(tc.in, tc.s)
+			if diff := cmp.Diff(tc.want, tc.in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	s := kinesis.StreamDescriptionSummary{OpenShardCount: aws.Int64(2), RetentionPeriodHours: aws.Int64(24)}
+
+	cases := map[string]struct {
+		p    v1alpha1.StreamParameters
+		want bool
+	}{
+		"Same": {
+			p:    v1alpha1.StreamParameters{ShardCount: 2, RetentionPeriodHours: aws.Int64(24)},
+			want: true,
+		},
+		"NoRetention": {
+			p:    v1alpha1.StreamParameters{ShardCount: 2},
+			want: true,
+		},
+		"ShardCountChanged": {
+			p:    v1alpha1.StreamParameters{ShardCount: 4, RetentionPeriodHours: aws.Int64(24)},
+			want: false,
+		},
+		"RetentionChanged": {
+			p:    v1alpha1.StreamParameters{ShardCount: 2, RetentionPeriodHours: aws.Int64(48)},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(tc.p, s)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kms
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+
+	"github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+)
+
+// AliasPrefix is the prefix of all alias names.
+const AliasPrefix = "alias/"
+
+// Client defines KMS client operations
+type Client interface {
+	ListAliasesRequest(input *kms.ListAliasesInput) kms.ListAliasesRequest
+	CreateAliasRequest(input *kms.CreateAliasInput) kms.CreateAliasRequest
+	UpdateAliasRequest(input *kms.UpdateAliasInput) kms.UpdateAliasRequest
+	DeleteAliasRequest(input *kms.DeleteAliasInput) kms.DeleteAliasRequest
+}
+
+// NewClient creates new KMS Client with provided AWS Configurations/Credentials
+func NewClient(cfg aws.Config) Client {
+	return kms.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the alias doesn't exist
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == kms.ErrCodeNotFoundException
+	}
+	return false
+}
+
+// AliasName returns the name of the alias with the given external name.
+func AliasName(externalName string) string {
+	return AliasPrefix + strings.TrimPrefix(externalName, AliasPrefix)
+}
+
+// FindAlias returns the alias with the given name, or nil if there is no
+// such alias. KMS has no call to get a single alias, so all aliases are
+// listed.
+func FindAlias(ctx context.Context, c Client, name string) (*kms.AliasListEntry, error) {
+	input := &kms.ListAliasesInput{}
+	for {
+		rsp, err := c.ListAliasesRequest(input).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range rsp.Aliases {
+			if aws.StringValue(rsp.Aliases[i].AliasName) == name {
+				return &rsp.Aliases[i], nil
+			}
+		}
+		if !aws.BoolValue(rsp.Truncated) {
+			return nil, nil
+		}
+		input = &kms.ListAliasesInput{Marker: rsp.NextMarker}
+	}
+}
+
+// GenerateObservation is used to produce v1alpha1.AliasObservation from
+// kms.AliasListEntry.
+func GenerateObservation(a kms.AliasListEntry) v1alpha1.AliasObservation {
+	return v1alpha1.AliasObservation{
+		AliasARN:    aws.StringValue(a.AliasArn),
+		TargetKeyID: aws.StringValue(a.TargetKeyId),
+	}
+}
+
+// IsUpToDate checks whether the alias points to the desired key. The key may
+// be given by its ID or its ARN, which ends with the ID.
+func IsUpToDate(p v1alpha1.AliasParameters, a kms.AliasListEntry) bool {
+	desired, current := aws.StringValue(p.TargetKeyID), aws.StringValue(a.TargetKeyId)
+	return desired == current || strings.HasSuffix(desired, "/"+current)
+}
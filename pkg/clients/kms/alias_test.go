@@ -0,0 +1,122 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kms
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/kms/fake"
+)
+
+var (
+	keyID  = "1234abcd-12ab-34cd-56ef-1234567890ab"
+	keyARN = "arn:aws:kms:us-east-1:123456789012:key/" + keyID
+)
+
+func TestAliasName(t *testing.T) {
+	cases := map[string]struct {
+		in   string
+		want string
+	}{
+		"WithoutPrefix": {in: "test", want: "alias/test"},
+		"WithPrefix":    {in: "alias/test", want: "alias/test"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, AliasName(tc.in)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindAlias(t *testing.T) {
+	pages := map[string]*kms.ListAliasesOutput{
+		"": {
+			Aliases:    []kms.AliasListEntry{{AliasName: aws.String("alias/first")}},
+			Truncated:  aws.Bool(true),
+			NextMarker: aws.String("next"),
+		},
+		"next": {
+			Aliases: []kms.AliasListEntry{{AliasName: aws.String("alias/second"), TargetKeyId: aws.String(keyID)}},
+		},
+	}
+	c := &fake.MockClient{
+		MockList: func(input *kms.ListAliasesInput) kms.ListAliasesRequest {
+			return kms.ListAliasesRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: pages[aws.StringValue(input.Marker)]},
+			}
+		},
+	}
+
+	cases := map[string]struct {
+		name string
+		want *kms.AliasListEntry
+	}{
+		"FirstPage": {
+			name: "alias/first",
+			want: &kms.AliasListEntry{AliasName: aws.String("alias/first")},
+		},
+		"LaterPage": {
+			name: "alias/second",
+			want: &kms.AliasListEntry{AliasName: aws.String("alias/second"), TargetKeyId: aws.String(keyID)},
+		},
+		"Missing": {
+			name: "alias/missing",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := FindAlias(context.Background(), c, tc.name)
+			if err != nil {
+				t.Fatalf("FindAlias(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		desired string
+		want    bool
+	}{
+		"SameID":    {desired: keyID, want: true},
+		"SameARN":   {desired: keyARN, want: true},
+		"Different": {desired: "other", want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(v1alpha1.AliasParameters{TargetKeyID: aws.String(tc.desired)}, kms.AliasListEntry{TargetKeyId: aws.String(keyID)})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
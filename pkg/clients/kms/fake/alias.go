@@ -0,0 +1,49 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+)
+
+// MockClient for testing.
+type MockClient struct {
+	MockList   func(*kms.ListAliasesInput) kms.ListAliasesRequest
+	MockCreate func(*kms.CreateAliasInput) kms.CreateAliasRequest
+	MockUpdate func(*kms.UpdateAliasInput) kms.UpdateAliasRequest
+	MockDelete func(*kms.DeleteAliasInput) kms.DeleteAliasRequest
+}
+
+// ListAliasesRequest calls the underlying MockList method.
+func (m *MockClient) ListAliasesRequest(i *kms.ListAliasesInput) kms.ListAliasesRequest {
+	return m.MockList(i)
+}
+
+// CreateAliasRequest calls the underlying MockCreate method.
+func (m *MockClient) CreateAliasRequest(i *kms.CreateAliasInput) kms.CreateAliasRequest {
+	return m.MockCreate(i)
+}
+
+// UpdateAliasRequest calls the underlying MockUpdate method.
+func (m *MockClient) UpdateAliasRequest(i *kms.UpdateAliasInput) kms.UpdateAliasRequest {
+	return m.MockUpdate(i)
+}
+
+// DeleteAliasRequest calls the underlying MockDelete method.
+func (m *MockClient) DeleteAliasRequest(i *kms.DeleteAliasInput) kms.DeleteAliasRequest {
+	return m.MockDelete(i)
+}
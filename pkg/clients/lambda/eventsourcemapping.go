@@ -0,0 +1,124 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package lambda
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+)
+
+// EventSourceMappingClient defines Lambda EventSourceMapping client operations
+type EventSourceMappingClient interface {
+	CreateEventSourceMappingRequest(input *lambda.CreateEventSourceMappingInput) lambda.CreateEventSourceMappingRequest
+	GetEventSourceMappingRequest(input *lambda.GetEventSourceMappingInput) lambda.GetEventSourceMappingRequest
+	UpdateEventSourceMappingRequest(input *lambda.UpdateEventSourceMappingInput) lambda.UpdateEventSourceMappingRequest
+	DeleteEventSourceMappingRequest(input *lambda.DeleteEventSourceMappingInput) lambda.DeleteEventSourceMappingRequest
+}
+
+// NewEventSourceMappingClient creates new Lambda Client with provided AWS
+// Configurations/Credentials
+func NewEventSourceMappingClient(cfg aws.Config) EventSourceMappingClient {
+	return lambda.New(cfg)
+}
+
+// GenerateCreateEventSourceMappingInput returns the create input for the event
+// source mapping described by p.
+func GenerateCreateEventSourceMappingInput(p v1alpha1.EventSourceMappingParameters) *lambda.CreateEventSourceMappingInput {
+	return &lambda.CreateEventSourceMappingInput{
+		FunctionName:                   p.FunctionName,
+		EventSourceArn:                 p.EventSourceARN,
+		BatchSize:                      p.BatchSize,
+		MaximumBatchingWindowInSeconds: p.MaximumBatchingWindowInSeconds,
+		Enabled:                        p.Enabled,
+		StartingPosition:               lambda.EventSourcePosition(aws.StringValue(p.StartingPosition)),
+	}
+}
+
+// GenerateUpdateEventSourceMappingInput returns the update input for the event
+// source mapping with the given UUID.
+func GenerateUpdateEventSourceMappingInput(uuid string, p v1alpha1.EventSourceMappingParameters) *lambda.UpdateEventSourceMappingInput {
+	return &lambda.UpdateEventSourceMappingInput{
+		UUID:                           aws.String(uuid),
+		FunctionName:                   p.FunctionName,
+		BatchSize:                      p.BatchSize,
+		MaximumBatchingWindowInSeconds: p.MaximumBatchingWindowInSeconds,
+		Enabled:                        p.Enabled,
+	}
+}
+
+// GenerateEventSourceMappingObservation is used to produce
+// v1alpha1.EventSourceMappingObservation from lambda.GetEventSourceMappingOutput.
+func GenerateEventSourceMappingObservation(o lambda.GetEventSourceMappingOutput) v1alpha1.EventSourceMappingObservation {
+	return v1alpha1.EventSourceMappingObservation{
+		FunctionARN:           aws.StringValue(o.FunctionArn),
+		State:                 aws.StringValue(o.State),
+		StateTransitionReason: aws.StringValue(o.StateTransitionReason),
+		LastProcessingResult:  aws.StringValue(o.LastProcessingResult),
+	}
+}
+
+// LateInitializeEventSourceMapping fills the empty fields in
+// *v1alpha1.EventSourceMappingParameters with the values seen in
+// lambda.GetEventSourceMappingOutput.
+func LateInitializeEventSourceMapping(in *v1alpha1.EventSourceMappingParameters, o *lambda.GetEventSourceMappingOutput) {
+	if o == nil {
+		return
+	}
+	if in.BatchSize == nil {
+		in.BatchSize = o.BatchSize
+	}
+	if in.MaximumBatchingWindowInSeconds == nil {
+		in.MaximumBatchingWindowInSeconds = o.MaximumBatchingWindowInSeconds
+	}
+}
+
+// IsEventSourceMappingEnabled returns whether the function is invoked for the
+// events of an event source mapping in the given state, or will be once the
+// state settles.
+func IsEventSourceMappingEnabled(state string) bool {
+	switch state {
+	case v1alpha1.EventSourceMappingStateCreating, v1alpha1.EventSourceMappingStateEnabling, v1alpha1.EventSourceMappingStateEnabled:
+		return true
+	}
+	return false
+}
+
+// IsEventSourceMappingUpToDate checks whether the observed event source
+// mapping matches the desired parameters.
+func IsEventSourceMappingUpToDate(p v1alpha1.EventSourceMappingParameters, o lambda.GetEventSourceMappingOutput) bool {
+	switch {
+	case p.BatchSize != nil && aws.Int64Value(p.BatchSize) != aws.Int64Value(o.BatchSize),
+		p.MaximumBatchingWindowInSeconds != nil && aws.Int64Value(p.MaximumBatchingWindowInSeconds) != aws.Int64Value(o.MaximumBatchingWindowInSeconds),
+		p.Enabled != nil && aws.BoolValue(p.Enabled) != IsEventSourceMappingEnabled(aws.StringValue(o.State)),
+		!isFunction(aws.StringValue(p.FunctionName), aws.StringValue(o.FunctionArn)):
+		return false
+	}
+	return true
+}
+
+// isFunction returns whether the given function name, which may also be a
+// partial or full ARN, refers to the function with the given ARN.
+func isFunction(name, arn string) bool {
+	if name == arn || strings.HasSuffix(arn, ":function:"+name) {
+		return true
+	}
+	// Partial ARNs, e.g. 123456789012:function:my-function.
+	return strings.Contains(name, ":function:") && strings.HasSuffix(arn, ":"+name)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package lambda
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+)
+
+const functionARN = "arn:aws:lambda:us-east-1:123456789012:function:test"
+
+func TestIsEventSourceMappingUpToDate(t *testing.T) {
+	observed := lambda.GetEventSourceMappingOutput{
+		FunctionArn:                    aws.String(functionARN),
+		BatchSize:                      aws.Int64(10),
+		MaximumBatchingWindowInSeconds: aws.Int64(0),
+		State:                          aws.String(v1alpha1.EventSourceMappingStateEnabled),
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.EventSourceMappingParameters
+		want bool
+	}{
+		"FunctionName": {
+			p:    v1alpha1.EventSourceMappingParameters{FunctionName: aws.String("test"), BatchSize: aws.Int64(10)},
+			want: true,
+		},
+		"FunctionARN": {
+			p:    v1alpha1.EventSourceMappingParameters{FunctionName: aws.String(functionARN), Enabled: aws.Bool(true)},
+			want: true,
+		},
+		"PartialFunctionARN": {
+			p:    v1alpha1.EventSourceMappingParameters{FunctionName: aws.String("123456789012:function:test")},
+			want: true,
+		},
+		"DifferentFunction": {
+			p:    v1alpha1.EventSourceMappingParameters{FunctionName: aws.String("other")},
+			want: false,
+		},
+		"DifferentBatchSize": {
+			p:    v1alpha1.EventSourceMappingParameters{FunctionName: aws.String("test"), BatchSize: aws.Int64(5)},
+			want: false,
+		},
+		"DifferentBatchingWindow": {
+			p:    v1alpha1.EventSourceMappingParameters{FunctionName: aws.String("test"), MaximumBatchingWindowInSeconds: aws.Int64(30)},
+			want: false,
+		},
+		"Disabled": {
+			p:    v1alpha1.EventSourceMappingParameters{FunctionName: aws.String("test"), Enabled: aws.Bool(false)},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsEventSourceMappingUpToDate(tc.p, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+)
+
+// MockEventSourceMappingClient for testing.
+type MockEventSourceMappingClient struct {
+	MockCreate func(*lambda.CreateEventSourceMappingInput) lambda.CreateEventSourceMappingRequest
+	MockGet    func(*lambda.GetEventSourceMappingInput) lambda.GetEventSourceMappingRequest
+	MockUpdate func(*lambda.UpdateEventSourceMappingInput) lambda.UpdateEventSourceMappingRequest
+	MockDelete func(*lambda.DeleteEventSourceMappingInput) lambda.DeleteEventSourceMappingRequest
+}
+
+// CreateEventSourceMappingRequest calls the underlying MockCreate method.
+func (m *MockEventSourceMappingClient) CreateEventSourceMappingRequest(i *lambda.CreateEventSourceMappingInput) lambda.CreateEventSourceMappingRequest {
+	return m.MockCreate(i)
+}
+
+// GetEventSourceMappingRequest calls the underlying MockGet method.
+func (m *MockEventSourceMappingClient) GetEventSourceMappingRequest(i *lambda.GetEventSourceMappingInput) lambda.GetEventSourceMappingRequest {
+	return m.MockGet(i)
+}
+
+// UpdateEventSourceMappingRequest calls the underlying MockUpdate method.
+func (m *MockEventSourceMappingClient) UpdateEventSourceMappingRequest(i *lambda.UpdateEventSourceMappingInput) lambda.UpdateEventSourceMappingRequest {
+	return m.MockUpdate(i)
+}
+
+// DeleteEventSourceMappingRequest calls the underlying MockDelete method.
+func (m *MockEventSourceMappingClient) DeleteEventSourceMappingRequest(i *lambda.DeleteEventSourceMappingInput) lambda.DeleteEventSourceMappingRequest {
+	return m.MockDelete(i)
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+)
+
+// MockClient for testing.
+type MockClient struct {
+	MockCreate       func(*lambda.CreateFunctionInput) lambda.CreateFunctionRequest
+	MockGet          func(*lambda.GetFunctionInput) lambda.GetFunctionRequest
+	MockUpdateConfig func(*lambda.UpdateFunctionConfigurationInput) lambda.UpdateFunctionConfigurationRequest
+	MockUpdateCode   func(*lambda.UpdateFunctionCodeInput) lambda.UpdateFunctionCodeRequest
+	MockDelete       func(*lambda.DeleteFunctionInput) lambda.DeleteFunctionRequest
+}
+
+// CreateFunctionRequest calls the underlying MockCreate method.
+func (m *MockClient) CreateFunctionRequest(i *lambda.CreateFunctionInput) lambda.CreateFunctionRequest {
+	return m.MockCreate(i)
+}
+
+// GetFunctionRequest calls the underlying MockGet method.
+func (m *MockClient) GetFunctionRequest(i *lambda.GetFunctionInput) lambda.GetFunctionRequest {
+	return m.MockGet(i)
+}
+
+// UpdateFunctionConfigurationRequest calls the underlying MockUpdateConfig
+// method.
+func (m *MockClient) UpdateFunctionConfigurationRequest(i *lambda.UpdateFunctionConfigurationInput) lambda.UpdateFunctionConfigurationRequest {
+	return m.MockUpdateConfig(i)
+}
+
+// UpdateFunctionCodeRequest calls the underlying MockUpdateCode method.
+func (m *MockClient) UpdateFunctionCodeRequest(i *lambda.UpdateFunctionCodeInput) lambda.UpdateFunctionCodeRequest {
+	return m.MockUpdateCode(i)
+}
+
+// DeleteFunctionRequest calls the underlying MockDelete method.
+func (m *MockClient) DeleteFunctionRequest(i *lambda.DeleteFunctionInput) lambda.DeleteFunctionRequest {
+	return m.MockDelete(i)
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lambda
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// Client defines Lambda client operations
+type Client interface {
+	CreateFunctionRequest(input *lambda.CreateFunctionInput) lambda.CreateFunctionRequest
+	GetFunctionRequest(input *lambda.GetFunctionInput) lambda.GetFunctionRequest
+	UpdateFunctionConfigurationRequest(input *lambda.UpdateFunctionConfigurationInput) lambda.UpdateFunctionConfigurationRequest
+	UpdateFunctionCodeRequest(input *lambda.UpdateFunctionCodeInput) lambda.UpdateFunctionCodeRequest
+	DeleteFunctionRequest(input *lambda.DeleteFunctionInput) lambda.DeleteFunctionRequest
+}
+
+// NewClient creates new Lambda Client with provided AWS Configurations/Credentials
+func NewClient(cfg aws.Config) Client {
+	return lambda.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the function doesn't exist
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == lambda.ErrCodeResourceNotFoundException
+	}
+	return false
+}
+
+// GenerateCreateFunctionInput returns the create input for the function with
+// the given name.
+func GenerateCreateFunctionInput(name string, p v1alpha1.FunctionParameters) *lambda.CreateFunctionInput {
+	return &lambda.CreateFunctionInput{
+		FunctionName: aws.String(name),
+		Runtime:      lambda.Runtime(p.Runtime),
+		Handler:      aws.String(p.Handler),
+		Role:         p.Role,
+		MemorySize:   p.MemorySize,
+		Timeout:      p.Timeout,
+		Description:  p.Description,
+		Code: &lambda.FunctionCode{
+			S3Bucket:        p.Code.S3Bucket,
+			S3Key:           aws.String(p.Code.S3Key),
+			S3ObjectVersion: p.Code.S3ObjectVersion,
+		},
+	}
+}
+
+// GenerateUpdateFunctionConfigurationInput returns the configuration update
+// input for the function with the given name.
+func GenerateUpdateFunctionConfigurationInput(name string, p v1alpha1.FunctionParameters) *lambda.UpdateFunctionConfigurationInput {
+	return &lambda.UpdateFunctionConfigurationInput{
+		FunctionName: aws.String(name),
+		Runtime:      lambda.Runtime(p.Runtime),
+		Handler:      aws.String(p.Handler),
+		Role:         p.Role,
+		MemorySize:   p.MemorySize,
+		Timeout:      p.Timeout,
+		Description:  p.Description,
+	}
+}
+
+// GenerateUpdateFunctionCodeInput returns the code update input for the
+// function with the given name.
+func GenerateUpdateFunctionCodeInput(name string, p v1alpha1.FunctionParameters) *lambda.UpdateFunctionCodeInput {
+	return &lambda.UpdateFunctionCodeInput{
+		FunctionName:    aws.String(name),
+		S3Bucket:        p.Code.S3Bucket,
+		S3Key:           aws.String(p.Code.S3Key),
+		S3ObjectVersion: p.Code.S3ObjectVersion,
+	}
+}
+
+// GenerateObservation is used to produce v1alpha1.FunctionObservation from
+// lambda.FunctionConfiguration.
+func GenerateObservation(c lambda.FunctionConfiguration) v1alpha1.FunctionObservation {
+	return v1alpha1.FunctionObservation{
+		FunctionARN:      aws.StringValue(c.FunctionArn),
+		State:            string(c.State),
+		StateReason:      aws.StringValue(c.StateReason),
+		LastUpdateStatus: string(c.LastUpdateStatus),
+		CodeSHA256:       aws.StringValue(c.CodeSha256),
+	}
+}
+
+// LateInitialize fills the empty fields in *v1alpha1.FunctionParameters with
+// the values seen in lambda.FunctionConfiguration.
+func LateInitialize(in *v1alpha1.FunctionParameters, c *lambda.FunctionConfiguration) {
+	if c == nil {
+		return
+	}
+	in.Role = awsclients.LateInitializeStringPtr(in.Role, c.Role)
+	in.MemorySize = awsclients.LateInitializeInt64Ptr(in.MemorySize, c.MemorySize)
+	in.Timeout = awsclients.LateInitializeInt64Ptr(in.Timeout, c.Timeout)
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, c.Description)
+}
+
+// IsConfigurationUpToDate checks whether there is a change in any of the
+// modifiable configuration fields.
+func IsConfigurationUpToDate(p v1alpha1.FunctionParameters, c lambda.FunctionConfiguration) bool {
+	return p.Runtime == string(c.Runtime) &&
+		p.Handler == aws.StringValue(c.Handler) &&
+		aws.StringValue(p.Role) == aws.StringValue(c.Role) &&
+		aws.Int64Value(p.MemorySize) == aws.Int64Value(c.MemorySize) &&
+		aws.Int64Value(p.Timeout) == aws.Int64Value(c.Timeout) &&
+		aws.StringValue(p.Description) == aws.StringValue(c.Description)
+}
+
+// IsCodeUpToDate checks whether the S3 object version in the spec has been
+// deployed. Lambda doesn't report the object version, so it's compared to the
+// last deployed version recorded in the status.
+func IsCodeUpToDate(p v1alpha1.FunctionParameters, o v1alpha1.FunctionObservation) bool {
+	return aws.StringValue(p.Code.S3ObjectVersion) == o.CodeS3ObjectVersion
+}
+
+// GetConnectionDetails returns the connection details of the function.
+func GetConnectionDetails(in v1alpha1.Function) managed.ConnectionDetails {
+	if in.Status.AtProvider.FunctionARN == "" {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		v1alpha1.ResourceCredentialsSecretARNKey: []byte(in.Status.AtProvider.FunctionARN),
+	}
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/optiongroup"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockOptionGroupClient)(nil)
+
+// MockOptionGroupClient is a type that implements all the methods for the
+// OptionGroup Client interface
+type MockOptionGroupClient struct {
+	MockCreate   func(*rds.CreateOptionGroupInput) rds.CreateOptionGroupRequest
+	MockDescribe func(*rds.DescribeOptionGroupsInput) rds.DescribeOptionGroupsRequest
+	MockModify   func(*rds.ModifyOptionGroupInput) rds.ModifyOptionGroupRequest
+	MockDelete   func(*rds.DeleteOptionGroupInput) rds.DeleteOptionGroupRequest
+}
+
+// CreateOptionGroupRequest mocks CreateOptionGroupRequest method
+func (m *MockOptionGroupClient) CreateOptionGroupRequest(input *rds.CreateOptionGroupInput) rds.CreateOptionGroupRequest {
+	return m.MockCreate(input)
+}
+
+// DescribeOptionGroupsRequest mocks DescribeOptionGroupsRequest method
+func (m *MockOptionGroupClient) DescribeOptionGroupsRequest(input *rds.DescribeOptionGroupsInput) rds.DescribeOptionGroupsRequest {
+	return m.MockDescribe(input)
+}
+
+// ModifyOptionGroupRequest mocks ModifyOptionGroupRequest method
+func (m *MockOptionGroupClient) ModifyOptionGroupRequest(input *rds.ModifyOptionGroupInput) rds.ModifyOptionGroupRequest {
+	return m.MockModify(input)
+}
+
+// DeleteOptionGroupRequest mocks DeleteOptionGroupRequest method
+func (m *MockOptionGroupClient) DeleteOptionGroupRequest(input *rds.DeleteOptionGroupInput) rds.DeleteOptionGroupRequest {
+	return m.MockDelete(input)
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package optiongroup
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+)
+
+// Client is the external client used for OptionGroup Custom Resource
+type Client interface {
+	CreateOptionGroupRequest(input *rds.CreateOptionGroupInput) rds.CreateOptionGroupRequest
+	DescribeOptionGroupsRequest(input *rds.DescribeOptionGroupsInput) rds.DescribeOptionGroupsRequest
+	ModifyOptionGroupRequest(input *rds.ModifyOptionGroupInput) rds.ModifyOptionGroupRequest
+	DeleteOptionGroupRequest(input *rds.DeleteOptionGroupInput) rds.DeleteOptionGroupRequest
+}
+
+// NewClient returns a new client using AWS credentials as JSON encoded data.
+func NewClient(cfg aws.Config) Client {
+	return rds.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the option group doesn't
+// exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == rds.ErrCodeOptionGroupNotFoundFault
+	}
+	return false
+}
+
+// GenerateObservation is used to produce v1alpha1.OptionGroupObservation from
+// rds.OptionGroup.
+func GenerateObservation(og rds.OptionGroup) v1alpha1.OptionGroupObservation {
+	o := v1alpha1.OptionGroupObservation{
+		OptionGroupARN: aws.StringValue(og.OptionGroupArn),
+		VPCID:          aws.StringValue(og.VpcId),
+	}
+	for _, opt := range og.Options {
+		o.Options = append(o.Options, aws.StringValue(opt.OptionName))
+	}
+	return o
+}
+
+// GenerateOptionConfiguration returns the configuration that adds or changes
+// the given option.
+func GenerateOptionConfiguration(opt v1alpha1.OptionConfiguration) rds.OptionConfiguration {
+	c := rds.OptionConfiguration{
+		OptionName:                  aws.String(opt.OptionName),
+		OptionVersion:               opt.OptionVersion,
+		Port:                        opt.Port,
+		VpcSecurityGroupMemberships: opt.VPCSecurityGroupMemberships,
+	}
+	for _, s := range opt.OptionSettings {
+		c.OptionSettings = append(c.OptionSettings, rds.OptionSetting{Name: aws.String(s.Name), Value: aws.String(s.Value)})
+	}
+	return c
+}
+
+// DiffOptions returns the options that have to be added or changed and the
+// names of the options that have to be removed so that the option group
+// matches the desired options. Permanent options cannot be removed and are
+// left alone.
+func DiffOptions(p v1alpha1.OptionGroupParameters, og rds.OptionGroup) ([]rds.OptionConfiguration, []string) {
+	current := make(map[string]rds.Option, len(og.Options))
+	for _, opt := range og.Options {
+		current[aws.StringValue(opt.OptionName)] = opt
+	}
+	var include []rds.OptionConfiguration
+	desired := make(map[string]bool, len(p.Options))
+	for _, opt := range p.Options {
+		desired[opt.OptionName] = true
+		if c, ok := current[opt.OptionName]; ok && isOptionUpToDate(opt, c) {
+			continue
+		}
+		include = append(include, GenerateOptionConfiguration(opt))
+	}
+	var remove []string
+	for name, opt := range current {
+		if !desired[name] && !aws.BoolValue(opt.Permanent) {
+			remove = append(remove, name)
+		}
+	}
+	sort.Strings(remove)
+	return include, remove
+}
+
+// isOptionUpToDate checks whether the option has the desired configuration.
+// Fields and settings that are not given are not compared, since AWS fills
+// them with defaults.
+func isOptionUpToDate(desired v1alpha1.OptionConfiguration, current rds.Option) bool {
+	if desired.OptionVersion != nil && aws.StringValue(desired.OptionVersion) != aws.StringValue(current.OptionVersion) {
+		return false
+	}
+	if desired.Port != nil && aws.Int64Value(desired.Port) != aws.Int64Value(current.Port) {
+		return false
+	}
+	if len(desired.VPCSecurityGroupMemberships) != 0 {
+		groups := make(map[string]bool, len(current.VpcSecurityGroupMemberships))
+		for _, m := range current.VpcSecurityGroupMemberships {
+			groups[aws.StringValue(m.VpcSecurityGroupId)] = true
+		}
+		if len(groups) != len(desired.VPCSecurityGroupMemberships) {
+			return false
+		}
+		for _, id := range desired.VPCSecurityGroupMemberships {
+			if !groups[id] {
+				return false
+			}
+		}
+	}
+	settings := make(map[string]string, len(current.OptionSettings))
+	for _, s := range current.OptionSettings {
+		settings[aws.StringValue(s.Name)] = aws.StringValue(s.Value)
+	}
+	for _, s := range desired.OptionSettings {
+		if v, ok := settings[s.Name]; !ok || v != s.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// IsUpToDate checks whether the option group has the desired options.
+func IsUpToDate(p v1alpha1.OptionGroupParameters, og rds.OptionGroup) bool {
+	include, remove := DiffOptions(p, og)
+	return len(include) == 0 && len(remove) == 0
+}
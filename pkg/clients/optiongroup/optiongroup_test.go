@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package optiongroup
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+)
+
+var (
+	auditOption = v1alpha1.OptionConfiguration{
+		OptionName:     "SQLSERVER_AUDIT",
+		OptionSettings: []v1alpha1.OptionSetting{{Name: "ENABLE_COMPRESSION", Value: "true"}},
+	}
+	s3Option = v1alpha1.OptionConfiguration{OptionName: "S3_INTEGRATION", OptionVersion: aws.String("1.0")}
+)
+
+func TestDiffOptions(t *testing.T) {
+	type want struct {
+		include []rds.OptionConfiguration
+		remove  []string
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.OptionGroupParameters
+		og   rds.OptionGroup
+		want want
+	}{
+		"UpToDate": {
+			p: v1alpha1.OptionGroupParameters{Options: []v1alpha1.OptionConfiguration{auditOption, s3Option}},
+			og: rds.OptionGroup{Options: []rds.Option{
+				{
+					OptionName: aws.String("SQLSERVER_AUDIT"),
+					OptionSettings: []rds.OptionSetting{
+						{Name: aws.String("ENABLE_COMPRESSION"), Value: aws.String("true")},
+						{Name: aws.String("RETENTION_TIME"), Value: aws.String("0")},
+					},
+				},
+				{OptionName: aws.String("S3_INTEGRATION"), OptionVersion: aws.String("1.0")},
+			}},
+		},
+		"AddOption": {
+			p:  v1alpha1.OptionGroupParameters{Options: []v1alpha1.OptionConfiguration{s3Option}},
+			og: rds.OptionGroup{},
+			want: want{
+				include: []rds.OptionConfiguration{{OptionName: aws.String("S3_INTEGRATION"), OptionVersion: aws.String("1.0")}},
+			},
+		},
+		"ChangeSetting": {
+			p: v1alpha1.OptionGroupParameters{Options: []v1alpha1.OptionConfiguration{auditOption}},
+			og: rds.OptionGroup{Options: []rds.Option{{
+				OptionName:     aws.String("SQLSERVER_AUDIT"),
+				OptionSettings: []rds.OptionSetting{{Name: aws.String("ENABLE_COMPRESSION"), Value: aws.String("false")}},
+			}}},
+			want: want{
+				include: []rds.OptionConfiguration{{
+					OptionName:     aws.String("SQLSERVER_AUDIT"),
+					OptionSettings: []rds.OptionSetting{{Name: aws.String("ENABLE_COMPRESSION"), Value: aws.String("true")}},
+				}},
+			},
+		},
+		"RemoveOption": {
+			p: v1alpha1.OptionGroupParameters{},
+			og: rds.OptionGroup{Options: []rds.Option{
+				{OptionName: aws.String("S3_INTEGRATION")},
+				{OptionName: aws.String("TDE"), Permanent: aws.Bool(true)},
+			}},
+			want: want{
+				remove: []string{"S3_INTEGRATION"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			include, remove := DiffOptions(tc.p, tc.og)
+			if diff := cmp.Diff(tc.want.include, include); diff != "" {
+				t.Errorf("include: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(len(include) == 0 && len(remove) == 0, IsUpToDate(tc.p, tc.og)); diff != "" {
+				t.Errorf("IsUpToDate: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// DefaultReconcileTimeout is the default time that all calls made by a single
+// reconcile of a managed resource may take together. It matches the default of
+// the managed resource reconciler.
+const DefaultReconcileTimeout = time.Minute
+
+// Options configure how the AWS controllers reconcile managed resources and
+// how their AWS clients send requests.
+type Options struct {
+	// MaxConcurrentReconciles is the number of managed resources of each kind
+	// that are reconciled in parallel. Higher values keep slow AWS calls from
+	// holding up every other resource of the same kind, but send more requests
+	// to AWS at once and so hit the AWS API rate limits sooner. Values below 1
+	// are treated as 1.
+	MaxConcurrentReconciles int
+
+	// ReconcileTimeout is how long all calls made by a single reconcile of a
+	// managed resource may take together. Calls to AWS that are still running
+	// when it expires are cancelled, so that a hung call cannot block a worker
+	// indefinitely. Non-positive values are treated as
+	// DefaultReconcileTimeout.
+	ReconcileTimeout time.Duration
+
+	// DriftPollInterval is how often a managed resource whose spec hasn't
+	// changed since it was last observed as available and up to date is
+	// observed in AWS. Zero observes every resource on every poll.
+	DriftPollInterval time.Duration
+
+	// DescribeCacheTTL is how long controllers that cache the observed state
+	// of their external resources may reuse it instead of describing them
+	// again. Zero disables caching.
+	DescribeCacheTTL time.Duration
+
+	// CredentialsCheckInterval is how often the credentials of a
+	// ProviderConfig are checked with AWS, so that expired or revoked
+	// credentials are noticed. Zero disables the check.
+	CredentialsCheckInterval time.Duration
+
+	// API configures the requests the AWS clients of the controllers send.
+	API APIOptions
+}
+
+// WithDefaults returns the options with the fields that are out of range set
+// to their defaults.
+func (o Options) WithDefaults() Options {
+	if o.MaxConcurrentReconciles < 1 {
+		o.MaxConcurrentReconciles = 1
+	}
+	if o.ReconcileTimeout <= 0 {
+		o.ReconcileTimeout = DefaultReconcileTimeout
+	}
+	if o.DriftPollInterval < 0 {
+		o.DriftPollInterval = 0
+	}
+	if o.DescribeCacheTTL < 0 {
+		o.DescribeCacheTTL = 0
+	}
+	if o.CredentialsCheckInterval < 0 {
+		o.CredentialsCheckInterval = 0
+	}
+	return o
+}
+
+// APIOptions configure the requests AWS clients send.
+type APIOptions struct {
+	// Limiter throttles the requests that all AWS clients send in total.
+	// There is no limit if it's nil.
+	Limiter *rate.Limiter
+
+	// Logger logs the requests that AWS clients send at debug level. Nothing
+	// is logged if it's nil.
+	Logger logging.Logger
+
+	// RetryMode is how failed requests are retried, either RetryModeStandard
+	// or RetryModeAdaptive. RetryModeStandard is used if it's empty.
+	RetryMode string
+
+	// MaxAttempts is the number of attempts made for each request, including
+	// the first one. The default of the AWS SDK is used if it's not positive.
+	MaxAttempts int
+}
+
+// NewAPIRateLimiter returns a limiter that allows the given number of requests
+// per second, with bursts of the given size. It returns nil, i.e. no limit,
+// for a non-positive rps.
+func NewAPIRateLimiter(rps float64, burst int) *rate.Limiter {
+	if rps <= 0 {
+		return nil
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return rate.NewLimiter(rate.Limit(rps), burst)
+}
+
+type apiOptionsKey struct{}
+
+// WithAPIOptions returns a context that makes GetConfig and GetConfigV1
+// configure the requests of the AWS clients they are used for with the given
+// options.
+func WithAPIOptions(ctx context.Context, o APIOptions) context.Context {
+	return context.WithValue(ctx, apiOptionsKey{}, o)
+}
+
+func apiOptionsFrom(ctx context.Context) APIOptions {
+	o, _ := ctx.Value(apiOptionsKey{}).(APIOptions)
+	return o
+}
+
+// NewConnecter returns an ExternalConnecter that connects with c, configuring
+// the AWS clients c creates with the API options of o, and that skips
+// observing unchanged managed resources as set by the drift poll interval of o.
+func NewConnecter(c managed.ExternalConnecter, o Options) managed.ExternalConnecter {
+	return TrackGeneration(&apiConnecter{ExternalConnecter: c, api: o.API}, o.DriftPollInterval)
+}
+
+// An apiConnecter connects with the API options it was created with.
+type apiConnecter struct {
+	managed.ExternalConnecter
+	api APIOptions
+}
+
+func (c *apiConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	return c.ExternalConnecter.Connect(WithAPIOptions(ctx, c.api), mg)
+}
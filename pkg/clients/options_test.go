@@ -0,0 +1,74 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+)
+
+func TestOptionsWithDefaults(t *testing.T) {
+	cases := map[string]struct {
+		o    Options
+		want Options
+	}{
+		"Unset": {
+			o:    Options{},
+			want: Options{MaxConcurrentReconciles: 1, ReconcileTimeout: DefaultReconcileTimeout},
+		},
+		"OutOfRange": {
+			o:    Options{MaxConcurrentReconciles: -1, ReconcileTimeout: -time.Second, DriftPollInterval: -time.Second, DescribeCacheTTL: -time.Second, CredentialsCheckInterval: -time.Second},
+			want: Options{MaxConcurrentReconciles: 1, ReconcileTimeout: DefaultReconcileTimeout},
+		},
+		"Set": {
+			o:    Options{MaxConcurrentReconciles: 5, ReconcileTimeout: 5 * time.Minute, DriftPollInterval: time.Minute, DescribeCacheTTL: time.Second, CredentialsCheckInterval: time.Minute},
+			want: Options{MaxConcurrentReconciles: 5, ReconcileTimeout: 5 * time.Minute, DriftPollInterval: time.Minute, DescribeCacheTTL: time.Second, CredentialsCheckInterval: time.Minute},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, tc.o.WithDefaults()); diff != "" {
+				t.Errorf("WithDefaults(): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewConnecter(t *testing.T) {
+	api := APIOptions{RetryMode: RetryModeAdaptive, MaxAttempts: 5}
+
+	var got APIOptions
+	c := NewConnecter(managed.ExternalConnectorFn(func(ctx context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		got = apiOptionsFrom(ctx)
+		return nil, nil
+	}), Options{API: api})
+
+	if _, err := c.Connect(context.Background(), &fake.Managed{}); err != nil {
+		t.Fatalf("Connect(...): %s", err)
+	}
+	if diff := cmp.Diff(api, got); diff != "" {
+		t.Errorf("Connect(...): -want API options, +got:\n%s", diff)
+	}
+}
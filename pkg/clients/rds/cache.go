@@ -0,0 +1,88 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rds
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+)
+
+// A DescribeCache remembers the DB instances returned by DescribeDBInstances,
+// along with their tags, for a short time, so that reconciles that follow each
+// other quickly don't describe the same instance again. A nil DescribeCache,
+// or one with a TTL of zero, caches nothing.
+type DescribeCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu        sync.Mutex
+	instances map[string]cachedInstance
+}
+
+type cachedInstance struct {
+	instance rds.DBInstance
+	tags     []rds.Tag
+	time     time.Time
+}
+
+// NewDescribeCache returns a DescribeCache whose entries expire after the
+// given TTL.
+func NewDescribeCache(ttl time.Duration) *DescribeCache {
+	return &DescribeCache{ttl: ttl, now: time.Now, instances: map[string]cachedInstance{}}
+}
+
+// Get returns the DB instance and tags cached with the given key, if they
+// haven't expired.
+func (c *DescribeCache) Get(key string) (rds.DBInstance, []rds.Tag, bool) {
+	if c == nil || c.ttl <= 0 {
+		return rds.DBInstance{}, nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	i, ok := c.instances[key]
+	if !ok {
+		return rds.DBInstance{}, nil, false
+	}
+	if c.now().Sub(i.time) >= c.ttl {
+		delete(c.instances, key)
+		return rds.DBInstance{}, nil, false
+	}
+	return i.instance, i.tags, true
+}
+
+// Set caches the given DB instance and its tags with the given key.
+func (c *DescribeCache) Set(key string, db rds.DBInstance, tags []rds.Tag) {
+	if c == nil || c.ttl <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.instances[key] = cachedInstance{instance: db, tags: tags, time: c.now()}
+}
+
+// Invalidate removes the DB instance cached with the given key. It must be
+// called after any change is made to the DB instance or its tags.
+func (c *DescribeCache) Invalidate(key string) {
+	if c == nil || c.ttl <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.instances, key)
+}
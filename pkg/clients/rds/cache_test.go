@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rds
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDescribeCache(t *testing.T) {
+	now := time.Now()
+	db := rds.DBInstance{DBInstanceIdentifier: aws.String("prod")}
+	tags := []rds.Tag{{Key: aws.String("team"), Value: aws.String("data")}}
+
+	type want struct {
+		db   rds.DBInstance
+		tags []rds.Tag
+		ok   bool
+	}
+
+	cases := map[string]struct {
+		cache *DescribeCache
+		fn    func(c *DescribeCache)
+		want  want
+	}{
+		"Cached": {
+			cache: &DescribeCache{ttl: time.Minute, now: func() time.Time { return now }, instances: map[string]cachedInstance{}},
+			fn:    func(c *DescribeCache) { c.Set("key", db, tags) },
+			want:  want{db: db, tags: tags, ok: true},
+		},
+		"Expired": {
+			cache: &DescribeCache{ttl: time.Minute, now: func() time.Time { return now }, instances: map[string]cachedInstance{
+				"key": {instance: db, tags: tags, time: now.Add(-time.Minute)},
+			}},
+			fn:   func(c *DescribeCache) {},
+			want: want{},
+		},
+		"Invalidated": {
+			cache: &DescribeCache{ttl: time.Minute, now: func() time.Time { return now }, instances: map[string]cachedInstance{}},
+			fn: func(c *DescribeCache) {
+				c.Set("key", db, tags)
+				c.Invalidate("key")
+			},
+			want: want{},
+		},
+		"Disabled": {
+			cache: NewDescribeCache(0),
+			fn:    func(c *DescribeCache) { c.Set("key", db, tags) },
+			want:  want{},
+		},
+		"Nil": {
+			fn: func(c *DescribeCache) {
+				c.Set("key", db, tags)
+				c.Invalidate("key")
+			},
+			want: want{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.fn(tc.cache)
+			got, gotTags, ok := tc.cache.Get("key")
+			if diff := cmp.Diff(tc.want, want{db: got, tags: gotTags, ok: ok}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("Get(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -22,11 +22,21 @@ import (
 
 // MockRDSClient for testing.
 type MockRDSClient struct {
-	MockCreate   func(*rds.CreateDBInstanceInput) rds.CreateDBInstanceRequest
-	MockDescribe func(*rds.DescribeDBInstancesInput) rds.DescribeDBInstancesRequest
-	MockModify   func(*rds.ModifyDBInstanceInput) rds.ModifyDBInstanceRequest
-	MockDelete   func(*rds.DeleteDBInstanceInput) rds.DeleteDBInstanceRequest
-	MockAddTags  func(*rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest
+	MockCreate           func(*rds.CreateDBInstanceInput) rds.CreateDBInstanceRequest
+	MockDescribe         func(*rds.DescribeDBInstancesInput) rds.DescribeDBInstancesRequest
+	MockDescribeClusters func(*rds.DescribeDBClustersInput) rds.DescribeDBClustersRequest
+	MockModify           func(*rds.ModifyDBInstanceInput) rds.ModifyDBInstanceRequest
+	MockDelete           func(*rds.DeleteDBInstanceInput) rds.DeleteDBInstanceRequest
+	MockAddTags          func(*rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest
+	MockRemoveTags       func(*rds.RemoveTagsFromResourceInput) rds.RemoveTagsFromResourceRequest
+	MockListTags         func(*rds.ListTagsForResourceInput) rds.ListTagsForResourceRequest
+
+	MockCreateSnapshot      func(*rds.CreateDBSnapshotInput) rds.CreateDBSnapshotRequest
+	MockDescribeSnapshots   func(*rds.DescribeDBSnapshotsInput) rds.DescribeDBSnapshotsRequest
+	MockDeleteSnapshot      func(*rds.DeleteDBSnapshotInput) rds.DeleteDBSnapshotRequest
+	MockRestoreFromSnapshot func(*rds.RestoreDBInstanceFromDBSnapshotInput) rds.RestoreDBInstanceFromDBSnapshotRequest
+
+	MockDescribeOrderableOptions func(*rds.DescribeOrderableDBInstanceOptionsInput) rds.DescribeOrderableDBInstanceOptionsRequest
 }
 
 // DescribeDBInstancesRequest finds RDS Instance by name
@@ -34,6 +44,11 @@ func (m *MockRDSClient) DescribeDBInstancesRequest(i *rds.DescribeDBInstancesInp
 	return m.MockDescribe(i)
 }
 
+// DescribeDBClustersRequest mocks DescribeDBClustersRequest
+func (m *MockRDSClient) DescribeDBClustersRequest(i *rds.DescribeDBClustersInput) rds.DescribeDBClustersRequest {
+	return m.MockDescribeClusters(i)
+}
+
 // CreateDBInstanceRequest creates RDS Instance with provided Specification
 func (m *MockRDSClient) CreateDBInstanceRequest(i *rds.CreateDBInstanceInput) rds.CreateDBInstanceRequest {
 	return m.MockCreate(i)
@@ -53,3 +68,38 @@ func (m *MockRDSClient) DeleteDBInstanceRequest(i *rds.DeleteDBInstanceInput) rd
 func (m *MockRDSClient) AddTagsToResourceRequest(i *rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest {
 	return m.MockAddTags(i)
 }
+
+// RemoveTagsFromResourceRequest removes tags from RDS Instance.
+func (m *MockRDSClient) RemoveTagsFromResourceRequest(i *rds.RemoveTagsFromResourceInput) rds.RemoveTagsFromResourceRequest {
+	return m.MockRemoveTags(i)
+}
+
+// ListTagsForResourceRequest lists the tags of RDS Instance.
+func (m *MockRDSClient) ListTagsForResourceRequest(i *rds.ListTagsForResourceInput) rds.ListTagsForResourceRequest {
+	return m.MockListTags(i)
+}
+
+// DescribeOrderableDBInstanceOptionsRequest lists the available RDS Instance offerings.
+func (m *MockRDSClient) DescribeOrderableDBInstanceOptionsRequest(i *rds.DescribeOrderableDBInstanceOptionsInput) rds.DescribeOrderableDBInstanceOptionsRequest {
+	return m.MockDescribeOrderableOptions(i)
+}
+
+// CreateDBSnapshotRequest creates a snapshot of RDS Instance.
+func (m *MockRDSClient) CreateDBSnapshotRequest(i *rds.CreateDBSnapshotInput) rds.CreateDBSnapshotRequest {
+	return m.MockCreateSnapshot(i)
+}
+
+// DescribeDBSnapshotsRequest finds snapshots of RDS Instance.
+func (m *MockRDSClient) DescribeDBSnapshotsRequest(i *rds.DescribeDBSnapshotsInput) rds.DescribeDBSnapshotsRequest {
+	return m.MockDescribeSnapshots(i)
+}
+
+// DeleteDBSnapshotRequest deletes a snapshot of RDS Instance.
+func (m *MockRDSClient) DeleteDBSnapshotRequest(i *rds.DeleteDBSnapshotInput) rds.DeleteDBSnapshotRequest {
+	return m.MockDeleteSnapshot(i)
+}
+
+// RestoreDBInstanceFromDBSnapshotRequest restores a snapshot to a new RDS Instance.
+func (m *MockRDSClient) RestoreDBInstanceFromDBSnapshotRequest(i *rds.RestoreDBInstanceFromDBSnapshotInput) rds.RestoreDBInstanceFromDBSnapshotRequest {
+	return m.MockRestoreFromSnapshot(i)
+}
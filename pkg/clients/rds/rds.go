@@ -18,7 +18,11 @@ package rds
 
 import (
 	"context"
+	"crypto/rand"
 	"encoding/json"
+	"math/big"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -33,6 +37,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/password"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
@@ -42,15 +47,52 @@ import (
 
 const (
 	errGetPasswordSecretFailed = "cannot get password secret"
+	errIOPSStorageType         = "iops can only be set for io1 and io2 storage types"
+	errIOPSRatioFmt            = "iops must be between %d and %d times the allocated storage for %s storage type"
+	errDBNameFmt               = "dbName %q is not valid for %s: %s"
+	errAvailabilityZoneMultiAZ = "availabilityZone cannot be set for Multi-AZ instances"
+	errAvailabilityZoneFmt     = "availabilityZone %q is not in region %s"
+	errPasswordLengthFmt       = "masterPasswordLength %d is too short for %d character classes"
+	errPasswordClassFmt        = "unknown password character class %q"
+	errImmutableFieldsFmt      = "cannot change %s after creation: the RDS instance has to be deleted and created again to change them"
 )
 
+// Naming rules for the initial database. For Oracle the name is the SID of
+// the instance.
+var (
+	dbNameMySQL    = regexp.MustCompile(`^[a-zA-Z0-9]{1,64}$`)
+	dbNamePostgres = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]{0,62}$`)
+	dbNameOracle   = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]{0,7}$`)
+)
+
+// Storage types that support provisioned IOPS.
+const (
+	StorageTypeIO1 = "io1"
+	StorageTypeIO2 = "io2"
+)
+
+// maxIOPSRatio is the maximum ratio of provisioned IOPS to allocated storage
+// in GiB per storage type. The minimum ratio is 1 for both.
+var maxIOPSRatio = map[string]int{
+	StorageTypeIO1: 50,
+	StorageTypeIO2: 500,
+}
+
 // Client defines RDS RDSClient operations
 type Client interface {
 	CreateDBInstanceRequest(*rds.CreateDBInstanceInput) rds.CreateDBInstanceRequest
 	DescribeDBInstancesRequest(*rds.DescribeDBInstancesInput) rds.DescribeDBInstancesRequest
+	DescribeDBClustersRequest(*rds.DescribeDBClustersInput) rds.DescribeDBClustersRequest
 	ModifyDBInstanceRequest(*rds.ModifyDBInstanceInput) rds.ModifyDBInstanceRequest
 	DeleteDBInstanceRequest(*rds.DeleteDBInstanceInput) rds.DeleteDBInstanceRequest
 	AddTagsToResourceRequest(*rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest
+	RemoveTagsFromResourceRequest(*rds.RemoveTagsFromResourceInput) rds.RemoveTagsFromResourceRequest
+	ListTagsForResourceRequest(*rds.ListTagsForResourceInput) rds.ListTagsForResourceRequest
+	CreateDBSnapshotRequest(*rds.CreateDBSnapshotInput) rds.CreateDBSnapshotRequest
+	DescribeDBSnapshotsRequest(*rds.DescribeDBSnapshotsInput) rds.DescribeDBSnapshotsRequest
+	DeleteDBSnapshotRequest(*rds.DeleteDBSnapshotInput) rds.DeleteDBSnapshotRequest
+	RestoreDBInstanceFromDBSnapshotRequest(*rds.RestoreDBInstanceFromDBSnapshotInput) rds.RestoreDBInstanceFromDBSnapshotRequest
+	DescribeOrderableDBInstanceOptionsRequest(*rds.DescribeOrderableDBInstanceOptionsInput) rds.DescribeOrderableDBInstanceOptionsRequest
 }
 
 // NewClient creates new RDS RDSClient with provided AWS Configurations/Credentials
@@ -75,6 +117,28 @@ func IsErrorNotFound(err error) bool {
 	return strings.Contains(err.Error(), rds.ErrCodeDBInstanceNotFoundFault)
 }
 
+// IsSnapshotNotFound returns true if the supplied error indicates a DB
+// snapshot was not found.
+func IsSnapshotNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(err.Error(), rds.ErrCodeDBSnapshotNotFoundFault)
+}
+
+// GenerateDescribeOrderableDBInstanceOptionsInput returns the input to list
+// the offerings that match the engine, engine version, instance class and
+// license model of the given RDSInstanceParameters.
+func GenerateDescribeOrderableDBInstanceOptionsInput(p *v1beta1.RDSInstanceParameters) *rds.DescribeOrderableDBInstanceOptionsInput {
+	return &rds.DescribeOrderableDBInstanceOptionsInput{
+		DBInstanceClass: aws.String(p.DBInstanceClass),
+		Engine:          aws.String(p.Engine),
+		EngineVersion:   p.EngineVersion,
+		LicenseModel:    p.LicenseModel,
+		MaxRecords:      aws.Int64(20),
+	}
+}
+
 // GenerateCreateDBInstanceInput from RDSInstanceSpec
 func GenerateCreateDBInstanceInput(name, password string, p *v1beta1.RDSInstanceParameters) *rds.CreateDBInstanceInput {
 	c := &rds.CreateDBInstanceInput{
@@ -120,6 +184,12 @@ func GenerateCreateDBInstanceInput(name, password string, p *v1beta1.RDSInstance
 		StorageType:                        p.StorageType,
 		VpcSecurityGroupIds:                p.VPCSecurityGroupIDs,
 	}
+	// The master credentials of a cluster member are managed by the cluster
+	// and AWS rejects them in the creation request.
+	if p.DBClusterIdentifier != nil {
+		c.MasterUsername = nil
+		c.MasterUserPassword = nil
+	}
 	if len(p.ProcessorFeatures) != 0 {
 		c.ProcessorFeatures = make([]rds.ProcessorFeature, len(p.ProcessorFeatures))
 		for i, val := range p.ProcessorFeatures {
@@ -148,6 +218,22 @@ func CreatePatch(in *rds.DBInstance, target *v1beta1.RDSInstanceParameters) (*v1
 	currentParams := &v1beta1.RDSInstanceParameters{}
 	LateInitialize(currentParams, in)
 
+	// A port change is reported as pending until the instance has been
+	// rebooted with the new port, so we don't want to request it again.
+	if in.PendingModifiedValues != nil && in.PendingModifiedValues.Port != nil {
+		currentParams.Port = awsclients.IntAddress(in.PendingModifiedValues.Port)
+	}
+	// Likewise, a subnet group move is pending until it is applied.
+	if in.PendingModifiedValues != nil && in.PendingModifiedValues.DBSubnetGroupName != nil {
+		currentParams.DBSubnetGroupName = in.PendingModifiedValues.DBSubnetGroupName
+	}
+
+	// AWS does not guarantee the order of security groups, so we don't want a
+	// patch if only the order differs.
+	if sameStrings(currentParams.VPCSecurityGroupIDs, target.VPCSecurityGroupIDs) {
+		currentParams.VPCSecurityGroupIDs = target.VPCSecurityGroupIDs
+	}
+
 	jsonPatch, err := awsclients.CreateJSONPatch(currentParams, target)
 	if err != nil {
 		return nil, err
@@ -156,9 +242,125 @@ func CreatePatch(in *rds.DBInstance, target *v1beta1.RDSInstanceParameters) (*v1
 	if err := json.Unmarshal(jsonPatch, patch); err != nil {
 		return nil, err
 	}
+	// RDS expects the domain and the IAM role used to join it to be given
+	// together, so a change to either one sends both.
+	if patch.Domain != nil || patch.DomainIAMRoleName != nil {
+		patch.Domain = target.Domain
+		patch.DomainIAMRoleName = target.DomainIAMRoleName
+	}
 	return patch, nil
 }
 
+// ValidateImmutableFields returns an error naming the fields of the given
+// patch that RDS only accepts when an instance is created. ModifyDBInstance has
+// no way to change them, so the only option is to recreate the instance.
+func ValidateImmutableFields(patch *v1beta1.RDSInstanceParameters) error {
+	var changed []string
+	if patch.Engine != "" {
+		changed = append(changed, "engine")
+	}
+	if patch.MasterUsername != nil {
+		changed = append(changed, "masterUsername")
+	}
+	if patch.DBName != nil {
+		changed = append(changed, "dbName")
+	}
+	if patch.CharacterSetName != nil {
+		changed = append(changed, "characterSetName")
+	}
+	if patch.StorageEncrypted != nil {
+		changed = append(changed, "storageEncrypted")
+	}
+	if patch.Timezone != nil {
+		changed = append(changed, "timezone")
+	}
+	if patch.DBClusterIdentifier != nil {
+		changed = append(changed, "dbClusterIdentifier")
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+	return errors.Errorf(errImmutableFieldsFmt, strings.Join(changed, ", "))
+}
+
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := make(map[string]int, len(a))
+	for _, s := range a {
+		counts[s]++
+	}
+	for _, s := range b {
+		if counts[s] == 0 {
+			return false
+		}
+		counts[s]--
+	}
+	return true
+}
+
+// UpgradeCandidateIdentifier returns the identifier of the instance that the
+// upgrade of the instance with the given name to the given engine version is
+// validated on. The snapshot it is restored from has the same identifier.
+func UpgradeCandidateIdentifier(name, version string) string {
+	suffix := "-" + strings.ReplaceAll(version, ".", "-")
+	if max := 63 - len(suffix); len(name) > max {
+		name = strings.TrimRight(name[:max], "-")
+	}
+	return name + suffix
+}
+
+// GenerateRestoreDBInstanceFromDBSnapshotInput returns the input to restore
+// the given snapshot of the given instance to an upgrade candidate with the
+// given identifier. The candidate is placed in the same network as the
+// instance.
+func GenerateRestoreDBInstanceFromDBSnapshotInput(id, snapshot string, p *v1beta1.RDSInstanceParameters, db rds.DBInstance) *rds.RestoreDBInstanceFromDBSnapshotInput {
+	in := &rds.RestoreDBInstanceFromDBSnapshotInput{
+		DBInstanceIdentifier: aws.String(id),
+		DBSnapshotIdentifier: aws.String(snapshot),
+		DBInstanceClass:      db.DBInstanceClass,
+		Iops:                 db.Iops,
+		MultiAZ:              db.MultiAZ,
+		PubliclyAccessible:   db.PubliclyAccessible,
+		StorageType:          db.StorageType,
+	}
+	if db.DBSubnetGroup != nil {
+		in.DBSubnetGroupName = db.DBSubnetGroup.DBSubnetGroupName
+	}
+	if db.Endpoint != nil {
+		in.Port = db.Endpoint.Port
+	}
+	for _, g := range db.VpcSecurityGroups {
+		in.VpcSecurityGroupIds = append(in.VpcSecurityGroupIds, aws.StringValue(g.VpcSecurityGroupId))
+	}
+	for _, g := range db.DBParameterGroups {
+		in.DBParameterGroupName = g.DBParameterGroupName
+	}
+	for _, t := range p.Tags {
+		in.Tags = append(in.Tags, rds.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)})
+	}
+	return in
+}
+
+// GenerateUpgradeCandidate is used to produce v1beta1.UpgradeCandidate from
+// the rds.DBInstance of the candidate.
+func GenerateUpgradeCandidate(db rds.DBInstance) *v1beta1.UpgradeCandidate {
+	c := &v1beta1.UpgradeCandidate{
+		DBInstanceIdentifier: aws.StringValue(db.DBInstanceIdentifier),
+		DBInstanceStatus:     aws.StringValue(db.DBInstanceStatus),
+		EngineVersion:        aws.StringValue(db.EngineVersion),
+	}
+	if db.Endpoint != nil {
+		c.Endpoint = v1beta1.Endpoint{
+			Address:      aws.StringValue(db.Endpoint.Address),
+			HostedZoneID: aws.StringValue(db.Endpoint.HostedZoneId),
+			Port:         int(aws.Int64Value(db.Endpoint.Port)),
+		}
+	}
+	return c
+}
+
 // GenerateModifyDBInstanceInput from RDSInstanceSpec
 func GenerateModifyDBInstanceInput(name string, p *v1beta1.RDSInstanceParameters) *rds.ModifyDBInstanceInput {
 	// NOTE(muvaf): MasterUserPassword is not used here. So, password is set once
@@ -226,6 +428,7 @@ func GenerateObservation(db rds.DBInstance) v1beta1.RDSInstanceObservation { //
 	o := v1beta1.RDSInstanceObservation{
 		DBInstanceStatus:                      aws.StringValue(db.DBInstanceStatus),
 		DBInstanceArn:                         aws.StringValue(db.DBInstanceArn),
+		CACertificateIdentifier:               aws.StringValue(db.CACertificateIdentifier),
 		DBInstancePort:                        int(aws.Int64Value(db.DbInstancePort)),
 		DBResourceID:                          aws.StringValue(db.DbiResourceId),
 		EnhancedMonitoringResourceArn:         aws.StringValue(db.EnhancedMonitoringResourceArn),
@@ -400,6 +603,10 @@ func LateInitialize(in *v1beta1.RDSInstanceParameters, db *rds.DBInstance) { //
 	in.StorageEncrypted = awsclients.LateInitializeBoolPtr(in.StorageEncrypted, db.StorageEncrypted)
 	in.StorageType = awsclients.LateInitializeStringPtr(in.StorageType, db.StorageType)
 	in.Timezone = awsclients.LateInitializeStringPtr(in.Timezone, db.Timezone)
+	if len(db.DomainMemberships) != 0 {
+		in.Domain = awsclients.LateInitializeStringPtr(in.Domain, db.DomainMemberships[0].Domain)
+		in.DomainIAMRoleName = awsclients.LateInitializeStringPtr(in.DomainIAMRoleName, db.DomainMemberships[0].IAMRoleName)
+	}
 
 	// NOTE(muvaf): Do not use db.DbInstancePort as that always returns 0 for
 	// some reason. See the bug here:
@@ -452,9 +659,180 @@ func LateInitialize(in *v1beta1.RDSInstanceParameters, db *rds.DBInstance) { //
 	}
 }
 
+// IsMajorVersionChange reports whether moving the given engine from the
+// current to the desired engine version changes its major version. The major
+// version is the first component of the version for PostgreSQL 10 and later
+// and the first two components for all other engines and versions, e.g. 5.7
+// for MySQL 5.7.33 and 12 for PostgreSQL 12.5.
+func IsMajorVersionChange(engine, current, desired string) bool {
+	return majorVersion(engine, current) != majorVersion(engine, desired)
+}
+
+func majorVersion(engine, version string) string {
+	parts := strings.SplitN(version, ".", 3)
+	if strings.Contains(engine, "postgres") {
+		if v, err := strconv.Atoi(parts[0]); err == nil && v >= 10 {
+			return parts[0]
+		}
+	}
+	if len(parts) < 2 {
+		return parts[0]
+	}
+	return parts[0] + "." + parts[1]
+}
+
+// ValidateStorage checks that IOPS is only requested for provisioned IOPS
+// storage types and that its ratio to the allocated storage is within the
+// limits of AWS. A missing storage type defaults to io1 when IOPS is given.
+func ValidateStorage(p *v1beta1.RDSInstanceParameters) error {
+	if p.IOPS == nil {
+		return nil
+	}
+	st := StorageTypeIO1
+	if p.StorageType != nil {
+		st = *p.StorageType
+	}
+	max, ok := maxIOPSRatio[st]
+	if !ok {
+		return errors.New(errIOPSStorageType)
+	}
+	if p.AllocatedStorage == nil {
+		return nil
+	}
+	iops, storage := *p.IOPS, *p.AllocatedStorage
+	if iops < storage || iops > max*storage {
+		return errors.Errorf(errIOPSRatioFmt, 1, max, st)
+	}
+	return nil
+}
+
+// ValidateAvailabilityZone checks that an Availability Zone is only requested
+// for single-AZ instances and that it is one of the configured region, e.g.
+// us-east-1a or the Local Zone us-west-2-lax-1a for us-west-2.
+func ValidateAvailabilityZone(p *v1beta1.RDSInstanceParameters) error {
+	az := aws.StringValue(p.AvailabilityZone)
+	if az == "" {
+		return nil
+	}
+	if aws.BoolValue(p.MultiAZ) {
+		return errors.New(errAvailabilityZoneMultiAZ)
+	}
+	region := aws.StringValue(p.Region)
+	if region != "" && (!strings.HasPrefix(az, region) || len(az) == len(region)) {
+		return errors.Errorf(errAvailabilityZoneFmt, az, region)
+	}
+	return nil
+}
+
+// ValidateDBName checks that the name of the initial database follows the
+// naming rules of the engine, which differ between MySQL and PostgreSQL
+// compatible engines.
+func ValidateDBName(p *v1beta1.RDSInstanceParameters) error {
+	if p.DBName == nil {
+		return nil
+	}
+	name, engine := *p.DBName, strings.ToLower(p.Engine)
+	switch {
+	case strings.HasPrefix(engine, "sqlserver"):
+		return errors.Errorf(errDBNameFmt, name, p.Engine, "SQL Server does not support it")
+	case strings.HasPrefix(engine, "oracle"):
+		if !dbNameOracle.MatchString(name) {
+			return errors.Errorf(errDBNameFmt, name, p.Engine, "must be 1 to 8 letters or numbers, beginning with a letter")
+		}
+	case strings.Contains(engine, v1beta1.PostgresqlEngine):
+		if !dbNamePostgres.MatchString(name) {
+			return errors.Errorf(errDBNameFmt, name, p.Engine, "must be 1 to 63 letters, numbers or underscores, beginning with a letter or an underscore")
+		}
+	default:
+		if !dbNameMySQL.MatchString(name) {
+			return errors.Errorf(errDBNameFmt, name, p.Engine, "must be 1 to 64 letters or numbers")
+		}
+	}
+	return nil
+}
+
+// forbiddenPasswordCharacters are the characters RDS does not allow in master
+// passwords.
+const forbiddenPasswordCharacters = `/@" `
+
+// The characters of each password character class. Symbols leave out quotes
+// and backslashes that are easily mangled in connection strings.
+var passwordCharacters = map[v1beta1.PasswordCharacterClass]string{
+	v1beta1.PasswordCharacterClassLowercase: "abcdefghijklmnopqrstuvwxyz",
+	v1beta1.PasswordCharacterClassUppercase: "ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+	v1beta1.PasswordCharacterClassDigits:    "0123456789",
+	v1beta1.PasswordCharacterClassSymbols:   "!#$%&()*+,-.:;<=>?[]^_{|}~",
+}
+
+var defaultPasswordCharacterClasses = []v1beta1.PasswordCharacterClass{
+	v1beta1.PasswordCharacterClassLowercase,
+	v1beta1.PasswordCharacterClassUppercase,
+	v1beta1.PasswordCharacterClassDigits,
+}
+
+// withoutForbiddenPasswordCharacters removes the characters RDS does not allow
+// from a character set, so that a generated password never fails the creation.
+func withoutForbiddenPasswordCharacters(chars string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(forbiddenPasswordCharacters, r) {
+			return -1
+		}
+		return r
+	}, chars)
+}
+
+// GeneratePassword generates a master password with the length and character
+// classes given in the parameters. The password contains at least one
+// character of each class and none of the characters RDS does not allow.
+func GeneratePassword(p *v1beta1.RDSInstanceParameters) (string, error) {
+	length := password.Default.Length
+	if p.MasterPasswordLength != nil {
+		length = *p.MasterPasswordLength
+	}
+	classes := p.MasterPasswordCharacterClasses
+	if len(classes) == 0 {
+		classes = defaultPasswordCharacterClasses
+	}
+	if length < len(classes) {
+		return "", errors.Errorf(errPasswordLengthFmt, length, len(classes))
+	}
+
+	all := ""
+	pw := make([]byte, 0, length)
+	for _, c := range classes {
+		chars, ok := passwordCharacters[c]
+		if !ok {
+			return "", errors.Errorf(errPasswordClassFmt, c)
+		}
+		chars = withoutForbiddenPasswordCharacters(chars)
+		all += chars
+		one, err := password.Settings{CharacterSet: chars, Length: 1}.Generate()
+		if err != nil {
+			return "", err
+		}
+		pw = append(pw, one...)
+	}
+	rest, err := password.Settings{CharacterSet: all, Length: length - len(pw)}.Generate()
+	if err != nil {
+		return "", err
+	}
+	pw = append(pw, rest...)
+
+	// Shuffle so that the guaranteed characters are not always up front.
+	for i := len(pw) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		pw[i], pw[j.Int64()] = pw[j.Int64()], pw[i]
+	}
+	return string(pw), nil
+}
+
 // IsUpToDate checks whether there is a change in any of the modifiable fields.
 func IsUpToDate(ctx context.Context, kube client.Client, r *v1beta1.RDSInstance, db rds.DBInstance) (bool, error) {
-	_, pwdChanged, err := GetPassword(ctx, kube, r.Spec.ForProvider.MasterPasswordSecretRef, r.Spec.WriteConnectionSecretToReference)
+	_, pwdChanged, err := GetPasswordForKey(ctx, kube, r.Spec.ForProvider.MasterPasswordSecretRef, r.Spec.WriteConnectionSecretToReference,
+		ConnectionSecretKey(r.Spec.ConnectionSecretKeyMap, xpv1.ResourceCredentialsSecretPasswordKey))
 	if err != nil {
 		return false, err
 	}
@@ -470,12 +848,43 @@ func IsUpToDate(ctx context.Context, kube client.Client, r *v1beta1.RDSInstance,
 		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "FinalDBSnapshotIdentifier"),
 		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "ApplyModificationsImmediately"),
 		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "AllowMajorVersionUpgrade"),
+		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "AllowSubnetGroupChange"),
+		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "MajorVersionUpgradeStrategy"),
 		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "MasterPasswordSecretRef"),
+		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "MasterPasswordLength"),
+		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "MasterPasswordCharacterClasses"),
 	) && !pwdChanged, nil
 }
 
+// DiffTags returns the tags that should be added to and removed from an RDS
+// resource so that its observed tags match the desired ones. Tags whose value
+// changed are both removed and added, so removal must happen first.
+func DiffTags(spec []v1beta1.Tag, observed []rds.Tag) (add []rds.Tag, remove []string) {
+	local := make(map[string]string, len(spec))
+	for _, t := range spec {
+		local[t.Key] = t.Value
+	}
+	remote := make(map[string]string, len(observed))
+	for _, t := range observed {
+		remote[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	addMap, remove := awsclients.DiffTags(local, remote)
+	for k, v := range addMap {
+		add = append(add, rds.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	sort.Slice(add, func(i, j int) bool { return aws.StringValue(add[i].Key) < aws.StringValue(add[j].Key) })
+	sort.Strings(remove)
+	return add, remove
+}
+
 // GetPassword fetches the referenced input password for an RDSInstance CRD and determines whether it has changed or not
 func GetPassword(ctx context.Context, kube client.Client, in *xpv1.SecretKeySelector, out *xpv1.SecretReference) (newPwd string, changed bool, err error) {
+	return GetPasswordForKey(ctx, kube, in, out, xpv1.ResourceCredentialsSecretPasswordKey)
+}
+
+// GetPasswordForKey works like GetPassword but compares the input password
+// with the one stored under the given key of the output secret.
+func GetPasswordForKey(ctx context.Context, kube client.Client, in *xpv1.SecretKeySelector, out *xpv1.SecretReference, outKey string) (newPwd string, changed bool, err error) {
 	if in == nil {
 		return "", false, nil
 	}
@@ -502,7 +911,7 @@ func GetPassword(ctx context.Context, kube client.Client, in *xpv1.SecretKeySele
 		}
 		// if newPwd was set to some value, compare value in output secret with
 		// newPwd
-		changed = newPwd != "" && newPwd != string(s.Data[xpv1.ResourceCredentialsSecretPasswordKey])
+		changed = newPwd != "" && newPwd != string(s.Data[outKey])
 	}
 
 	return newPwd, changed, nil
@@ -513,8 +922,56 @@ func GetConnectionDetails(in v1beta1.RDSInstance) managed.ConnectionDetails {
 	if in.Status.AtProvider.Endpoint.Address == "" {
 		return nil
 	}
-	return managed.ConnectionDetails{
+	conn := managed.ConnectionDetails{
 		xpv1.ResourceCredentialsSecretEndpointKey: []byte(in.Status.AtProvider.Endpoint.Address),
 		xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(in.Status.AtProvider.Endpoint.Port)),
 	}
+	if in.Spec.ForProvider.DBName != nil {
+		conn[v1beta1.ResourceCredentialsSecretDatabaseKey] = []byte(*in.Spec.ForProvider.DBName)
+	}
+	return MapConnectionDetails(conn, in.Spec.ConnectionSecretKeyMap)
+}
+
+// GetClusterConnectionDetails returns the connection details of an RDSInstance
+// that is a member of the given DB cluster. Applications connect to the
+// endpoints of the cluster rather than to the instance, and the master
+// password is managed by the cluster.
+func GetClusterConnectionDetails(in v1beta1.RDSInstance, c rds.DBCluster) managed.ConnectionDetails {
+	if aws.StringValue(c.Endpoint) == "" {
+		return nil
+	}
+	conn := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey:          []byte(aws.StringValue(c.Endpoint)),
+		v1beta1.ResourceCredentialsSecretReaderEndpointKey: []byte(aws.StringValue(c.ReaderEndpoint)),
+		xpv1.ResourceCredentialsSecretPortKey:              []byte(strconv.FormatInt(aws.Int64Value(c.Port), 10)),
+	}
+	if c.MasterUsername != nil {
+		conn[xpv1.ResourceCredentialsSecretUserKey] = []byte(aws.StringValue(c.MasterUsername))
+	}
+	if in.Spec.ForProvider.DBName != nil {
+		conn[v1beta1.ResourceCredentialsSecretDatabaseKey] = []byte(*in.Spec.ForProvider.DBName)
+	}
+	return MapConnectionDetails(conn, in.Spec.ConnectionSecretKeyMap)
+}
+
+// ConnectionSecretKey returns the name under which the given connection
+// detail is written according to the supplied key map.
+func ConnectionSecretKey(keyMap map[string]string, key string) string {
+	if k, ok := keyMap[key]; ok && k != "" {
+		return k
+	}
+	return key
+}
+
+// MapConnectionDetails renames the keys of the supplied connection details
+// according to the supplied key map.
+func MapConnectionDetails(conn managed.ConnectionDetails, keyMap map[string]string) managed.ConnectionDetails {
+	if len(keyMap) == 0 || conn == nil {
+		return conn
+	}
+	out := make(managed.ConnectionDetails, len(conn))
+	for k, v := range conn {
+		out[ConnectionSecretKey(keyMap, k)] = v
+	}
+	return out
 }
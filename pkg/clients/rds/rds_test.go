@@ -19,6 +19,7 @@ package rds
 import (
 	"context"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -134,6 +135,131 @@ func TestCreatePatch(t *testing.T) {
 				},
 			},
 		},
+		"PendingPortChange": {
+			args: args{
+				db: &rds.DBInstance{
+					DBName:                &dbName,
+					Endpoint:              &rds.Endpoint{Port: aws.Int64(5432)},
+					PendingModifiedValues: &rds.PendingModifiedValues{Port: aws.Int64(6543)},
+				},
+				p: &v1beta1.RDSInstanceParameters{
+					DBName: &dbName,
+					Port:   aws.IntAddress(aws.Int64(6543)),
+				},
+			},
+			want: want{
+				patch: &v1beta1.RDSInstanceParameters{},
+			},
+		},
+		"PendingSubnetGroupChange": {
+			args: args{
+				db: &rds.DBInstance{
+					DBName:                &dbName,
+					DBSubnetGroup:         &rds.DBSubnetGroup{DBSubnetGroupName: aws.String("old")},
+					PendingModifiedValues: &rds.PendingModifiedValues{DBSubnetGroupName: aws.String("new")},
+				},
+				p: &v1beta1.RDSInstanceParameters{
+					DBName:            &dbName,
+					DBSubnetGroupName: aws.String("new"),
+				},
+			},
+			want: want{
+				patch: &v1beta1.RDSInstanceParameters{},
+			},
+		},
+		"PortChanged": {
+			args: args{
+				db: &rds.DBInstance{
+					DBName:   &dbName,
+					Endpoint: &rds.Endpoint{Port: aws.Int64(5432)},
+				},
+				p: &v1beta1.RDSInstanceParameters{
+					DBName: &dbName,
+					Port:   aws.IntAddress(aws.Int64(6543)),
+				},
+			},
+			want: want{
+				patch: &v1beta1.RDSInstanceParameters{
+					Port: aws.IntAddress(aws.Int64(6543)),
+				},
+			},
+		},
+		"PubliclyAccessibleChanged": {
+			args: args{
+				db: &rds.DBInstance{
+					DBName:             &dbName,
+					PubliclyAccessible: aws.Bool(false),
+				},
+				p: &v1beta1.RDSInstanceParameters{
+					DBName:             &dbName,
+					PubliclyAccessible: aws.Bool(true),
+				},
+			},
+			want: want{
+				patch: &v1beta1.RDSInstanceParameters{
+					PubliclyAccessible: aws.Bool(true),
+				},
+			},
+		},
+		"AutoMinorVersionUpgradeDisabled": {
+			args: args{
+				db: &rds.DBInstance{
+					DBName:                  &dbName,
+					AutoMinorVersionUpgrade: aws.Bool(true),
+				},
+				p: &v1beta1.RDSInstanceParameters{
+					DBName:                  &dbName,
+					AutoMinorVersionUpgrade: aws.Bool(false),
+				},
+			},
+			want: want{
+				patch: &v1beta1.RDSInstanceParameters{
+					AutoMinorVersionUpgrade: aws.Bool(false),
+				},
+			},
+		},
+		"DomainJoined": {
+			args: args{
+				db: &rds.DBInstance{
+					DBName: &dbName,
+					DomainMemberships: []rds.DomainMembership{{
+						Domain:      aws.String("d-1234567890"),
+						IAMRoleName: aws.String("rds-directoryservice-access"),
+						Status:      aws.String("joined"),
+					}},
+				},
+				p: &v1beta1.RDSInstanceParameters{
+					DBName:            &dbName,
+					Domain:            aws.String("d-1234567890"),
+					DomainIAMRoleName: aws.String("rds-directoryservice-access"),
+				},
+			},
+			want: want{
+				patch: &v1beta1.RDSInstanceParameters{},
+			},
+		},
+		"DomainIAMRoleNameChanged": {
+			args: args{
+				db: &rds.DBInstance{
+					DBName: &dbName,
+					DomainMemberships: []rds.DomainMembership{{
+						Domain:      aws.String("d-1234567890"),
+						IAMRoleName: aws.String("old-role"),
+					}},
+				},
+				p: &v1beta1.RDSInstanceParameters{
+					DBName:            &dbName,
+					Domain:            aws.String("d-1234567890"),
+					DomainIAMRoleName: aws.String("new-role"),
+				},
+			},
+			want: want{
+				patch: &v1beta1.RDSInstanceParameters{
+					Domain:            aws.String("d-1234567890"),
+					DomainIAMRoleName: aws.String("new-role"),
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -197,6 +323,79 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			want: false,
 		},
+		"CopyTagsToSnapshotChanged": {
+			args: args{
+				db: rds.DBInstance{
+					DBName:             &dbName,
+					CopyTagsToSnapshot: aws.Bool(false),
+				},
+				r: v1beta1.RDSInstance{
+					Spec: v1beta1.RDSInstanceSpec{
+						ForProvider: v1beta1.RDSInstanceParameters{
+							DBName:             &dbName,
+							CopyTagsToSnapshot: aws.Bool(true),
+						},
+					},
+				},
+			},
+			want: false,
+		},
+		"PromotionTierChanged": {
+			args: args{
+				db: rds.DBInstance{
+					DBName:        &dbName,
+					PromotionTier: aws.Int64(1),
+				},
+				r: v1beta1.RDSInstance{
+					Spec: v1beta1.RDSInstanceSpec{
+						ForProvider: v1beta1.RDSInstanceParameters{
+							DBName:        &dbName,
+							PromotionTier: aws.IntAddress(aws.Int64(2)),
+						},
+					},
+				},
+			},
+			want: false,
+		},
+		"SecurityGroupsInDifferentOrder": {
+			args: args{
+				db: rds.DBInstance{
+					DBName: &dbName,
+					VpcSecurityGroups: []rds.VpcSecurityGroupMembership{
+						{VpcSecurityGroupId: aws.String("sg-1")},
+						{VpcSecurityGroupId: aws.String("sg-2")},
+					},
+				},
+				r: v1beta1.RDSInstance{
+					Spec: v1beta1.RDSInstanceSpec{
+						ForProvider: v1beta1.RDSInstanceParameters{
+							DBName:              &dbName,
+							VPCSecurityGroupIDs: []string{"sg-2", "sg-1"},
+						},
+					},
+				},
+			},
+			want: true,
+		},
+		"DifferentSecurityGroups": {
+			args: args{
+				db: rds.DBInstance{
+					DBName: &dbName,
+					VpcSecurityGroups: []rds.VpcSecurityGroupMembership{
+						{VpcSecurityGroupId: aws.String("sg-1")},
+					},
+				},
+				r: v1beta1.RDSInstance{
+					Spec: v1beta1.RDSInstanceSpec{
+						ForProvider: v1beta1.RDSInstanceParameters{
+							DBName:              &dbName,
+							VPCSecurityGroupIDs: []string{"sg-1", "sg-2"},
+						},
+					},
+				},
+			},
+			want: false,
+		},
 		"IgnoresRefs": {
 			args: args{
 				db: rds.DBInstance{
@@ -328,6 +527,380 @@ func TestIsUpToDate(t *testing.T) {
 	}
 }
 
+func TestIsMajorVersionChange(t *testing.T) {
+	type args struct {
+		engine  string
+		current string
+		desired string
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"MySQLMinor": {
+			args: args{engine: "mysql", current: "5.7.31", desired: "5.7.33"},
+			want: false,
+		},
+		"MySQLMajor": {
+			args: args{engine: "mysql", current: "5.7.33", desired: "8.0.23"},
+			want: true,
+		},
+		"PostgresMinor": {
+			args: args{engine: "postgres", current: "12.4", desired: "12.5"},
+			want: false,
+		},
+		"PostgresMajor": {
+			args: args{engine: "postgres", current: "11.10", desired: "12.5"},
+			want: true,
+		},
+		"LegacyPostgresMajor": {
+			args: args{engine: "postgres", current: "9.5.24", desired: "9.6.20"},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsMajorVersionChange(tc.args.engine, tc.args.current, tc.args.desired)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	type want struct {
+		add    []rds.Tag
+		remove []string
+	}
+	cases := map[string]struct {
+		spec     []v1beta1.Tag
+		observed []rds.Tag
+		want     want
+	}{
+		"UpToDate": {
+			spec:     []v1beta1.Tag{{Key: "foo", Value: "bar"}},
+			observed: []rds.Tag{{Key: aws.String("foo"), Value: aws.String("bar")}},
+			want:     want{remove: []string{}},
+		},
+		"Added": {
+			spec: []v1beta1.Tag{{Key: "foo", Value: "bar"}, {Key: "bar", Value: "baz"}},
+			want: want{
+				add:    []rds.Tag{{Key: aws.String("bar"), Value: aws.String("baz")}, {Key: aws.String("foo"), Value: aws.String("bar")}},
+				remove: []string{},
+			},
+		},
+		"ChangedAndRemoved": {
+			spec:     []v1beta1.Tag{{Key: "foo", Value: "bar"}},
+			observed: []rds.Tag{{Key: aws.String("foo"), Value: aws.String("baz")}, {Key: aws.String("stale"), Value: aws.String("tag")}},
+			want: want{
+				add:    []rds.Tag{{Key: aws.String("foo"), Value: aws.String("bar")}},
+				remove: []string{"foo", "stale"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffTags(tc.spec, tc.observed)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateStorage(t *testing.T) {
+	cases := map[string]struct {
+		p    v1beta1.RDSInstanceParameters
+		want error
+	}{
+		"NoIOPS": {
+			p: v1beta1.RDSInstanceParameters{
+				StorageType: aws.String("gp2"),
+			},
+		},
+		"DefaultStorageType": {
+			p: v1beta1.RDSInstanceParameters{
+				IOPS:             aws.IntAddress(aws.Int64(1000)),
+				AllocatedStorage: aws.IntAddress(aws.Int64(100)),
+			},
+		},
+		"IO2WithinRatio": {
+			p: v1beta1.RDSInstanceParameters{
+				IOPS:             aws.IntAddress(aws.Int64(20000)),
+				AllocatedStorage: aws.IntAddress(aws.Int64(100)),
+				StorageType:      aws.String(StorageTypeIO2),
+			},
+		},
+		"UnsupportedStorageType": {
+			p: v1beta1.RDSInstanceParameters{
+				IOPS:             aws.IntAddress(aws.Int64(1000)),
+				AllocatedStorage: aws.IntAddress(aws.Int64(100)),
+				StorageType:      aws.String("gp2"),
+			},
+			want: errors.New(errIOPSStorageType),
+		},
+		"RatioTooHigh": {
+			p: v1beta1.RDSInstanceParameters{
+				IOPS:             aws.IntAddress(aws.Int64(6000)),
+				AllocatedStorage: aws.IntAddress(aws.Int64(100)),
+				StorageType:      aws.String(StorageTypeIO1),
+			},
+			want: errors.Errorf(errIOPSRatioFmt, 1, 50, StorageTypeIO1),
+		},
+		"RatioTooLow": {
+			p: v1beta1.RDSInstanceParameters{
+				IOPS:             aws.IntAddress(aws.Int64(50)),
+				AllocatedStorage: aws.IntAddress(aws.Int64(100)),
+				StorageType:      aws.String(StorageTypeIO1),
+			},
+			want: errors.Errorf(errIOPSRatioFmt, 1, 50, StorageTypeIO1),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateStorage(&tc.p)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateAvailabilityZone(t *testing.T) {
+	cases := map[string]struct {
+		p    v1beta1.RDSInstanceParameters
+		want error
+	}{
+		"NoAvailabilityZone": {
+			p: v1beta1.RDSInstanceParameters{
+				Region:  aws.String("us-east-1"),
+				MultiAZ: aws.Bool(true),
+			},
+		},
+		"InRegion": {
+			p: v1beta1.RDSInstanceParameters{
+				Region:           aws.String("us-east-1"),
+				AvailabilityZone: aws.String("us-east-1a"),
+			},
+		},
+		"LocalZone": {
+			p: v1beta1.RDSInstanceParameters{
+				Region:           aws.String("us-west-2"),
+				AvailabilityZone: aws.String("us-west-2-lax-1a"),
+			},
+		},
+		"MultiAZ": {
+			p: v1beta1.RDSInstanceParameters{
+				Region:           aws.String("us-east-1"),
+				AvailabilityZone: aws.String("us-east-1a"),
+				MultiAZ:          aws.Bool(true),
+			},
+			want: errors.New(errAvailabilityZoneMultiAZ),
+		},
+		"OtherRegion": {
+			p: v1beta1.RDSInstanceParameters{
+				Region:           aws.String("us-east-1"),
+				AvailabilityZone: aws.String("eu-west-1a"),
+			},
+			want: errors.Errorf(errAvailabilityZoneFmt, "eu-west-1a", "us-east-1"),
+		},
+		"RegionOnly": {
+			p: v1beta1.RDSInstanceParameters{
+				Region:           aws.String("us-east-1"),
+				AvailabilityZone: aws.String("us-east-1"),
+			},
+			want: errors.Errorf(errAvailabilityZoneFmt, "us-east-1", "us-east-1"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateAvailabilityZone(&tc.p)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateDBName(t *testing.T) {
+	cases := map[string]struct {
+		p    v1beta1.RDSInstanceParameters
+		want error
+	}{
+		"NoDBName": {
+			p: v1beta1.RDSInstanceParameters{Engine: "sqlserver-ex"},
+		},
+		"ValidMySQL": {
+			p: v1beta1.RDSInstanceParameters{Engine: "mysql", DBName: aws.String("app1")},
+		},
+		"InvalidMySQL": {
+			p:    v1beta1.RDSInstanceParameters{Engine: "mysql", DBName: aws.String("my_app")},
+			want: errors.Errorf(errDBNameFmt, "my_app", "mysql", "must be 1 to 64 letters or numbers"),
+		},
+		"ValidPostgres": {
+			p: v1beta1.RDSInstanceParameters{Engine: "postgres", DBName: aws.String("_my_app")},
+		},
+		"InvalidPostgres": {
+			p:    v1beta1.RDSInstanceParameters{Engine: "aurora-postgresql", DBName: aws.String("1app")},
+			want: errors.Errorf(errDBNameFmt, "1app", "aurora-postgresql", "must be 1 to 63 letters, numbers or underscores, beginning with a letter or an underscore"),
+		},
+		"InvalidOracle": {
+			p:    v1beta1.RDSInstanceParameters{Engine: "oracle-ee", DBName: aws.String("toolongsid")},
+			want: errors.Errorf(errDBNameFmt, "toolongsid", "oracle-ee", "must be 1 to 8 letters or numbers, beginning with a letter"),
+		},
+		"SQLServer": {
+			p:    v1beta1.RDSInstanceParameters{Engine: "sqlserver-ex", DBName: aws.String("app")},
+			want: errors.Errorf(errDBNameFmt, "app", "sqlserver-ex", "SQL Server does not support it"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateDBName(&tc.p)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateImmutableFields(t *testing.T) {
+	cases := map[string]struct {
+		patch v1beta1.RDSInstanceParameters
+		want  error
+	}{
+		"NoChanges": {
+			patch: v1beta1.RDSInstanceParameters{},
+		},
+		"ModifiableChanges": {
+			patch: v1beta1.RDSInstanceParameters{DBInstanceClass: "db.t3.small", EngineVersion: aws.String("12.5")},
+		},
+		"EngineChanged": {
+			patch: v1beta1.RDSInstanceParameters{Engine: "mysql"},
+			want:  errors.Errorf(errImmutableFieldsFmt, "engine"),
+		},
+		"SeveralChanged": {
+			patch: v1beta1.RDSInstanceParameters{MasterUsername: aws.String("root"), DBName: aws.String("app"), StorageEncrypted: aws.Bool(true)},
+			want:  errors.Errorf(errImmutableFieldsFmt, "masterUsername, dbName, storageEncrypted"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateImmutableFields(&tc.patch)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratePassword(t *testing.T) {
+	length := func(l int) *int { return &l }
+	all := []v1beta1.PasswordCharacterClass{
+		v1beta1.PasswordCharacterClassLowercase,
+		v1beta1.PasswordCharacterClassUppercase,
+		v1beta1.PasswordCharacterClassDigits,
+		v1beta1.PasswordCharacterClassSymbols,
+	}
+
+	type want struct {
+		length  int
+		classes []v1beta1.PasswordCharacterClass
+		err     error
+	}
+
+	cases := map[string]struct {
+		p    v1beta1.RDSInstanceParameters
+		want want
+	}{
+		"Default": {
+			want: want{length: 27, classes: defaultPasswordCharacterClasses},
+		},
+		"LengthAndClasses": {
+			p: v1beta1.RDSInstanceParameters{
+				MasterPasswordLength:           length(41),
+				MasterPasswordCharacterClasses: all,
+			},
+			want: want{length: 41, classes: all},
+		},
+		"TooShort": {
+			p: v1beta1.RDSInstanceParameters{
+				MasterPasswordLength:           length(3),
+				MasterPasswordCharacterClasses: all,
+			},
+			want: want{err: errors.Errorf(errPasswordLengthFmt, 3, 4)},
+		},
+		"UnknownClass": {
+			p: v1beta1.RDSInstanceParameters{
+				MasterPasswordCharacterClasses: []v1beta1.PasswordCharacterClass{"Emoji"},
+			},
+			want: want{err: errors.Errorf(errPasswordClassFmt, "Emoji")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			pw, err := GeneratePassword(&tc.p)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.length, len(pw)); diff != "" {
+				t.Errorf("length: -want, +got:\n%s", diff)
+			}
+			allowed := ""
+			for _, c := range tc.want.classes {
+				allowed += passwordCharacters[c]
+				if !strings.ContainsAny(pw, passwordCharacters[c]) {
+					t.Errorf("password %q has no %s characters", pw, c)
+				}
+			}
+			for _, r := range pw {
+				if !strings.ContainsRune(allowed, r) {
+					t.Errorf("password %q contains %q", pw, r)
+				}
+			}
+		})
+	}
+}
+
+func TestGeneratePasswordForbiddenCharacters(t *testing.T) {
+	p := &v1beta1.RDSInstanceParameters{
+		MasterPasswordCharacterClasses: []v1beta1.PasswordCharacterClass{
+			v1beta1.PasswordCharacterClassLowercase,
+			v1beta1.PasswordCharacterClassUppercase,
+			v1beta1.PasswordCharacterClassDigits,
+			v1beta1.PasswordCharacterClassSymbols,
+		},
+	}
+	for i := 0; i < 1000; i++ {
+		pw, err := GeneratePassword(p)
+		if err != nil {
+			t.Fatalf("GeneratePassword(...): %s", err)
+		}
+		if strings.ContainsAny(pw, forbiddenPasswordCharacters) {
+			t.Fatalf("GeneratePassword(...): %q contains characters RDS does not allow", pw)
+		}
+	}
+}
+
+func TestWithoutForbiddenPasswordCharacters(t *testing.T) {
+	if diff := cmp.Diff("ab!c", withoutForbiddenPasswordCharacters(`a/b@!" c`)); diff != "" {
+		t.Errorf("withoutForbiddenPasswordCharacters(...): -want, +got:\n%s", diff)
+	}
+}
+
 func TestGetPassword(t *testing.T) {
 	type args struct {
 		in   *xpv1.SecretKeySelector
@@ -581,6 +1154,7 @@ func TestGenerateObservation(t *testing.T) {
 			rds: rds.DBInstance{
 				DBInstanceStatus:                      &status,
 				DBInstanceArn:                         &arn,
+				CACertificateIdentifier:               &name,
 				InstanceCreateTime:                    &createTime,
 				DbInstancePort:                        &port64,
 				DbiResourceId:                         &resourceID,
@@ -618,10 +1192,11 @@ func TestGenerateObservation(t *testing.T) {
 				}},
 			},
 			want: v1beta1.RDSInstanceObservation{
-				DBInstanceStatus:  status,
-				DBInstanceArn:     arn,
-				DBParameterGroups: []v1beta1.DBParameterGroupStatus{{DBParameterGroupName: name}},
-				DBSecurityGroups:  []v1beta1.DBSecurityGroupMembership{{DBSecurityGroupName: name, Status: status}},
+				DBInstanceStatus:        status,
+				DBInstanceArn:           arn,
+				CACertificateIdentifier: name,
+				DBParameterGroups:       []v1beta1.DBParameterGroupStatus{{DBParameterGroupName: name}},
+				DBSecurityGroups:        []v1beta1.DBSecurityGroupMembership{{DBSecurityGroupName: name, Status: status}},
 				DBSubnetGroup: v1beta1.DBSubnetGroupInRDS{
 					DBSubnetGroupARN:         arn,
 					DBSubnetGroupDescription: description,
@@ -777,6 +1352,49 @@ func TestGetConnectionDetails(t *testing.T) {
 				xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(port)),
 			},
 		},
+		"MappedKeys": {
+			rds: v1beta1.RDSInstance{
+				Spec: v1beta1.RDSInstanceSpec{
+					ConnectionSecretKeyMap: map[string]string{
+						xpv1.ResourceCredentialsSecretEndpointKey: "DB_HOST",
+					},
+				},
+				Status: v1beta1.RDSInstanceStatus{
+					AtProvider: v1beta1.RDSInstanceObservation{
+						Endpoint: v1beta1.Endpoint{
+							Address: address,
+							Port:    port,
+						},
+					},
+				},
+			},
+			want: managed.ConnectionDetails{
+				"DB_HOST":                             []byte(address),
+				xpv1.ResourceCredentialsSecretPortKey: []byte(strconv.Itoa(port)),
+			},
+		},
+		"WithDBName": {
+			rds: v1beta1.RDSInstance{
+				Spec: v1beta1.RDSInstanceSpec{
+					ForProvider: v1beta1.RDSInstanceParameters{
+						DBName: aws.String("app"),
+					},
+				},
+				Status: v1beta1.RDSInstanceStatus{
+					AtProvider: v1beta1.RDSInstanceObservation{
+						Endpoint: v1beta1.Endpoint{
+							Address: address,
+							Port:    port,
+						},
+					},
+				},
+			},
+			want: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey:    []byte(address),
+				xpv1.ResourceCredentialsSecretPortKey:        []byte(strconv.Itoa(port)),
+				v1beta1.ResourceCredentialsSecretDatabaseKey: []byte("app"),
+			},
+		},
 		"NilInstance": {
 			rds:  v1beta1.RDSInstance{},
 			want: nil,
@@ -792,6 +1410,70 @@ func TestGetConnectionDetails(t *testing.T) {
 	}
 }
 
+func TestGetClusterConnectionDetails(t *testing.T) {
+	reader := "reader." + address
+	cases := map[string]struct {
+		rds     v1beta1.RDSInstance
+		cluster rds.DBCluster
+		want    managed.ConnectionDetails
+	}{
+		"ValidCluster": {
+			rds: v1beta1.RDSInstance{
+				Spec: v1beta1.RDSInstanceSpec{
+					ForProvider: v1beta1.RDSInstanceParameters{
+						DBName: aws.String("app"),
+					},
+				},
+			},
+			cluster: rds.DBCluster{
+				Endpoint:       aws.String(address),
+				ReaderEndpoint: aws.String(reader),
+				Port:           aws.Int64(port),
+				MasterUsername: aws.String(username),
+			},
+			want: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey:          []byte(address),
+				v1beta1.ResourceCredentialsSecretReaderEndpointKey: []byte(reader),
+				xpv1.ResourceCredentialsSecretPortKey:              []byte(strconv.Itoa(port)),
+				xpv1.ResourceCredentialsSecretUserKey:              []byte(username),
+				v1beta1.ResourceCredentialsSecretDatabaseKey:       []byte("app"),
+			},
+		},
+		"MappedKeys": {
+			rds: v1beta1.RDSInstance{
+				Spec: v1beta1.RDSInstanceSpec{
+					ConnectionSecretKeyMap: map[string]string{
+						v1beta1.ResourceCredentialsSecretReaderEndpointKey: "DB_READER_HOST",
+					},
+				},
+			},
+			cluster: rds.DBCluster{
+				Endpoint:       aws.String(address),
+				ReaderEndpoint: aws.String(reader),
+				Port:           aws.Int64(port),
+			},
+			want: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey: []byte(address),
+				"DB_READER_HOST":                      []byte(reader),
+				xpv1.ResourceCredentialsSecretPortKey: []byte(strconv.Itoa(port)),
+			},
+		},
+		"ClusterWithoutEndpoint": {
+			rds:  v1beta1.RDSInstance{},
+			want: nil,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetClusterConnectionDetails(tc.rds, tc.cluster)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestLateInitialize(t *testing.T) {
 	existingName := "existing"
 	subnetGroup := rds.DBSubnetGroup{
@@ -1138,3 +1820,31 @@ func TestGenerateModifyDBInstanceInput(t *testing.T) {
 		})
 	}
 }
+
+func TestUpgradeCandidateIdentifier(t *testing.T) {
+	cases := map[string]struct {
+		name    string
+		version string
+		want    string
+	}{
+		"Short": {
+			name:    "prod",
+			version: "8.0.23",
+			want:    "prod-8-0-23",
+		},
+		"Truncated": {
+			name:    strings.Repeat("a", 55) + "-bbbbbbb",
+			version: "8.0.23",
+			want:    strings.Repeat("a", 55) + "-8-0-23",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := UpgradeCandidateIdentifier(tc.name, tc.version)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
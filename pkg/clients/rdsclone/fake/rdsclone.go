@@ -0,0 +1,67 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/rdsclone"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockRDSCloneClient)(nil)
+
+// MockRDSCloneClient is a type that implements all the methods for the
+// RDSClone Client interface
+type MockRDSCloneClient struct {
+	MockDescribeInstances func(*rds.DescribeDBInstancesInput) rds.DescribeDBInstancesRequest
+	MockRestore           func(*rds.RestoreDBInstanceFromDBSnapshotInput) rds.RestoreDBInstanceFromDBSnapshotRequest
+	MockDeleteInstance    func(*rds.DeleteDBInstanceInput) rds.DeleteDBInstanceRequest
+	MockCreateSnapshot    func(*rds.CreateDBSnapshotInput) rds.CreateDBSnapshotRequest
+	MockDescribeSnapshots func(*rds.DescribeDBSnapshotsInput) rds.DescribeDBSnapshotsRequest
+	MockDeleteSnapshot    func(*rds.DeleteDBSnapshotInput) rds.DeleteDBSnapshotRequest
+}
+
+// DescribeDBInstancesRequest mocks DescribeDBInstancesRequest method
+func (m *MockRDSCloneClient) DescribeDBInstancesRequest(input *rds.DescribeDBInstancesInput) rds.DescribeDBInstancesRequest {
+	return m.MockDescribeInstances(input)
+}
+
+// RestoreDBInstanceFromDBSnapshotRequest mocks RestoreDBInstanceFromDBSnapshotRequest method
+func (m *MockRDSCloneClient) RestoreDBInstanceFromDBSnapshotRequest(input *rds.RestoreDBInstanceFromDBSnapshotInput) rds.RestoreDBInstanceFromDBSnapshotRequest {
+	return m.MockRestore(input)
+}
+
+// DeleteDBInstanceRequest mocks DeleteDBInstanceRequest method
+func (m *MockRDSCloneClient) DeleteDBInstanceRequest(input *rds.DeleteDBInstanceInput) rds.DeleteDBInstanceRequest {
+	return m.MockDeleteInstance(input)
+}
+
+// CreateDBSnapshotRequest mocks CreateDBSnapshotRequest method
+func (m *MockRDSCloneClient) CreateDBSnapshotRequest(input *rds.CreateDBSnapshotInput) rds.CreateDBSnapshotRequest {
+	return m.MockCreateSnapshot(input)
+}
+
+// DescribeDBSnapshotsRequest mocks DescribeDBSnapshotsRequest method
+func (m *MockRDSCloneClient) DescribeDBSnapshotsRequest(input *rds.DescribeDBSnapshotsInput) rds.DescribeDBSnapshotsRequest {
+	return m.MockDescribeSnapshots(input)
+}
+
+// DeleteDBSnapshotRequest mocks DeleteDBSnapshotRequest method
+func (m *MockRDSCloneClient) DeleteDBSnapshotRequest(input *rds.DeleteDBSnapshotInput) rds.DeleteDBSnapshotRequest {
+	return m.MockDeleteSnapshot(input)
+}
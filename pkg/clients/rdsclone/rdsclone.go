@@ -0,0 +1,178 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rdsclone
+
+import (
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+)
+
+const errNoSource = "one of sourceDBInstanceIdentifier and dbSnapshotIdentifier must be given"
+
+// Client is the external client used for RDSClone Custom Resource
+type Client interface {
+	DescribeDBInstancesRequest(input *rds.DescribeDBInstancesInput) rds.DescribeDBInstancesRequest
+	RestoreDBInstanceFromDBSnapshotRequest(input *rds.RestoreDBInstanceFromDBSnapshotInput) rds.RestoreDBInstanceFromDBSnapshotRequest
+	DeleteDBInstanceRequest(input *rds.DeleteDBInstanceInput) rds.DeleteDBInstanceRequest
+	CreateDBSnapshotRequest(input *rds.CreateDBSnapshotInput) rds.CreateDBSnapshotRequest
+	DescribeDBSnapshotsRequest(input *rds.DescribeDBSnapshotsInput) rds.DescribeDBSnapshotsRequest
+	DeleteDBSnapshotRequest(input *rds.DeleteDBSnapshotInput) rds.DeleteDBSnapshotRequest
+}
+
+// NewClient returns a new client using AWS credentials as JSON encoded data.
+func NewClient(cfg aws.Config) Client {
+	return rds.New(cfg)
+}
+
+// IsInstanceNotFound returns true if the error is because the DB instance
+// doesn't exist.
+func IsInstanceNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == rds.ErrCodeDBInstanceNotFoundFault
+	}
+	return false
+}
+
+// IsSnapshotNotFound returns true if the error is because the snapshot doesn't
+// exist.
+func IsSnapshotNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == rds.ErrCodeDBSnapshotNotFoundFault
+	}
+	return false
+}
+
+// ValidateSource checks that the clone has a DB instance or a snapshot to be
+// restored from.
+func ValidateSource(p v1alpha1.RDSCloneParameters) error {
+	if p.SourceDBInstanceIdentifier == nil && p.DBSnapshotIdentifier == nil {
+		return errors.New(errNoSource)
+	}
+	return nil
+}
+
+// TakesSnapshot returns true if the clone takes its own snapshot of the source
+// DB instance rather than reusing an existing one.
+func TakesSnapshot(p v1alpha1.RDSCloneParameters) bool {
+	return p.DBSnapshotIdentifier == nil
+}
+
+// SnapshotIdentifier returns the identifier of the snapshot the clone with the
+// given identifier is restored from. Snapshots taken by the clone are named
+// after it.
+func SnapshotIdentifier(id string, p v1alpha1.RDSCloneParameters) string {
+	if TakesSnapshot(p) {
+		return id
+	}
+	return aws.StringValue(p.DBSnapshotIdentifier)
+}
+
+// GenerateCreateDBSnapshotInput returns the input that takes the snapshot of
+// the source DB instance for the clone with the given identifier.
+func GenerateCreateDBSnapshotInput(id string, p v1alpha1.RDSCloneParameters) *rds.CreateDBSnapshotInput {
+	return &rds.CreateDBSnapshotInput{
+		DBSnapshotIdentifier: aws.String(id),
+		DBInstanceIdentifier: p.SourceDBInstanceIdentifier,
+		Tags:                 generateTags(p.Tags),
+	}
+}
+
+// GenerateRestoreDBInstanceFromDBSnapshotInput returns the input that restores
+// the given snapshot to the clone with the given identifier. Settings that
+// aren't given in the parameters are copied from the source DB instance, if
+// any, so that the clone is reachable like its source.
+func GenerateRestoreDBInstanceFromDBSnapshotInput(id, snapshot string, p v1alpha1.RDSCloneParameters, source *rds.DBInstance) *rds.RestoreDBInstanceFromDBSnapshotInput {
+	in := &rds.RestoreDBInstanceFromDBSnapshotInput{
+		DBInstanceIdentifier: aws.String(id),
+		DBSnapshotIdentifier: aws.String(snapshot),
+		DBInstanceClass:      p.DBInstanceClass,
+		DBSubnetGroupName:    p.DBSubnetGroupName,
+		VpcSecurityGroupIds:  p.VPCSecurityGroupIDs,
+		PubliclyAccessible:   p.PubliclyAccessible,
+		Tags:                 generateTags(p.Tags),
+	}
+	if source == nil {
+		return in
+	}
+	if in.DBSubnetGroupName == nil && source.DBSubnetGroup != nil {
+		in.DBSubnetGroupName = source.DBSubnetGroup.DBSubnetGroupName
+	}
+	if len(in.VpcSecurityGroupIds) == 0 {
+		for _, sg := range source.VpcSecurityGroups {
+			in.VpcSecurityGroupIds = append(in.VpcSecurityGroupIds, aws.StringValue(sg.VpcSecurityGroupId))
+		}
+	}
+	if in.PubliclyAccessible == nil {
+		in.PubliclyAccessible = source.PubliclyAccessible
+	}
+	if len(source.DBParameterGroups) != 0 {
+		in.DBParameterGroupName = source.DBParameterGroups[0].DBParameterGroupName
+	}
+	if source.Endpoint != nil {
+		in.Port = source.Endpoint.Port
+	}
+	return in
+}
+
+// GenerateObservation is used to produce v1alpha1.RDSCloneObservation from the
+// rds.DBInstance of the clone.
+func GenerateObservation(snapshot string, db rds.DBInstance) v1alpha1.RDSCloneObservation {
+	o := v1alpha1.RDSCloneObservation{
+		DBSnapshotIdentifier: snapshot,
+		DBInstanceARN:        aws.StringValue(db.DBInstanceArn),
+		DBInstanceStatus:     aws.StringValue(db.DBInstanceStatus),
+		EngineVersion:        aws.StringValue(db.EngineVersion),
+	}
+	if db.Endpoint != nil {
+		o.Address = aws.StringValue(db.Endpoint.Address)
+		o.Port = aws.Int64Value(db.Endpoint.Port)
+	}
+	return o
+}
+
+// GetConnectionDetails returns the connection details of the clone. Its master
+// password is the one of the DB instance the snapshot was taken of.
+func GetConnectionDetails(db rds.DBInstance) managed.ConnectionDetails {
+	if db.Endpoint == nil || db.Endpoint.Address == nil {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(aws.StringValue(db.Endpoint.Address)),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.FormatInt(aws.Int64Value(db.Endpoint.Port), 10)),
+		xpv1.ResourceCredentialsSecretUserKey:     []byte(aws.StringValue(db.MasterUsername)),
+	}
+}
+
+func generateTags(tags []v1alpha1.DBSnapshotTag) []rds.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]rds.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = rds.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	}
+	return res
+}
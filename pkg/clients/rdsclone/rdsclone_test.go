@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rdsclone
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+)
+
+func TestGenerateRestoreDBInstanceFromDBSnapshotInput(t *testing.T) {
+	source := &rds.DBInstance{
+		DBSubnetGroup:      &rds.DBSubnetGroup{DBSubnetGroupName: aws.String("private")},
+		VpcSecurityGroups:  []rds.VpcSecurityGroupMembership{{VpcSecurityGroupId: aws.String("sg-1")}},
+		PubliclyAccessible: aws.Bool(false),
+		DBParameterGroups:  []rds.DBParameterGroupStatus{{DBParameterGroupName: aws.String("tuned")}},
+		Endpoint:           &rds.Endpoint{Port: aws.Int64(3306)},
+	}
+
+	cases := map[string]struct {
+		p      v1alpha1.RDSCloneParameters
+		source *rds.DBInstance
+		want   *rds.RestoreDBInstanceFromDBSnapshotInput
+	}{
+		"CopiedFromSource": {
+			source: source,
+			want: &rds.RestoreDBInstanceFromDBSnapshotInput{
+				DBInstanceIdentifier: aws.String("clone"),
+				DBSnapshotIdentifier: aws.String("snap"),
+				DBSubnetGroupName:    aws.String("private"),
+				VpcSecurityGroupIds:  []string{"sg-1"},
+				PubliclyAccessible:   aws.Bool(false),
+				DBParameterGroupName: aws.String("tuned"),
+				Port:                 aws.Int64(3306),
+			},
+		},
+		"ExplicitSettingsWin": {
+			p: v1alpha1.RDSCloneParameters{
+				DBInstanceClass:     aws.String("db.t3.micro"),
+				DBSubnetGroupName:   aws.String("preview"),
+				VPCSecurityGroupIDs: []string{"sg-2"},
+				PubliclyAccessible:  aws.Bool(true),
+				Tags:                []v1alpha1.DBSnapshotTag{{Key: "pr", Value: "42"}},
+			},
+			source: source,
+			want: &rds.RestoreDBInstanceFromDBSnapshotInput{
+				DBInstanceIdentifier: aws.String("clone"),
+				DBSnapshotIdentifier: aws.String("snap"),
+				DBInstanceClass:      aws.String("db.t3.micro"),
+				DBSubnetGroupName:    aws.String("preview"),
+				VpcSecurityGroupIds:  []string{"sg-2"},
+				PubliclyAccessible:   aws.Bool(true),
+				DBParameterGroupName: aws.String("tuned"),
+				Port:                 aws.Int64(3306),
+				Tags:                 []rds.Tag{{Key: aws.String("pr"), Value: aws.String("42")}},
+			},
+		},
+		"NoSource": {
+			want: &rds.RestoreDBInstanceFromDBSnapshotInput{
+				DBInstanceIdentifier: aws.String("clone"),
+				DBSnapshotIdentifier: aws.String("snap"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateRestoreDBInstanceFromDBSnapshotInput("clone", "snap", tc.p, tc.source)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -450,10 +450,14 @@ func GetConnectionDetails(in v1alpha1.Cluster) managed.ConnectionDetails {
 	if in.Status.AtProvider.Endpoint.Address == "" {
 		return nil
 	}
-	return managed.ConnectionDetails{
+	conn := managed.ConnectionDetails{
 		xpv1.ResourceCredentialsSecretEndpointKey: []byte(in.Status.AtProvider.Endpoint.Address),
 		xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(int(in.Status.AtProvider.Endpoint.Port))),
 	}
+	if in.Spec.ForProvider.DBName != nil {
+		conn[v1alpha1.ResourceCredentialsSecretDatabaseKey] = []byte(aws.StringValue(in.Spec.ForProvider.DBName))
+	}
+	return conn
 }
 
 // isClusterParameterGroupNameUpdated check if ClusterParameterGroupName is updated or not.
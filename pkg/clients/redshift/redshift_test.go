@@ -25,6 +25,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/redshift"
 	"github.com/google/go-cmp/cmp"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
 	"github.com/crossplane/provider-aws/apis/redshift/v1alpha1"
 )
 
@@ -502,3 +505,41 @@ func TestGenerateDeleteClusterInput(t *testing.T) {
 		})
 	}
 }
+
+func TestGetConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		in  v1alpha1.Cluster
+		out managed.ConnectionDetails
+	}{
+		"NoEndpoint": {
+			in:  v1alpha1.Cluster{},
+			out: nil,
+		},
+		"WithEndpoint": {
+			in: v1alpha1.Cluster{
+				Spec: v1alpha1.ClusterSpec{
+					ForProvider: v1alpha1.ClusterParameters{DBName: aws.String("dev")},
+				},
+				Status: v1alpha1.ClusterStatus{
+					AtProvider: v1alpha1.ClusterObservation{
+						Endpoint: v1alpha1.Endpoint{Address: "example.com", Port: 5439},
+					},
+				},
+			},
+			out: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey:     []byte("example.com"),
+				xpv1.ResourceCredentialsSecretPortKey:         []byte("5439"),
+				v1alpha1.ResourceCredentialsSecretDatabaseKey: []byte("dev"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := GetConnectionDetails(tc.in)
+			if diff := cmp.Diff(tc.out, r); diff != "" {
+				t.Errorf("GetConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+)
+
+// MockClient for testing.
+type MockClient struct {
+	MockDescribe func(*secretsmanager.DescribeSecretInput) secretsmanager.DescribeSecretRequest
+	MockGetValue func(*secretsmanager.GetSecretValueInput) secretsmanager.GetSecretValueRequest
+	MockCreate   func(*secretsmanager.CreateSecretInput) secretsmanager.CreateSecretRequest
+	MockPutValue func(*secretsmanager.PutSecretValueInput) secretsmanager.PutSecretValueRequest
+	MockDelete   func(*secretsmanager.DeleteSecretInput) secretsmanager.DeleteSecretRequest
+}
+
+// DescribeSecretRequest calls the underlying MockDescribe method.
+func (m *MockClient) DescribeSecretRequest(i *secretsmanager.DescribeSecretInput) secretsmanager.DescribeSecretRequest {
+	return m.MockDescribe(i)
+}
+
+// GetSecretValueRequest calls the underlying MockGetValue method.
+func (m *MockClient) GetSecretValueRequest(i *secretsmanager.GetSecretValueInput) secretsmanager.GetSecretValueRequest {
+	return m.MockGetValue(i)
+}
+
+// CreateSecretRequest calls the underlying MockCreate method.
+func (m *MockClient) CreateSecretRequest(i *secretsmanager.CreateSecretInput) secretsmanager.CreateSecretRequest {
+	return m.MockCreate(i)
+}
+
+// PutSecretValueRequest calls the underlying MockPutValue method.
+func (m *MockClient) PutSecretValueRequest(i *secretsmanager.PutSecretValueInput) secretsmanager.PutSecretValueRequest {
+	return m.MockPutValue(i)
+}
+
+// DeleteSecretRequest calls the underlying MockDelete method.
+func (m *MockClient) DeleteSecretRequest(i *secretsmanager.DeleteSecretInput) secretsmanager.DeleteSecretRequest {
+	return m.MockDelete(i)
+}
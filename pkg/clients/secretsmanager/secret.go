@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretsmanager
+
+import (
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+)
+
+// TagKeyOwner is the tag of the secrets created to publish the connection
+// details of a managed resource. Its value is the UID of the resource, so
+// that a resource that is recreated with the same name, or one of the same
+// name in another cluster, does not take over the secret.
+const TagKeyOwner = "aws.crossplane.io/owner-uid"
+
+// Client defines Secrets Manager client operations
+type Client interface {
+	DescribeSecretRequest(input *secretsmanager.DescribeSecretInput) secretsmanager.DescribeSecretRequest
+	GetSecretValueRequest(input *secretsmanager.GetSecretValueInput) secretsmanager.GetSecretValueRequest
+	CreateSecretRequest(input *secretsmanager.CreateSecretInput) secretsmanager.CreateSecretRequest
+	PutSecretValueRequest(input *secretsmanager.PutSecretValueInput) secretsmanager.PutSecretValueRequest
+	DeleteSecretRequest(input *secretsmanager.DeleteSecretInput) secretsmanager.DeleteSecretRequest
+}
+
+// NewClient creates new Secrets Manager Client with provided AWS
+// Configurations/Credentials
+func NewClient(cfg aws.Config) Client {
+	return secretsmanager.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the secret doesn't exist
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == secretsmanager.ErrCodeResourceNotFoundException
+	}
+	return false
+}
+
+// MergeConnectionDetails adds the given connection details to the JSON
+// object in current, overwriting existing keys, and reports whether that
+// changed the object.
+func MergeConnectionDetails(current string, conn managed.ConnectionDetails) (string, bool, error) {
+	m := map[string]string{}
+	if current != "" {
+		if err := json.Unmarshal([]byte(current), &m); err != nil {
+			return "", false, err
+		}
+	}
+	changed := false
+	for k, v := range conn {
+		if val, ok := m[k]; !ok || val != string(v) {
+			m[k] = string(v)
+			changed = true
+		}
+	}
+	if !changed {
+		return current, false, nil
+	}
+	out, err := json.Marshal(m)
+	return string(out), true, err
+}
+
+// RemoveConnectionDetails removes the given keys from the JSON object in
+// current and reports whether that changed the object.
+func RemoveConnectionDetails(current string, keys []string) (string, bool, error) {
+	m := map[string]string{}
+	if current != "" {
+		if err := json.Unmarshal([]byte(current), &m); err != nil {
+			return "", false, err
+		}
+	}
+	changed := false
+	for _, k := range keys {
+		if _, ok := m[k]; ok {
+			delete(m, k)
+			changed = true
+		}
+	}
+	if !changed {
+		return current, false, nil
+	}
+	out, err := json.Marshal(m)
+	return string(out), true, err
+}
+
+// IsOwnedBy returns true if the given tags mark a secret as created to publish
+// the connection details of the resource with the given UID.
+func IsOwnedBy(tags []secretsmanager.Tag, uid string) bool {
+	for _, t := range tags {
+		if aws.StringValue(t.Key) == TagKeyOwner {
+			return aws.StringValue(t.Value) == uid
+		}
+	}
+	return false
+}
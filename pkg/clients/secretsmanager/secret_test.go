@@ -0,0 +1,108 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretsmanager
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+)
+
+func TestMergeConnectionDetails(t *testing.T) {
+	type want struct {
+		val     string
+		changed bool
+		err     bool
+	}
+	cases := map[string]struct {
+		current string
+		conn    managed.ConnectionDetails
+		want    want
+	}{
+		"Empty": {
+			conn: managed.ConnectionDetails{"password": []byte("pw")},
+			want: want{val: `{"password":"pw"}`, changed: true},
+		},
+		"Unchanged": {
+			current: `{"password":"pw","username":"admin"}`,
+			conn:    managed.ConnectionDetails{"password": []byte("pw")},
+			want:    want{val: `{"password":"pw","username":"admin"}`},
+		},
+		"PasswordChanged": {
+			current: `{"password":"old","username":"admin"}`,
+			conn:    managed.ConnectionDetails{"password": []byte("new")},
+			want:    want{val: `{"password":"new","username":"admin"}`, changed: true},
+		},
+		"NotJSON": {
+			current: "plain",
+			conn:    managed.ConnectionDetails{"password": []byte("pw")},
+			want:    want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			val, changed, err := MergeConnectionDetails(tc.current, tc.conn)
+			if diff := cmp.Diff(tc.want, want{val: val, changed: changed, err: err != nil}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRemoveConnectionDetails(t *testing.T) {
+	type want struct {
+		val     string
+		changed bool
+		err     bool
+	}
+	cases := map[string]struct {
+		current string
+		keys    []string
+		want    want
+	}{
+		"Empty": {
+			keys: []string{"password"},
+		},
+		"Unchanged": {
+			current: `{"api-key":"key"}`,
+			keys:    []string{"password"},
+			want:    want{val: `{"api-key":"key"}`},
+		},
+		"Removed": {
+			current: `{"api-key":"key","password":"pw","username":"admin"}`,
+			keys:    []string{"password", "username"},
+			want:    want{val: `{"api-key":"key"}`, changed: true},
+		},
+		"NotJSON": {
+			current: "plain",
+			keys:    []string{"password"},
+			want:    want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			val, changed, err := RemoveConnectionDetails(tc.current, tc.keys)
+			if diff := cmp.Diff(tc.want, want{val: val, changed: changed, err: err != nil}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
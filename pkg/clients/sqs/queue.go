@@ -28,6 +28,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
@@ -53,6 +54,13 @@ type Client interface {
 	GetQueueUrlRequest(input *sqs.GetQueueUrlInput) sqs.GetQueueUrlRequest
 }
 
+// redriveAllowPolicy is the JSON representation of the RedriveAllowPolicy
+// queue attribute.
+type redriveAllowPolicy struct {
+	RedrivePermission string   `json:"redrivePermission"`
+	SourceQueueARNs   []string `json:"sourceQueueArns,omitempty"`
+}
+
 // NewClient returns a new SQS Client.
 func NewClient(cfg aws.Config) Client {
 	return sqs.New(cfg)
@@ -103,6 +111,15 @@ func GenerateQueueAttributes(p *v1beta1.QueueParameters) map[string]string { //
 			m[v1beta1.AttributeRedrivePolicy] = string(val)
 		}
 	}
+	if p.RedriveAllowPolicy != nil {
+		val, err := json.Marshal(redriveAllowPolicy{
+			RedrivePermission: p.RedriveAllowPolicy.RedrivePermission,
+			SourceQueueARNs:   p.RedriveAllowPolicy.SourceQueueARNs,
+		})
+		if err == nil {
+			m[v1beta1.AttributeRedriveAllowPolicy] = string(val)
+		}
+	}
 	if p.VisibilityTimeout != nil {
 		m[v1beta1.AttributeVisibilityTimeout] = strconv.FormatInt(aws.Int64Value(p.VisibilityTimeout), 10)
 	}
@@ -226,6 +243,17 @@ func IsUpToDate(p v1beta1.QueueParameters, attributes map[string]string, tags ma
 			}
 		}
 	}
+	if p.RedriveAllowPolicy != nil {
+		observed := redriveAllowPolicy{}
+		_ = json.Unmarshal([]byte(attributes[v1beta1.AttributeRedriveAllowPolicy]), &observed)
+		desired := redriveAllowPolicy{
+			RedrivePermission: p.RedriveAllowPolicy.RedrivePermission,
+			SourceQueueARNs:   p.RedriveAllowPolicy.SourceQueueARNs,
+		}
+		if !cmp.Equal(desired, observed, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b })) {
+			return false
+		}
+	}
 	return true
 }
 
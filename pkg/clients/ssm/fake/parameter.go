@@ -0,0 +1,55 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ssm"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockParameterClient)(nil)
+
+// MockParameterClient is a type that implements all the methods for the
+// Parameter Client interface
+type MockParameterClient struct {
+	MockGet      func(*ssm.GetParameterInput) ssm.GetParameterRequest
+	MockDescribe func(*ssm.DescribeParametersInput) ssm.DescribeParametersRequest
+	MockPut      func(*ssm.PutParameterInput) ssm.PutParameterRequest
+	MockDelete   func(*ssm.DeleteParameterInput) ssm.DeleteParameterRequest
+}
+
+// GetParameterRequest mocks GetParameterRequest method
+func (m *MockParameterClient) GetParameterRequest(input *ssm.GetParameterInput) ssm.GetParameterRequest {
+	return m.MockGet(input)
+}
+
+// DescribeParametersRequest mocks DescribeParametersRequest method
+func (m *MockParameterClient) DescribeParametersRequest(input *ssm.DescribeParametersInput) ssm.DescribeParametersRequest {
+	return m.MockDescribe(input)
+}
+
+// PutParameterRequest mocks PutParameterRequest method
+func (m *MockParameterClient) PutParameterRequest(input *ssm.PutParameterInput) ssm.PutParameterRequest {
+	return m.MockPut(input)
+}
+
+// DeleteParameterRequest mocks DeleteParameterRequest method
+func (m *MockParameterClient) DeleteParameterRequest(input *ssm.DeleteParameterInput) ssm.DeleteParameterRequest {
+	return m.MockDelete(input)
+}
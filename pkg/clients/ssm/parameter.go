@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package ssm
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/ssm/v1alpha1"
+)
+
+// Client defines SSM Parameter client operations
+type Client interface {
+	GetParameterRequest(input *ssm.GetParameterInput) ssm.GetParameterRequest
+	DescribeParametersRequest(input *ssm.DescribeParametersInput) ssm.DescribeParametersRequest
+	PutParameterRequest(input *ssm.PutParameterInput) ssm.PutParameterRequest
+	DeleteParameterRequest(input *ssm.DeleteParameterInput) ssm.DeleteParameterRequest
+}
+
+// NewClient creates new SSM Client with provided AWS Configurations/Credentials
+func NewClient(cfg aws.Config) Client {
+	return ssm.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the item doesn't exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == ssm.ErrCodeParameterNotFound
+	}
+	return false
+}
+
+// GenerateDescribeParametersInput returns the input to find the metadata of
+// the parameter with the given name.
+func GenerateDescribeParametersInput(name string) *ssm.DescribeParametersInput {
+	return &ssm.DescribeParametersInput{
+		ParameterFilters: []ssm.ParameterStringFilter{{
+			Key:    aws.String("Name"),
+			Option: aws.String("Equals"),
+			Values: []string{name},
+		}},
+	}
+}
+
+// GeneratePutParameterInput returns the input to create or overwrite the
+// parameter with the given name and value.
+func GeneratePutParameterInput(name, value string, p v1alpha1.ParameterParameters) *ssm.PutParameterInput {
+	in := &ssm.PutParameterInput{
+		Name:           aws.String(name),
+		Value:          aws.String(value),
+		Type:           ssm.ParameterType(p.Type),
+		Description:    p.Description,
+		AllowedPattern: p.AllowedPattern,
+		DataType:       p.DataType,
+		Tier:           ssm.ParameterTier(aws.StringValue(p.Tier)),
+	}
+	// AWS rejects a key for parameters that are not encrypted.
+	if p.Type == v1alpha1.ParameterTypeSecureString {
+		in.KeyId = p.KMSKeyID
+	}
+	return in
+}
+
+// LateInitialize fills the empty fields in *v1alpha1.ParameterParameters with
+// the values seen in ssm.ParameterMetadata.
+func LateInitialize(in *v1alpha1.ParameterParameters, m *ssm.ParameterMetadata) {
+	if m == nil {
+		return
+	}
+	if in.KMSKeyID == nil && in.Type == v1alpha1.ParameterTypeSecureString {
+		in.KMSKeyID = m.KeyId
+	}
+	if in.DataType == nil {
+		in.DataType = m.DataType
+	}
+	if in.Tier == nil && m.Tier != "" {
+		in.Tier = aws.String(string(m.Tier))
+	}
+}
+
+// GenerateObservation is used to produce v1alpha1.ParameterObservation from
+// ssm.Parameter.
+func GenerateObservation(p ssm.Parameter) v1alpha1.ParameterObservation {
+	o := v1alpha1.ParameterObservation{
+		ARN:     aws.StringValue(p.ARN),
+		Version: aws.Int64Value(p.Version),
+	}
+	if p.LastModifiedDate != nil {
+		t := metav1.NewTime(*p.LastModifiedDate)
+		o.LastModifiedDate = &t
+	}
+	return o
+}
+
+// IsUpToDate checks whether the observed parameter and its metadata match the
+// desired value and parameters.
+func IsUpToDate(value string, p v1alpha1.ParameterParameters, param ssm.Parameter, m ssm.ParameterMetadata) bool { // nolint:gocyclo
+	switch {
+	case aws.StringValue(param.Value) != value,
+		string(param.Type) != p.Type,
+		aws.StringValue(m.Description) != aws.StringValue(p.Description),
+		aws.StringValue(m.AllowedPattern) != aws.StringValue(p.AllowedPattern):
+		return false
+	case p.DataType != nil && aws.StringValue(m.DataType) != *p.DataType:
+		return false
+	case p.Type == v1alpha1.ParameterTypeSecureString && p.KMSKeyID != nil && aws.StringValue(m.KeyId) != *p.KMSKeyID:
+		return false
+	}
+	// Intelligent-Tiering lets AWS choose between Standard and Advanced, so
+	// the observed tier is never Intelligent-Tiering.
+	if t := aws.StringValue(p.Tier); t != "" && t != string(ssm.ParameterTierIntelligentTiering) && string(m.Tier) != t {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package ssm
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ssm/v1alpha1"
+)
+
+var (
+	parameterName = "/some/parameter"
+	value         = "some-value"
+	keyID         = "alias/some-key"
+	description   = "some description"
+)
+
+func TestGeneratePutParameterInput(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.ParameterParameters
+		want *ssm.PutParameterInput
+	}{
+		"String": {
+			p: v1alpha1.ParameterParameters{
+				Type:        v1alpha1.ParameterTypeString,
+				Description: aws.String(description),
+				KMSKeyID:    aws.String(keyID),
+				Tier:        aws.String("Advanced"),
+			},
+			want: &ssm.PutParameterInput{
+				Name:        aws.String(parameterName),
+				Value:       aws.String(value),
+				Type:        ssm.ParameterTypeString,
+				Description: aws.String(description),
+				Tier:        ssm.ParameterTierAdvanced,
+			},
+		},
+		"SecureString": {
+			p: v1alpha1.ParameterParameters{
+				Type:     v1alpha1.ParameterTypeSecureString,
+				KMSKeyID: aws.String(keyID),
+			},
+			want: &ssm.PutParameterInput{
+				Name:  aws.String(parameterName),
+				Value: aws.String(value),
+				Type:  ssm.ParameterTypeSecureString,
+				KeyId: aws.String(keyID),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GeneratePutParameterInput(parameterName, value, tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.ParameterParameters
+		m    *ssm.ParameterMetadata
+		want v1alpha1.ParameterParameters
+	}{
+		"SecureString": {
+			p: v1alpha1.ParameterParameters{Type: v1alpha1.ParameterTypeSecureString},
+			m: &ssm.ParameterMetadata{KeyId: aws.String("alias/aws/ssm"), DataType: aws.String("text"), Tier: ssm.ParameterTierStandard},
+			want: v1alpha1.ParameterParameters{
+				Type:     v1alpha1.ParameterTypeSecureString,
+				KMSKeyID: aws.String("alias/aws/ssm"),
+				DataType: aws.String("text"),
+				Tier:     aws.String("Standard"),
+			},
+		},
+		"KeepExisting": {
+			p: v1alpha1.ParameterParameters{Type: v1alpha1.ParameterTypeString, Tier: aws.String("Intelligent-Tiering")},
+			m: &ssm.ParameterMetadata{KeyId: aws.String("alias/aws/ssm"), Tier: ssm.ParameterTierStandard},
+			want: v1alpha1.ParameterParameters{
+				Type: v1alpha1.ParameterTypeString,
+				Tier: aws.String("Intelligent-Tiering"),
+			},
+		},
+		"NilMetadata": {
+			p:    v1alpha1.ParameterParameters{Type: v1alpha1.ParameterTypeString},
+			want: v1alpha1.ParameterParameters{Type: v1alpha1.ParameterTypeString},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitialize(&tc.p, tc.m)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	params := v1alpha1.ParameterParameters{
+		Type:        v1alpha1.ParameterTypeSecureString,
+		Description: aws.String(description),
+		KMSKeyID:    aws.String(keyID),
+		Tier:        aws.String("Standard"),
+	}
+	param := ssm.Parameter{Value: aws.String(value), Type: ssm.ParameterTypeSecureString}
+	md := ssm.ParameterMetadata{Description: aws.String(description), KeyId: aws.String(keyID), Tier: ssm.ParameterTierStandard}
+
+	cases := map[string]struct {
+		value string
+		p     v1alpha1.ParameterParameters
+		param ssm.Parameter
+		m     ssm.ParameterMetadata
+		want  bool
+	}{
+		"UpToDate": {
+			value: value,
+			p:     params,
+			param: param,
+			m:     md,
+			want:  true,
+		},
+		"ValueChanged": {
+			value: "other-value",
+			p:     params,
+			param: param,
+			m:     md,
+			want:  false,
+		},
+		"KeyChanged": {
+			value: value,
+			p:     params,
+			param: param,
+			m:     ssm.ParameterMetadata{Description: aws.String(description), KeyId: aws.String("alias/aws/ssm"), Tier: ssm.ParameterTierStandard},
+			want:  false,
+		},
+		"IntelligentTiering": {
+			value: value,
+			p: v1alpha1.ParameterParameters{
+				Type:        v1alpha1.ParameterTypeSecureString,
+				Description: aws.String(description),
+				Tier:        aws.String("Intelligent-Tiering"),
+			},
+			param: param,
+			m:     ssm.ParameterMetadata{Description: aws.String(description), KeyId: aws.String(keyID), Tier: ssm.ParameterTierAdvanced},
+			want:  true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.value, tc.p, tc.param, tc.m)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
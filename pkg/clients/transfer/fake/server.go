@@ -0,0 +1,54 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/transfer"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/transfer"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockClient)(nil)
+
+// MockClient is a type that implements all the methods for the Transfer
+// Family Server Client interface
+type MockClient struct {
+	MockCreate   func(*transfer.CreateServerInput) transfer.CreateServerRequest
+	MockDescribe func(*transfer.DescribeServerInput) transfer.DescribeServerRequest
+	MockUpdate   func(*transfer.UpdateServerInput) transfer.UpdateServerRequest
+	MockDelete   func(*transfer.DeleteServerInput) transfer.DeleteServerRequest
+}
+
+// CreateServerRequest mocks CreateServerRequest method
+func (m *MockClient) CreateServerRequest(input *transfer.CreateServerInput) transfer.CreateServerRequest {
+	return m.MockCreate(input)
+}
+
+// DescribeServerRequest mocks DescribeServerRequest method
+func (m *MockClient) DescribeServerRequest(input *transfer.DescribeServerInput) transfer.DescribeServerRequest {
+	return m.MockDescribe(input)
+}
+
+// UpdateServerRequest mocks UpdateServerRequest method
+func (m *MockClient) UpdateServerRequest(input *transfer.UpdateServerInput) transfer.UpdateServerRequest {
+	return m.MockUpdate(input)
+}
+
+// DeleteServerRequest mocks DeleteServerRequest method
+func (m *MockClient) DeleteServerRequest(input *transfer.DeleteServerInput) transfer.DeleteServerRequest {
+	return m.MockDelete(input)
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package transfer
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/transfer"
+
+	"github.com/crossplane/provider-aws/apis/transfer/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// Client defines Transfer Family Server client operations
+type Client interface {
+	CreateServerRequest(input *transfer.CreateServerInput) transfer.CreateServerRequest
+	DescribeServerRequest(input *transfer.DescribeServerInput) transfer.DescribeServerRequest
+	UpdateServerRequest(input *transfer.UpdateServerInput) transfer.UpdateServerRequest
+	DeleteServerRequest(input *transfer.DeleteServerInput) transfer.DeleteServerRequest
+}
+
+// NewClient creates new Transfer Family Client with provided AWS
+// Configurations/Credentials
+func NewClient(cfg aws.Config) Client {
+	return transfer.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the server doesn't exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == transfer.ErrCodeResourceNotFoundException
+	}
+	return false
+}
+
+// GenerateCreateServerInput returns the input to create a server with the
+// given parameters.
+func GenerateCreateServerInput(p v1alpha1.ServerParameters) *transfer.CreateServerInput {
+	in := &transfer.CreateServerInput{
+		Certificate:             p.Certificate,
+		EndpointDetails:         generateEndpointDetails(p.EndpointDetails),
+		EndpointType:            transfer.EndpointType(aws.StringValue(p.EndpointType)),
+		IdentityProviderDetails: generateIdentityProviderDetails(p.IdentityProviderDetails),
+		IdentityProviderType:    transfer.IdentityProviderType(aws.StringValue(p.IdentityProviderType)),
+		LoggingRole:             p.LoggingRole,
+		Protocols:               generateProtocols(p.Protocols),
+	}
+	if len(p.Tags) != 0 {
+		in.Tags = make([]transfer.Tag, len(p.Tags))
+		for i, t := range p.Tags {
+			in.Tags[i] = transfer.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+		}
+	}
+	return in
+}
+
+// GenerateUpdateServerInput returns the input to update the server with the
+// given ID to match the given parameters.
+func GenerateUpdateServerInput(id string, p v1alpha1.ServerParameters) *transfer.UpdateServerInput {
+	return &transfer.UpdateServerInput{
+		ServerId:                aws.String(id),
+		Certificate:             p.Certificate,
+		EndpointDetails:         generateEndpointDetails(p.EndpointDetails),
+		EndpointType:            transfer.EndpointType(aws.StringValue(p.EndpointType)),
+		IdentityProviderDetails: generateIdentityProviderDetails(p.IdentityProviderDetails),
+		LoggingRole:             p.LoggingRole,
+		Protocols:               generateProtocols(p.Protocols),
+	}
+}
+
+// LateInitialize fills the empty fields in *v1alpha1.ServerParameters with
+// the values seen in transfer.DescribedServer.
+func LateInitialize(in *v1alpha1.ServerParameters, s *transfer.DescribedServer) {
+	if s == nil {
+		return
+	}
+	if len(in.Protocols) == 0 && len(s.Protocols) != 0 {
+		in.Protocols = make([]string, len(s.Protocols))
+		for i, p := range s.Protocols {
+			in.Protocols[i] = string(p)
+		}
+	}
+	if in.EndpointType == nil && s.EndpointType != "" {
+		in.EndpointType = aws.String(string(s.EndpointType))
+	}
+	if in.IdentityProviderType == nil && s.IdentityProviderType != "" {
+		in.IdentityProviderType = aws.String(string(s.IdentityProviderType))
+	}
+	in.Certificate = awsclients.LateInitializeStringPtr(in.Certificate, s.Certificate)
+	in.LoggingRole = awsclients.LateInitializeStringPtr(in.LoggingRole, s.LoggingRole)
+}
+
+// GenerateObservation is used to produce v1alpha1.ServerObservation from
+// transfer.DescribedServer.
+func GenerateObservation(s transfer.DescribedServer, region string) v1alpha1.ServerObservation {
+	return v1alpha1.ServerObservation{
+		ARN:                aws.StringValue(s.Arn),
+		ServerID:           aws.StringValue(s.ServerId),
+		State:              string(s.State),
+		Endpoint:           Endpoint(aws.StringValue(s.ServerId), region),
+		HostKeyFingerprint: aws.StringValue(s.HostKeyFingerprint),
+		UserCount:          aws.Int64Value(s.UserCount),
+	}
+}
+
+// Endpoint returns the hostname of the server with the given ID.
+func Endpoint(id, region string) string {
+	return fmt.Sprintf("%s.server.transfer.%s.amazonaws.com", id, region)
+}
+
+// IsUpToDate checks whether the observed server matches the desired
+// parameters. Tags are only set at creation and not compared.
+func IsUpToDate(p v1alpha1.ServerParameters, s transfer.DescribedServer) bool { // nolint:gocyclo
+	observed := make([]string, len(s.Protocols))
+	for i, pr := range s.Protocols {
+		observed[i] = string(pr)
+	}
+	if len(p.Protocols) != 0 && !sameStrings(p.Protocols, observed) {
+		return false
+	}
+	if p.EndpointType != nil && aws.StringValue(p.EndpointType) != string(s.EndpointType) {
+		return false
+	}
+	if aws.StringValue(p.Certificate) != aws.StringValue(s.Certificate) ||
+		aws.StringValue(p.LoggingRole) != aws.StringValue(s.LoggingRole) {
+		return false
+	}
+	if d := p.IdentityProviderDetails; d != nil {
+		o := s.IdentityProviderDetails
+		if o == nil {
+			o = &transfer.IdentityProviderDetails{}
+		}
+		if aws.StringValue(d.InvocationRole) != aws.StringValue(o.InvocationRole) ||
+			aws.StringValue(d.URL) != aws.StringValue(o.Url) {
+			return false
+		}
+	}
+	if d := p.EndpointDetails; d != nil {
+		o := s.EndpointDetails
+		if o == nil {
+			o = &transfer.EndpointDetails{}
+		}
+		if aws.StringValue(d.VPCID) != aws.StringValue(o.VpcId) ||
+			aws.StringValue(d.VPCEndpointID) != aws.StringValue(o.VpcEndpointId) ||
+			!sameStrings(d.SubnetIDs, o.SubnetIds) ||
+			!sameStrings(d.AddressAllocationIDs, o.AddressAllocationIds) {
+			return false
+		}
+	}
+	return true
+}
+
+func generateProtocols(in []string) []transfer.Protocol {
+	if len(in) == 0 {
+		return nil
+	}
+	res := make([]transfer.Protocol, len(in))
+	for i, p := range in {
+		res[i] = transfer.Protocol(p)
+	}
+	return res
+}
+
+func generateEndpointDetails(d *v1alpha1.EndpointDetails) *transfer.EndpointDetails {
+	if d == nil {
+		return nil
+	}
+	return &transfer.EndpointDetails{
+		AddressAllocationIds: d.AddressAllocationIDs,
+		SubnetIds:            d.SubnetIDs,
+		VpcEndpointId:        d.VPCEndpointID,
+		VpcId:                d.VPCID,
+	}
+}
+
+func generateIdentityProviderDetails(d *v1alpha1.IdentityProviderDetails) *transfer.IdentityProviderDetails {
+	if d == nil {
+		return nil
+	}
+	return &transfer.IdentityProviderDetails{
+		InvocationRole: d.InvocationRole,
+		Url:            d.URL,
+	}
+}
+
+// sameStrings reports whether a and b contain the same strings regardless of
+// their order.
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	x := append([]string{}, a...)
+	y := append([]string{}, b...)
+	sort.Strings(x)
+	sort.Strings(y)
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
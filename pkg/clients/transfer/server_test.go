@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package transfer
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/transfer"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/transfer/v1alpha1"
+)
+
+func TestLateInitialize(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.ServerParameters
+		s    *transfer.DescribedServer
+		want v1alpha1.ServerParameters
+	}{
+		"AllEmpty": {
+			s: &transfer.DescribedServer{
+				Protocols:            []transfer.Protocol{transfer.ProtocolSftp},
+				EndpointType:         transfer.EndpointTypePublic,
+				IdentityProviderType: transfer.IdentityProviderTypeServiceManaged,
+				LoggingRole:          aws.String("arn:aws:iam::123456789012:role/logging"),
+			},
+			want: v1alpha1.ServerParameters{
+				Protocols:            []string{"SFTP"},
+				EndpointType:         aws.String("PUBLIC"),
+				IdentityProviderType: aws.String("SERVICE_MANAGED"),
+				LoggingRole:          aws.String("arn:aws:iam::123456789012:role/logging"),
+			},
+		},
+		"KeepsSpec": {
+			in: v1alpha1.ServerParameters{
+				Protocols:    []string{"FTPS"},
+				EndpointType: aws.String("VPC"),
+			},
+			s: &transfer.DescribedServer{
+				Protocols:    []transfer.Protocol{transfer.ProtocolSftp},
+				EndpointType: transfer.EndpointTypePublic,
+			},
+			want: v1alpha1.ServerParameters{
+				Protocols:    []string{"FTPS"},
+				EndpointType: aws.String("VPC"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitialize(&tc.in, tc.s)
+			if diff := cmp.Diff(tc.want, tc.in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	vpc := transfer.DescribedServer{
+		Protocols:    []transfer.Protocol{transfer.ProtocolSftp, transfer.ProtocolFtps},
+		EndpointType: transfer.EndpointTypeVpc,
+		EndpointDetails: &transfer.EndpointDetails{
+			VpcId:     aws.String("vpc-1"),
+			SubnetIds: []string{"subnet-1", "subnet-2"},
+		},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.ServerParameters
+		s    transfer.DescribedServer
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.ServerParameters{
+				Protocols:    []string{"FTPS", "SFTP"},
+				EndpointType: aws.String("VPC"),
+				EndpointDetails: &v1alpha1.EndpointDetails{
+					VPCID:     aws.String("vpc-1"),
+					SubnetIDs: []string{"subnet-2", "subnet-1"},
+				},
+			},
+			s:    vpc,
+			want: true,
+		},
+		"ProtocolRemoved": {
+			p: v1alpha1.ServerParameters{
+				Protocols:    []string{"SFTP"},
+				EndpointType: aws.String("VPC"),
+			},
+			s:    vpc,
+			want: false,
+		},
+		"EndpointTypeChanged": {
+			p: v1alpha1.ServerParameters{
+				Protocols:    []string{"SFTP", "FTPS"},
+				EndpointType: aws.String("PUBLIC"),
+			},
+			s:    vpc,
+			want: false,
+		},
+		"SubnetsChanged": {
+			p: v1alpha1.ServerParameters{
+				Protocols:    []string{"SFTP", "FTPS"},
+				EndpointType: aws.String("VPC"),
+				EndpointDetails: &v1alpha1.EndpointDetails{
+					VPCID:     aws.String("vpc-1"),
+					SubnetIDs: []string{"subnet-1"},
+				},
+			},
+			s:    vpc,
+			want: false,
+		},
+		"IdentityProviderChanged": {
+			p: v1alpha1.ServerParameters{
+				IdentityProviderDetails: &v1alpha1.IdentityProviderDetails{URL: aws.String("https://example.com/auth")},
+			},
+			s: transfer.DescribedServer{
+				IdentityProviderDetails: &transfer.IdentityProviderDetails{Url: aws.String("https://example.com/old")},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.p, tc.s)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
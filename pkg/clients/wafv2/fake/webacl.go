@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/wafv2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockWebACLClient)(nil)
+
+// MockWebACLClient is a type that implements all the methods for the WebACL
+// Client interface
+type MockWebACLClient struct {
+	MockList          func(*wafv2.ListWebACLsInput) wafv2.ListWebACLsRequest
+	MockGet           func(*wafv2.GetWebACLInput) wafv2.GetWebACLRequest
+	MockCreate        func(*wafv2.CreateWebACLInput) wafv2.CreateWebACLRequest
+	MockUpdate        func(*wafv2.UpdateWebACLInput) wafv2.UpdateWebACLRequest
+	MockDelete        func(*wafv2.DeleteWebACLInput) wafv2.DeleteWebACLRequest
+	MockListResources func(*wafv2.ListResourcesForWebACLInput) wafv2.ListResourcesForWebACLRequest
+	MockAssociate     func(*wafv2.AssociateWebACLInput) wafv2.AssociateWebACLRequest
+	MockDisassociate  func(*wafv2.DisassociateWebACLInput) wafv2.DisassociateWebACLRequest
+	MockListTags      func(*wafv2.ListTagsForResourceInput) wafv2.ListTagsForResourceRequest
+	MockTagResource   func(*wafv2.TagResourceInput) wafv2.TagResourceRequest
+	MockUntagResource func(*wafv2.UntagResourceInput) wafv2.UntagResourceRequest
+}
+
+// ListWebACLsRequest mocks ListWebACLsRequest method
+func (m *MockWebACLClient) ListWebACLsRequest(input *wafv2.ListWebACLsInput) wafv2.ListWebACLsRequest {
+	return m.MockList(input)
+}
+
+// GetWebACLRequest mocks GetWebACLRequest method
+func (m *MockWebACLClient) GetWebACLRequest(input *wafv2.GetWebACLInput) wafv2.GetWebACLRequest {
+	return m.MockGet(input)
+}
+
+// CreateWebACLRequest mocks CreateWebACLRequest method
+func (m *MockWebACLClient) CreateWebACLRequest(input *wafv2.CreateWebACLInput) wafv2.CreateWebACLRequest {
+	return m.MockCreate(input)
+}
+
+// UpdateWebACLRequest mocks UpdateWebACLRequest method
+func (m *MockWebACLClient) UpdateWebACLRequest(input *wafv2.UpdateWebACLInput) wafv2.UpdateWebACLRequest {
+	return m.MockUpdate(input)
+}
+
+// DeleteWebACLRequest mocks DeleteWebACLRequest method
+func (m *MockWebACLClient) DeleteWebACLRequest(input *wafv2.DeleteWebACLInput) wafv2.DeleteWebACLRequest {
+	return m.MockDelete(input)
+}
+
+// ListResourcesForWebACLRequest mocks ListResourcesForWebACLRequest method
+func (m *MockWebACLClient) ListResourcesForWebACLRequest(input *wafv2.ListResourcesForWebACLInput) wafv2.ListResourcesForWebACLRequest {
+	return m.MockListResources(input)
+}
+
+// AssociateWebACLRequest mocks AssociateWebACLRequest method
+func (m *MockWebACLClient) AssociateWebACLRequest(input *wafv2.AssociateWebACLInput) wafv2.AssociateWebACLRequest {
+	return m.MockAssociate(input)
+}
+
+// DisassociateWebACLRequest mocks DisassociateWebACLRequest method
+func (m *MockWebACLClient) DisassociateWebACLRequest(input *wafv2.DisassociateWebACLInput) wafv2.DisassociateWebACLRequest {
+	return m.MockDisassociate(input)
+}
+
+// ListTagsForResourceRequest mocks ListTagsForResourceRequest method
+func (m *MockWebACLClient) ListTagsForResourceRequest(input *wafv2.ListTagsForResourceInput) wafv2.ListTagsForResourceRequest {
+	return m.MockListTags(input)
+}
+
+// TagResourceRequest mocks TagResourceRequest method
+func (m *MockWebACLClient) TagResourceRequest(input *wafv2.TagResourceInput) wafv2.TagResourceRequest {
+	return m.MockTagResource(input)
+}
+
+// UntagResourceRequest mocks UntagResourceRequest method
+func (m *MockWebACLClient) UntagResourceRequest(input *wafv2.UntagResourceInput) wafv2.UntagResourceRequest {
+	return m.MockUntagResource(input)
+}
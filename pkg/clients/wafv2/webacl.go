@@ -0,0 +1,343 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wafv2
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/wafv2/v1alpha1"
+)
+
+// Client defines WAFv2 WebACL client operations
+type Client interface {
+	ListWebACLsRequest(input *wafv2.ListWebACLsInput) wafv2.ListWebACLsRequest
+	GetWebACLRequest(input *wafv2.GetWebACLInput) wafv2.GetWebACLRequest
+	CreateWebACLRequest(input *wafv2.CreateWebACLInput) wafv2.CreateWebACLRequest
+	UpdateWebACLRequest(input *wafv2.UpdateWebACLInput) wafv2.UpdateWebACLRequest
+	DeleteWebACLRequest(input *wafv2.DeleteWebACLInput) wafv2.DeleteWebACLRequest
+	ListResourcesForWebACLRequest(input *wafv2.ListResourcesForWebACLInput) wafv2.ListResourcesForWebACLRequest
+	AssociateWebACLRequest(input *wafv2.AssociateWebACLInput) wafv2.AssociateWebACLRequest
+	DisassociateWebACLRequest(input *wafv2.DisassociateWebACLInput) wafv2.DisassociateWebACLRequest
+	ListTagsForResourceRequest(input *wafv2.ListTagsForResourceInput) wafv2.ListTagsForResourceRequest
+	TagResourceRequest(input *wafv2.TagResourceInput) wafv2.TagResourceRequest
+	UntagResourceRequest(input *wafv2.UntagResourceInput) wafv2.UntagResourceRequest
+}
+
+// NewClient creates new WAFv2 Client with provided AWS
+// Configurations/Credentials
+func NewClient(cfg aws.Config) Client {
+	return wafv2.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the item doesn't exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == wafv2.ErrCodeWAFNonexistentItemException
+	}
+	return false
+}
+
+// AssociableResourceTypes are the types of the resources a REGIONAL WebACL can
+// be associated with.
+var AssociableResourceTypes = []wafv2.ResourceType{
+	wafv2.ResourceTypeApplicationLoadBalancer,
+	wafv2.ResourceTypeApiGateway,
+}
+
+func generateVisibilityConfig(c v1alpha1.VisibilityConfig) *wafv2.VisibilityConfig {
+	return &wafv2.VisibilityConfig{
+		SampledRequestsEnabled:   aws.Bool(c.SampledRequestsEnabled),
+		CloudWatchMetricsEnabled: aws.Bool(c.CloudWatchMetricsEnabled),
+		MetricName:               aws.String(c.MetricName),
+	}
+}
+
+func generateDefaultAction(a string) *wafv2.DefaultAction {
+	if a == v1alpha1.ActionBlock {
+		return &wafv2.DefaultAction{Block: &wafv2.BlockAction{}}
+	}
+	return &wafv2.DefaultAction{Allow: &wafv2.AllowAction{}}
+}
+
+func generateExcludedRules(names []string) []wafv2.ExcludedRule {
+	if len(names) == 0 {
+		return nil
+	}
+	rules := make([]wafv2.ExcludedRule, len(names))
+	for i, n := range names {
+		rules[i] = wafv2.ExcludedRule{Name: aws.String(n)}
+	}
+	return rules
+}
+
+func generateStatement(s v1alpha1.Statement) *wafv2.Statement {
+	st := &wafv2.Statement{}
+	if s.ManagedRuleGroup != nil {
+		st.ManagedRuleGroupStatement = &wafv2.ManagedRuleGroupStatement{
+			VendorName:    aws.String(s.ManagedRuleGroup.VendorName),
+			Name:          aws.String(s.ManagedRuleGroup.Name),
+			ExcludedRules: generateExcludedRules(s.ManagedRuleGroup.ExcludedRules),
+		}
+	}
+	if s.RuleGroupReference != nil {
+		st.RuleGroupReferenceStatement = &wafv2.RuleGroupReferenceStatement{
+			ARN:           aws.String(s.RuleGroupReference.ARN),
+			ExcludedRules: generateExcludedRules(s.RuleGroupReference.ExcludedRules),
+		}
+	}
+	if s.IPSetReference != nil {
+		st.IPSetReferenceStatement = &wafv2.IPSetReferenceStatement{
+			ARN: aws.String(s.IPSetReference.ARN),
+		}
+	}
+	if s.RateBased != nil {
+		st.RateBasedStatement = &wafv2.RateBasedStatement{
+			Limit:            aws.Int64(s.RateBased.Limit),
+			AggregateKeyType: wafv2.RateBasedStatementAggregateKeyTypeIp,
+		}
+	}
+	return st
+}
+
+// GenerateRules returns the WAFv2 representation of the given rules.
+func GenerateRules(in []v1alpha1.Rule) []wafv2.Rule {
+	if len(in) == 0 {
+		return nil
+	}
+	rules := make([]wafv2.Rule, len(in))
+	for i, r := range in {
+		rules[i] = wafv2.Rule{
+			Name:             aws.String(r.Name),
+			Priority:         aws.Int64(r.Priority),
+			Statement:        generateStatement(r.Statement),
+			VisibilityConfig: generateVisibilityConfig(r.VisibilityConfig),
+		}
+		if r.Action != nil {
+			rules[i].Action = &wafv2.RuleAction{}
+			switch aws.StringValue(r.Action) {
+			case v1alpha1.ActionAllow:
+				rules[i].Action.Allow = &wafv2.AllowAction{}
+			case v1alpha1.ActionBlock:
+				rules[i].Action.Block = &wafv2.BlockAction{}
+			case v1alpha1.ActionCount:
+				rules[i].Action.Count = &wafv2.CountAction{}
+			}
+		}
+		if r.OverrideAction != nil {
+			rules[i].OverrideAction = &wafv2.OverrideAction{}
+			switch aws.StringValue(r.OverrideAction) {
+			case v1alpha1.ActionNone:
+				rules[i].OverrideAction.None = &wafv2.NoneAction{}
+			case v1alpha1.ActionCount:
+				rules[i].OverrideAction.Count = &wafv2.CountAction{}
+			}
+		}
+	}
+	return rules
+}
+
+// GenerateCreateWebACLInput returns the input to create a WebACL with the
+// given name.
+func GenerateCreateWebACLInput(name string, p v1alpha1.WebACLParameters) *wafv2.CreateWebACLInput {
+	in := &wafv2.CreateWebACLInput{
+		Name:             aws.String(name),
+		Scope:            wafv2.Scope(p.Scope),
+		Description:      p.Description,
+		DefaultAction:    generateDefaultAction(p.DefaultAction),
+		Rules:            GenerateRules(p.Rules),
+		VisibilityConfig: generateVisibilityConfig(p.VisibilityConfig),
+	}
+	for _, t := range p.Tags {
+		in.Tags = append(in.Tags, wafv2.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)})
+	}
+	return in
+}
+
+// GenerateUpdateWebACLInput returns the input to update the given WebACL to
+// the desired state.
+func GenerateUpdateWebACLInput(acl wafv2.WebACL, lockToken *string, p v1alpha1.WebACLParameters) *wafv2.UpdateWebACLInput {
+	return &wafv2.UpdateWebACLInput{
+		Name:             acl.Name,
+		Id:               acl.Id,
+		LockToken:        lockToken,
+		Scope:            wafv2.Scope(p.Scope),
+		Description:      p.Description,
+		DefaultAction:    generateDefaultAction(p.DefaultAction),
+		Rules:            GenerateRules(p.Rules),
+		VisibilityConfig: generateVisibilityConfig(p.VisibilityConfig),
+	}
+}
+
+// GenerateObservation is used to produce v1alpha1.WebACLObservation from
+// wafv2.WebACL and the ARNs of the resources it is associated with.
+func GenerateObservation(acl wafv2.WebACL, associated []string) v1alpha1.WebACLObservation {
+	return v1alpha1.WebACLObservation{
+		ARN:                    aws.StringValue(acl.ARN),
+		ID:                     aws.StringValue(acl.Id),
+		Capacity:               aws.Int64Value(acl.Capacity),
+		AssociatedResourceARNs: associated,
+	}
+}
+
+func generateVisibilityConfigParameters(c *wafv2.VisibilityConfig) v1alpha1.VisibilityConfig {
+	if c == nil {
+		return v1alpha1.VisibilityConfig{}
+	}
+	return v1alpha1.VisibilityConfig{
+		SampledRequestsEnabled:   aws.BoolValue(c.SampledRequestsEnabled),
+		CloudWatchMetricsEnabled: aws.BoolValue(c.CloudWatchMetricsEnabled),
+		MetricName:               aws.StringValue(c.MetricName),
+	}
+}
+
+func generateExcludedRuleNames(rules []wafv2.ExcludedRule) []string {
+	var names []string
+	for _, r := range rules {
+		names = append(names, aws.StringValue(r.Name))
+	}
+	return names
+}
+
+func generateStatementParameters(s *wafv2.Statement) v1alpha1.Statement { // nolint:gocyclo
+	st := v1alpha1.Statement{}
+	if s == nil {
+		return st
+	}
+	if g := s.ManagedRuleGroupStatement; g != nil {
+		st.ManagedRuleGroup = &v1alpha1.ManagedRuleGroupStatement{
+			VendorName:    aws.StringValue(g.VendorName),
+			Name:          aws.StringValue(g.Name),
+			ExcludedRules: generateExcludedRuleNames(g.ExcludedRules),
+		}
+	}
+	if g := s.RuleGroupReferenceStatement; g != nil {
+		st.RuleGroupReference = &v1alpha1.RuleGroupReferenceStatement{
+			ARN:           aws.StringValue(g.ARN),
+			ExcludedRules: generateExcludedRuleNames(g.ExcludedRules),
+		}
+	}
+	if s.IPSetReferenceStatement != nil {
+		st.IPSetReference = &v1alpha1.IPSetReferenceStatement{ARN: aws.StringValue(s.IPSetReferenceStatement.ARN)}
+	}
+	if s.RateBasedStatement != nil {
+		st.RateBased = &v1alpha1.RateBasedStatement{Limit: aws.Int64Value(s.RateBasedStatement.Limit)}
+	}
+	return st
+}
+
+func generateRuleParameters(in []wafv2.Rule) []v1alpha1.Rule { // nolint:gocyclo
+	var rules []v1alpha1.Rule
+	for _, r := range in {
+		rule := v1alpha1.Rule{
+			Name:             aws.StringValue(r.Name),
+			Priority:         aws.Int64Value(r.Priority),
+			Statement:        generateStatementParameters(r.Statement),
+			VisibilityConfig: generateVisibilityConfigParameters(r.VisibilityConfig),
+		}
+		if a := r.Action; a != nil {
+			switch {
+			case a.Allow != nil:
+				rule.Action = aws.String(v1alpha1.ActionAllow)
+			case a.Block != nil:
+				rule.Action = aws.String(v1alpha1.ActionBlock)
+			case a.Count != nil:
+				rule.Action = aws.String(v1alpha1.ActionCount)
+			}
+		}
+		if a := r.OverrideAction; a != nil {
+			switch {
+			case a.None != nil:
+				rule.OverrideAction = aws.String(v1alpha1.ActionNone)
+			case a.Count != nil:
+				rule.OverrideAction = aws.String(v1alpha1.ActionCount)
+			}
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// IsUpToDate checks whether the WebACL has the desired default action,
+// description, visibility configuration and rules. The order of the rules is
+// given by their priorities, so it is ignored.
+func IsUpToDate(p v1alpha1.WebACLParameters, acl wafv2.WebACL) bool {
+	if aws.StringValue(p.Description) != aws.StringValue(acl.Description) {
+		return false
+	}
+	if acl.DefaultAction == nil || (p.DefaultAction == v1alpha1.ActionBlock) != (acl.DefaultAction.Block != nil) {
+		return false
+	}
+	if p.VisibilityConfig != generateVisibilityConfigParameters(acl.VisibilityConfig) {
+		return false
+	}
+	return cmp.Equal(p.Rules, generateRuleParameters(acl.Rules),
+		cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b v1alpha1.Rule) bool { return a.Priority < b.Priority }))
+}
+
+// DiffAssociations returns the ARNs of the resources the WebACL has to be
+// associated with and disassociated from.
+func DiffAssociations(desired, current []string) (associate, disassociate []string) {
+	c := make(map[string]bool, len(current))
+	for _, arn := range current {
+		c[arn] = true
+	}
+	d := make(map[string]bool, len(desired))
+	for _, arn := range desired {
+		d[arn] = true
+		if !c[arn] {
+			associate = append(associate, arn)
+		}
+	}
+	for _, arn := range current {
+		if !d[arn] {
+			disassociate = append(disassociate, arn)
+		}
+	}
+	sort.Strings(associate)
+	sort.Strings(disassociate)
+	return associate, disassociate
+}
+
+// DiffTags returns the tags that have to be added to or updated on the WebACL
+// and the keys of the tags that have to be removed.
+func DiffTags(desired []v1alpha1.Tag, current []wafv2.Tag) (add []wafv2.Tag, remove []string) {
+	c := make(map[string]string, len(current))
+	for _, t := range current {
+		c[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	d := make(map[string]bool, len(desired))
+	for _, t := range desired {
+		d[t.Key] = true
+		if v, ok := c[t.Key]; !ok || v != t.Value {
+			add = append(add, wafv2.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)})
+		}
+	}
+	for _, t := range current {
+		if !d[aws.StringValue(t.Key)] {
+			remove = append(remove, aws.StringValue(t.Key))
+		}
+	}
+	sort.Strings(remove)
+	return add, remove
+}
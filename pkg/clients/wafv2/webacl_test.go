@@ -0,0 +1,259 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wafv2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/wafv2/v1alpha1"
+)
+
+var (
+	visibility = v1alpha1.VisibilityConfig{
+		SampledRequestsEnabled:   true,
+		CloudWatchMetricsEnabled: true,
+		MetricName:               "metric",
+	}
+	commonRuleSet = v1alpha1.Rule{
+		Name:           "common",
+		Priority:       1,
+		OverrideAction: aws.String(v1alpha1.ActionNone),
+		Statement: v1alpha1.Statement{
+			ManagedRuleGroup: &v1alpha1.ManagedRuleGroupStatement{
+				VendorName:    "AWS",
+				Name:          "AWSManagedRulesCommonRuleSet",
+				ExcludedRules: []string{"SizeRestrictions_BODY"},
+			},
+		},
+		VisibilityConfig: visibility,
+	}
+	rateLimit = v1alpha1.Rule{
+		Name:             "rate",
+		Priority:         0,
+		Action:           aws.String(v1alpha1.ActionBlock),
+		Statement:        v1alpha1.Statement{RateBased: &v1alpha1.RateBasedStatement{Limit: 1000}},
+		VisibilityConfig: visibility,
+	}
+)
+
+func webACLParameters() v1alpha1.WebACLParameters {
+	return v1alpha1.WebACLParameters{
+		Scope:            v1alpha1.ScopeRegional,
+		Description:      aws.String("some description"),
+		DefaultAction:    v1alpha1.ActionAllow,
+		Rules:            []v1alpha1.Rule{commonRuleSet, rateLimit},
+		VisibilityConfig: visibility,
+	}
+}
+
+func webACL() wafv2.WebACL {
+	p := webACLParameters()
+	return wafv2.WebACL{
+		Description:      p.Description,
+		DefaultAction:    generateDefaultAction(p.DefaultAction),
+		Rules:            GenerateRules(p.Rules),
+		VisibilityConfig: generateVisibilityConfig(p.VisibilityConfig),
+	}
+}
+
+func TestGenerateRules(t *testing.T) {
+	want := []wafv2.Rule{
+		{
+			Name:           aws.String("common"),
+			Priority:       aws.Int64(1),
+			OverrideAction: &wafv2.OverrideAction{None: &wafv2.NoneAction{}},
+			Statement: &wafv2.Statement{
+				ManagedRuleGroupStatement: &wafv2.ManagedRuleGroupStatement{
+					VendorName:    aws.String("AWS"),
+					Name:          aws.String("AWSManagedRulesCommonRuleSet"),
+					ExcludedRules: []wafv2.ExcludedRule{{Name: aws.String("SizeRestrictions_BODY")}},
+				},
+			},
+			VisibilityConfig: &wafv2.VisibilityConfig{
+				SampledRequestsEnabled:   aws.Bool(true),
+				CloudWatchMetricsEnabled: aws.Bool(true),
+				MetricName:               aws.String("metric"),
+			},
+		},
+		{
+			Name:     aws.String("rate"),
+			Priority: aws.Int64(0),
+			Action:   &wafv2.RuleAction{Block: &wafv2.BlockAction{}},
+			Statement: &wafv2.Statement{
+				RateBasedStatement: &wafv2.RateBasedStatement{
+					Limit:            aws.Int64(1000),
+					AggregateKeyType: wafv2.RateBasedStatementAggregateKeyTypeIp,
+				},
+			},
+			VisibilityConfig: &wafv2.VisibilityConfig{
+				SampledRequestsEnabled:   aws.Bool(true),
+				CloudWatchMetricsEnabled: aws.Bool(true),
+				MetricName:               aws.String("metric"),
+			},
+		},
+	}
+	got := GenerateRules([]v1alpha1.Rule{commonRuleSet, rateLimit})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.WebACLParameters
+		acl  func() wafv2.WebACL
+		want bool
+	}{
+		"UpToDate": {
+			p:    webACLParameters(),
+			acl:  webACL,
+			want: true,
+		},
+		"RulesInDifferentOrder": {
+			p: webACLParameters(),
+			acl: func() wafv2.WebACL {
+				acl := webACL()
+				acl.Rules[0], acl.Rules[1] = acl.Rules[1], acl.Rules[0]
+				return acl
+			},
+			want: true,
+		},
+		"DifferentDefaultAction": {
+			p: webACLParameters(),
+			acl: func() wafv2.WebACL {
+				acl := webACL()
+				acl.DefaultAction = &wafv2.DefaultAction{Block: &wafv2.BlockAction{}}
+				return acl
+			},
+			want: false,
+		},
+		"DifferentDescription": {
+			p: webACLParameters(),
+			acl: func() wafv2.WebACL {
+				acl := webACL()
+				acl.Description = nil
+				return acl
+			},
+			want: false,
+		},
+		"MissingRule": {
+			p: webACLParameters(),
+			acl: func() wafv2.WebACL {
+				acl := webACL()
+				acl.Rules = acl.Rules[:1]
+				return acl
+			},
+			want: false,
+		},
+		"DifferentExcludedRules": {
+			p: webACLParameters(),
+			acl: func() wafv2.WebACL {
+				acl := webACL()
+				acl.Rules[0].Statement.ManagedRuleGroupStatement.ExcludedRules = nil
+				return acl
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.p, tc.acl())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffAssociations(t *testing.T) {
+	type want struct {
+		associate    []string
+		disassociate []string
+	}
+	cases := map[string]struct {
+		desired []string
+		current []string
+		want
+	}{
+		"NoChange": {
+			desired: []string{"arn:a", "arn:b"},
+			current: []string{"arn:b", "arn:a"},
+		},
+		"AssociateAndDisassociate": {
+			desired: []string{"arn:b", "arn:a"},
+			current: []string{"arn:c", "arn:a"},
+			want: want{
+				associate:    []string{"arn:b"},
+				disassociate: []string{"arn:c"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			associate, disassociate := DiffAssociations(tc.desired, tc.current)
+			if diff := cmp.Diff(tc.want.associate, associate); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.disassociate, disassociate); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	type want struct {
+		add    []wafv2.Tag
+		remove []string
+	}
+	cases := map[string]struct {
+		desired []v1alpha1.Tag
+		current []wafv2.Tag
+		want
+	}{
+		"NoChange": {
+			desired: []v1alpha1.Tag{{Key: "k", Value: "v"}},
+			current: []wafv2.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+		},
+		"AddUpdateAndRemove": {
+			desired: []v1alpha1.Tag{{Key: "k", Value: "new"}, {Key: "added", Value: "v"}},
+			current: []wafv2.Tag{{Key: aws.String("k"), Value: aws.String("v")}, {Key: aws.String("removed"), Value: aws.String("v")}},
+			want: want{
+				add:    []wafv2.Tag{{Key: aws.String("k"), Value: aws.String("new")}, {Key: aws.String("added"), Value: aws.String("v")}},
+				remove: []string{"removed"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffTags(tc.desired, tc.current)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
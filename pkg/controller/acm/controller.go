@@ -59,21 +59,22 @@ const (
 )
 
 // SetupCertificate adds a controller that reconciles Certificates.
-func SetupCertificate(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+func SetupCertificate(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclient.Options) error {
 	name := managed.ControllerName(v1alpha1.CertificateGroupKind)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
 		}).
 		For(&v1alpha1.Certificate{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.CertificateGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acm.NewClient}),
-			managed.WithConnectionPublishers(),
+			managed.WithExternalConnecter(awsclient.NewConnecter(&connector{client: mgr.GetClient(), newClientFn: acm.NewClient}, o)),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithTimeout(o.ReconcileTimeout),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -133,10 +134,17 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		}
 	}
 
-	cr.SetConditions(xpv1.Available())
-
 	cr.Status.AtProvider = acm.GenerateCertificateStatus(certificate)
 
+	switch certificate.Status { // nolint:exhaustive
+	case awsacm.CertificateStatusIssued:
+		cr.SetConditions(xpv1.Available())
+	case awsacm.CertificateStatusPendingValidation:
+		cr.SetConditions(xpv1.Creating())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
 	tags, err := e.client.ListTagsForCertificateRequest(&awsacm.ListTagsForCertificateInput{
 		CertificateArn: aws.String(meta.GetExternalName(cr)),
 	}).Send(ctx)
@@ -147,6 +155,9 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	return managed.ExternalObservation{
 		ResourceUpToDate: acm.IsCertificateUpToDate(cr.Spec.ForProvider, certificate, tags.Tags),
 		ResourceExists:   true,
+		ConnectionDetails: managed.ConnectionDetails{
+			v1alpha1.ResourceCredentialsSecretARNKey: []byte(aws.StringValue(certificate.CertificateArn)),
+		},
 	}, nil
 }
 
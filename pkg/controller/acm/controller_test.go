@@ -85,6 +85,10 @@ func withTags() certificateModifier {
 	}
 }
 
+func withStatus(st awsacm.CertificateStatus) certificateModifier {
+	return func(r *v1alpha1.Certificate) { r.Status.AtProvider.Status = st }
+}
+
 func withCertificateArn() certificateModifier {
 	return func(r *v1alpha1.Certificate) {
 		certificateTransparencyLoggingPreference := awsacm.CertificateTransparencyLoggingPreferenceDisabled
@@ -124,6 +128,7 @@ func TestObserve(t *testing.T) {
 							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacm.DescribeCertificateOutput{
 								Certificate: &awsacm.CertificateDetail{
 									CertificateArn: aws.String(certificateArn),
+									Status:         awsacm.CertificateStatusIssued,
 									Options:        &awsacm.CertificateOptions{CertificateTransparencyLoggingPreference: awsacm.CertificateTransparencyLoggingPreferenceDisabled},
 								},
 							}},
@@ -140,10 +145,48 @@ func TestObserve(t *testing.T) {
 				cr: certificate(),
 			},
 			want: want{
-				cr: certificate(withCertificateArn(), withConditions(xpv1.Available())),
+				cr: certificate(withCertificateArn(), withStatus(awsacm.CertificateStatusIssued), withConditions(xpv1.Available())),
 				result: managed.ExternalObservation{
 					ResourceExists:   true,
 					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1alpha1.ResourceCredentialsSecretARNKey: []byte(certificateArn),
+					},
+				},
+			},
+		},
+		"PendingValidation": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockDescribeCertificateRequest: func(input *awsacm.DescribeCertificateInput) awsacm.DescribeCertificateRequest {
+						return awsacm.DescribeCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacm.DescribeCertificateOutput{
+								Certificate: &awsacm.CertificateDetail{
+									CertificateArn: aws.String(certificateArn),
+									Status:         awsacm.CertificateStatusPendingValidation,
+									Options:        &awsacm.CertificateOptions{CertificateTransparencyLoggingPreference: awsacm.CertificateTransparencyLoggingPreferenceDisabled},
+								},
+							}},
+						}
+					},
+					MockListTagsForCertificateRequest: func(input *awsacm.ListTagsForCertificateInput) awsacm.ListTagsForCertificateRequest {
+						return awsacm.ListTagsForCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacm.ListTagsForCertificateOutput{
+								Tags: []awsacm.Tag{{}},
+							}},
+						}
+					},
+				},
+				cr: certificate(),
+			},
+			want: want{
+				cr: certificate(withCertificateArn(), withStatus(awsacm.CertificateStatusPendingValidation), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1alpha1.ResourceCredentialsSecretARNKey: []byte(certificateArn),
+					},
 				},
 			},
 		},
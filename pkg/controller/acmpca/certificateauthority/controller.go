@@ -57,23 +57,25 @@ const (
 )
 
 // SetupCertificateAuthority adds a controller that reconciles ACMPCA.
-func SetupCertificateAuthority(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+func SetupCertificateAuthority(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclient.Options) error {
 	name := managed.ControllerName(v1alpha1.CertificateAuthorityGroupKind)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
 		}).
 		For(&v1alpha1.CertificateAuthority{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.CertificateAuthorityGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acmpca.NewClient}),
+			managed.WithExternalConnecter(awsclient.NewConnecter(&connector{client: mgr.GetClient(), newClientFn: acmpca.NewClient}, o)),
 			managed.WithConnectionPublishers(),
 
 			// TODO: implement tag initializer
 
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithTimeout(o.ReconcileTimeout),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
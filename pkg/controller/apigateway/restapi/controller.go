@@ -0,0 +1,239 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restapi
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsapigateway "github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apigateway"
+)
+
+const (
+	errUnexpectedObject = "the managed resource is not a RestAPI"
+	errKubeUpdateFailed = "cannot update RestAPI custom resource"
+	errGet              = "cannot get RestAPI"
+	errGetStages        = "cannot get the stages of the RestAPI"
+	errBody             = "cannot generate the definition of the RestAPI"
+	errCreate           = "cannot create RestAPI"
+	errUpdate           = "cannot update RestAPI"
+	errPut              = "cannot put the definition of the RestAPI"
+	errDeploy           = "cannot deploy RestAPI"
+	errDelete           = "cannot delete RestAPI"
+)
+
+// SetupRestAPI adds a controller that reconciles RestAPIs.
+func SetupRestAPI(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclient.Options) error {
+	name := managed.ControllerName(v1alpha1.RestAPIGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		For(&v1alpha1.RestAPI{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.RestAPIGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: apigateway.NewClient}, o)),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithTimeout(o.ReconcileTimeout),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) apigateway.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.RestAPI)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client apigateway.Client
+}
+
+func (e *external) stages(ctx context.Context, id string) ([]awsapigateway.Stage, error) {
+	rsp, err := e.client.GetStagesRequest(&awsapigateway.GetStagesInput{RestApiId: aws.String(id)}).Send(ctx)
+	if err != nil {
+		return nil, awsclient.Wrap(err, errGetStages)
+	}
+	return rsp.Item, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.RestAPI)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	rsp, err := e.client.GetRestApiRequest(&awsapigateway.GetRestApiInput{RestApiId: aws.String(meta.GetExternalName(cr))}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(apigateway.IsNotFound, err), errGet)
+	}
+	stages, err := e.stages(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	apigateway.LateInitialize(&cr.Spec.ForProvider, rsp.GetRestApiOutput)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	// The checksum of the definition is only known to this controller, so
+	// it is carried over.
+	o := apigateway.GenerateObservation(*rsp.GetRestApiOutput, stages, cr.Spec.ForProvider.Region)
+	o.BodySHA256 = cr.Status.AtProvider.BodySHA256
+	cr.Status.AtProvider = o
+	cr.Status.SetConditions(xpv1.Available())
+
+	upToDate, err := apigateway.IsUpToDate(cr.Spec.ForProvider, cr.Status.AtProvider, *rsp.GetRestApiOutput, stages)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errBody)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: apigateway.GetConnectionDetails(cr.Spec.ForProvider, cr.Status.AtProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.RestAPI)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+	// The definition is put and deployed by the first update.
+	rsp, err := e.client.CreateRestApiRequest(apigateway.GenerateCreateRestAPIInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.StringValue(rsp.Id))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.RestAPI)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	id := aws.String(meta.GetExternalName(cr))
+
+	rsp, err := e.client.GetRestApiRequest(&awsapigateway.GetRestApiInput{RestApiId: id}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errGet)
+	}
+	if ops := apigateway.GeneratePatchOperations(cr.Spec.ForProvider, *rsp.GetRestApiOutput); len(ops) != 0 {
+		if _, err := e.client.UpdateRestApiRequest(&awsapigateway.UpdateRestApiInput{
+			RestApiId:       id,
+			PatchOperations: ops,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+		}
+	}
+
+	body, err := apigateway.GenerateBody(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errBody)
+	}
+	sum := apigateway.SHA256(body)
+	changed := len(body) != 0 && sum != cr.Status.AtProvider.BodySHA256
+	deploy := cr.Spec.ForProvider.StageNames
+	if changed {
+		if _, err := e.client.PutRestApiRequest(&awsapigateway.PutRestApiInput{
+			RestApiId: id,
+			Mode:      awsapigateway.PutModeOverwrite,
+			Body:      body,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errPut)
+		}
+	} else {
+		stages, err := e.stages(ctx, *id)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		deploy = apigateway.MissingStages(cr.Spec.ForProvider, stages)
+	}
+
+	// A new definition is deployed to all stages, otherwise only the stages
+	// that do not exist yet are created.
+	for _, s := range deploy {
+		if _, err := e.client.CreateDeploymentRequest(&awsapigateway.CreateDeploymentInput{
+			RestApiId: id,
+			StageName: aws.String(s),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeploy)
+		}
+	}
+	// The checksum is only recorded once the definition is deployed, so
+	// that a failed deployment is retried.
+	if changed {
+		cr.Status.AtProvider.BodySHA256 = sum
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.RestAPI)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteRestApiRequest(&awsapigateway.DeleteRestApiInput{RestApiId: aws.String(meta.GetExternalName(cr))}).Send(ctx)
+	return awsclient.Wrap(resource.Ignore(apigateway.IsNotFound, err), errDelete)
+}
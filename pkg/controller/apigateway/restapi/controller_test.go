@@ -0,0 +1,462 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsapigateway "github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apigateway"
+	"github.com/crossplane/provider-aws/pkg/clients/apigateway/fake"
+)
+
+var (
+	apiID     = "abc123"
+	apiName   = "some-api"
+	region    = "us-east-1"
+	stageName = "prod"
+	body      = `{"openapi":"3.0.1"}`
+	invokeURL = "https://abc123.execute-api.us-east-1.amazonaws.com/prod"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	kube   client.Client
+	client apigateway.Client
+	cr     resource.Managed
+}
+
+type restAPIModifier func(*v1alpha1.RestAPI)
+
+func withExternalName(n string) restAPIModifier {
+	return func(r *v1alpha1.RestAPI) { meta.SetExternalName(r, n) }
+}
+
+func withConditions(c ...xpv1.Condition) restAPIModifier {
+	return func(r *v1alpha1.RestAPI) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withBody(b string) restAPIModifier {
+	return func(r *v1alpha1.RestAPI) { r.Spec.ForProvider.Body = aws.String(b) }
+}
+
+func withStageNames(n ...string) restAPIModifier {
+	return func(r *v1alpha1.RestAPI) { r.Spec.ForProvider.StageNames = n }
+}
+
+func withEndpointType(t string) restAPIModifier {
+	return func(r *v1alpha1.RestAPI) { r.Spec.ForProvider.EndpointType = aws.String(t) }
+}
+
+func withObservation(o v1alpha1.RestAPIObservation) restAPIModifier {
+	return func(r *v1alpha1.RestAPI) { r.Status.AtProvider = o }
+}
+
+func restAPI(m ...restAPIModifier) *v1alpha1.RestAPI {
+	cr := &v1alpha1.RestAPI{
+		Spec: v1alpha1.RestAPISpec{
+			ForProvider: v1alpha1.RestAPIParameters{Region: region, Name: apiName},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func mockGet(err error) func(*awsapigateway.GetRestApiInput) awsapigateway.GetRestApiRequest {
+	return func(_ *awsapigateway.GetRestApiInput) awsapigateway.GetRestApiRequest {
+		return awsapigateway.GetRestApiRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsapigateway.GetRestApiOutput{
+				Id:                    aws.String(apiID),
+				Name:                  aws.String(apiName),
+				EndpointConfiguration: &awsapigateway.EndpointConfiguration{Types: []awsapigateway.EndpointType{awsapigateway.EndpointTypeRegional}},
+			}},
+		}
+	}
+}
+
+func mockGetStages(names ...string) func(*awsapigateway.GetStagesInput) awsapigateway.GetStagesRequest {
+	return func(_ *awsapigateway.GetStagesInput) awsapigateway.GetStagesRequest {
+		out := &awsapigateway.GetStagesOutput{}
+		for _, n := range names {
+			out.Item = append(out.Item, awsapigateway.Stage{StageName: aws.String(n), DeploymentId: aws.String("d1")})
+		}
+		return awsapigateway.GetStagesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AvailableAndUpToDate": {
+			args: args{
+				client: &fake.MockRestAPIClient{
+					MockGet:       mockGet(nil),
+					MockGetStages: mockGetStages(stageName),
+				},
+				cr: restAPI(withExternalName(apiID), withEndpointType("REGIONAL"), withBody(body), withStageNames(stageName),
+					withObservation(v1alpha1.RestAPIObservation{BodySHA256: apigateway.SHA256([]byte(body))})),
+			},
+			want: want{
+				cr: restAPI(withExternalName(apiID), withEndpointType("REGIONAL"), withBody(body), withStageNames(stageName),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.RestAPIObservation{
+						ID:         apiID,
+						BodySHA256: apigateway.SHA256([]byte(body)),
+						Stages:     []v1alpha1.StageObservation{{Name: stageName, DeploymentID: "d1", InvokeURL: invokeURL}},
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1alpha1.ResourceCredentialsSecretInvokeURLKeyPrefix + stageName: []byte(invokeURL),
+					},
+				},
+			},
+		},
+		"LateInitAndNotDeployed": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockRestAPIClient{
+					MockGet:       mockGet(nil),
+					MockGetStages: mockGetStages(),
+				},
+				cr: restAPI(withExternalName(apiID), withBody(body), withStageNames(stageName)),
+			},
+			want: want{
+				cr: restAPI(withExternalName(apiID), withEndpointType("REGIONAL"), withBody(body), withStageNames(stageName),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.RestAPIObservation{ID: apiID})),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: restAPI(),
+			},
+			want: want{
+				cr: restAPI(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockRestAPIClient{
+					MockGet: mockGet(awserr.New(awsapigateway.ErrCodeNotFoundException, "", nil)),
+				},
+				cr: restAPI(withExternalName(apiID)),
+			},
+			want: want{
+				cr: restAPI(withExternalName(apiID)),
+			},
+		},
+		"GetFail": {
+			args: args{
+				client: &fake.MockRestAPIClient{
+					MockGet: mockGet(errBoom),
+				},
+				cr: restAPI(withExternalName(apiID)),
+			},
+			want: want{
+				cr:  restAPI(withExternalName(apiID)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockRestAPIClient{
+					MockCreate: func(_ *awsapigateway.CreateRestApiInput) awsapigateway.CreateRestApiRequest {
+						return awsapigateway.CreateRestApiRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsapigateway.CreateRestApiOutput{Id: aws.String(apiID)}},
+						}
+					},
+				},
+				cr: restAPI(),
+			},
+			want: want{
+				cr:     restAPI(withExternalName(apiID), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFail": {
+			args: args{
+				client: &fake.MockRestAPIClient{
+					MockCreate: func(_ *awsapigateway.CreateRestApiInput) awsapigateway.CreateRestApiRequest {
+						return awsapigateway.CreateRestApiRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: restAPI(),
+			},
+			want: want{
+				cr:  restAPI(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr       resource.Managed
+		put      bool
+		deployed []string
+		err      error
+	}
+
+	cases := map[string]struct {
+		args
+		deployErr error
+		want
+	}{
+		"NewDefinition": {
+			args: args{
+				client: &fake.MockRestAPIClient{
+					MockGet: mockGet(nil),
+				},
+				cr: restAPI(withExternalName(apiID), withBody(body), withStageNames(stageName, "test")),
+			},
+			want: want{
+				cr: restAPI(withExternalName(apiID), withBody(body), withStageNames(stageName, "test"),
+					withObservation(v1alpha1.RestAPIObservation{BodySHA256: apigateway.SHA256([]byte(body))})),
+				put:      true,
+				deployed: []string{stageName, "test"},
+			},
+		},
+		"NewStage": {
+			args: args{
+				client: &fake.MockRestAPIClient{
+					MockGet:       mockGet(nil),
+					MockGetStages: mockGetStages(stageName),
+				},
+				cr: restAPI(withExternalName(apiID), withBody(body), withStageNames(stageName, "test"),
+					withObservation(v1alpha1.RestAPIObservation{BodySHA256: apigateway.SHA256([]byte(body))})),
+			},
+			want: want{
+				cr: restAPI(withExternalName(apiID), withBody(body), withStageNames(stageName, "test"),
+					withObservation(v1alpha1.RestAPIObservation{BodySHA256: apigateway.SHA256([]byte(body))})),
+				deployed: []string{"test"},
+			},
+		},
+		"DeployFail": {
+			args: args{
+				client: &fake.MockRestAPIClient{
+					MockGet: mockGet(nil),
+				},
+				cr: restAPI(withExternalName(apiID), withBody(body), withStageNames(stageName)),
+			},
+			deployErr: errBoom,
+			want: want{
+				cr:       restAPI(withExternalName(apiID), withBody(body), withStageNames(stageName)),
+				put:      true,
+				deployed: []string{stageName},
+				err:      awsclient.Wrap(errBoom, errDeploy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var put bool
+			var deployed []string
+			mc := tc.client.(*fake.MockRestAPIClient)
+			mc.MockPut = func(in *awsapigateway.PutRestApiInput) awsapigateway.PutRestApiRequest {
+				put = in.Mode == awsapigateway.PutModeOverwrite && string(in.Body) == body
+				return awsapigateway.PutRestApiRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsapigateway.PutRestApiOutput{}},
+				}
+			}
+			mc.MockCreateDeployment = func(in *awsapigateway.CreateDeploymentInput) awsapigateway.CreateDeploymentRequest {
+				deployed = append(deployed, aws.StringValue(in.StageName))
+				return awsapigateway.CreateDeploymentRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsapigateway.CreateDeploymentOutput{}, Error: tc.deployErr},
+				}
+			}
+			e := &external{client: mc}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.put, put); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deployed, deployed); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockRestAPIClient{
+					MockDelete: func(_ *awsapigateway.DeleteRestApiInput) awsapigateway.DeleteRestApiRequest {
+						return awsapigateway.DeleteRestApiRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsapigateway.DeleteRestApiOutput{}},
+						}
+					},
+				},
+				cr: restAPI(withExternalName(apiID)),
+			},
+			want: want{
+				cr: restAPI(withExternalName(apiID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				client: &fake.MockRestAPIClient{
+					MockDelete: func(_ *awsapigateway.DeleteRestApiInput) awsapigateway.DeleteRestApiRequest {
+						return awsapigateway.DeleteRestApiRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awsapigateway.ErrCodeNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: restAPI(withExternalName(apiID)),
+			},
+			want: want{
+				cr: restAPI(withExternalName(apiID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				client: &fake.MockRestAPIClient{
+					MockDelete: func(_ *awsapigateway.DeleteRestApiInput) awsapigateway.DeleteRestApiRequest {
+						return awsapigateway.DeleteRestApiRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: restAPI(withExternalName(apiID)),
+			},
+			want: want{
+				cr:  restAPI(withExternalName(apiID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -37,7 +37,7 @@ import (
 )
 
 // SetupAuthorizer adds a controller that reconciles Authorizer.
-func SetupAuthorizer(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+func SetupAuthorizer(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o aws.Options) error {
 	name := managed.ControllerName(svcapitypes.AuthorizerGroupKind)
 	opts := []option{
 		func(e *external) {
@@ -51,13 +51,15 @@ func SetupAuthorizer(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimite
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
 		}).
 		For(&svcapitypes.Authorizer{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.AuthorizerGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(aws.NewConnecter(&connector{kube: mgr.GetClient(), opts: opts}, o)),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithTimeout(o.ReconcileTimeout),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalabletarget
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsaas "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/applicationautoscaling/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/applicationautoscaling"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a ScalableTarget custom resource"
+	errDescribeFailed   = "cannot describe ScalableTarget"
+	errRegisterFailed   = "cannot register ScalableTarget"
+	errDeregisterFailed = "cannot deregister ScalableTarget"
+)
+
+// SetupScalableTarget adds a controller that reconciles ScalableTargets.
+func SetupScalableTarget(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclient.Options) error {
+	name := managed.ControllerName(v1alpha1.ScalableTargetGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		For(&v1alpha1.ScalableTarget{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ScalableTargetGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: applicationautoscaling.NewScalableTargetClient}, o)),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithTimeout(o.ReconcileTimeout),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) applicationautoscaling.ScalableTargetClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ScalableTarget)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client applicationautoscaling.ScalableTargetClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ScalableTarget)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// A scalable target has no name or ID of its own; it is identified by
+	// the service namespace, resource ID and scalable dimension.
+	rsp, err := e.client.DescribeScalableTargetsRequest(applicationautoscaling.GenerateDescribeScalableTargetsInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribeFailed)
+	}
+	if len(rsp.ScalableTargets) == 0 {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	t := rsp.ScalableTargets[0]
+
+	cr.Status.AtProvider = applicationautoscaling.GenerateScalableTargetObservation(t)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: applicationautoscaling.IsScalableTargetUpToDate(cr.Spec.ForProvider, t),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ScalableTarget)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.client.RegisterScalableTargetRequest(applicationautoscaling.GenerateRegisterScalableTargetInput(cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errRegisterFailed)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ScalableTarget)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	// Registering an existing scalable target updates it.
+	_, err := e.client.RegisterScalableTargetRequest(applicationautoscaling.GenerateRegisterScalableTargetInput(cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errRegisterFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ScalableTarget)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeregisterScalableTargetRequest(&awsaas.DeregisterScalableTargetInput{
+		ServiceNamespace:  awsaas.ServiceNamespace(cr.Spec.ForProvider.ServiceNamespace),
+		ResourceId:        cr.Spec.ForProvider.ResourceID,
+		ScalableDimension: awsaas.ScalableDimension(cr.Spec.ForProvider.ScalableDimension),
+	}).Send(ctx)
+	return awsclient.Wrap(resource.Ignore(applicationautoscaling.IsNotFound, err), errDeregisterFailed)
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalabletarget
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsaas "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/applicationautoscaling/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/applicationautoscaling/fake"
+)
+
+var (
+	resourceID = "table/some-table"
+	roleARN    = "arn:aws:iam::123456789012:role/aws-service-role/dynamodb.application-autoscaling.amazonaws.com/AWSServiceRoleForApplicationAutoScaling_DynamoDBTable"
+
+	errBoom = errors.New("boom")
+)
+
+type targetModifier func(*v1alpha1.ScalableTarget)
+
+func withConditions(c ...xpv1.Condition) targetModifier {
+	return func(r *v1alpha1.ScalableTarget) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.ScalableTargetObservation) targetModifier {
+	return func(r *v1alpha1.ScalableTarget) { r.Status.AtProvider = o }
+}
+
+func target(m ...targetModifier) *v1alpha1.ScalableTarget {
+	cr := &v1alpha1.ScalableTarget{
+		Spec: v1alpha1.ScalableTargetSpec{
+			ForProvider: v1alpha1.ScalableTargetParameters{
+				ServiceNamespace:  "dynamodb",
+				ResourceID:        aws.String(resourceID),
+				ScalableDimension: "dynamodb:table:ReadCapacityUnits",
+				MinCapacity:       5,
+				MaxCapacity:       100,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func mockDescribe(max int64, err error) func(*awsaas.DescribeScalableTargetsInput) awsaas.DescribeScalableTargetsRequest {
+	return func(_ *awsaas.DescribeScalableTargetsInput) awsaas.DescribeScalableTargetsRequest {
+		out := &awsaas.DescribeScalableTargetsOutput{}
+		if max != 0 {
+			out.ScalableTargets = []awsaas.ScalableTarget{{
+				ResourceId:  aws.String(resourceID),
+				MinCapacity: aws.Int64(5),
+				MaxCapacity: aws.Int64(max),
+				RoleARN:     aws.String(roleARN),
+			}}
+		}
+		return awsaas.DescribeScalableTargetsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: out},
+		}
+	}
+}
+
+func mockRegister(err error) func(*awsaas.RegisterScalableTargetInput) awsaas.RegisterScalableTargetRequest {
+	return func(_ *awsaas.RegisterScalableTargetInput) awsaas.RegisterScalableTargetRequest {
+		return awsaas.RegisterScalableTargetRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsaas.RegisterScalableTargetOutput{}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockScalableTargetClient
+		cr     resource.Managed
+		want   want
+	}{
+		"AvailableAndUpToDate": {
+			client: &fake.MockScalableTargetClient{MockDescribe: mockDescribe(100, nil)},
+			cr:     target(),
+			want: want{
+				cr: target(withConditions(xpv1.Available()), withObservation(v1alpha1.ScalableTargetObservation{RoleARN: roleARN})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			client: &fake.MockScalableTargetClient{MockDescribe: mockDescribe(50, nil)},
+			cr:     target(),
+			want: want{
+				cr: target(withConditions(xpv1.Available()), withObservation(v1alpha1.ScalableTargetObservation{RoleARN: roleARN})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockScalableTargetClient{MockDescribe: mockDescribe(0, nil)},
+			cr:     target(),
+			want: want{
+				cr: target(),
+			},
+		},
+		"DescribeFail": {
+			client: &fake.MockScalableTargetClient{MockDescribe: mockDescribe(0, errBoom)},
+			cr:     target(),
+			want: want{
+				cr:  target(),
+				err: awsclient.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cases := map[string]struct {
+		client *fake.MockScalableTargetClient
+		cr     resource.Managed
+		wantCR resource.Managed
+		err    error
+	}{
+		"Successful": {
+			client: &fake.MockScalableTargetClient{MockRegister: mockRegister(nil)},
+			cr:     target(),
+			wantCR: target(withConditions(xpv1.Creating())),
+		},
+		"RegisterFail": {
+			client: &fake.MockScalableTargetClient{MockRegister: mockRegister(errBoom)},
+			cr:     target(),
+			wantCR: target(withConditions(xpv1.Creating())),
+			err:    awsclient.Wrap(errBoom, errRegisterFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantCR, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		client *fake.MockScalableTargetClient
+		err    error
+	}{
+		"Successful": {
+			client: &fake.MockScalableTargetClient{MockRegister: mockRegister(nil)},
+		},
+		"RegisterFail": {
+			client: &fake.MockScalableTargetClient{MockRegister: mockRegister(errBoom)},
+			err:    awsclient.Wrap(errBoom, errRegisterFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), target())
+
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	mockDeregister := func(err error) func(*awsaas.DeregisterScalableTargetInput) awsaas.DeregisterScalableTargetRequest {
+		return func(_ *awsaas.DeregisterScalableTargetInput) awsaas.DeregisterScalableTargetRequest {
+			return awsaas.DeregisterScalableTargetRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsaas.DeregisterScalableTargetOutput{}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		client *fake.MockScalableTargetClient
+		err    error
+	}{
+		"Successful": {
+			client: &fake.MockScalableTargetClient{MockDeregister: mockDeregister(nil)},
+		},
+		"AlreadyGone": {
+			client: &fake.MockScalableTargetClient{MockDeregister: mockDeregister(awserr.New(awsaas.ErrCodeObjectNotFoundException, "", nil))},
+		},
+		"DeregisterFail": {
+			client: &fake.MockScalableTargetClient{MockDeregister: mockDeregister(errBoom)},
+			err:    awsclient.Wrap(errBoom, errDeregisterFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			cr := target()
+			err := e.Delete(context.Background(), cr)
+
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(target(withConditions(xpv1.Deleting())), cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
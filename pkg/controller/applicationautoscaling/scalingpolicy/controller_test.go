@@ -0,0 +1,305 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalingpolicy
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsaas "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/applicationautoscaling/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/applicationautoscaling/fake"
+)
+
+var (
+	policyName = "read-capacity"
+	policyARN  = "arn:aws:autoscaling:us-east-1:123456789012:scalingPolicy:some-id:resource/dynamodb/table/some-table:policyName/read-capacity"
+	alarmName  = "TargetTracking-table/some-table-AlarmHigh"
+
+	errBoom = errors.New("boom")
+)
+
+type policyModifier func(*v1alpha1.ScalingPolicy)
+
+func withConditions(c ...xpv1.Condition) policyModifier {
+	return func(r *v1alpha1.ScalingPolicy) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.ScalingPolicyObservation) policyModifier {
+	return func(r *v1alpha1.ScalingPolicy) { r.Status.AtProvider = o }
+}
+
+func withCooldowns(in, out int64) policyModifier {
+	return func(r *v1alpha1.ScalingPolicy) {
+		r.Spec.ForProvider.TargetTrackingConfiguration.ScaleInCooldown = aws.Int64(in)
+		r.Spec.ForProvider.TargetTrackingConfiguration.ScaleOutCooldown = aws.Int64(out)
+	}
+}
+
+func withTargetValue(v float64) policyModifier {
+	return func(r *v1alpha1.ScalingPolicy) { r.Spec.ForProvider.TargetTrackingConfiguration.TargetValue = v }
+}
+
+func policy(m ...policyModifier) *v1alpha1.ScalingPolicy {
+	cr := &v1alpha1.ScalingPolicy{
+		Spec: v1alpha1.ScalingPolicySpec{
+			ForProvider: v1alpha1.ScalingPolicyParameters{
+				ServiceNamespace:  "dynamodb",
+				ResourceID:        aws.String("table/some-table"),
+				ScalableDimension: "dynamodb:table:ReadCapacityUnits",
+				TargetTrackingConfiguration: v1alpha1.TargetTrackingConfiguration{
+					TargetValue:          70,
+					PredefinedMetricType: aws.String("DynamoDBReadCapacityUtilization"),
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, policyName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func mockDescribe(found bool, err error) func(*awsaas.DescribeScalingPoliciesInput) awsaas.DescribeScalingPoliciesRequest {
+	return func(_ *awsaas.DescribeScalingPoliciesInput) awsaas.DescribeScalingPoliciesRequest {
+		out := &awsaas.DescribeScalingPoliciesOutput{}
+		if found {
+			out.ScalingPolicies = []awsaas.ScalingPolicy{{
+				PolicyName: aws.String(policyName),
+				PolicyARN:  aws.String(policyARN),
+				PolicyType: awsaas.PolicyTypeTargetTrackingScaling,
+				Alarms:     []awsaas.Alarm{{AlarmName: aws.String(alarmName)}},
+				TargetTrackingScalingPolicyConfiguration: &awsaas.TargetTrackingScalingPolicyConfiguration{
+					TargetValue: aws.Float64(70),
+					PredefinedMetricSpecification: &awsaas.PredefinedMetricSpecification{
+						PredefinedMetricType: awsaas.MetricTypeDynamoDbreadCapacityUtilization,
+					},
+					ScaleInCooldown:  aws.Int64(0),
+					ScaleOutCooldown: aws.Int64(0),
+				},
+			}}
+		}
+		return awsaas.DescribeScalingPoliciesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: out},
+		}
+	}
+}
+
+func mockPut(err error) func(*awsaas.PutScalingPolicyInput) awsaas.PutScalingPolicyRequest {
+	return func(_ *awsaas.PutScalingPolicyInput) awsaas.PutScalingPolicyRequest {
+		return awsaas.PutScalingPolicyRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsaas.PutScalingPolicyOutput{}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observation := v1alpha1.ScalingPolicyObservation{PolicyARN: policyARN, Alarms: []string{alarmName}}
+
+	cases := map[string]struct {
+		kube   client.Client
+		client *fake.MockScalingPolicyClient
+		cr     resource.Managed
+		want   want
+	}{
+		"LateInitAndUpToDate": {
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			client: &fake.MockScalingPolicyClient{MockDescribe: mockDescribe(true, nil)},
+			cr:     policy(),
+			want: want{
+				cr: policy(withCooldowns(0, 0), withConditions(xpv1.Available()), withObservation(observation)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			client: &fake.MockScalingPolicyClient{MockDescribe: mockDescribe(true, nil)},
+			cr:     policy(withCooldowns(0, 0), withTargetValue(50)),
+			want: want{
+				cr: policy(withCooldowns(0, 0), withTargetValue(50), withConditions(xpv1.Available()), withObservation(observation)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockScalingPolicyClient{MockDescribe: mockDescribe(false, nil)},
+			cr:     policy(),
+			want: want{
+				cr: policy(),
+			},
+		},
+		"DescribeFail": {
+			client: &fake.MockScalingPolicyClient{MockDescribe: mockDescribe(false, errBoom)},
+			cr:     policy(),
+			want: want{
+				cr:  policy(),
+				err: awsclient.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"SpecUpdateFail": {
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			client: &fake.MockScalingPolicyClient{MockDescribe: mockDescribe(true, nil)},
+			cr:     policy(),
+			want: want{
+				cr:  policy(withCooldowns(0, 0)),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cases := map[string]struct {
+		client *fake.MockScalingPolicyClient
+		err    error
+	}{
+		"Successful": {
+			client: &fake.MockScalingPolicyClient{MockPut: mockPut(nil)},
+		},
+		"PutFail": {
+			client: &fake.MockScalingPolicyClient{MockPut: mockPut(errBoom)},
+			err:    awsclient.Wrap(errBoom, errPutFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			cr := policy()
+			_, err := e.Create(context.Background(), cr)
+
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(policy(withConditions(xpv1.Creating())), cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		client *fake.MockScalingPolicyClient
+		err    error
+	}{
+		"Successful": {
+			client: &fake.MockScalingPolicyClient{MockPut: mockPut(nil)},
+		},
+		"PutFail": {
+			client: &fake.MockScalingPolicyClient{MockPut: mockPut(errBoom)},
+			err:    awsclient.Wrap(errBoom, errPutFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), policy())
+
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	mockDelete := func(err error) func(*awsaas.DeleteScalingPolicyInput) awsaas.DeleteScalingPolicyRequest {
+		return func(in *awsaas.DeleteScalingPolicyInput) awsaas.DeleteScalingPolicyRequest {
+			if diff := cmp.Diff(policyName, aws.StringValue(in.PolicyName)); diff != "" {
+				t.Errorf("PolicyName: -want, +got:\n%s", diff)
+			}
+			return awsaas.DeleteScalingPolicyRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsaas.DeleteScalingPolicyOutput{}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		client *fake.MockScalingPolicyClient
+		err    error
+	}{
+		"Successful": {
+			client: &fake.MockScalingPolicyClient{MockDelete: mockDelete(nil)},
+		},
+		"AlreadyGone": {
+			client: &fake.MockScalingPolicyClient{MockDelete: mockDelete(awserr.New(awsaas.ErrCodeObjectNotFoundException, "", nil))},
+		},
+		"DeleteFail": {
+			client: &fake.MockScalingPolicyClient{MockDelete: mockDelete(errBoom)},
+			err:    awsclient.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			cr := policy()
+			err := e.Delete(context.Background(), cr)
+
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(policy(withConditions(xpv1.Deleting())), cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
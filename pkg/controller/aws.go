@@ -22,9 +22,11 @@ import (
 
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/controller/acm"
 	"github.com/crossplane/provider-aws/pkg/controller/acmpca/certificateauthority"
 	"github.com/crossplane/provider-aws/pkg/controller/acmpca/certificateauthoritypermission"
+	"github.com/crossplane/provider-aws/pkg/controller/apigateway/restapi"
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/api"
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/apimapping"
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/authorizer"
@@ -37,31 +39,52 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/routeresponse"
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/stage"
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/vpclink"
+	"github.com/crossplane/provider-aws/pkg/controller/applicationautoscaling/scalabletarget"
+	"github.com/crossplane/provider-aws/pkg/controller/applicationautoscaling/scalingpolicy"
+	"github.com/crossplane/provider-aws/pkg/controller/batch/computeenvironment"
+	"github.com/crossplane/provider-aws/pkg/controller/batch/jobqueue"
 	"github.com/crossplane/provider-aws/pkg/controller/cache"
+	"github.com/crossplane/provider-aws/pkg/controller/cache/cacheparametergroup"
 	"github.com/crossplane/provider-aws/pkg/controller/cache/cachesubnetgroup"
 	"github.com/crossplane/provider-aws/pkg/controller/cache/cluster"
+	"github.com/crossplane/provider-aws/pkg/controller/cloudfront/distribution"
+	"github.com/crossplane/provider-aws/pkg/controller/cloudwatch/alarm"
+	"github.com/crossplane/provider-aws/pkg/controller/cloudwatchlogs/loggroup"
 	"github.com/crossplane/provider-aws/pkg/controller/config"
 	"github.com/crossplane/provider-aws/pkg/controller/database"
+	"github.com/crossplane/provider-aws/pkg/controller/database/dbproxy"
+	"github.com/crossplane/provider-aws/pkg/controller/database/dbsnapshot"
 	"github.com/crossplane/provider-aws/pkg/controller/database/dbsubnetgroup"
+	"github.com/crossplane/provider-aws/pkg/controller/database/optiongroup"
+	"github.com/crossplane/provider-aws/pkg/controller/database/rdsclone"
 	"github.com/crossplane/provider-aws/pkg/controller/dynamodb/backup"
 	"github.com/crossplane/provider-aws/pkg/controller/dynamodb/globaltable"
 	"github.com/crossplane/provider-aws/pkg/controller/dynamodb/table"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/address"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/instance"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/internetgateway"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/natgateway"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/networkacl"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/routetable"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/securitygroup"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/subnet"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpc"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpccidrblock"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpcpeeringconnection"
 	"github.com/crossplane/provider-aws/pkg/controller/ecr/repository"
 	"github.com/crossplane/provider-aws/pkg/controller/ecr/repositorypolicy"
+	ecscluster "github.com/crossplane/provider-aws/pkg/controller/ecs/cluster"
+	ecsservice "github.com/crossplane/provider-aws/pkg/controller/ecs/service"
 	"github.com/crossplane/provider-aws/pkg/controller/efs/filesystem"
+	"github.com/crossplane/provider-aws/pkg/controller/efs/mounttarget"
 	"github.com/crossplane/provider-aws/pkg/controller/eks"
 	"github.com/crossplane/provider-aws/pkg/controller/eks/fargateprofile"
 	"github.com/crossplane/provider-aws/pkg/controller/eks/nodegroup"
 	"github.com/crossplane/provider-aws/pkg/controller/elasticloadbalancing/elb"
 	"github.com/crossplane/provider-aws/pkg/controller/elasticloadbalancing/elbattachment"
+	"github.com/crossplane/provider-aws/pkg/controller/elasticloadbalancing/loadbalancer"
+	"github.com/crossplane/provider-aws/pkg/controller/elasticsearch/domain"
+	gluedatabase "github.com/crossplane/provider-aws/pkg/controller/glue/database"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamaccesskey"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamgroup"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamgrouppolicyattachment"
@@ -71,7 +94,11 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamrolepolicyattachment"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamuser"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamuserpolicyattachment"
+	"github.com/crossplane/provider-aws/pkg/controller/kinesis/stream"
+	"github.com/crossplane/provider-aws/pkg/controller/kms/alias"
 	"github.com/crossplane/provider-aws/pkg/controller/kms/key"
+	"github.com/crossplane/provider-aws/pkg/controller/lambda/eventsourcemapping"
+	"github.com/crossplane/provider-aws/pkg/controller/lambda/function"
 	"github.com/crossplane/provider-aws/pkg/controller/notification/snssubscription"
 	"github.com/crossplane/provider-aws/pkg/controller/notification/snstopic"
 	"github.com/crossplane/provider-aws/pkg/controller/rds/dbcluster"
@@ -85,12 +112,16 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/sfn/activity"
 	"github.com/crossplane/provider-aws/pkg/controller/sfn/statemachine"
 	"github.com/crossplane/provider-aws/pkg/controller/sqs/queue"
+	"github.com/crossplane/provider-aws/pkg/controller/ssm/parameter"
+	"github.com/crossplane/provider-aws/pkg/controller/transfer/server"
+	"github.com/crossplane/provider-aws/pkg/controller/wafv2/webacl"
 )
 
-// Setup creates all AWS controllers with the supplied logger and adds them to
-// the supplied manager.
-func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
-	for _, setup := range []func(ctrl.Manager, logging.Logger, workqueue.RateLimiter) error{
+// Setup creates all AWS controllers with the supplied logger and options and
+// adds them to the supplied manager.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclient.Options) error {
+	o = o.WithDefaults()
+	for _, setup := range []func(ctrl.Manager, logging.Logger, workqueue.RateLimiter, awsclient.Options) error{
 		config.Setup,
 		cache.SetupReplicationGroup,
 		cachesubnetgroup.SetupCacheSubnetGroup,
@@ -154,8 +185,37 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
 		dbcluster.SetupDBCluster,
 		dbparametergroup.SetupDBParameterGroup,
 		vpccidrblock.SetupVPCCIDRBlock,
+		domain.SetupDomain,
+		function.SetupFunction,
+		alias.SetupAlias,
+		loadbalancer.SetupLoadBalancer,
+		alarm.SetupAlarm,
+		instance.SetupInstance,
+		mounttarget.SetupMountTarget,
+		stream.SetupStream,
+		optiongroup.SetupOptionGroup,
+		webacl.SetupWebACL,
+		restapi.SetupRestAPI,
+		distribution.SetupDistribution,
+		scalabletarget.SetupScalableTarget,
+		scalingpolicy.SetupScalingPolicy,
+		parameter.SetupParameter,
+		eventsourcemapping.SetupEventSourceMapping,
+		gluedatabase.SetupDatabase,
+		dbsnapshot.SetupDBSnapshot,
+		loggroup.SetupLogGroup,
+		ecscluster.SetupECSCluster,
+		ecsservice.SetupService,
+		cacheparametergroup.SetupCacheParameterGroup,
+		server.SetupServer,
+		rdsclone.SetupRDSClone,
+		computeenvironment.SetupComputeEnvironment,
+		jobqueue.SetupJobQueue,
+		networkacl.SetupNetworkACL,
+		vpcpeeringconnection.SetupVPCPeeringConnection,
+		dbproxy.SetupDBProxy,
 	} {
-		if err := setup(mgr, l, rl); err != nil {
+		if err := setup(mgr, l, rl, o); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,178 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package computeenvironment
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsbatch "github.com/aws/aws-sdk-go/service/batch"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/batch/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/batch"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a ComputeEnvironment custom resource"
+	errCreateSession    = "cannot create a new session"
+	errDescribeFailed   = "cannot describe Batch ComputeEnvironment"
+	errCreateFailed     = "cannot create Batch ComputeEnvironment"
+	errUpdateFailed     = "cannot update Batch ComputeEnvironment"
+	errDisableFailed    = "cannot disable Batch ComputeEnvironment"
+	errDeleteFailed     = "cannot delete Batch ComputeEnvironment"
+)
+
+// SetupComputeEnvironment adds a controller that reconciles
+// ComputeEnvironments.
+func SetupComputeEnvironment(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclient.Options) error {
+	name := managed.ControllerName(v1alpha1.ComputeEnvironmentGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		For(&v1alpha1.ComputeEnvironment{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ComputeEnvironmentGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: batch.NewClient}, o)),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithTimeout(o.ReconcileTimeout),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) batch.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ComputeEnvironment)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client batch.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ComputeEnvironment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	rsp, err := e.client.DescribeComputeEnvironmentsWithContext(ctx, &awsbatch.DescribeComputeEnvironmentsInput{
+		ComputeEnvironments: aws.StringSlice([]string{meta.GetExternalName(cr)}),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribeFailed)
+	}
+	if len(rsp.ComputeEnvironments) == 0 || aws.StringValue(rsp.ComputeEnvironments[0].Status) == v1alpha1.StatusDeleted {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	ce := rsp.ComputeEnvironments[0]
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	batch.LateInitializeComputeEnvironment(&cr.Spec.ForProvider, ce)
+
+	cr.Status.AtProvider = batch.GenerateComputeEnvironmentObservation(*ce)
+	switch cr.Status.AtProvider.Status {
+	case v1alpha1.StatusValid, v1alpha1.StatusUpdating:
+		cr.SetConditions(xpv1.Available())
+	case v1alpha1.StatusCreating:
+		cr.SetConditions(xpv1.Creating())
+	case v1alpha1.StatusDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        !batch.Settled(cr.Status.AtProvider.Status) || batch.IsComputeEnvironmentUpToDate(cr.Spec.ForProvider, *ce),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ComputeEnvironment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.client.CreateComputeEnvironmentWithContext(ctx, batch.GenerateCreateComputeEnvironmentInput(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreateFailed)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ComputeEnvironment)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	_, err := e.client.UpdateComputeEnvironmentWithContext(ctx, batch.GenerateUpdateComputeEnvironmentInput(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdateFailed)
+}
+
+// Delete disables the compute environment first because AWS Batch only deletes
+// disabled compute environments. The deletion is requested once the compute
+// environment has settled in the disabled state.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ComputeEnvironment)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	if !batch.Settled(cr.Status.AtProvider.Status) {
+		return nil
+	}
+	if cr.Status.AtProvider.State != v1alpha1.StateDisabled {
+		_, err := e.client.UpdateComputeEnvironmentWithContext(ctx, &awsbatch.UpdateComputeEnvironmentInput{
+			ComputeEnvironment: aws.String(meta.GetExternalName(cr)),
+			State:              aws.String(v1alpha1.StateDisabled),
+		})
+		return awsclient.Wrap(err, errDisableFailed)
+	}
+	_, err := e.client.DeleteComputeEnvironmentWithContext(ctx, &awsbatch.DeleteComputeEnvironmentInput{
+		ComputeEnvironment: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(err, errDeleteFailed)
+}
@@ -0,0 +1,337 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package computeenvironment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsbatch "github.com/aws/aws-sdk-go/service/batch"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/batch/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/batch/fake"
+)
+
+var (
+	ceName = "data-jobs"
+	ceARN  = "arn:aws:batch:us-east-1:123456789012:compute-environment/data-jobs"
+	region = "us-east-1"
+
+	errBoom = errors.New("boom")
+)
+
+type ceModifier func(*v1alpha1.ComputeEnvironment)
+
+func withConditions(c ...xpv1.Condition) ceModifier {
+	return func(r *v1alpha1.ComputeEnvironment) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(state, status string) ceModifier {
+	return func(r *v1alpha1.ComputeEnvironment) {
+		r.Status.AtProvider = v1alpha1.ComputeEnvironmentObservation{ARN: ceARN, State: state, Status: status}
+	}
+}
+
+func withState(s string) ceModifier {
+	return func(r *v1alpha1.ComputeEnvironment) { r.Spec.ForProvider.State = aws.String(s) }
+}
+
+func withMaxvCPUs(n int64) ceModifier {
+	return func(r *v1alpha1.ComputeEnvironment) { r.Spec.ForProvider.ComputeResources.MaxvCPUs = n }
+}
+
+func computeEnvironment(m ...ceModifier) *v1alpha1.ComputeEnvironment {
+	cr := &v1alpha1.ComputeEnvironment{}
+	meta.SetExternalName(cr, ceName)
+	cr.Spec.ForProvider.Region = region
+	cr.Spec.ForProvider.Type = awsbatch.CETypeManaged
+	cr.Spec.ForProvider.ComputeResources = &v1alpha1.ComputeResource{
+		Type:     awsbatch.CRTypeFargate,
+		MaxvCPUs: 16,
+		Subnets:  []string{"subnet-0123"},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// initialized returns a compute environment whose parameters match
+// observed().
+func initialized(m ...ceModifier) *v1alpha1.ComputeEnvironment {
+	return computeEnvironment(append([]ceModifier{withState(v1alpha1.StateEnabled)}, m...)...)
+}
+
+func observed(state, status string) *awsbatch.ComputeEnvironmentDetail {
+	return &awsbatch.ComputeEnvironmentDetail{
+		ComputeEnvironmentArn:  aws.String(ceARN),
+		ComputeEnvironmentName: aws.String(ceName),
+		State:                  aws.String(state),
+		Status:                 aws.String(status),
+		Type:                   aws.String(awsbatch.CETypeManaged),
+		ComputeResources: &awsbatch.ComputeResource{
+			Type:     aws.String(awsbatch.CRTypeFargate),
+			MaxvCpus: aws.Int64(16),
+			Subnets:  aws.StringSlice([]string{"subnet-0123"}),
+		},
+	}
+}
+
+func describe(ce *awsbatch.ComputeEnvironmentDetail, err error) func(*awsbatch.DescribeComputeEnvironmentsInput) (*awsbatch.DescribeComputeEnvironmentsOutput, error) {
+	return func(*awsbatch.DescribeComputeEnvironmentsInput) (*awsbatch.DescribeComputeEnvironmentsOutput, error) {
+		if err != nil {
+			return nil, err
+		}
+		out := &awsbatch.DescribeComputeEnvironmentsOutput{}
+		if ce != nil {
+			out.ComputeEnvironments = []*awsbatch.ComputeEnvironmentDetail{ce}
+		}
+		return out, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.ComputeEnvironment
+		want   want
+	}{
+		"Valid": {
+			client: &fake.MockClient{MockDescribeComputeEnvironments: describe(observed(v1alpha1.StateEnabled, v1alpha1.StatusValid), nil)},
+			cr:     initialized(),
+			want: want{
+				cr:     initialized(withStatus(v1alpha1.StateEnabled, v1alpha1.StatusValid), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Creating": {
+			client: &fake.MockClient{MockDescribeComputeEnvironments: describe(observed(v1alpha1.StateEnabled, v1alpha1.StatusCreating), nil)},
+			cr:     initialized(),
+			want: want{
+				cr:     initialized(withStatus(v1alpha1.StateEnabled, v1alpha1.StatusCreating), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Invalid": {
+			client: &fake.MockClient{MockDescribeComputeEnvironments: describe(observed(v1alpha1.StateEnabled, v1alpha1.StatusInvalid), nil)},
+			cr:     initialized(),
+			want: want{
+				cr:     initialized(withStatus(v1alpha1.StateEnabled, v1alpha1.StatusInvalid), withConditions(xpv1.Unavailable())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"MaxvCPUsChanged": {
+			client: &fake.MockClient{MockDescribeComputeEnvironments: describe(observed(v1alpha1.StateEnabled, v1alpha1.StatusValid), nil)},
+			cr:     initialized(withMaxvCPUs(32)),
+			want: want{
+				cr:     initialized(withMaxvCPUs(32), withStatus(v1alpha1.StateEnabled, v1alpha1.StatusValid), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"ChangeWhileUpdating": {
+			client: &fake.MockClient{MockDescribeComputeEnvironments: describe(observed(v1alpha1.StateEnabled, v1alpha1.StatusUpdating), nil)},
+			cr:     initialized(withMaxvCPUs(32)),
+			want: want{
+				cr:     initialized(withMaxvCPUs(32), withStatus(v1alpha1.StateEnabled, v1alpha1.StatusUpdating), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitialized": {
+			client: &fake.MockClient{MockDescribeComputeEnvironments: describe(observed(v1alpha1.StateEnabled, v1alpha1.StatusValid), nil)},
+			cr:     computeEnvironment(),
+			want: want{
+				cr:     initialized(withStatus(v1alpha1.StateEnabled, v1alpha1.StatusValid), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{MockDescribeComputeEnvironments: describe(nil, nil)},
+			cr:     initialized(),
+			want: want{
+				cr: initialized(),
+			},
+		},
+		"Deleted": {
+			client: &fake.MockClient{MockDescribeComputeEnvironments: describe(observed(v1alpha1.StateDisabled, v1alpha1.StatusDeleted), nil)},
+			cr:     initialized(),
+			want: want{
+				cr: initialized(),
+			},
+		},
+		"DescribeFailed": {
+			client: &fake.MockClient{MockDescribeComputeEnvironments: describe(nil, errBoom)},
+			cr:     initialized(),
+			want: want{
+				cr:  initialized(),
+				err: awsclient.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.ComputeEnvironment
+		want   want
+	}{
+		"Successful": {
+			client: &fake.MockClient{
+				MockCreateComputeEnvironment: func(in *awsbatch.CreateComputeEnvironmentInput) (*awsbatch.CreateComputeEnvironmentOutput, error) {
+					if aws.StringValue(in.ComputeEnvironmentName) != ceName || aws.StringValue(in.ComputeResources.Type) != awsbatch.CRTypeFargate {
+						return nil, errBoom
+					}
+					return &awsbatch.CreateComputeEnvironmentOutput{ComputeEnvironmentArn: aws.String(ceARN)}, nil
+				},
+			},
+			cr: computeEnvironment(),
+			want: want{
+				cr: computeEnvironment(withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			client: &fake.MockClient{
+				MockCreateComputeEnvironment: func(*awsbatch.CreateComputeEnvironmentInput) (*awsbatch.CreateComputeEnvironmentOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: computeEnvironment(),
+			want: want{
+				cr:  computeEnvironment(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		disabled bool
+		deleted  bool
+		err      error
+	}
+
+	cases := map[string]struct {
+		updateErr error
+		deleteErr error
+		cr        *v1alpha1.ComputeEnvironment
+		want      want
+	}{
+		"Disable": {
+			cr:   initialized(withStatus(v1alpha1.StateEnabled, v1alpha1.StatusValid)),
+			want: want{disabled: true},
+		},
+		"DisableFailed": {
+			updateErr: errBoom,
+			cr:        initialized(withStatus(v1alpha1.StateEnabled, v1alpha1.StatusValid)),
+			want:      want{disabled: true, err: awsclient.Wrap(errBoom, errDisableFailed)},
+		},
+		"WaitForDisable": {
+			cr:   initialized(withStatus(v1alpha1.StateDisabled, v1alpha1.StatusUpdating)),
+			want: want{},
+		},
+		"Delete": {
+			cr:   initialized(withStatus(v1alpha1.StateDisabled, v1alpha1.StatusValid)),
+			want: want{deleted: true},
+		},
+		"DeleteFailed": {
+			deleteErr: errBoom,
+			cr:        initialized(withStatus(v1alpha1.StateDisabled, v1alpha1.StatusValid)),
+			want:      want{deleted: true, err: awsclient.Wrap(errBoom, errDeleteFailed)},
+		},
+		"AlreadyDeleting": {
+			cr:   initialized(withStatus(v1alpha1.StateDisabled, v1alpha1.StatusDeleting)),
+			want: want{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			e := &external{client: &fake.MockClient{
+				MockUpdateComputeEnvironment: func(in *awsbatch.UpdateComputeEnvironmentInput) (*awsbatch.UpdateComputeEnvironmentOutput, error) {
+					got.disabled = aws.StringValue(in.State) == v1alpha1.StateDisabled && in.ComputeResources == nil
+					return &awsbatch.UpdateComputeEnvironmentOutput{}, tc.updateErr
+				},
+				MockDeleteComputeEnvironment: func(in *awsbatch.DeleteComputeEnvironmentInput) (*awsbatch.DeleteComputeEnvironmentOutput, error) {
+					got.deleted = aws.StringValue(in.ComputeEnvironment) == ceName
+					return &awsbatch.DeleteComputeEnvironmentOutput{}, tc.deleteErr
+				},
+			}}
+			got.err = e.Delete(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff([]xpv1.Condition{xpv1.Deleting()}, tc.cr.Status.Conditions, test.EquateConditions()); diff != "" {
+				t.Errorf("conditions: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
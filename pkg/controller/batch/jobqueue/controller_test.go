@@ -0,0 +1,270 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobqueue
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsbatch "github.com/aws/aws-sdk-go/service/batch"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/batch/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/batch/fake"
+)
+
+var (
+	jqName = "data-jobs"
+	jqARN  = "arn:aws:batch:us-east-1:123456789012:job-queue/data-jobs"
+	ceARN  = "arn:aws:batch:us-east-1:123456789012:compute-environment/data-jobs"
+	region = "us-east-1"
+
+	errBoom = errors.New("boom")
+)
+
+type jqModifier func(*v1alpha1.JobQueue)
+
+func withConditions(c ...xpv1.Condition) jqModifier {
+	return func(r *v1alpha1.JobQueue) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(state, status string) jqModifier {
+	return func(r *v1alpha1.JobQueue) {
+		r.Status.AtProvider = v1alpha1.JobQueueObservation{ARN: jqARN, State: state, Status: status}
+	}
+}
+
+func withState(s string) jqModifier {
+	return func(r *v1alpha1.JobQueue) { r.Spec.ForProvider.State = aws.String(s) }
+}
+
+func withPriority(p int64) jqModifier {
+	return func(r *v1alpha1.JobQueue) { r.Spec.ForProvider.Priority = p }
+}
+
+func jobQueue(m ...jqModifier) *v1alpha1.JobQueue {
+	cr := &v1alpha1.JobQueue{}
+	meta.SetExternalName(cr, jqName)
+	cr.Spec.ForProvider.Region = region
+	cr.Spec.ForProvider.Priority = 10
+	cr.Spec.ForProvider.ComputeEnvironmentOrder = []v1alpha1.ComputeEnvironmentOrder{
+		{Order: 1, ComputeEnvironment: aws.String(ceARN)},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// initialized returns a job queue whose parameters match observed().
+func initialized(m ...jqModifier) *v1alpha1.JobQueue {
+	return jobQueue(append([]jqModifier{withState(v1alpha1.StateEnabled)}, m...)...)
+}
+
+func observed(state, status string) *awsbatch.JobQueueDetail {
+	return &awsbatch.JobQueueDetail{
+		JobQueueArn:  aws.String(jqARN),
+		JobQueueName: aws.String(jqName),
+		Priority:     aws.Int64(10),
+		State:        aws.String(state),
+		Status:       aws.String(status),
+		ComputeEnvironmentOrder: []*awsbatch.ComputeEnvironmentOrder{
+			{Order: aws.Int64(1), ComputeEnvironment: aws.String(ceARN)},
+		},
+	}
+}
+
+func describe(jq *awsbatch.JobQueueDetail, err error) func(*awsbatch.DescribeJobQueuesInput) (*awsbatch.DescribeJobQueuesOutput, error) {
+	return func(*awsbatch.DescribeJobQueuesInput) (*awsbatch.DescribeJobQueuesOutput, error) {
+		if err != nil {
+			return nil, err
+		}
+		out := &awsbatch.DescribeJobQueuesOutput{}
+		if jq != nil {
+			out.JobQueues = []*awsbatch.JobQueueDetail{jq}
+		}
+		return out, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.JobQueue
+		want   want
+	}{
+		"Valid": {
+			client: &fake.MockClient{MockDescribeJobQueues: describe(observed(v1alpha1.StateEnabled, v1alpha1.StatusValid), nil)},
+			cr:     initialized(),
+			want: want{
+				cr:     initialized(withStatus(v1alpha1.StateEnabled, v1alpha1.StatusValid), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"PriorityChanged": {
+			client: &fake.MockClient{MockDescribeJobQueues: describe(observed(v1alpha1.StateEnabled, v1alpha1.StatusValid), nil)},
+			cr:     initialized(withPriority(20)),
+			want: want{
+				cr:     initialized(withPriority(20), withStatus(v1alpha1.StateEnabled, v1alpha1.StatusValid), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"LateInitialized": {
+			client: &fake.MockClient{MockDescribeJobQueues: describe(observed(v1alpha1.StateEnabled, v1alpha1.StatusCreating), nil)},
+			cr:     jobQueue(),
+			want: want{
+				cr:     initialized(withStatus(v1alpha1.StateEnabled, v1alpha1.StatusCreating), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{MockDescribeJobQueues: describe(nil, nil)},
+			cr:     initialized(),
+			want: want{
+				cr: initialized(),
+			},
+		},
+		"DescribeFailed": {
+			client: &fake.MockClient{MockDescribeJobQueues: describe(nil, errBoom)},
+			cr:     initialized(),
+			want: want{
+				cr:  initialized(),
+				err: awsclient.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.JobQueue
+		err    error
+	}{
+		"Successful": {
+			client: &fake.MockClient{
+				MockUpdateJobQueue: func(in *awsbatch.UpdateJobQueueInput) (*awsbatch.UpdateJobQueueOutput, error) {
+					if aws.StringValue(in.JobQueue) != jqName || aws.Int64Value(in.Priority) != 20 {
+						return nil, errBoom
+					}
+					return &awsbatch.UpdateJobQueueOutput{}, nil
+				},
+			},
+			cr: initialized(withPriority(20)),
+		},
+		"UpdateFailed": {
+			client: &fake.MockClient{
+				MockUpdateJobQueue: func(*awsbatch.UpdateJobQueueInput) (*awsbatch.UpdateJobQueueOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr:  initialized(),
+			err: awsclient.Wrap(errBoom, errUpdateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		disabled bool
+		deleted  bool
+	}
+
+	cases := map[string]struct {
+		cr   *v1alpha1.JobQueue
+		want want
+	}{
+		"Disable": {
+			cr:   initialized(withStatus(v1alpha1.StateEnabled, v1alpha1.StatusValid)),
+			want: want{disabled: true},
+		},
+		"WaitForDisable": {
+			cr:   initialized(withStatus(v1alpha1.StateDisabled, v1alpha1.StatusUpdating)),
+			want: want{},
+		},
+		"Delete": {
+			cr:   initialized(withStatus(v1alpha1.StateDisabled, v1alpha1.StatusValid)),
+			want: want{deleted: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			e := &external{client: &fake.MockClient{
+				MockUpdateJobQueue: func(in *awsbatch.UpdateJobQueueInput) (*awsbatch.UpdateJobQueueOutput, error) {
+					got.disabled = aws.StringValue(in.State) == v1alpha1.StateDisabled && in.ComputeEnvironmentOrder == nil
+					return &awsbatch.UpdateJobQueueOutput{}, nil
+				},
+				MockDeleteJobQueue: func(in *awsbatch.DeleteJobQueueInput) (*awsbatch.DeleteJobQueueOutput, error) {
+					got.deleted = aws.StringValue(in.JobQueue) == jqName
+					return &awsbatch.DeleteJobQueueOutput{}, nil
+				},
+			}}
+			if err := e.Delete(context.Background(), tc.cr); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
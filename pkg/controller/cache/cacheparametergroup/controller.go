@@ -0,0 +1,223 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cacheparametergroup
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awscache "github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+)
+
+// Error strings.
+const (
+	errNotParameterGroup      = "managed resource is not a Cache Parameter Group"
+	errDescribeParameterGroup = "cannot describe Cache Parameter Group"
+	errDescribeParameters     = "cannot describe parameters of Cache Parameter Group"
+	errCreateParameterGroup   = "cannot create Cache Parameter Group"
+	errModifyParameterGroup   = "cannot modify Cache Parameter Group"
+	errResetParameterGroup    = "cannot reset parameters of Cache Parameter Group"
+	errDeleteParameterGroup   = "cannot delete Cache Parameter Group"
+)
+
+// ElastiCache accepts at most 20 parameters in a single modify or reset
+// request.
+const maxParametersPerRequest = 20
+
+// SetupCacheParameterGroup adds a controller that reconciles
+// CacheParameterGroups.
+func SetupCacheParameterGroup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclient.Options) error {
+	name := managed.ControllerName(v1alpha1.CacheParameterGroupGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		For(&v1alpha1.CacheParameterGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.CacheParameterGroupGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: elasticache.NewClient}, o)),
+			managed.WithTimeout(o.ReconcileTimeout),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) elasticache.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.CacheParameterGroup)
+	if !ok {
+		return nil, errors.New(errNotParameterGroup)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client elasticache.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CacheParameterGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotParameterGroup)
+	}
+
+	resp, err := e.client.DescribeCacheParameterGroupsRequest(&awscache.DescribeCacheParameterGroupsInput{
+		CacheParameterGroupName: awsclient.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil || len(resp.CacheParameterGroups) == 0 {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(elasticache.IsParameterGroupNotFound, err), errDescribeParameterGroup)
+	}
+
+	params, err := e.describeUserParameters(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribeParameters)
+	}
+
+	cr.Status.AtProvider = elasticache.GenerateParameterGroupObservation(resp.CacheParameterGroups[0])
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: elasticache.IsParameterGroupUpToDate(cr.Spec.ForProvider, params),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CacheParameterGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotParameterGroup)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	// The parameters are set by the first update after the group is observed.
+	_, err := e.client.CreateCacheParameterGroupRequest(&awscache.CreateCacheParameterGroupInput{
+		CacheParameterGroupFamily: awsclient.String(cr.Spec.ForProvider.CacheParameterGroupFamily),
+		CacheParameterGroupName:   awsclient.String(meta.GetExternalName(cr)),
+		Description:               awsclient.String(cr.Spec.ForProvider.Description),
+	}).Send(ctx)
+
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreateParameterGroup)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CacheParameterGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotParameterGroup)
+	}
+	name := meta.GetExternalName(cr)
+
+	params, err := e.describeUserParameters(ctx, name)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribeParameters)
+	}
+	modify, reset := elasticache.GenerateParameterChanges(cr.Spec.ForProvider.Parameters, params)
+
+	for _, batch := range batches(modify) {
+		if _, err := e.client.ModifyCacheParameterGroupRequest(&awscache.ModifyCacheParameterGroupInput{
+			CacheParameterGroupName: aws.String(name),
+			ParameterNameValues:     batch,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyParameterGroup)
+		}
+	}
+	for _, batch := range batches(reset) {
+		if _, err := e.client.ResetCacheParameterGroupRequest(&awscache.ResetCacheParameterGroupInput{
+			CacheParameterGroupName: aws.String(name),
+			ParameterNameValues:     batch,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errResetParameterGroup)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CacheParameterGroup)
+	if !ok {
+		return errors.New(errNotParameterGroup)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteCacheParameterGroupRequest(&awscache.DeleteCacheParameterGroupInput{
+		CacheParameterGroupName: awsclient.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+
+	return awsclient.Wrap(resource.Ignore(elasticache.IsParameterGroupNotFound, err), errDeleteParameterGroup)
+}
+
+// describeUserParameters returns the parameters of the group whose values
+// were changed from the engine defaults.
+func (e *external) describeUserParameters(ctx context.Context, name string) ([]awscache.Parameter, error) {
+	var params []awscache.Parameter
+	in := &awscache.DescribeCacheParametersInput{
+		CacheParameterGroupName: aws.String(name),
+		Source:                  aws.String(elasticache.ParameterSourceUser),
+	}
+	for {
+		resp, err := e.client.DescribeCacheParametersRequest(in).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, resp.Parameters...)
+		if resp.Marker == nil {
+			return params, nil
+		}
+		in.Marker = resp.Marker
+	}
+}
+
+func batches(params []awscache.ParameterNameValue) [][]awscache.ParameterNameValue {
+	var res [][]awscache.ParameterNameValue
+	for len(params) > maxParametersPerRequest {
+		res = append(res, params[:maxParametersPerRequest])
+		params = params[maxParametersPerRequest:]
+	}
+	if len(params) > 0 {
+		res = append(res, params)
+	}
+	return res
+}
@@ -0,0 +1,380 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cacheparametergroup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awscache "github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache/fake"
+)
+
+var (
+	groupName = "redis-tuned"
+	groupARN  = "arn:aws:elasticache:us-east-1:123456789012:parametergroup:redis-tuned"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	cache elasticache.Client
+	cr    *v1alpha1.CacheParameterGroup
+}
+
+type cpgModifier func(*v1alpha1.CacheParameterGroup)
+
+func withConditions(c ...xpv1.Condition) cpgModifier {
+	return func(r *v1alpha1.CacheParameterGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withParameters(p ...v1alpha1.CacheParameter) cpgModifier {
+	return func(r *v1alpha1.CacheParameterGroup) { r.Spec.ForProvider.Parameters = p }
+}
+
+func withObservation(o v1alpha1.CacheParameterGroupObservation) cpgModifier {
+	return func(r *v1alpha1.CacheParameterGroup) { r.Status.AtProvider = o }
+}
+
+func cpg(m ...cpgModifier) *v1alpha1.CacheParameterGroup {
+	cr := &v1alpha1.CacheParameterGroup{}
+	meta.SetExternalName(cr, groupName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeGroups(err error) func(*awscache.DescribeCacheParameterGroupsInput) awscache.DescribeCacheParameterGroupsRequest {
+	return func(*awscache.DescribeCacheParameterGroupsInput) awscache.DescribeCacheParameterGroupsRequest {
+		return awscache.DescribeCacheParameterGroupsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.DescribeCacheParameterGroupsOutput{
+				CacheParameterGroups: []awscache.CacheParameterGroup{{ARN: aws.String(groupARN)}},
+			}, Error: err},
+		}
+	}
+}
+
+// describeParameters returns the given parameters one page at a time.
+func describeParameters(params ...awscache.Parameter) func(*awscache.DescribeCacheParametersInput) awscache.DescribeCacheParametersRequest {
+	return func(in *awscache.DescribeCacheParametersInput) awscache.DescribeCacheParametersRequest {
+		i := 0
+		if in.Marker != nil {
+			fmt.Sscan(aws.StringValue(in.Marker), &i) // nolint:errcheck
+		}
+		out := &awscache.DescribeCacheParametersOutput{}
+		if i < len(params) {
+			out.Parameters = params[i : i+1]
+		}
+		if i+1 < len(params) {
+			out.Marker = aws.String(fmt.Sprint(i + 1))
+		}
+		return awscache.DescribeCacheParametersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+		}
+	}
+}
+
+func param(name, value string) awscache.Parameter {
+	return awscache.Parameter{ParameterName: aws.String(name), ParameterValue: aws.String(value), Source: aws.String(elasticache.ParameterSourceUser)}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.CacheParameterGroup
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheParameterGroupsRequest: describeGroups(nil),
+					MockDescribeCacheParametersRequest:      describeParameters(param("maxmemory-policy", "allkeys-lru"), param("timeout", "300")),
+				},
+				cr: cpg(withParameters(v1alpha1.CacheParameter{Name: "timeout", Value: "300"}, v1alpha1.CacheParameter{Name: "maxmemory-policy", Value: "allkeys-lru"})),
+			},
+			want: want{
+				cr: cpg(withParameters(v1alpha1.CacheParameter{Name: "timeout", Value: "300"}, v1alpha1.CacheParameter{Name: "maxmemory-policy", Value: "allkeys-lru"}),
+					withObservation(v1alpha1.CacheParameterGroupObservation{ARN: groupARN}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ParameterChanged": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheParameterGroupsRequest: describeGroups(nil),
+					MockDescribeCacheParametersRequest:      describeParameters(param("maxmemory-policy", "volatile-lru")),
+				},
+				cr: cpg(withParameters(v1alpha1.CacheParameter{Name: "maxmemory-policy", Value: "allkeys-lru"})),
+			},
+			want: want{
+				cr: cpg(withParameters(v1alpha1.CacheParameter{Name: "maxmemory-policy", Value: "allkeys-lru"}),
+					withObservation(v1alpha1.CacheParameterGroupObservation{ARN: groupARN}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"ParameterRemoved": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheParameterGroupsRequest: describeGroups(nil),
+					MockDescribeCacheParametersRequest:      describeParameters(param("timeout", "300")),
+				},
+				cr: cpg(),
+			},
+			want: want{
+				cr: cpg(withObservation(v1alpha1.CacheParameterGroupObservation{ARN: groupARN}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"NotFound": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheParameterGroupsRequest: describeGroups(awserr.New(awscache.ErrCodeCacheParameterGroupNotFoundFault, "", nil)),
+				},
+				cr: cpg(),
+			},
+			want: want{
+				cr: cpg(),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheParameterGroupsRequest: describeGroups(errBoom),
+				},
+				cr: cpg(),
+			},
+			want: want{
+				cr:  cpg(),
+				err: awsclient.Wrap(errBoom, errDescribeParameterGroup),
+			},
+		},
+		"DescribeParametersFailed": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheParameterGroupsRequest: describeGroups(nil),
+					MockDescribeCacheParametersRequest: func(*awscache.DescribeCacheParametersInput) awscache.DescribeCacheParametersRequest {
+						return awscache.DescribeCacheParametersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: cpg(),
+			},
+			want: want{
+				cr:  cpg(),
+				err: awsclient.Wrap(errBoom, errDescribeParameters),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cache}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want error
+	}{
+		"Successful":   {},
+		"CreateFailed": {err: errBoom, want: awsclient.Wrap(errBoom, errCreateParameterGroup)},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: &fake.MockClient{
+				MockCreateCacheParameterGroupRequest: func(*awscache.CreateCacheParameterGroupInput) awscache.CreateCacheParameterGroupRequest {
+					return awscache.CreateCacheParameterGroupRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.CreateCacheParameterGroupOutput{}, Error: tc.err},
+					}
+				},
+			}}
+			cr := cpg()
+			_, err := e.Create(context.Background(), cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(cpg(withConditions(xpv1.Creating())), cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		modified [][]string
+		reset    [][]string
+		err      error
+	}
+
+	names := func(p []awscache.ParameterNameValue) []string {
+		res := make([]string, len(p))
+		for i := range p {
+			res[i] = aws.StringValue(p[i].ParameterName)
+		}
+		return res
+	}
+
+	many := make([]v1alpha1.CacheParameter, 25)
+	manyNames := make([]string, 25)
+	for i := range many {
+		manyNames[i] = fmt.Sprintf("p%02d", i)
+		many[i] = v1alpha1.CacheParameter{Name: manyNames[i], Value: "1"}
+	}
+
+	cases := map[string]struct {
+		observed  []awscache.Parameter
+		desired   []v1alpha1.CacheParameter
+		modifyErr error
+		resetErr  error
+		want      want
+	}{
+		"ModifyAndReset": {
+			observed: []awscache.Parameter{param("maxmemory-policy", "volatile-lru"), param("timeout", "300")},
+			desired:  []v1alpha1.CacheParameter{{Name: "maxmemory-policy", Value: "allkeys-lru"}},
+			want: want{
+				modified: [][]string{{"maxmemory-policy"}},
+				reset:    [][]string{{"timeout"}},
+			},
+		},
+		"Batched": {
+			desired: many,
+			want: want{
+				modified: [][]string{manyNames[:20], manyNames[20:]},
+			},
+		},
+		"ModifyFailed": {
+			desired:   []v1alpha1.CacheParameter{{Name: "timeout", Value: "300"}},
+			modifyErr: errBoom,
+			want: want{
+				modified: [][]string{{"timeout"}},
+				err:      awsclient.Wrap(errBoom, errModifyParameterGroup),
+			},
+		},
+		"ResetFailed": {
+			observed: []awscache.Parameter{param("timeout", "300")},
+			resetErr: errBoom,
+			want: want{
+				reset: [][]string{{"timeout"}},
+				err:   awsclient.Wrap(errBoom, errResetParameterGroup),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var modified, reset [][]string
+			e := &external{client: &fake.MockClient{
+				MockDescribeCacheParametersRequest: describeParameters(tc.observed...),
+				MockModifyCacheParameterGroupRequest: func(in *awscache.ModifyCacheParameterGroupInput) awscache.ModifyCacheParameterGroupRequest {
+					modified = append(modified, names(in.ParameterNameValues))
+					return awscache.ModifyCacheParameterGroupRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.ModifyCacheParameterGroupOutput{}, Error: tc.modifyErr},
+					}
+				},
+				MockResetCacheParameterGroupRequest: func(in *awscache.ResetCacheParameterGroupInput) awscache.ResetCacheParameterGroupRequest {
+					reset = append(reset, names(in.ParameterNameValues))
+					return awscache.ResetCacheParameterGroupRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.ResetCacheParameterGroupOutput{}, Error: tc.resetErr},
+					}
+				},
+			}}
+			_, err := e.Update(context.Background(), cpg(withParameters(tc.desired...)))
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.modified, modified); diff != "" {
+				t.Errorf("modified: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.reset, reset); diff != "" {
+				t.Errorf("reset: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want error
+	}{
+		"Successful":   {},
+		"AlreadyGone":  {err: awserr.New(awscache.ErrCodeCacheParameterGroupNotFoundFault, "", nil)},
+		"DeleteFailed": {err: errBoom, want: awsclient.Wrap(errBoom, errDeleteParameterGroup)},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: &fake.MockClient{
+				MockDeleteCacheParameterGroupRequest: func(*awscache.DeleteCacheParameterGroupInput) awscache.DeleteCacheParameterGroupRequest {
+					return awscache.DeleteCacheParameterGroupRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.DeleteCacheParameterGroupOutput{}, Error: tc.err},
+					}
+				},
+			}}
+			cr := cpg()
+			err := e.Delete(context.Background(), cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(cpg(withConditions(xpv1.Deleting())), cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
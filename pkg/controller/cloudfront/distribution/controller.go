@@ -0,0 +1,200 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package distribution
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awscloudfront "github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudfront"
+)
+
+const (
+	errUnexpectedObject = "the managed resource is not a Distribution"
+	errGet              = "cannot get Distribution"
+	errCreate           = "cannot create Distribution"
+	errUpdate           = "cannot update Distribution"
+	errDisable          = "cannot disable Distribution"
+	errDelete           = "cannot delete Distribution"
+)
+
+// SetupDistribution adds a controller that reconciles Distributions.
+func SetupDistribution(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclient.Options) error {
+	name := managed.ControllerName(v1alpha1.DistributionGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		For(&v1alpha1.Distribution{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DistributionGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: cloudfront.NewClient}, o)),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithTimeout(o.ReconcileTimeout),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) cloudfront.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, ok := mg.(*v1alpha1.Distribution)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, awsclient.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client cloudfront.Client
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Distribution)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	rsp, err := e.client.GetDistributionRequest(&awscloudfront.GetDistributionInput{Id: aws.String(meta.GetExternalName(cr))}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(cloudfront.IsNotFound, err), errGet)
+	}
+	d := *rsp.Distribution
+	cr.Status.AtProvider = cloudfront.GenerateObservation(d)
+
+	// Changes are only served once they are deployed to all edge locations.
+	switch cr.Status.AtProvider.Status {
+	case v1alpha1.DistributionStatusDeployed:
+		cr.Status.SetConditions(xpv1.Available())
+	default:
+		cr.Status.SetConditions(xpv1.Creating())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  cloudfront.IsUpToDate(cr.Spec.ForProvider, d),
+		ConnectionDetails: cloudfront.GetConnectionDetails(d),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Distribution)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+	// The UID makes the creation idempotent in case the external name cannot
+	// be persisted.
+	rsp, err := e.client.CreateDistributionRequest(&awscloudfront.CreateDistributionInput{
+		DistributionConfig: cloudfront.GenerateDistributionConfig(string(cr.GetUID()), cr.Spec.ForProvider, nil),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.StringValue(rsp.Distribution.Id))
+	return managed.ExternalCreation{
+		ExternalNameAssigned: true,
+		ConnectionDetails:    cloudfront.GetConnectionDetails(*rsp.Distribution),
+	}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Distribution)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	rsp, err := e.client.GetDistributionRequest(&awscloudfront.GetDistributionInput{Id: aws.String(meta.GetExternalName(cr))}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errGet)
+	}
+	observed := rsp.Distribution.DistributionConfig
+	_, err = e.client.UpdateDistributionRequest(&awscloudfront.UpdateDistributionInput{
+		Id:                 rsp.Distribution.Id,
+		IfMatch:            rsp.ETag,
+		DistributionConfig: cloudfront.GenerateDistributionConfig(aws.StringValue(observed.CallerReference), cr.Spec.ForProvider, observed),
+	}).Send(ctx)
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Distribution)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	rsp, err := e.client.GetDistributionRequest(&awscloudfront.GetDistributionInput{Id: aws.String(meta.GetExternalName(cr))}).Send(ctx)
+	if err != nil {
+		return awsclient.Wrap(resource.Ignore(cloudfront.IsNotFound, err), errGet)
+	}
+	d := rsp.Distribution
+
+	// CloudFront only deletes distributions that are disabled and deployed,
+	// so the deletion takes a few reconciles.
+	if aws.BoolValue(d.DistributionConfig.Enabled) {
+		c := *d.DistributionConfig
+		c.Enabled = aws.Bool(false)
+		_, err := e.client.UpdateDistributionRequest(&awscloudfront.UpdateDistributionInput{
+			Id:                 d.Id,
+			IfMatch:            rsp.ETag,
+			DistributionConfig: &c,
+		}).Send(ctx)
+		return awsclient.Wrap(err, errDisable)
+	}
+	if aws.StringValue(d.Status) != v1alpha1.DistributionStatusDeployed {
+		return nil
+	}
+	_, err = e.client.DeleteDistributionRequest(&awscloudfront.DeleteDistributionInput{
+		Id:      d.Id,
+		IfMatch: rsp.ETag,
+	}).Send(ctx)
+	return awsclient.Wrap(resource.Ignore(cloudfront.IsNotFound, err), errDelete)
+}
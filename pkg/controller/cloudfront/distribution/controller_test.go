@@ -0,0 +1,472 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package distribution
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awscloudfront "github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudfront"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudfront/fake"
+)
+
+var (
+	distributionID = "EDFDVBD6EXAMPLE"
+	domainName     = "d111111abcdef8.cloudfront.net"
+	arn            = "arn:aws:cloudfront::123456789012:distribution/EDFDVBD6EXAMPLE"
+	uid            = types.UID("some-uid")
+	etag           = "E2QWRUHEXAMPLE"
+	inProgress     = "InProgress"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client cloudfront.Client
+	cr     resource.Managed
+}
+
+type distributionModifier func(*v1alpha1.Distribution)
+
+func withExternalName(n string) distributionModifier {
+	return func(r *v1alpha1.Distribution) { meta.SetExternalName(r, n) }
+}
+
+func withConditions(c ...xpv1.Condition) distributionModifier {
+	return func(r *v1alpha1.Distribution) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withComment(c string) distributionModifier {
+	return func(r *v1alpha1.Distribution) { r.Spec.ForProvider.Comment = aws.String(c) }
+}
+
+func withObservation(o v1alpha1.DistributionObservation) distributionModifier {
+	return func(r *v1alpha1.Distribution) { r.Status.AtProvider = o }
+}
+
+func distribution(m ...distributionModifier) *v1alpha1.Distribution {
+	cr := &v1alpha1.Distribution{
+		Spec: v1alpha1.DistributionSpec{
+			ForProvider: v1alpha1.DistributionParameters{
+				Origins: []v1alpha1.Origin{{ID: "s3", S3BucketName: aws.String("some-bucket")}},
+				DefaultCacheBehavior: v1alpha1.DefaultCacheBehavior{
+					TargetOriginID:       "s3",
+					ViewerProtocolPolicy: "redirect-to-https",
+				},
+			},
+		},
+	}
+	cr.SetUID(uid)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed(status string, enabled bool) *awscloudfront.Distribution {
+	c := cloudfront.GenerateDistributionConfig(string(uid), distribution().Spec.ForProvider, nil)
+	c.Enabled = aws.Bool(enabled)
+	return &awscloudfront.Distribution{
+		Id:                 aws.String(distributionID),
+		ARN:                aws.String(arn),
+		DomainName:         aws.String(domainName),
+		Status:             aws.String(status),
+		DistributionConfig: c,
+	}
+}
+
+func mockGet(d *awscloudfront.Distribution, err error) func(*awscloudfront.GetDistributionInput) awscloudfront.GetDistributionRequest {
+	return func(_ *awscloudfront.GetDistributionInput) awscloudfront.GetDistributionRequest {
+		return awscloudfront.GetDistributionRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awscloudfront.GetDistributionOutput{
+				Distribution: d,
+				ETag:         aws.String(etag),
+			}},
+		}
+	}
+}
+
+func mockUpdate(t *testing.T, enabled bool, err error) func(*awscloudfront.UpdateDistributionInput) awscloudfront.UpdateDistributionRequest {
+	return func(in *awscloudfront.UpdateDistributionInput) awscloudfront.UpdateDistributionRequest {
+		if diff := cmp.Diff(etag, aws.StringValue(in.IfMatch)); diff != "" {
+			t.Errorf("IfMatch: -want, +got:\n%s", diff)
+		}
+		if diff := cmp.Diff(enabled, aws.BoolValue(in.DistributionConfig.Enabled)); diff != "" {
+			t.Errorf("Enabled: -want, +got:\n%s", diff)
+		}
+		return awscloudfront.UpdateDistributionRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awscloudfront.UpdateDistributionOutput{}},
+		}
+	}
+}
+
+func connectionDetails() managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		v1alpha1.ResourceCredentialsSecretDomainNameKey: []byte(domainName),
+		v1alpha1.ResourceCredentialsSecretIDKey:         []byte(distributionID),
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"DeployedAndUpToDate": {
+			args: args{
+				client: &fake.MockDistributionClient{
+					MockGet: mockGet(observed(v1alpha1.DistributionStatusDeployed, true), nil),
+				},
+				cr: distribution(withExternalName(distributionID)),
+			},
+			want: want{
+				cr: distribution(withExternalName(distributionID),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.DistributionObservation{
+						ID:         distributionID,
+						ARN:        arn,
+						DomainName: domainName,
+						Status:     v1alpha1.DistributionStatusDeployed,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails(),
+				},
+			},
+		},
+		"InProgressAndNotUpToDate": {
+			args: args{
+				client: &fake.MockDistributionClient{
+					MockGet: mockGet(observed(inProgress, true), nil),
+				},
+				cr: distribution(withExternalName(distributionID), withComment("new")),
+			},
+			want: want{
+				cr: distribution(withExternalName(distributionID), withComment("new"),
+					withConditions(xpv1.Creating()),
+					withObservation(v1alpha1.DistributionObservation{
+						ID:         distributionID,
+						ARN:        arn,
+						DomainName: domainName,
+						Status:     inProgress,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connectionDetails(),
+				},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: distribution(),
+			},
+			want: want{
+				cr: distribution(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockDistributionClient{
+					MockGet: mockGet(nil, awserr.New(awscloudfront.ErrCodeNoSuchDistribution, "", nil)),
+				},
+				cr: distribution(withExternalName(distributionID)),
+			},
+			want: want{
+				cr: distribution(withExternalName(distributionID)),
+			},
+		},
+		"GetFail": {
+			args: args{
+				client: &fake.MockDistributionClient{
+					MockGet: mockGet(nil, errBoom),
+				},
+				cr: distribution(withExternalName(distributionID)),
+			},
+			want: want{
+				cr:  distribution(withExternalName(distributionID)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDistributionClient{
+					MockCreate: func(in *awscloudfront.CreateDistributionInput) awscloudfront.CreateDistributionRequest {
+						if diff := cmp.Diff(string(uid), aws.StringValue(in.DistributionConfig.CallerReference)); diff != "" {
+							t.Errorf("CallerReference: -want, +got:\n%s", diff)
+						}
+						return awscloudfront.CreateDistributionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudfront.CreateDistributionOutput{
+								Distribution: observed(inProgress, true),
+							}},
+						}
+					},
+				},
+				cr: distribution(),
+			},
+			want: want{
+				cr: distribution(withExternalName(distributionID), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{
+					ExternalNameAssigned: true,
+					ConnectionDetails:    connectionDetails(),
+				},
+			},
+		},
+		"CreateFail": {
+			args: args{
+				client: &fake.MockDistributionClient{
+					MockCreate: func(_ *awscloudfront.CreateDistributionInput) awscloudfront.CreateDistributionRequest {
+						return awscloudfront.CreateDistributionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: distribution(),
+			},
+			want: want{
+				cr:  distribution(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDistributionClient{
+					MockGet:    mockGet(observed(v1alpha1.DistributionStatusDeployed, true), nil),
+					MockUpdate: mockUpdate(t, true, nil),
+				},
+				cr: distribution(withExternalName(distributionID), withComment("new")),
+			},
+		},
+		"GetFail": {
+			args: args{
+				client: &fake.MockDistributionClient{
+					MockGet: mockGet(nil, errBoom),
+				},
+				cr: distribution(withExternalName(distributionID)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"UpdateFail": {
+			args: args{
+				client: &fake.MockDistributionClient{
+					MockGet:    mockGet(observed(v1alpha1.DistributionStatusDeployed, true), nil),
+					MockUpdate: mockUpdate(t, true, errBoom),
+				},
+				cr: distribution(withExternalName(distributionID)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	mockDelete := func(err error) func(*awscloudfront.DeleteDistributionInput) awscloudfront.DeleteDistributionRequest {
+		return func(in *awscloudfront.DeleteDistributionInput) awscloudfront.DeleteDistributionRequest {
+			if diff := cmp.Diff(etag, aws.StringValue(in.IfMatch)); diff != "" {
+				t.Errorf("IfMatch: -want, +got:\n%s", diff)
+			}
+			return awscloudfront.DeleteDistributionRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awscloudfront.DeleteDistributionOutput{}},
+			}
+		}
+	}
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"DisableFirst": {
+			args: args{
+				client: &fake.MockDistributionClient{
+					MockGet:    mockGet(observed(v1alpha1.DistributionStatusDeployed, true), nil),
+					MockUpdate: mockUpdate(t, false, nil),
+				},
+				cr: distribution(withExternalName(distributionID)),
+			},
+			want: want{
+				cr: distribution(withExternalName(distributionID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"WaitForDeployment": {
+			args: args{
+				client: &fake.MockDistributionClient{
+					MockGet: mockGet(observed(inProgress, false), nil),
+				},
+				cr: distribution(withExternalName(distributionID)),
+			},
+			want: want{
+				cr: distribution(withExternalName(distributionID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"Successful": {
+			args: args{
+				client: &fake.MockDistributionClient{
+					MockGet:    mockGet(observed(v1alpha1.DistributionStatusDeployed, false), nil),
+					MockDelete: mockDelete(nil),
+				},
+				cr: distribution(withExternalName(distributionID)),
+			},
+			want: want{
+				cr: distribution(withExternalName(distributionID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				client: &fake.MockDistributionClient{
+					MockGet: mockGet(nil, awserr.New(awscloudfront.ErrCodeNoSuchDistribution, "", nil)),
+				},
+				cr: distribution(withExternalName(distributionID)),
+			},
+			want: want{
+				cr: distribution(withExternalName(distributionID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				client: &fake.MockDistributionClient{
+					MockGet:    mockGet(observed(v1alpha1.DistributionStatusDeployed, false), nil),
+					MockDelete: mockDelete(errBoom),
+				},
+				cr: distribution(withExternalName(distributionID)),
+			},
+			want: want{
+				cr:  distribution(withExternalName(distributionID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
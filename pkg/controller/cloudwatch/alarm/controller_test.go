@@ -0,0 +1,306 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alarm
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awscw "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cloudwatch/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudwatch/fake"
+)
+
+var (
+	alarmName = "cpu"
+	alarmARN  = "arn:alarm"
+
+	errBoom = errors.New("boom")
+
+	params = v1alpha1.AlarmParameters{
+		ActionsEnabled:     aws.Bool(true),
+		Namespace:          "AWS/RDS",
+		MetricName:         "CPUUtilization",
+		Statistic:          "Average",
+		Period:             300,
+		EvaluationPeriods:  1,
+		Threshold:          80,
+		ComparisonOperator: "GreaterThanThreshold",
+		Tags:               []v1alpha1.Tag{{Key: "k", Value: "v"}},
+	}
+	metricAlarm = awscw.MetricAlarm{
+		AlarmArn:           &alarmARN,
+		ActionsEnabled:     aws.Bool(true),
+		Namespace:          aws.String("AWS/RDS"),
+		MetricName:         aws.String("CPUUtilization"),
+		Statistic:          awscw.StatisticAverage,
+		Period:             aws.Int64(300),
+		EvaluationPeriods:  aws.Int64(1),
+		Threshold:          aws.Float64(80),
+		ComparisonOperator: awscw.ComparisonOperatorGreaterThanThreshold,
+		StateValue:         awscw.StateValueOk,
+	}
+)
+
+type alarmModifier func(*v1alpha1.Alarm)
+
+func withConditions(c ...xpv1.Condition) alarmModifier {
+	return func(r *v1alpha1.Alarm) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.AlarmObservation) alarmModifier {
+	return func(r *v1alpha1.Alarm) { r.Status.AtProvider = o }
+}
+
+func withThreshold(t float64) alarmModifier {
+	return func(r *v1alpha1.Alarm) { r.Spec.ForProvider.Threshold = t }
+}
+
+func alarm(m ...alarmModifier) *v1alpha1.Alarm {
+	cr := &v1alpha1.Alarm{Spec: v1alpha1.AlarmSpec{ForProvider: *params.DeepCopy()}}
+	meta.SetExternalName(cr, alarmName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(alarms ...awscw.MetricAlarm) func(*awscw.DescribeAlarmsInput) awscw.DescribeAlarmsRequest {
+	return func(*awscw.DescribeAlarmsInput) awscw.DescribeAlarmsRequest {
+		return awscw.DescribeAlarmsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscw.DescribeAlarmsOutput{MetricAlarms: alarms}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     resource.Managed
+		want
+	}{
+		"UpToDate": {
+			client: &fake.MockClient{MockDescribeAlarms: describe(metricAlarm)},
+			cr:     alarm(),
+			want: want{
+				cr: alarm(withObservation(v1alpha1.AlarmObservation{AlarmARN: alarmARN, StateValue: "OK"}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ThresholdChanged": {
+			client: &fake.MockClient{MockDescribeAlarms: describe(metricAlarm)},
+			cr:     alarm(withThreshold(90)),
+			want: want{
+				cr: alarm(withThreshold(90),
+					withObservation(v1alpha1.AlarmObservation{AlarmARN: alarmARN, StateValue: "OK"}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{MockDescribeAlarms: describe()},
+			cr:     alarm(),
+			want: want{
+				cr: alarm(),
+			},
+		},
+		"DescribeError": {
+			client: &fake.MockClient{MockDescribeAlarms: func(*awscw.DescribeAlarmsInput) awscw.DescribeAlarmsRequest {
+				return awscw.DescribeAlarmsRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+				}
+			}},
+			cr: alarm(),
+			want: want{
+				cr:  alarm(),
+				err: awsclient.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}}
+			o, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     resource.Managed
+		want
+	}{
+		"Successful": {
+			client: &fake.MockClient{MockPutMetricAlarm: func(i *awscw.PutMetricAlarmInput) awscw.PutMetricAlarmRequest {
+				if aws.StringValue(i.AlarmName) != alarmName || len(i.Tags) != 1 {
+					return awscw.PutMetricAlarmRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom}}
+				}
+				return awscw.PutMetricAlarmRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscw.PutMetricAlarmOutput{}},
+				}
+			}},
+			cr: alarm(),
+			want: want{
+				cr: alarm(withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateError": {
+			client: &fake.MockClient{MockPutMetricAlarm: func(*awscw.PutMetricAlarmInput) awscw.PutMetricAlarmRequest {
+				return awscw.PutMetricAlarmRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+				}
+			}},
+			cr: alarm(),
+			want: want{
+				cr:  alarm(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     resource.Managed
+		err    error
+	}{
+		"Successful": {
+			client: &fake.MockClient{MockPutMetricAlarm: func(i *awscw.PutMetricAlarmInput) awscw.PutMetricAlarmRequest {
+				if aws.Float64Value(i.Threshold) != 90 || len(i.Tags) != 0 {
+					return awscw.PutMetricAlarmRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom}}
+				}
+				return awscw.PutMetricAlarmRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscw.PutMetricAlarmOutput{}},
+				}
+			}},
+			cr: alarm(withThreshold(90)),
+		},
+		"UpdateError": {
+			client: &fake.MockClient{MockPutMetricAlarm: func(*awscw.PutMetricAlarmInput) awscw.PutMetricAlarmRequest {
+				return awscw.PutMetricAlarmRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+				}
+			}},
+			cr:  alarm(),
+			err: awsclient.Wrap(errBoom, errUpdateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		client *fake.MockClient
+		err    error
+	}{
+		"Successful": {
+			client: &fake.MockClient{MockDeleteAlarms: func(*awscw.DeleteAlarmsInput) awscw.DeleteAlarmsRequest {
+				return awscw.DeleteAlarmsRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscw.DeleteAlarmsOutput{}},
+				}
+			}},
+		},
+		"AlreadyDeleted": {
+			client: &fake.MockClient{MockDeleteAlarms: func(*awscw.DeleteAlarmsInput) awscw.DeleteAlarmsRequest {
+				return awscw.DeleteAlarmsRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awscw.ErrCodeResourceNotFound, "", nil)},
+				}
+			}},
+		},
+		"DeleteError": {
+			client: &fake.MockClient{MockDeleteAlarms: func(*awscw.DeleteAlarmsInput) awscw.DeleteAlarmsRequest {
+				return awscw.DeleteAlarmsRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+				}
+			}},
+			err: awsclient.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := alarm()
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(alarm(withConditions(xpv1.Deleting())), cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
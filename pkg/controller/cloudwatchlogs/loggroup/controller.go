@@ -0,0 +1,200 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package loggroup
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awslogs "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/cloudwatchlogs/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudwatchlogs"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a CloudWatch Logs LogGroup custom resource"
+	errDescribeFailed   = "cannot describe CloudWatch Logs LogGroup"
+	errCreateFailed     = "cannot create CloudWatch Logs LogGroup"
+	errRetentionFailed  = "cannot put retention policy of CloudWatch Logs LogGroup"
+	errKMSKeyFailed     = "cannot associate KMS key with CloudWatch Logs LogGroup"
+	errDeleteFailed     = "cannot delete CloudWatch Logs LogGroup"
+	errSpecUpdate       = "cannot update spec of CloudWatch Logs LogGroup custom resource"
+)
+
+// SetupLogGroup adds a controller that reconciles CloudWatch Logs LogGroups.
+func SetupLogGroup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclient.Options) error {
+	name := managed.ControllerName(v1alpha1.LogGroupGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		For(&v1alpha1.LogGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.LogGroupGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: cloudwatchlogs.NewLogGroupClient}, o)),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithTimeout(o.ReconcileTimeout),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) cloudwatchlogs.LogGroupClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.LogGroup)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client cloudwatchlogs.LogGroupClient
+}
+
+// describe returns the log group with the given name, or nil if there is
+// none. DescribeLogGroups only filters by prefix, so it pages through the log
+// groups whose names start with the given name until it finds an exact match.
+func (e *external) describe(ctx context.Context, name string) (*awslogs.LogGroup, error) {
+	input := &awslogs.DescribeLogGroupsInput{LogGroupNamePrefix: aws.String(name)}
+	for {
+		rsp, err := e.client.DescribeLogGroupsRequest(input).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if lg := cloudwatchlogs.FindLogGroup(name, rsp.LogGroups); lg != nil {
+			return lg, nil
+		}
+		if rsp.NextToken == nil {
+			return nil, nil
+		}
+		input.NextToken = rsp.NextToken
+	}
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.LogGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	lg, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribeFailed)
+	}
+	if lg == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	cloudwatchlogs.LateInitialize(&cr.Spec.ForProvider, lg)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = cloudwatchlogs.GenerateObservation(*lg)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: cloudwatchlogs.IsUpToDate(cr.Spec.ForProvider, *lg),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.LogGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.client.CreateLogGroupRequest(cloudwatchlogs.GenerateCreateLogGroupInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreateFailed)
+	}
+	// A log group is created with infinite retention, so the retention is put
+	// right away rather than on the next reconcile.
+	return managed.ExternalCreation{}, e.putRetentionPolicy(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.LogGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	if err := e.putRetentionPolicy(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if cr.Spec.ForProvider.KMSKeyID == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err := e.client.AssociateKmsKeyRequest(&awslogs.AssociateKmsKeyInput{
+		LogGroupName: aws.String(meta.GetExternalName(cr)),
+		KmsKeyId:     cr.Spec.ForProvider.KMSKeyID,
+	}).Send(ctx)
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errKMSKeyFailed)
+}
+
+func (e *external) putRetentionPolicy(ctx context.Context, cr *v1alpha1.LogGroup) error {
+	if cr.Spec.ForProvider.RetentionInDays == nil {
+		return nil
+	}
+	_, err := e.client.PutRetentionPolicyRequest(&awslogs.PutRetentionPolicyInput{
+		LogGroupName:    aws.String(meta.GetExternalName(cr)),
+		RetentionInDays: cr.Spec.ForProvider.RetentionInDays,
+	}).Send(ctx)
+	return awsclient.Wrap(err, errRetentionFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.LogGroup)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteLogGroupRequest(&awslogs.DeleteLogGroupInput{
+		LogGroupName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return awsclient.Wrap(resource.Ignore(cloudwatchlogs.IsNotFound, err), errDeleteFailed)
+}
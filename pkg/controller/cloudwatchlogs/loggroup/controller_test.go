@@ -0,0 +1,399 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package loggroup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awslogs "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cloudwatchlogs/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudwatchlogs/fake"
+)
+
+var (
+	logGroupName = "/aws/lambda/some-function"
+	logGroupARN  = "arn:aws:logs:us-east-1:123456789012:log-group:/aws/lambda/some-function:*"
+	keyARN       = "arn:aws:kms:us-east-1:123456789012:key/some-key"
+
+	errBoom = errors.New("boom")
+)
+
+type logGroupModifier func(*v1alpha1.LogGroup)
+
+func withConditions(c ...xpv1.Condition) logGroupModifier {
+	return func(r *v1alpha1.LogGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.LogGroupObservation) logGroupModifier {
+	return func(r *v1alpha1.LogGroup) { r.Status.AtProvider = o }
+}
+
+func withRetention(d int64) logGroupModifier {
+	return func(r *v1alpha1.LogGroup) { r.Spec.ForProvider.RetentionInDays = aws.Int64(d) }
+}
+
+func withKMSKeyID(id string) logGroupModifier {
+	return func(r *v1alpha1.LogGroup) { r.Spec.ForProvider.KMSKeyID = aws.String(id) }
+}
+
+func logGroup(m ...logGroupModifier) *v1alpha1.LogGroup {
+	cr := &v1alpha1.LogGroup{}
+	meta.SetExternalName(cr, logGroupName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(pages ...awslogs.DescribeLogGroupsOutput) func(*awslogs.DescribeLogGroupsInput) awslogs.DescribeLogGroupsRequest {
+	return func(in *awslogs.DescribeLogGroupsInput) awslogs.DescribeLogGroupsRequest {
+		page := pages[0]
+		if in.NextToken != nil {
+			page = pages[1]
+		}
+		return awslogs.DescribeLogGroupsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &page},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockLogGroupClient
+		kube   client.Client
+		cr     *v1alpha1.LogGroup
+		want   want
+	}{
+		"UpToDate": {
+			client: &fake.MockLogGroupClient{
+				MockDescribe: describe(awslogs.DescribeLogGroupsOutput{LogGroups: []awslogs.LogGroup{{
+					LogGroupName:    aws.String(logGroupName),
+					Arn:             aws.String(logGroupARN),
+					RetentionInDays: aws.Int64(30),
+				}}}),
+			},
+			cr: logGroup(withRetention(30)),
+			want: want{
+				cr: logGroup(withRetention(30), withConditions(xpv1.Available()),
+					withObservation(v1alpha1.LogGroupObservation{ARN: logGroupARN})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"RetentionChanged": {
+			client: &fake.MockLogGroupClient{
+				MockDescribe: describe(awslogs.DescribeLogGroupsOutput{LogGroups: []awslogs.LogGroup{{
+					LogGroupName:    aws.String(logGroupName),
+					Arn:             aws.String(logGroupARN),
+					RetentionInDays: aws.Int64(30),
+				}}}),
+			},
+			cr: logGroup(withRetention(7)),
+			want: want{
+				cr: logGroup(withRetention(7), withConditions(xpv1.Available()),
+					withObservation(v1alpha1.LogGroupObservation{ARN: logGroupARN})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"LateInitialized": {
+			client: &fake.MockLogGroupClient{
+				MockDescribe: describe(awslogs.DescribeLogGroupsOutput{LogGroups: []awslogs.LogGroup{{
+					LogGroupName: aws.String(logGroupName),
+					Arn:          aws.String(logGroupARN),
+					KmsKeyId:     aws.String(keyARN),
+				}}}),
+			},
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			cr:   logGroup(),
+			want: want{
+				cr: logGroup(withKMSKeyID(keyARN), withConditions(xpv1.Available()),
+					withObservation(v1alpha1.LogGroupObservation{ARN: logGroupARN})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"FoundOnSecondPage": {
+			client: &fake.MockLogGroupClient{
+				MockDescribe: describe(
+					awslogs.DescribeLogGroupsOutput{
+						LogGroups: []awslogs.LogGroup{{LogGroupName: aws.String(logGroupName + "-other")}},
+						NextToken: aws.String("next"),
+					},
+					awslogs.DescribeLogGroupsOutput{LogGroups: []awslogs.LogGroup{{
+						LogGroupName: aws.String(logGroupName),
+						Arn:          aws.String(logGroupARN),
+					}}},
+				),
+			},
+			cr: logGroup(),
+			want: want{
+				cr: logGroup(withConditions(xpv1.Available()),
+					withObservation(v1alpha1.LogGroupObservation{ARN: logGroupARN})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockLogGroupClient{
+				MockDescribe: describe(awslogs.DescribeLogGroupsOutput{LogGroups: []awslogs.LogGroup{{
+					LogGroupName: aws.String(logGroupName + "-other"),
+				}}}),
+			},
+			cr: logGroup(),
+			want: want{
+				cr: logGroup(),
+			},
+		},
+		"DescribeFailed": {
+			client: &fake.MockLogGroupClient{
+				MockDescribe: func(*awslogs.DescribeLogGroupsInput) awslogs.DescribeLogGroupsRequest {
+					return awslogs.DescribeLogGroupsRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+					}
+				},
+			},
+			cr: logGroup(),
+			want: want{
+				cr:  logGroup(),
+				err: awsclient.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func putRetention(called *int64, err error) func(*awslogs.PutRetentionPolicyInput) awslogs.PutRetentionPolicyRequest {
+	return func(in *awslogs.PutRetentionPolicyInput) awslogs.PutRetentionPolicyRequest {
+		*called = aws.Int64Value(in.RetentionInDays)
+		return awslogs.PutRetentionPolicyRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslogs.PutRetentionPolicyOutput{}, Error: err},
+		}
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr        resource.Managed
+		retention int64
+		err       error
+	}
+
+	create := func(err error) func(*awslogs.CreateLogGroupInput) awslogs.CreateLogGroupRequest {
+		return func(*awslogs.CreateLogGroupInput) awslogs.CreateLogGroupRequest {
+			return awslogs.CreateLogGroupRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslogs.CreateLogGroupOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		create       error
+		putRetention error
+		cr           *v1alpha1.LogGroup
+		want         want
+	}{
+		"WithRetention": {
+			cr: logGroup(withRetention(14)),
+			want: want{
+				cr:        logGroup(withRetention(14), withConditions(xpv1.Creating())),
+				retention: 14,
+			},
+		},
+		"WithoutRetention": {
+			cr: logGroup(),
+			want: want{
+				cr: logGroup(withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			create: errBoom,
+			cr:     logGroup(withRetention(14)),
+			want: want{
+				cr:  logGroup(withRetention(14), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreateFailed),
+			},
+		},
+		"RetentionFailed": {
+			putRetention: errBoom,
+			cr:           logGroup(withRetention(14)),
+			want: want{
+				cr:        logGroup(withRetention(14), withConditions(xpv1.Creating())),
+				retention: 14,
+				err:       awsclient.Wrap(errBoom, errRetentionFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var retention int64
+			e := &external{client: &fake.MockLogGroupClient{
+				MockCreate:             create(tc.create),
+				MockPutRetentionPolicy: putRetention(&retention, tc.putRetention),
+			}}
+			_, err := e.Create(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.retention, retention); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		retention int64
+		key       string
+		err       error
+	}
+
+	associate := func(called *string, err error) func(*awslogs.AssociateKmsKeyInput) awslogs.AssociateKmsKeyRequest {
+		return func(in *awslogs.AssociateKmsKeyInput) awslogs.AssociateKmsKeyRequest {
+			*called = aws.StringValue(in.KmsKeyId)
+			return awslogs.AssociateKmsKeyRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslogs.AssociateKmsKeyOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		putRetention error
+		associate    error
+		cr           *v1alpha1.LogGroup
+		want         want
+	}{
+		"RetentionAndKey": {
+			cr:   logGroup(withRetention(90), withKMSKeyID(keyARN)),
+			want: want{retention: 90, key: keyARN},
+		},
+		"RetentionOnly": {
+			cr:   logGroup(withRetention(90)),
+			want: want{retention: 90},
+		},
+		"RetentionFailed": {
+			putRetention: errBoom,
+			cr:           logGroup(withRetention(90), withKMSKeyID(keyARN)),
+			want:         want{retention: 90, err: awsclient.Wrap(errBoom, errRetentionFailed)},
+		},
+		"AssociateFailed": {
+			associate: errBoom,
+			cr:        logGroup(withKMSKeyID(keyARN)),
+			want:      want{key: keyARN, err: awsclient.Wrap(errBoom, errKMSKeyFailed)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var retention int64
+			var key string
+			e := &external{client: &fake.MockLogGroupClient{
+				MockPutRetentionPolicy: putRetention(&retention, tc.putRetention),
+				MockAssociateKmsKey:    associate(&key, tc.associate),
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.retention, retention); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.key, key); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	del := func(err error) func(*awslogs.DeleteLogGroupInput) awslogs.DeleteLogGroupRequest {
+		return func(*awslogs.DeleteLogGroupInput) awslogs.DeleteLogGroupRequest {
+			return awslogs.DeleteLogGroupRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslogs.DeleteLogGroupOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		client *fake.MockLogGroupClient
+		err    error
+	}{
+		"Successful": {
+			client: &fake.MockLogGroupClient{MockDelete: del(nil)},
+		},
+		"AlreadyGone": {
+			client: &fake.MockLogGroupClient{MockDelete: del(awserr.New(awslogs.ErrCodeResourceNotFoundException, "", nil))},
+		},
+		"DeleteFailed": {
+			client: &fake.MockLogGroupClient{MockDelete: del(errBoom)},
+			err:    awsclient.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := logGroup()
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(logGroup(withConditions(xpv1.Deleting())), cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	healthTimeout = 1 * time.Minute
+
+	errGetProviderConfig = "cannot get ProviderConfig"
+	errPatchStatus       = "cannot patch ProviderConfig status"
+	errGetCallerIdentity = "cannot get caller identity"
+)
+
+// An identityClient returns the identity the credentials it uses belong to.
+type identityClient interface {
+	GetCallerIdentityRequest(input *sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest
+}
+
+func newIdentityClient(cfg aws.Config) identityClient {
+	return sts.New(cfg)
+}
+
+// A healthReconciler reconciles a ProviderConfig with the wrapped reconciler
+// and then checks whether AWS accepts its credentials, reporting the result
+// in its CredentialsValid condition. The credentials of each ProviderConfig
+// are checked at most once per interval, no matter how often its usages
+// change.
+type healthReconciler struct {
+	reconcile.Reconciler
+
+	kube        client.Client
+	newClientFn func(cfg aws.Config) identityClient
+	log         logging.Logger
+	interval    time.Duration
+	now         func() time.Time
+
+	mu      sync.Mutex
+	checked map[string]time.Time
+}
+
+func newHealthReconciler(r reconcile.Reconciler, kube client.Client, l logging.Logger, interval time.Duration) *healthReconciler {
+	return &healthReconciler{
+		Reconciler:  r,
+		kube:        kube,
+		newClientFn: newIdentityClient,
+		log:         l,
+		interval:    interval,
+		now:         time.Now,
+		checked:     map[string]time.Time{},
+	}
+}
+
+// Reconcile calls sts:GetCallerIdentity with the credentials of a
+// ProviderConfig once they are due to be checked. Only the CredentialsValid
+// condition is patched, and only when the outcome changes.
+func (r *healthReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	result, err := r.Reconciler.Reconcile(ctx, req)
+	if err != nil {
+		return result, err
+	}
+
+	due, wait := r.due(req.Name)
+	if !due {
+		return requeueBy(result, wait), nil
+	}
+
+	log := r.log.WithValues("request", req)
+	ctx, cancel := context.WithTimeout(ctx, healthTimeout)
+	defer cancel()
+
+	pc := &v1beta1.ProviderConfig{}
+	if err := r.kube.Get(ctx, types.NamespacedName{Name: req.Name}, pc); err != nil {
+		r.forget(req.Name)
+		return result, errors.Wrap(resource.IgnoreNotFound(err), errGetProviderConfig)
+	}
+	if meta.WasDeleted(pc) {
+		r.forget(req.Name)
+		return result, nil
+	}
+
+	var c xpv1.Condition
+	id, err := r.callerIdentity(ctx, pc)
+	if err != nil {
+		log.Debug("Credentials are not valid", "error", err)
+		c = v1beta1.CredentialsInvalid(err)
+	} else {
+		c = v1beta1.CredentialsValid(id)
+	}
+	r.mu.Lock()
+	r.checked[req.Name] = r.now()
+	r.mu.Unlock()
+
+	result = requeueBy(result, r.interval)
+	if pc.GetCondition(v1beta1.TypeCredentialsValid).Equal(c) {
+		return result, nil
+	}
+	p := client.MergeFrom(pc.DeepCopy())
+	pc.SetConditions(c)
+	return result, errors.Wrap(r.kube.Status().Patch(ctx, pc, p), errPatchStatus)
+}
+
+// due returns whether the credentials of the named ProviderConfig are due to
+// be checked, and if not how long until they are.
+func (r *healthReconciler) due(name string) (bool, time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t, ok := r.checked[name]
+	if !ok {
+		return true, 0
+	}
+	wait := t.Add(r.interval).Sub(r.now())
+	return wait <= 0, wait
+}
+
+func (r *healthReconciler) forget(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.checked, name)
+}
+
+// requeueBy returns the given result, requeued after the given duration at
+// the latest.
+func requeueBy(result reconcile.Result, after time.Duration) reconcile.Result {
+	if result.RequeueAfter == 0 || after < result.RequeueAfter {
+		result.RequeueAfter = after
+	}
+	return result
+}
+
+// callerIdentity returns the ARN of the identity the credentials of the given
+// ProviderConfig belong to. The global STS endpoint is used unless the
+// ProviderConfig overrides the endpoint.
+func (r *healthReconciler) callerIdentity(ctx context.Context, pc *v1beta1.ProviderConfig) (string, error) {
+	region := awsclient.GlobalRegion
+	if e := pc.Spec.Endpoint; e != nil && e.SigningRegion != nil {
+		region = aws.StringValue(e.SigningRegion)
+	}
+	cfg, err := awsclient.UseProviderConfigSpec(ctx, r.kube, pc, region)
+	if err != nil {
+		return "", err
+	}
+	rsp, err := r.newClientFn(*cfg).GetCallerIdentityRequest(&sts.GetCallerIdentityInput{}).Send(ctx)
+	if err != nil {
+		return "", awsclient.Wrap(err, errGetCallerIdentity)
+	}
+	return aws.StringValue(rsp.Arn), nil
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+type mockIdentityClient struct {
+	arn string
+	err error
+}
+
+func (m *mockIdentityClient) GetCallerIdentityRequest(*sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest {
+	return sts.GetCallerIdentityRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &sts.GetCallerIdentityOutput{Arn: aws.String(m.arn)}, Error: m.err},
+	}
+}
+
+func TestHealthReconcile(t *testing.T) {
+	errBoom := errors.New("boom")
+	arn := "arn:aws:iam::123456789012:user/crossplane"
+	credentials := []byte("[default]\naws_access_key_id = id\naws_secret_access_key = secret")
+
+	interval := 10 * time.Minute
+	now := time.Now()
+
+	type args struct {
+		wrapped   reconcile.Result
+		wrapErr   error
+		checked   map[string]time.Time
+		sts       *mockIdentityClient
+		current   []xpv1.Condition
+		getErr    error
+		secretErr error
+	}
+	type want struct {
+		updated *xpv1.Condition
+		checked bool
+		result  reconcile.Result
+		err     error
+	}
+
+	valid := v1beta1.CredentialsValid(arn)
+	invalid := v1beta1.CredentialsInvalid(awsclient.Wrap(errBoom, errGetCallerIdentity))
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"WrappedFailed": {
+			args: args{wrapErr: errBoom, sts: &mockIdentityClient{arn: arn}},
+			want: want{err: errBoom},
+		},
+		"NotDue": {
+			args: args{checked: map[string]time.Time{"default": now.Add(-time.Minute)}, sts: &mockIdentityClient{err: errBoom}, current: []xpv1.Condition{valid}},
+			want: want{checked: true, result: reconcile.Result{RequeueAfter: interval - time.Minute}},
+		},
+		"WrappedRequeuesSooner": {
+			args: args{wrapped: reconcile.Result{RequeueAfter: 30 * time.Second}, sts: &mockIdentityClient{arn: arn}, current: []xpv1.Condition{valid}},
+			want: want{checked: true, result: reconcile.Result{RequeueAfter: 30 * time.Second}},
+		},
+		"NotFound": {
+			args: args{getErr: kerrors.NewNotFound(schema.GroupResource{}, "")},
+			want: want{},
+		},
+		"GetFailed": {
+			args: args{getErr: errBoom},
+			want: want{err: errors.Wrap(errBoom, errGetProviderConfig)},
+		},
+		"BecameValid": {
+			args: args{sts: &mockIdentityClient{arn: arn}, current: []xpv1.Condition{invalid}},
+			want: want{updated: &valid, checked: true, result: reconcile.Result{RequeueAfter: interval}},
+		},
+		"StillValid": {
+			args: args{sts: &mockIdentityClient{arn: arn}, current: []xpv1.Condition{valid}},
+			want: want{checked: true, result: reconcile.Result{RequeueAfter: interval}},
+		},
+		"Rejected": {
+			args: args{sts: &mockIdentityClient{err: errBoom}, current: []xpv1.Condition{valid}},
+			want: want{updated: &invalid, checked: true, result: reconcile.Result{RequeueAfter: interval}},
+		},
+		"SecretMissing": {
+			args: args{sts: &mockIdentityClient{arn: arn}, secretErr: errBoom},
+			want: want{
+				updated: func() *xpv1.Condition {
+					c := v1beta1.CredentialsInvalid(errors.Wrap(errors.Wrap(errBoom, "cannot get credentials secret"), "cannot get credentials"))
+					return &c
+				}(),
+				checked: true,
+				result:  reconcile.Result{RequeueAfter: interval},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var updated *xpv1.Condition
+			kube := &test.MockClient{
+				MockGet: func(_ context.Context, _ types.NamespacedName, obj client.Object) error {
+					switch o := obj.(type) {
+					case *v1beta1.ProviderConfig:
+						o.Spec.Credentials.Source = xpv1.CredentialsSourceSecret
+						o.Spec.Credentials.SecretRef = &xpv1.SecretKeySelector{
+							SecretReference: xpv1.SecretReference{Name: "creds", Namespace: "crossplane-system"},
+							Key:             "credentials",
+						}
+						o.Status.Conditions = tc.args.current
+						return tc.args.getErr
+					case *corev1.Secret:
+						o.Data = map[string][]byte{"credentials": credentials}
+						return tc.args.secretErr
+					}
+					return nil
+				},
+				MockStatusPatch: func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+					c := obj.(*v1beta1.ProviderConfig).GetCondition(v1beta1.TypeCredentialsValid)
+					updated = &c
+					return nil
+				},
+			}
+			wrapped := reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+				return tc.args.wrapped, tc.args.wrapErr
+			})
+			r := newHealthReconciler(wrapped, kube, logging.NewNopLogger(), interval)
+			r.newClientFn = func(aws.Config) identityClient { return tc.args.sts }
+			r.now = func() time.Time { return now }
+			if tc.args.checked != nil {
+				r.checked = tc.args.checked
+			}
+			got, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "default"}})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("r: -want result, +got result:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want condition, +got condition:\n%s", diff)
+			}
+			if _, checked := r.checked["default"]; checked != tc.want.checked {
+				t.Errorf("r: want checked %t, got %t", tc.want.checked, checked)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	errGetConnectionSecret    = "cannot get connection secret"
+	errDeleteConnectionSecret = "cannot delete connection secret"
+)
+
+// connectionSecretPublisher publishes connection details to a Kubernetes
+// Secret like the API secret publisher does, but explicitly deletes that
+// Secret when the connection is unpublished rather than leaving it to the
+// garbage collector. Secrets that are not controlled by the managed resource
+// are left untouched.
+type connectionSecretPublisher struct {
+	managed.ConnectionPublisher
+	kube client.Client
+}
+
+func (p *connectionSecretPublisher) UnpublishConnection(ctx context.Context, mg resource.Managed, c managed.ConnectionDetails) error {
+	if err := p.ConnectionPublisher.UnpublishConnection(ctx, mg, c); err != nil {
+		return err
+	}
+	ref := mg.GetWriteConnectionSecretToReference()
+	if ref == nil {
+		return nil
+	}
+	s := &corev1.Secret{}
+	if err := p.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return errors.Wrap(resource.IgnoreNotFound(err), errGetConnectionSecret)
+	}
+	if !metav1.IsControlledBy(s, mg) {
+		return nil
+	}
+	return errors.Wrap(resource.IgnoreNotFound(p.kube.Delete(ctx, s)), errDeleteConnectionSecret)
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+)
+
+func TestConnectionSecretUnpublish(t *testing.T) {
+	owner := &v1beta1.RDSInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", UID: types.UID("owner")},
+		Spec: v1beta1.RDSInstanceSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				WriteConnectionSecretToReference: &xpv1.SecretReference{Namespace: "elsewhere", Name: "db-conn"},
+			},
+		},
+	}
+	controller := true
+	controlledBy := func(uid types.UID) func(_ context.Context, key types.NamespacedName, obj client.Object) error {
+		return func(_ context.Context, key types.NamespacedName, obj client.Object) error {
+			s := obj.(*corev1.Secret)
+			s.SetNamespace(key.Namespace)
+			s.SetName(key.Name)
+			if uid != "" {
+				meta.AddControllerReference(s, metav1.OwnerReference{UID: uid, Controller: &controller})
+			}
+			return nil
+		}
+	}
+
+	type want struct {
+		deleted bool
+		err     error
+	}
+
+	cases := map[string]struct {
+		publisher managed.ConnectionPublisher
+		get       test.MockGetFn
+		deleteErr error
+		cr        *v1beta1.RDSInstance
+		want      want
+	}{
+		"NoSecretReference": {
+			cr:   &v1beta1.RDSInstance{},
+			want: want{},
+		},
+		"UnpublishFailed": {
+			publisher: managed.ConnectionPublisherFns{
+				UnpublishConnectionFn: func(_ context.Context, _ resource.Managed, _ managed.ConnectionDetails) error { return errBoom },
+			},
+			cr:   owner,
+			want: want{err: errBoom},
+		},
+		"SecretNotFound": {
+			get:  test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "db-conn")),
+			cr:   owner,
+			want: want{},
+		},
+		"GetFailed": {
+			get:  test.NewMockGetFn(errBoom),
+			cr:   owner,
+			want: want{err: errors.Wrap(errBoom, errGetConnectionSecret)},
+		},
+		"NotControlled": {
+			get:  controlledBy("someone-else"),
+			cr:   owner,
+			want: want{},
+		},
+		"Deleted": {
+			get:  controlledBy("owner"),
+			cr:   owner,
+			want: want{deleted: true},
+		},
+		"DeleteFailed": {
+			get:       controlledBy("owner"),
+			deleteErr: errBoom,
+			cr:        owner,
+			want:      want{deleted: true, err: errors.Wrap(errBoom, errDeleteConnectionSecret)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			deleted := false
+			kube := &test.MockClient{
+				MockGet: tc.get,
+				MockDelete: func(_ context.Context, obj client.Object, _ ...client.DeleteOption) error {
+					deleted = obj.GetNamespace() == "elsewhere" && obj.GetName() == "db-conn"
+					return tc.deleteErr
+				},
+			}
+			pub := tc.publisher
+			if pub == nil {
+				pub = managed.ConnectionPublisherFns{
+					UnpublishConnectionFn: func(_ context.Context, _ resource.Managed, _ managed.ConnectionDetails) error { return nil },
+				}
+			}
+			p := &connectionSecretPublisher{ConnectionPublisher: pub, kube: kube}
+			err := p.UnpublishConnection(context.Background(), tc.cr, nil)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("deleted: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
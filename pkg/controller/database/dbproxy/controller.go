@@ -0,0 +1,282 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package dbproxy
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/dbproxy"
+)
+
+const (
+	errUnexpectedObject     = "the managed resource is not a DBProxy"
+	errKubeUpdateFailed     = "cannot update DBProxy custom resource"
+	errDescribe             = "cannot describe DBProxy"
+	errDescribeTargets      = "cannot describe the targets of the DBProxy"
+	errCreate               = "cannot create the DBProxy"
+	errModify               = "cannot modify the DBProxy"
+	errRegisterTargets      = "cannot register the targets of the DBProxy"
+	errDeregisterTargets    = "cannot deregister the targets of the DBProxy"
+	errDelete               = "cannot delete the DBProxy"
+	errNotOne               = "expected exactly one DBProxy"
+	errResolveInstanceField = "spec.forProvider.dbInstanceIdentifier"
+)
+
+// SetupDBProxy adds a controller that reconciles DBProxies.
+func SetupDBProxy(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclient.Options) error {
+	name := managed.ControllerName(v1alpha1.DBProxyGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		For(&v1alpha1.DBProxy{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DBProxyGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: dbproxy.NewClient}, o)),
+			managed.WithReferenceResolver(&instanceReferenceResolver{
+				kube:     mgr.GetClient(),
+				resolver: managed.NewAPISimpleReferenceResolver(mgr.GetClient()),
+			}),
+			managed.WithTimeout(o.ReconcileTimeout),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+// instanceReferenceResolver resolves the target RDSInstance reference of a
+// DBProxy in addition to the references resolved by the wrapped resolver.
+// The RDSInstance API imports the one of DBProxy, so the reference cannot be
+// resolved by the DBProxy itself without an import cycle.
+type instanceReferenceResolver struct {
+	kube     client.Client
+	resolver managed.ReferenceResolver
+}
+
+func (r *instanceReferenceResolver) ResolveReferences(ctx context.Context, mg resource.Managed) error {
+	if err := r.resolver.ResolveReferences(ctx, mg); err != nil {
+		return err
+	}
+	cr, ok := mg.(*v1alpha1.DBProxy)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	existing := cr.DeepCopyObject()
+	rsp, err := reference.NewAPIResolver(r.kube, cr).Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(cr.Spec.ForProvider.DBInstanceIdentifier),
+		Reference:    cr.Spec.ForProvider.DBInstanceIdentifierRef,
+		Selector:     cr.Spec.ForProvider.DBInstanceIdentifierSelector,
+		To:           reference.To{Managed: &v1beta1.RDSInstance{}, List: &v1beta1.RDSInstanceList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, errResolveInstanceField)
+	}
+	cr.Spec.ForProvider.DBInstanceIdentifier = reference.ToPtrValue(rsp.ResolvedValue)
+	cr.Spec.ForProvider.DBInstanceIdentifierRef = rsp.ResolvedReference
+	if cmp.Equal(existing, cr) {
+		return nil
+	}
+	return errors.Wrap(r.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) dbproxy.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.DBProxy)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client dbproxy.Client
+}
+
+func (e *external) describe(ctx context.Context, cr *v1alpha1.DBProxy) (*awsrds.DBProxy, error) {
+	rsp, err := e.client.DescribeDBProxiesRequest(&awsrds.DescribeDBProxiesInput{
+		DBProxyName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// in a successful response, there should be one and only one object
+	if len(rsp.DBProxies) != 1 {
+		return nil, errors.New(errNotOne)
+	}
+	return &rsp.DBProxies[0], nil
+}
+
+// diffTargets returns the DB instances that have to be registered and
+// deregistered so that the desired instance is the target of the proxy.
+func (e *external) diffTargets(ctx context.Context, cr *v1alpha1.DBProxy) ([]string, []string, error) {
+	if cr.Spec.ForProvider.DBInstanceIdentifier == nil {
+		return nil, nil, nil
+	}
+	rsp, err := e.client.DescribeDBProxyTargetsRequest(&awsrds.DescribeDBProxyTargetsInput{
+		DBProxyName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return nil, nil, awsclient.Wrap(err, errDescribeTargets)
+	}
+	register, deregister := dbproxy.DiffTargets(cr.Spec.ForProvider.DBInstanceIdentifier, rsp.Targets)
+	return register, deregister, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.DBProxy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	proxy, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(dbproxy.IsNotFound, err), errDescribe)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	dbproxy.LateInitialize(&cr.Spec.ForProvider, *proxy)
+
+	cr.Status.AtProvider = dbproxy.GenerateObservation(*proxy)
+	switch proxy.Status {
+	case awsrds.DBProxyStatusAvailable:
+		cr.Status.SetConditions(xpv1.Available())
+	case awsrds.DBProxyStatusCreating:
+		cr.Status.SetConditions(xpv1.Creating())
+	case awsrds.DBProxyStatusDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+
+	// The proxy can only be modified and its targets can only be changed
+	// while it is available.
+	upToDate := true
+	if proxy.Status == awsrds.DBProxyStatusAvailable {
+		register, deregister, err := e.diffTargets(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		upToDate = dbproxy.IsUpToDate(cr.Spec.ForProvider, *proxy) && len(register) == 0 && len(deregister) == 0
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ConnectionDetails: managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretEndpointKey: []byte(aws.StringValue(proxy.Endpoint)),
+		},
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.DBProxy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+	// The DB instance is registered by the first update once the proxy is
+	// available.
+	_, err := e.client.CreateDBProxyRequest(dbproxy.GenerateCreateDBProxyInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.DBProxy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	proxy, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+	if !dbproxy.IsUpToDate(cr.Spec.ForProvider, *proxy) {
+		if _, err := e.client.ModifyDBProxyRequest(dbproxy.GenerateModifyDBProxyInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModify)
+		}
+	}
+
+	register, deregister, err := e.diffTargets(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if len(deregister) != 0 {
+		_, err := e.client.DeregisterDBProxyTargetsRequest(&awsrds.DeregisterDBProxyTargetsInput{
+			DBProxyName:           aws.String(meta.GetExternalName(cr)),
+			DBInstanceIdentifiers: deregister,
+		}).Send(ctx)
+		if err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeregisterTargets)
+		}
+	}
+	if len(register) != 0 {
+		_, err := e.client.RegisterDBProxyTargetsRequest(&awsrds.RegisterDBProxyTargetsInput{
+			DBProxyName:           aws.String(meta.GetExternalName(cr)),
+			DBInstanceIdentifiers: register,
+		}).Send(ctx)
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errRegisterTargets)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.DBProxy)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.Status == string(awsrds.DBProxyStatusDeleting) {
+		return nil
+	}
+	_, err := e.client.DeleteDBProxyRequest(&awsrds.DeleteDBProxyInput{
+		DBProxyName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return awsclient.Wrap(resource.Ignore(dbproxy.IsNotFound, err), errDelete)
+}
@@ -0,0 +1,503 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package dbproxy
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/dbproxy"
+	"github.com/crossplane/provider-aws/pkg/clients/dbproxy/fake"
+)
+
+var (
+	proxyName     = "some-proxy"
+	proxyARN      = "arn:aws:rds:us-east-1:123456789012:db-proxy:prx-123"
+	proxyEndpoint = "some-proxy.proxy-abc.us-east-1.rds.amazonaws.com"
+	instanceID    = "some-instance"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client dbproxy.Client
+	cr     resource.Managed
+}
+
+type proxyModifier func(*v1alpha1.DBProxy)
+
+func withExternalName(n string) proxyModifier {
+	return func(r *v1alpha1.DBProxy) { meta.SetExternalName(r, n) }
+}
+
+func withConditions(c ...xpv1.Condition) proxyModifier {
+	return func(r *v1alpha1.DBProxy) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withInstance(id string) proxyModifier {
+	return func(r *v1alpha1.DBProxy) { r.Spec.ForProvider.DBInstanceIdentifier = &id }
+}
+
+func withIdleClientTimeout(t int64) proxyModifier {
+	return func(r *v1alpha1.DBProxy) { r.Spec.ForProvider.IdleClientTimeout = &t }
+}
+
+func withObservation(o v1alpha1.DBProxyObservation) proxyModifier {
+	return func(r *v1alpha1.DBProxy) { r.Status.AtProvider = o }
+}
+
+func dbProxy(m ...proxyModifier) *v1alpha1.DBProxy {
+	cr := &v1alpha1.DBProxy{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeRequest(proxy *awsrds.DBProxy, err error) func(*awsrds.DescribeDBProxiesInput) awsrds.DescribeDBProxiesRequest {
+	return func(_ *awsrds.DescribeDBProxiesInput) awsrds.DescribeDBProxiesRequest {
+		out := &awsrds.DescribeDBProxiesOutput{}
+		if proxy != nil {
+			out.DBProxies = []awsrds.DBProxy{*proxy}
+		}
+		return awsrds.DescribeDBProxiesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out, Error: err},
+		}
+	}
+}
+
+func describeTargetsRequest(ids ...string) func(*awsrds.DescribeDBProxyTargetsInput) awsrds.DescribeDBProxyTargetsRequest {
+	return func(_ *awsrds.DescribeDBProxyTargetsInput) awsrds.DescribeDBProxyTargetsRequest {
+		out := &awsrds.DescribeDBProxyTargetsOutput{}
+		for _, id := range ids {
+			out.Targets = append(out.Targets, awsrds.DBProxyTarget{Type: awsrds.TargetTypeRdsInstance, RdsResourceId: aws.String(id)})
+		}
+		return awsrds.DescribeDBProxyTargetsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	available := &awsrds.DBProxy{
+		DBProxyArn: aws.String(proxyARN),
+		Endpoint:   aws.String(proxyEndpoint),
+		Status:     awsrds.DBProxyStatusAvailable,
+	}
+	details := managed.ConnectionDetails{xpv1.ResourceCredentialsSecretEndpointKey: []byte(proxyEndpoint)}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AvailableAndUpToDate": {
+			args: args{
+				client: &fake.MockDBProxyClient{
+					MockDescribe:        describeRequest(available, nil),
+					MockDescribeTargets: describeTargetsRequest(instanceID),
+				},
+				cr: dbProxy(withExternalName(proxyName), withInstance(instanceID)),
+			},
+			want: want{
+				cr: dbProxy(withExternalName(proxyName), withInstance(instanceID),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.DBProxyObservation{
+						DBProxyARN: proxyARN,
+						Endpoint:   proxyEndpoint,
+						Status:     string(awsrds.DBProxyStatusAvailable),
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: details,
+				},
+			},
+		},
+		"TargetNotRegistered": {
+			args: args{
+				client: &fake.MockDBProxyClient{
+					MockDescribe:        describeRequest(available, nil),
+					MockDescribeTargets: describeTargetsRequest(),
+				},
+				cr: dbProxy(withExternalName(proxyName), withInstance(instanceID)),
+			},
+			want: want{
+				cr: dbProxy(withExternalName(proxyName), withInstance(instanceID),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.DBProxyObservation{
+						DBProxyARN: proxyARN,
+						Endpoint:   proxyEndpoint,
+						Status:     string(awsrds.DBProxyStatusAvailable),
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: details,
+				},
+			},
+		},
+		"Creating": {
+			args: args{
+				client: &fake.MockDBProxyClient{
+					MockDescribe: describeRequest(&awsrds.DBProxy{
+						DBProxyArn: aws.String(proxyARN),
+						Status:     awsrds.DBProxyStatusCreating,
+					}, nil),
+				},
+				cr: dbProxy(withExternalName(proxyName), withInstance(instanceID)),
+			},
+			want: want{
+				cr: dbProxy(withExternalName(proxyName), withInstance(instanceID),
+					withConditions(xpv1.Creating()),
+					withObservation(v1alpha1.DBProxyObservation{
+						DBProxyARN: proxyARN,
+						Status:     string(awsrds.DBProxyStatusCreating),
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{xpv1.ResourceCredentialsSecretEndpointKey: []byte("")},
+				},
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				client: &fake.MockDBProxyClient{
+					MockDescribe: describeRequest(&awsrds.DBProxy{
+						DBProxyArn:        aws.String(proxyARN),
+						Endpoint:          aws.String(proxyEndpoint),
+						Status:            awsrds.DBProxyStatusAvailable,
+						IdleClientTimeout: aws.Int64(1800),
+					}, nil),
+				},
+				cr: dbProxy(withExternalName(proxyName)),
+			},
+			want: want{
+				cr: dbProxy(withExternalName(proxyName), withIdleClientTimeout(1800),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.DBProxyObservation{
+						DBProxyARN: proxyARN,
+						Endpoint:   proxyEndpoint,
+						Status:     string(awsrds.DBProxyStatusAvailable),
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       details,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockDBProxyClient{
+					MockDescribe: describeRequest(nil, awserr.New(awsrds.ErrCodeDBProxyNotFoundFault, "", nil)),
+				},
+				cr: dbProxy(withExternalName(proxyName)),
+			},
+			want: want{
+				cr: dbProxy(withExternalName(proxyName)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockDBProxyClient{
+					MockDescribe: describeRequest(nil, errBoom),
+				},
+				cr: dbProxy(withExternalName(proxyName)),
+			},
+			want: want{
+				cr:  dbProxy(withExternalName(proxyName)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDBProxyClient{
+					MockCreate: func(_ *awsrds.CreateDBProxyInput) awsrds.CreateDBProxyRequest {
+						return awsrds.CreateDBProxyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.CreateDBProxyOutput{}},
+						}
+					},
+				},
+				cr: dbProxy(withExternalName(proxyName)),
+			},
+			want: want{
+				cr: dbProxy(withExternalName(proxyName), withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				client: &fake.MockDBProxyClient{
+					MockCreate: func(_ *awsrds.CreateDBProxyInput) awsrds.CreateDBProxyRequest {
+						return awsrds.CreateDBProxyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: dbProxy(withExternalName(proxyName)),
+			},
+			want: want{
+				cr:  dbProxy(withExternalName(proxyName), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		modified   bool
+		register   []string
+		deregister []string
+		err        error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ModifyIdleClientTimeout": {
+			args: args{
+				client: &fake.MockDBProxyClient{
+					MockDescribe: describeRequest(&awsrds.DBProxy{IdleClientTimeout: aws.Int64(1800)}, nil),
+				},
+				cr: dbProxy(withExternalName(proxyName), withIdleClientTimeout(300)),
+			},
+			want: want{
+				modified: true,
+			},
+		},
+		"ReplaceTarget": {
+			args: args{
+				client: &fake.MockDBProxyClient{
+					MockDescribe:        describeRequest(&awsrds.DBProxy{}, nil),
+					MockDescribeTargets: describeTargetsRequest("old-instance"),
+				},
+				cr: dbProxy(withExternalName(proxyName), withInstance(instanceID)),
+			},
+			want: want{
+				register:   []string{instanceID},
+				deregister: []string{"old-instance"},
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockDBProxyClient{
+					MockDescribe: describeRequest(nil, errBoom),
+				},
+				cr: dbProxy(withExternalName(proxyName)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var modified bool
+			var register, deregister []string
+			mc := tc.client.(*fake.MockDBProxyClient)
+			mc.MockModify = func(_ *awsrds.ModifyDBProxyInput) awsrds.ModifyDBProxyRequest {
+				modified = true
+				return awsrds.ModifyDBProxyRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ModifyDBProxyOutput{}},
+				}
+			}
+			mc.MockRegisterTargets = func(in *awsrds.RegisterDBProxyTargetsInput) awsrds.RegisterDBProxyTargetsRequest {
+				register = in.DBInstanceIdentifiers
+				return awsrds.RegisterDBProxyTargetsRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.RegisterDBProxyTargetsOutput{}},
+				}
+			}
+			mc.MockDeregisterTargets = func(in *awsrds.DeregisterDBProxyTargetsInput) awsrds.DeregisterDBProxyTargetsRequest {
+				deregister = in.DBInstanceIdentifiers
+				return awsrds.DeregisterDBProxyTargetsRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DeregisterDBProxyTargetsOutput{}},
+				}
+			}
+			e := &external{client: mc}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.modified, modified); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.register, register); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deregister, deregister); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDBProxyClient{
+					MockDelete: func(_ *awsrds.DeleteDBProxyInput) awsrds.DeleteDBProxyRequest {
+						return awsrds.DeleteDBProxyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DeleteDBProxyOutput{}},
+						}
+					},
+				},
+				cr: dbProxy(withExternalName(proxyName)),
+			},
+			want: want{
+				cr: dbProxy(withExternalName(proxyName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				client: &fake.MockDBProxyClient{},
+				cr: dbProxy(withExternalName(proxyName),
+					withObservation(v1alpha1.DBProxyObservation{Status: string(awsrds.DBProxyStatusDeleting)})),
+			},
+			want: want{
+				cr: dbProxy(withExternalName(proxyName),
+					withObservation(v1alpha1.DBProxyObservation{Status: string(awsrds.DBProxyStatusDeleting)}),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				client: &fake.MockDBProxyClient{
+					MockDelete: func(_ *awsrds.DeleteDBProxyInput) awsrds.DeleteDBProxyRequest {
+						return awsrds.DeleteDBProxyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awsrds.ErrCodeDBProxyNotFoundFault, "", nil)},
+						}
+					},
+				},
+				cr: dbProxy(withExternalName(proxyName)),
+			},
+			want: want{
+				cr: dbProxy(withExternalName(proxyName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				client: &fake.MockDBProxyClient{
+					MockDelete: func(_ *awsrds.DeleteDBProxyInput) awsrds.DeleteDBProxyRequest {
+						return awsrds.DeleteDBProxyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: dbProxy(withExternalName(proxyName)),
+			},
+			want: want{
+				cr:  dbProxy(withExternalName(proxyName), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
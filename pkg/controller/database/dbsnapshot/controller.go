@@ -0,0 +1,167 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package dbsnapshot
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/dbsnapshot"
+)
+
+const (
+	errUnexpectedObject = "the managed resource is not a DBSnapshot"
+	errDescribe         = "cannot describe DBSnapshot"
+	errCreate           = "cannot create the DBSnapshot"
+	errCopy             = "cannot copy the source snapshot of the DBSnapshot"
+	errDelete           = "cannot delete the DBSnapshot"
+	errNotOne           = "expected exactly one DBSnapshot"
+)
+
+// SetupDBSnapshot adds a controller that reconciles DBSnapshots.
+func SetupDBSnapshot(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclient.Options) error {
+	name := managed.ControllerName(v1alpha1.DBSnapshotGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		For(&v1alpha1.DBSnapshot{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DBSnapshotGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: dbsnapshot.NewClient}, o)),
+			managed.WithTimeout(o.ReconcileTimeout),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) dbsnapshot.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.DBSnapshot)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client dbsnapshot.Client
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.DBSnapshot)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	rsp, err := e.client.DescribeDBSnapshotsRequest(&awsrds.DescribeDBSnapshotsInput{
+		DBSnapshotIdentifier: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(dbsnapshot.IsNotFound, err), errDescribe)
+	}
+	// in a successful response, there should be one and only one object
+	if len(rsp.DBSnapshots) != 1 {
+		return managed.ExternalObservation{}, errors.New(errNotOne)
+	}
+
+	cr.Status.AtProvider = dbsnapshot.GenerateObservation(rsp.DBSnapshots[0])
+	switch cr.Status.AtProvider.Status {
+	case v1alpha1.DBSnapshotStateAvailable:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.DBSnapshotStateCreating, v1alpha1.DBSnapshotStateCopying:
+		cr.Status.SetConditions(xpv1.Creating())
+	case v1alpha1.DBSnapshotStateDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+
+	// A snapshot cannot be changed once it has been taken, so it is always
+	// up to date.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+		ConnectionDetails: managed.ConnectionDetails{
+			v1alpha1.ResourceCredentialsSecretSnapshotARNKey: []byte(cr.Status.AtProvider.DBSnapshotARN),
+		},
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.DBSnapshot)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	if err := dbsnapshot.ValidateSource(cr.Spec.ForProvider); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	cr.SetConditions(xpv1.Creating())
+	if dbsnapshot.IsCopy(cr.Spec.ForProvider) {
+		_, err := e.client.CopyDBSnapshotRequest(dbsnapshot.GenerateCopyDBSnapshotInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCopy)
+	}
+	_, err := e.client.CreateDBSnapshotRequest(dbsnapshot.GenerateCreateDBSnapshotInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.DBSnapshot)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.Status == v1alpha1.DBSnapshotStateDeleting {
+		return nil
+	}
+	_, err := e.client.DeleteDBSnapshotRequest(&awsrds.DeleteDBSnapshotInput{
+		DBSnapshotIdentifier: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return awsclient.Wrap(resource.Ignore(dbsnapshot.IsNotFound, err), errDelete)
+}
@@ -0,0 +1,391 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package dbsnapshot
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/dbsnapshot"
+	"github.com/crossplane/provider-aws/pkg/clients/dbsnapshot/fake"
+)
+
+var (
+	snapshotName = "some-snapshot"
+	snapshotARN  = "arn:aws:rds:eu-west-1:123456789012:snapshot:some-snapshot"
+	sourceARN    = "arn:aws:rds:us-east-1:123456789012:snapshot:source-snapshot"
+	instanceName = "some-instance"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client dbsnapshot.Client
+	cr     resource.Managed
+}
+
+type snapshotModifier func(*v1alpha1.DBSnapshot)
+
+func withExternalName(n string) snapshotModifier {
+	return func(r *v1alpha1.DBSnapshot) { meta.SetExternalName(r, n) }
+}
+
+func withConditions(c ...xpv1.Condition) snapshotModifier {
+	return func(r *v1alpha1.DBSnapshot) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDBInstanceIdentifier(id string) snapshotModifier {
+	return func(r *v1alpha1.DBSnapshot) { r.Spec.ForProvider.DBInstanceIdentifier = aws.String(id) }
+}
+
+func withSource(id, region string) snapshotModifier {
+	return func(r *v1alpha1.DBSnapshot) {
+		r.Spec.ForProvider.SourceDBSnapshotIdentifier = aws.String(id)
+		r.Spec.ForProvider.SourceRegion = aws.String(region)
+	}
+}
+
+func withObservation(o v1alpha1.DBSnapshotObservation) snapshotModifier {
+	return func(r *v1alpha1.DBSnapshot) { r.Status.AtProvider = o }
+}
+
+func snapshot(m ...snapshotModifier) *v1alpha1.DBSnapshot {
+	cr := &v1alpha1.DBSnapshot{}
+	cr.Spec.ForProvider.Region = "eu-west-1"
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeRequest(s *awsrds.DBSnapshot, err error) func(*awsrds.DescribeDBSnapshotsInput) awsrds.DescribeDBSnapshotsRequest {
+	return func(_ *awsrds.DescribeDBSnapshotsInput) awsrds.DescribeDBSnapshotsRequest {
+		out := &awsrds.DescribeDBSnapshotsOutput{}
+		if s != nil {
+			out.DBSnapshots = []awsrds.DBSnapshot{*s}
+		}
+		return awsrds.DescribeDBSnapshotsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out, Error: err},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				client: &fake.MockDBSnapshotClient{
+					MockDescribe: describeRequest(&awsrds.DBSnapshot{
+						DBSnapshotArn:   aws.String(snapshotARN),
+						Status:          aws.String(v1alpha1.DBSnapshotStateAvailable),
+						PercentProgress: aws.Int64(100),
+					}, nil),
+				},
+				cr: snapshot(withExternalName(snapshotName)),
+			},
+			want: want{
+				cr: snapshot(withExternalName(snapshotName),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.DBSnapshotObservation{
+						DBSnapshotARN:   snapshotARN,
+						Status:          v1alpha1.DBSnapshotStateAvailable,
+						PercentProgress: 100,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1alpha1.ResourceCredentialsSecretSnapshotARNKey: []byte(snapshotARN),
+					},
+				},
+			},
+		},
+		"Copying": {
+			args: args{
+				client: &fake.MockDBSnapshotClient{
+					MockDescribe: describeRequest(&awsrds.DBSnapshot{
+						DBSnapshotArn:   aws.String(snapshotARN),
+						Status:          aws.String(v1alpha1.DBSnapshotStateCopying),
+						PercentProgress: aws.Int64(40),
+					}, nil),
+				},
+				cr: snapshot(withExternalName(snapshotName)),
+			},
+			want: want{
+				cr: snapshot(withExternalName(snapshotName),
+					withConditions(xpv1.Creating()),
+					withObservation(v1alpha1.DBSnapshotObservation{
+						DBSnapshotARN:   snapshotARN,
+						Status:          v1alpha1.DBSnapshotStateCopying,
+						PercentProgress: 40,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1alpha1.ResourceCredentialsSecretSnapshotARNKey: []byte(snapshotARN),
+					},
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockDBSnapshotClient{
+					MockDescribe: describeRequest(nil, awserr.New(awsrds.ErrCodeDBSnapshotNotFoundFault, "", nil)),
+				},
+				cr: snapshot(withExternalName(snapshotName)),
+			},
+			want: want{
+				cr: snapshot(withExternalName(snapshotName)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockDBSnapshotClient{
+					MockDescribe: describeRequest(nil, errBoom),
+				},
+				cr: snapshot(withExternalName(snapshotName)),
+			},
+			want: want{
+				cr:  snapshot(withExternalName(snapshotName)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr   resource.Managed
+		copy *awsrds.CopyDBSnapshotInput
+		err  error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SnapshotOfInstance": {
+			args: args{
+				client: &fake.MockDBSnapshotClient{
+					MockCreate: func(in *awsrds.CreateDBSnapshotInput) awsrds.CreateDBSnapshotRequest {
+						return awsrds.CreateDBSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.CreateDBSnapshotOutput{}},
+						}
+					},
+				},
+				cr: snapshot(withExternalName(snapshotName), withDBInstanceIdentifier(instanceName)),
+			},
+			want: want{
+				cr: snapshot(withExternalName(snapshotName), withDBInstanceIdentifier(instanceName), withConditions(xpv1.Creating())),
+			},
+		},
+		"CopyAcrossRegions": {
+			args: args{
+				client: &fake.MockDBSnapshotClient{},
+				cr:     snapshot(withExternalName(snapshotName), withSource(sourceARN, "us-east-1")),
+			},
+			want: want{
+				cr: snapshot(withExternalName(snapshotName), withSource(sourceARN, "us-east-1"), withConditions(xpv1.Creating())),
+				copy: &awsrds.CopyDBSnapshotInput{
+					TargetDBSnapshotIdentifier: aws.String(snapshotName),
+					SourceDBSnapshotIdentifier: aws.String(sourceARN),
+					SourceRegion:               aws.String("us-east-1"),
+				},
+			},
+		},
+		"NoSource": {
+			args: args{
+				cr: snapshot(withExternalName(snapshotName)),
+			},
+			want: want{
+				cr:  snapshot(withExternalName(snapshotName)),
+				err: dbsnapshot.ValidateSource(v1alpha1.DBSnapshotParameters{}),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				client: &fake.MockDBSnapshotClient{
+					MockCreate: func(_ *awsrds.CreateDBSnapshotInput) awsrds.CreateDBSnapshotRequest {
+						return awsrds.CreateDBSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: snapshot(withExternalName(snapshotName), withDBInstanceIdentifier(instanceName)),
+			},
+			want: want{
+				cr:  snapshot(withExternalName(snapshotName), withDBInstanceIdentifier(instanceName), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awsrds.CopyDBSnapshotInput
+			mc, _ := tc.client.(*fake.MockDBSnapshotClient)
+			if mc != nil {
+				mc.MockCopy = func(in *awsrds.CopyDBSnapshotInput) awsrds.CopyDBSnapshotRequest {
+					input = in
+					return awsrds.CopyDBSnapshotRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.CopyDBSnapshotOutput{}},
+					}
+				}
+			}
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.copy, input); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDBSnapshotClient{
+					MockDelete: func(_ *awsrds.DeleteDBSnapshotInput) awsrds.DeleteDBSnapshotRequest {
+						return awsrds.DeleteDBSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DeleteDBSnapshotOutput{}},
+						}
+					},
+				},
+				cr: snapshot(withExternalName(snapshotName)),
+			},
+			want: want{
+				cr: snapshot(withExternalName(snapshotName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				client: &fake.MockDBSnapshotClient{},
+				cr: snapshot(withExternalName(snapshotName),
+					withObservation(v1alpha1.DBSnapshotObservation{Status: v1alpha1.DBSnapshotStateDeleting})),
+			},
+			want: want{
+				cr: snapshot(withExternalName(snapshotName), withConditions(xpv1.Deleting()),
+					withObservation(v1alpha1.DBSnapshotObservation{Status: v1alpha1.DBSnapshotStateDeleting})),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				client: &fake.MockDBSnapshotClient{
+					MockDelete: func(_ *awsrds.DeleteDBSnapshotInput) awsrds.DeleteDBSnapshotRequest {
+						return awsrds.DeleteDBSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awsrds.ErrCodeDBSnapshotNotFoundFault, "", nil)},
+						}
+					},
+				},
+				cr: snapshot(withExternalName(snapshotName)),
+			},
+			want: want{
+				cr: snapshot(withExternalName(snapshotName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				client: &fake.MockDBSnapshotClient{
+					MockDelete: func(_ *awsrds.DeleteDBSnapshotInput) awsrds.DeleteDBSnapshotRequest {
+						return awsrds.DeleteDBSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: snapshot(withExternalName(snapshotName)),
+			},
+			want: want{
+				cr:  snapshot(withExternalName(snapshotName), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
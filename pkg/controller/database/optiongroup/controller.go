@@ -0,0 +1,181 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package optiongroup
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/optiongroup"
+)
+
+const (
+	errUnexpectedObject = "the managed resource is not an OptionGroup"
+	errDescribe         = "cannot describe OptionGroup"
+	errCreate           = "cannot create the OptionGroup"
+	errModify           = "cannot modify the options of the OptionGroup"
+	errDelete           = "cannot delete the OptionGroup"
+	errNotOne           = "expected exactly one OptionGroup"
+)
+
+// SetupOptionGroup adds a controller that reconciles OptionGroups.
+func SetupOptionGroup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclient.Options) error {
+	name := managed.ControllerName(v1alpha1.OptionGroupGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		For(&v1alpha1.OptionGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.OptionGroupGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: optiongroup.NewClient}, o)),
+			managed.WithConnectionPublishers(),
+			managed.WithTimeout(o.ReconcileTimeout),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) optiongroup.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.OptionGroup)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client optiongroup.Client
+}
+
+func (e *external) describe(ctx context.Context, cr *v1alpha1.OptionGroup) (*awsrds.OptionGroup, error) {
+	rsp, err := e.client.DescribeOptionGroupsRequest(&awsrds.DescribeOptionGroupsInput{
+		OptionGroupName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// in a successful response, there should be one and only one object
+	if len(rsp.OptionGroupsList) != 1 {
+		return nil, errors.New(errNotOne)
+	}
+	return &rsp.OptionGroupsList[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.OptionGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	og, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(optiongroup.IsNotFound, err), errDescribe)
+	}
+
+	cr.Status.AtProvider = optiongroup.GenerateObservation(*og)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: optiongroup.IsUpToDate(cr.Spec.ForProvider, *og),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.OptionGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+	// Options cannot be given on creation. They are added by the first
+	// update.
+	_, err := e.client.CreateOptionGroupRequest(&awsrds.CreateOptionGroupInput{
+		OptionGroupName:        aws.String(meta.GetExternalName(cr)),
+		OptionGroupDescription: aws.String(cr.Spec.ForProvider.OptionGroupDescription),
+		EngineName:             aws.String(cr.Spec.ForProvider.EngineName),
+		MajorEngineVersion:     aws.String(cr.Spec.ForProvider.MajorEngineVersion),
+	}).Send(ctx)
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.OptionGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	// The status only lists the names of the options, so the option group
+	// has to be described again to diff their configuration.
+	og, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+	include, remove := optiongroup.DiffOptions(cr.Spec.ForProvider, *og)
+	if len(include) == 0 && len(remove) == 0 {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.client.ModifyOptionGroupRequest(&awsrds.ModifyOptionGroupInput{
+		OptionGroupName:  aws.String(meta.GetExternalName(cr)),
+		OptionsToInclude: include,
+		OptionsToRemove:  remove,
+		ApplyImmediately: cr.Spec.ForProvider.ApplyImmediately,
+	}).Send(ctx)
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errModify)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.OptionGroup)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteOptionGroupRequest(&awsrds.DeleteOptionGroupInput{
+		OptionGroupName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return awsclient.Wrap(resource.Ignore(optiongroup.IsNotFound, err), errDelete)
+}
@@ -0,0 +1,408 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package optiongroup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/optiongroup"
+	"github.com/crossplane/provider-aws/pkg/clients/optiongroup/fake"
+)
+
+var (
+	ogName     = "some-option-group"
+	ogARN      = "arn:aws:rds:us-east-1:123456789012:og:some-option-group"
+	optionName = "S3_INTEGRATION"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client optiongroup.Client
+	cr     resource.Managed
+}
+
+type ogModifier func(*v1alpha1.OptionGroup)
+
+func withExternalName(n string) ogModifier {
+	return func(r *v1alpha1.OptionGroup) { meta.SetExternalName(r, n) }
+}
+
+func withConditions(c ...xpv1.Condition) ogModifier {
+	return func(r *v1alpha1.OptionGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withOptions(o ...v1alpha1.OptionConfiguration) ogModifier {
+	return func(r *v1alpha1.OptionGroup) { r.Spec.ForProvider.Options = o }
+}
+
+func withObservation(o v1alpha1.OptionGroupObservation) ogModifier {
+	return func(r *v1alpha1.OptionGroup) { r.Status.AtProvider = o }
+}
+
+func optionGroup(m ...ogModifier) *v1alpha1.OptionGroup {
+	cr := &v1alpha1.OptionGroup{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeRequest(og *awsrds.OptionGroup, err error) func(*awsrds.DescribeOptionGroupsInput) awsrds.DescribeOptionGroupsRequest {
+	return func(_ *awsrds.DescribeOptionGroupsInput) awsrds.DescribeOptionGroupsRequest {
+		out := &awsrds.DescribeOptionGroupsOutput{}
+		if og != nil {
+			out.OptionGroupsList = []awsrds.OptionGroup{*og}
+		}
+		return awsrds.DescribeOptionGroupsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out, Error: err},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AvailableAndUpToDate": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockDescribe: describeRequest(&awsrds.OptionGroup{
+						OptionGroupArn: aws.String(ogARN),
+						Options:        []awsrds.Option{{OptionName: aws.String(optionName)}},
+					}, nil),
+				},
+				cr: optionGroup(withExternalName(ogName),
+					withOptions(v1alpha1.OptionConfiguration{OptionName: optionName})),
+			},
+			want: want{
+				cr: optionGroup(withExternalName(ogName),
+					withOptions(v1alpha1.OptionConfiguration{OptionName: optionName}),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.OptionGroupObservation{
+						OptionGroupARN: ogARN,
+						Options:        []string{optionName},
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"MissingOption": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockDescribe: describeRequest(&awsrds.OptionGroup{OptionGroupArn: aws.String(ogARN)}, nil),
+				},
+				cr: optionGroup(withExternalName(ogName),
+					withOptions(v1alpha1.OptionConfiguration{OptionName: optionName})),
+			},
+			want: want{
+				cr: optionGroup(withExternalName(ogName),
+					withOptions(v1alpha1.OptionConfiguration{OptionName: optionName}),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.OptionGroupObservation{OptionGroupARN: ogARN})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockDescribe: describeRequest(nil, awserr.New(awsrds.ErrCodeOptionGroupNotFoundFault, "", nil)),
+				},
+				cr: optionGroup(withExternalName(ogName)),
+			},
+			want: want{
+				cr: optionGroup(withExternalName(ogName)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockDescribe: describeRequest(nil, errBoom),
+				},
+				cr: optionGroup(withExternalName(ogName)),
+			},
+			want: want{
+				cr:  optionGroup(withExternalName(ogName)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockCreate: func(_ *awsrds.CreateOptionGroupInput) awsrds.CreateOptionGroupRequest {
+						return awsrds.CreateOptionGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.CreateOptionGroupOutput{}},
+						}
+					},
+				},
+				cr: optionGroup(withExternalName(ogName)),
+			},
+			want: want{
+				cr: optionGroup(withExternalName(ogName), withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockCreate: func(_ *awsrds.CreateOptionGroupInput) awsrds.CreateOptionGroupRequest {
+						return awsrds.CreateOptionGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: optionGroup(withExternalName(ogName)),
+			},
+			want: want{
+				cr:  optionGroup(withExternalName(ogName), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		include []awsrds.OptionConfiguration
+		remove  []string
+		err     error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AddAndRemoveOptions": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockDescribe: describeRequest(&awsrds.OptionGroup{
+						Options: []awsrds.Option{{OptionName: aws.String("OEM")}},
+					}, nil),
+				},
+				cr: optionGroup(withExternalName(ogName),
+					withOptions(v1alpha1.OptionConfiguration{OptionName: optionName})),
+			},
+			want: want{
+				include: []awsrds.OptionConfiguration{{OptionName: aws.String(optionName)}},
+				remove:  []string{"OEM"},
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockDescribe: describeRequest(nil, errBoom),
+				},
+				cr: optionGroup(withExternalName(ogName)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"ModifyFail": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockDescribe: describeRequest(&awsrds.OptionGroup{}, nil),
+				},
+				cr: optionGroup(withExternalName(ogName),
+					withOptions(v1alpha1.OptionConfiguration{OptionName: optionName})),
+			},
+			want: want{
+				include: []awsrds.OptionConfiguration{{OptionName: aws.String(optionName)}},
+				err:     awsclient.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awsrds.ModifyOptionGroupInput
+			mc := tc.client.(*fake.MockOptionGroupClient)
+			mc.MockModify = func(in *awsrds.ModifyOptionGroupInput) awsrds.ModifyOptionGroupRequest {
+				input = in
+				var err error
+				if tc.want.err != nil {
+					err = errBoom
+				}
+				return awsrds.ModifyOptionGroupRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ModifyOptionGroupOutput{}, Error: err},
+				}
+			}
+			e := &external{client: mc}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if input == nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.include, input.OptionsToInclude); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, input.OptionsToRemove); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockDelete: func(_ *awsrds.DeleteOptionGroupInput) awsrds.DeleteOptionGroupRequest {
+						return awsrds.DeleteOptionGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DeleteOptionGroupOutput{}},
+						}
+					},
+				},
+				cr: optionGroup(withExternalName(ogName)),
+			},
+			want: want{
+				cr: optionGroup(withExternalName(ogName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockDelete: func(_ *awsrds.DeleteOptionGroupInput) awsrds.DeleteOptionGroupRequest {
+						return awsrds.DeleteOptionGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awsrds.ErrCodeOptionGroupNotFoundFault, "", nil)},
+						}
+					},
+				},
+				cr: optionGroup(withExternalName(ogName)),
+			},
+			want: want{
+				cr: optionGroup(withExternalName(ogName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockDelete: func(_ *awsrds.DeleteOptionGroupInput) awsrds.DeleteOptionGroupRequest {
+						return awsrds.DeleteOptionGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: optionGroup(withExternalName(ogName)),
+			},
+			want: want{
+				cr:  optionGroup(withExternalName(ogName), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,274 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rdsclone
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/rdsclone"
+)
+
+const (
+	errUnexpectedObject   = "the managed resource is not an RDSClone"
+	errKubeUpdateFailed   = "cannot update RDSClone custom resource"
+	errDescribe           = "cannot describe the DB instance of the RDSClone"
+	errDescribeSource     = "cannot describe the source DB instance of the RDSClone"
+	errDescribeSnapshot   = "cannot describe the snapshot of the RDSClone"
+	errSnapshotNotFound   = "the snapshot of the RDSClone does not exist"
+	errCreateSnapshot     = "cannot take the snapshot of the RDSClone"
+	errRestore            = "cannot restore the snapshot of the RDSClone"
+	errDelete             = "cannot delete the DB instance of the RDSClone"
+	errDeleteSnapshot     = "cannot delete the snapshot of the RDSClone"
+	errNotOneSnapshot     = "expected exactly one DB snapshot"
+	errNotOneInstance     = "expected exactly one DB instance"
+	errResolveSourceField = "spec.forProvider.sourceDBInstanceIdentifier"
+)
+
+// SetupRDSClone adds a controller that reconciles RDSClones.
+func SetupRDSClone(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclient.Options) error {
+	name := managed.ControllerName(v1alpha1.RDSCloneGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		For(&v1alpha1.RDSClone{}).
+		Complete(newReconciler(mgr, l, o))
+}
+
+// newReconciler returns the reconciler of RDSClones. The supplied options are
+// applied last, so that tests can override the defaults.
+func newReconciler(mgr ctrl.Manager, l logging.Logger, o awsclient.Options, opts ...managed.ReconcilerOption) *managed.Reconciler {
+	name := managed.ControllerName(v1alpha1.RDSCloneGroupKind)
+	return managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.RDSCloneGroupVersionKind),
+		append([]managed.ReconcilerOption{
+			managed.WithExternalConnecter(awsclient.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: rdsclone.NewClient}, o)),
+			managed.WithReferenceResolver(&sourceReferenceResolver{
+				kube:     mgr.GetClient(),
+				resolver: managed.NewAPISimpleReferenceResolver(mgr.GetClient()),
+			}),
+			managed.WithTimeout(o.ReconcileTimeout),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		}, opts...)...)
+}
+
+// sourceReferenceResolver resolves the source RDSInstance reference of an
+// RDSClone in addition to the references resolved by the wrapped resolver.
+// The RDSInstance API imports the one of RDSClone, so the reference cannot be
+// resolved by the RDSClone itself without an import cycle.
+type sourceReferenceResolver struct {
+	kube     client.Client
+	resolver managed.ReferenceResolver
+}
+
+func (r *sourceReferenceResolver) ResolveReferences(ctx context.Context, mg resource.Managed) error {
+	if err := r.resolver.ResolveReferences(ctx, mg); err != nil {
+		return err
+	}
+	cr, ok := mg.(*v1alpha1.RDSClone)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	existing := cr.DeepCopyObject()
+	rsp, err := reference.NewAPIResolver(r.kube, cr).Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(cr.Spec.ForProvider.SourceDBInstanceIdentifier),
+		Reference:    cr.Spec.ForProvider.SourceDBInstanceIdentifierRef,
+		Selector:     cr.Spec.ForProvider.SourceDBInstanceIdentifierSelector,
+		To:           reference.To{Managed: &v1beta1.RDSInstance{}, List: &v1beta1.RDSInstanceList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, errResolveSourceField)
+	}
+	cr.Spec.ForProvider.SourceDBInstanceIdentifier = reference.ToPtrValue(rsp.ResolvedValue)
+	cr.Spec.ForProvider.SourceDBInstanceIdentifierRef = rsp.ResolvedReference
+	if cmp.Equal(existing, cr) {
+		return nil
+	}
+	return errors.Wrap(r.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) rdsclone.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.RDSClone)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client rdsclone.Client
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.RDSClone)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	rsp, err := e.client.DescribeDBInstancesRequest(&awsrds.DescribeDBInstancesInput{
+		DBInstanceIdentifier: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if rdsclone.IsInstanceNotFound(err) {
+		// The reconciler deletes only clones that exist, so the snapshot
+		// taken for the clone is deleted once the clone is gone, unless the
+		// clone is orphaned.
+		if meta.WasDeleted(cr) && cr.GetDeletionPolicy() != xpv1.DeletionOrphan && rdsclone.TakesSnapshot(cr.Spec.ForProvider) {
+			_, err := e.client.DeleteDBSnapshotRequest(&awsrds.DeleteDBSnapshotInput{
+				DBSnapshotIdentifier: aws.String(meta.GetExternalName(cr)),
+			}).Send(ctx)
+			return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(rdsclone.IsSnapshotNotFound, err), errDeleteSnapshot)
+		}
+		return managed.ExternalObservation{}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribe)
+	}
+	// in a successful response, there should be one and only one object
+	if len(rsp.DBInstances) != 1 {
+		return managed.ExternalObservation{}, errors.New(errNotOneInstance)
+	}
+
+	db := rsp.DBInstances[0]
+	cr.Status.AtProvider = rdsclone.GenerateObservation(rdsclone.SnapshotIdentifier(meta.GetExternalName(cr), cr.Spec.ForProvider), db)
+	switch cr.Status.AtProvider.DBInstanceStatus {
+	case v1beta1.RDSInstanceStateAvailable:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1beta1.RDSInstanceStateCreating:
+		cr.Status.SetConditions(xpv1.Creating())
+	case v1beta1.RDSInstanceStateDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+
+	// A clone is never changed once it has been restored, so it is always up
+	// to date.
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: rdsclone.GetConnectionDetails(db),
+	}, nil
+}
+
+// Create restores the clone once its snapshot is available. It takes the
+// snapshot first unless an existing one is given, so it may take several
+// reconciles until the clone is restored.
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.RDSClone)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	if err := rdsclone.ValidateSource(cr.Spec.ForProvider); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	cr.SetConditions(xpv1.Creating())
+	id := meta.GetExternalName(cr)
+	snapshot := rdsclone.SnapshotIdentifier(id, cr.Spec.ForProvider)
+	cr.Status.AtProvider.DBSnapshotIdentifier = snapshot
+	rsp, err := e.client.DescribeDBSnapshotsRequest(&awsrds.DescribeDBSnapshotsInput{DBSnapshotIdentifier: aws.String(snapshot)}).Send(ctx)
+	switch {
+	case rdsclone.IsSnapshotNotFound(err) && rdsclone.TakesSnapshot(cr.Spec.ForProvider):
+		_, err := e.client.CreateDBSnapshotRequest(rdsclone.GenerateCreateDBSnapshotInput(id, cr.Spec.ForProvider)).Send(ctx)
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreateSnapshot)
+	case rdsclone.IsSnapshotNotFound(err):
+		return managed.ExternalCreation{}, errors.New(errSnapshotNotFound)
+	case err != nil:
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errDescribeSnapshot)
+	case len(rsp.DBSnapshots) != 1:
+		return managed.ExternalCreation{}, errors.New(errNotOneSnapshot)
+	}
+	cr.Status.AtProvider.DBSnapshotStatus = aws.StringValue(rsp.DBSnapshots[0].Status)
+	if cr.Status.AtProvider.DBSnapshotStatus != v1alpha1.DBSnapshotStateAvailable {
+		return managed.ExternalCreation{}, nil
+	}
+
+	var source *awsrds.DBInstance
+	if cr.Spec.ForProvider.SourceDBInstanceIdentifier != nil {
+		rsp, err := e.client.DescribeDBInstancesRequest(&awsrds.DescribeDBInstancesInput{
+			DBInstanceIdentifier: cr.Spec.ForProvider.SourceDBInstanceIdentifier,
+		}).Send(ctx)
+		if err != nil {
+			return managed.ExternalCreation{}, awsclient.Wrap(err, errDescribeSource)
+		}
+		if len(rsp.DBInstances) != 1 {
+			return managed.ExternalCreation{}, errors.New(errNotOneInstance)
+		}
+		source = &rsp.DBInstances[0]
+	}
+	_, err = e.client.RestoreDBInstanceFromDBSnapshotRequest(rdsclone.GenerateRestoreDBInstanceFromDBSnapshotInput(id, snapshot, cr.Spec.ForProvider, source)).Send(ctx)
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errRestore)
+}
+
+func (e *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.RDSClone)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.DBInstanceStatus == v1beta1.RDSInstanceStateDeleting {
+		return nil
+	}
+	// Clones are short-lived, so neither a final snapshot nor automated
+	// backups are kept.
+	_, err := e.client.DeleteDBInstanceRequest(&awsrds.DeleteDBInstanceInput{
+		DBInstanceIdentifier:   aws.String(meta.GetExternalName(cr)),
+		SkipFinalSnapshot:      aws.Bool(true),
+		DeleteAutomatedBackups: aws.Bool(true),
+	}).Send(ctx)
+	return awsclient.Wrap(resource.Ignore(rdsclone.IsInstanceNotFound, err), errDelete)
+}
@@ -0,0 +1,541 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rdsclone
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	xpfake "github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/rdsclone"
+	"github.com/crossplane/provider-aws/pkg/clients/rdsclone/fake"
+)
+
+var (
+	cloneName    = "preview-42"
+	sourceName   = "production"
+	snapshotName = "nightly"
+	address      = "preview-42.abc.eu-west-1.rds.amazonaws.com"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client rdsclone.Client
+	cr     resource.Managed
+}
+
+type cloneModifier func(*v1alpha1.RDSClone)
+
+func withExternalName(n string) cloneModifier {
+	return func(r *v1alpha1.RDSClone) { meta.SetExternalName(r, n) }
+}
+
+func withConditions(c ...xpv1.Condition) cloneModifier {
+	return func(r *v1alpha1.RDSClone) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSource(id string) cloneModifier {
+	return func(r *v1alpha1.RDSClone) { r.Spec.ForProvider.SourceDBInstanceIdentifier = aws.String(id) }
+}
+
+func withSnapshot(id string) cloneModifier {
+	return func(r *v1alpha1.RDSClone) { r.Spec.ForProvider.DBSnapshotIdentifier = aws.String(id) }
+}
+
+func withObservation(o v1alpha1.RDSCloneObservation) cloneModifier {
+	return func(r *v1alpha1.RDSClone) { r.Status.AtProvider = o }
+}
+
+func withDeletionTimestamp() cloneModifier {
+	return func(r *v1alpha1.RDSClone) { r.SetDeletionTimestamp(&metav1.Time{}) }
+}
+
+func withDeletionPolicy(p xpv1.DeletionPolicy) cloneModifier {
+	return func(r *v1alpha1.RDSClone) { r.SetDeletionPolicy(p) }
+}
+
+func clone(m ...cloneModifier) *v1alpha1.RDSClone {
+	cr := &v1alpha1.RDSClone{}
+	cr.Spec.ForProvider.Region = "eu-west-1"
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeInstances(dbs map[string]awsrds.DBInstance, err error) func(*awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+	return func(in *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+		out := &awsrds.DescribeDBInstancesOutput{}
+		rerr := err
+		if db, ok := dbs[aws.StringValue(in.DBInstanceIdentifier)]; ok {
+			out.DBInstances = []awsrds.DBInstance{db}
+		} else if rerr == nil {
+			rerr = awserr.New(awsrds.ErrCodeDBInstanceNotFoundFault, "", nil)
+		}
+		return awsrds.DescribeDBInstancesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out, Error: rerr},
+		}
+	}
+}
+
+func describeSnapshot(status string) func(*awsrds.DescribeDBSnapshotsInput) awsrds.DescribeDBSnapshotsRequest {
+	return func(in *awsrds.DescribeDBSnapshotsInput) awsrds.DescribeDBSnapshotsRequest {
+		if status == "" {
+			return awsrds.DescribeDBSnapshotsRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awsrds.ErrCodeDBSnapshotNotFoundFault, "", nil)},
+			}
+		}
+		return awsrds.DescribeDBSnapshotsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBSnapshotsOutput{
+				DBSnapshots: []awsrds.DBSnapshot{{DBSnapshotIdentifier: in.DBSnapshotIdentifier, Status: aws.String(status)}},
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				client: &fake.MockRDSCloneClient{
+					MockDescribeInstances: describeInstances(map[string]awsrds.DBInstance{cloneName: {
+						DBInstanceStatus: aws.String(v1beta1.RDSInstanceStateAvailable),
+						MasterUsername:   aws.String("admin"),
+						Endpoint:         &awsrds.Endpoint{Address: aws.String(address), Port: aws.Int64(5432)},
+					}}, nil),
+				},
+				cr: clone(withExternalName(cloneName), withSource(sourceName)),
+			},
+			want: want{
+				cr: clone(withExternalName(cloneName), withSource(sourceName),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.RDSCloneObservation{
+						DBSnapshotIdentifier: cloneName,
+						DBInstanceStatus:     v1beta1.RDSInstanceStateAvailable,
+						Address:              address,
+						Port:                 5432,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(address),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte("5432"),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte("admin"),
+					},
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockRDSCloneClient{
+					MockDescribeInstances: describeInstances(nil, nil),
+				},
+				cr: clone(withExternalName(cloneName), withSource(sourceName)),
+			},
+			want: want{
+				cr: clone(withExternalName(cloneName), withSource(sourceName)),
+			},
+		},
+		"DeletedRemovesSnapshot": {
+			args: args{
+				client: &fake.MockRDSCloneClient{
+					MockDescribeInstances: describeInstances(nil, nil),
+					MockDeleteSnapshot: func(in *awsrds.DeleteDBSnapshotInput) awsrds.DeleteDBSnapshotRequest {
+						if aws.StringValue(in.DBSnapshotIdentifier) != cloneName {
+							return awsrds.DeleteDBSnapshotRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom}}
+						}
+						return awsrds.DeleteDBSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DeleteDBSnapshotOutput{}},
+						}
+					},
+				},
+				cr: clone(withExternalName(cloneName), withSource(sourceName), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: clone(withExternalName(cloneName), withSource(sourceName), withDeletionTimestamp()),
+			},
+		},
+		"OrphanedKeepsSnapshot": {
+			args: args{
+				client: &fake.MockRDSCloneClient{
+					MockDescribeInstances: describeInstances(nil, nil),
+				},
+				cr: clone(withExternalName(cloneName), withSource(sourceName), withDeletionTimestamp(), withDeletionPolicy(xpv1.DeletionOrphan)),
+			},
+			want: want{
+				cr: clone(withExternalName(cloneName), withSource(sourceName), withDeletionTimestamp(), withDeletionPolicy(xpv1.DeletionOrphan)),
+			},
+		},
+		"DeletedKeepsGivenSnapshot": {
+			args: args{
+				client: &fake.MockRDSCloneClient{
+					MockDescribeInstances: describeInstances(nil, nil),
+				},
+				cr: clone(withExternalName(cloneName), withSnapshot(snapshotName), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: clone(withExternalName(cloneName), withSnapshot(snapshotName), withDeletionTimestamp()),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockRDSCloneClient{
+					MockDescribeInstances: describeInstances(nil, errBoom),
+				},
+				cr: clone(withExternalName(cloneName), withSource(sourceName)),
+			},
+			want: want{
+				cr:  clone(withExternalName(cloneName), withSource(sourceName)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr       resource.Managed
+		snapshot *awsrds.CreateDBSnapshotInput
+		restore  *awsrds.RestoreDBInstanceFromDBSnapshotInput
+		err      error
+	}
+
+	source := awsrds.DBInstance{
+		DBSubnetGroup:     &awsrds.DBSubnetGroup{DBSubnetGroupName: aws.String("private")},
+		VpcSecurityGroups: []awsrds.VpcSecurityGroupMembership{{VpcSecurityGroupId: aws.String("sg-1")}},
+		Endpoint:          &awsrds.Endpoint{Port: aws.Int64(5432)},
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"TakesSnapshot": {
+			args: args{
+				client: &fake.MockRDSCloneClient{
+					MockDescribeSnapshots: describeSnapshot(""),
+				},
+				cr: clone(withExternalName(cloneName), withSource(sourceName)),
+			},
+			want: want{
+				cr: clone(withExternalName(cloneName), withSource(sourceName), withConditions(xpv1.Creating()),
+					withObservation(v1alpha1.RDSCloneObservation{DBSnapshotIdentifier: cloneName})),
+				snapshot: &awsrds.CreateDBSnapshotInput{
+					DBSnapshotIdentifier: aws.String(cloneName),
+					DBInstanceIdentifier: aws.String(sourceName),
+				},
+			},
+		},
+		"WaitsForSnapshot": {
+			args: args{
+				client: &fake.MockRDSCloneClient{
+					MockDescribeSnapshots: describeSnapshot(v1alpha1.DBSnapshotStateCreating),
+				},
+				cr: clone(withExternalName(cloneName), withSource(sourceName)),
+			},
+			want: want{
+				cr: clone(withExternalName(cloneName), withSource(sourceName), withConditions(xpv1.Creating()),
+					withObservation(v1alpha1.RDSCloneObservation{DBSnapshotIdentifier: cloneName, DBSnapshotStatus: v1alpha1.DBSnapshotStateCreating})),
+			},
+		},
+		"RestoresLikeSource": {
+			args: args{
+				client: &fake.MockRDSCloneClient{
+					MockDescribeSnapshots: describeSnapshot(v1alpha1.DBSnapshotStateAvailable),
+					MockDescribeInstances: describeInstances(map[string]awsrds.DBInstance{sourceName: source}, nil),
+				},
+				cr: clone(withExternalName(cloneName), withSource(sourceName)),
+			},
+			want: want{
+				cr: clone(withExternalName(cloneName), withSource(sourceName), withConditions(xpv1.Creating()),
+					withObservation(v1alpha1.RDSCloneObservation{DBSnapshotIdentifier: cloneName, DBSnapshotStatus: v1alpha1.DBSnapshotStateAvailable})),
+				restore: &awsrds.RestoreDBInstanceFromDBSnapshotInput{
+					DBInstanceIdentifier: aws.String(cloneName),
+					DBSnapshotIdentifier: aws.String(cloneName),
+					DBSubnetGroupName:    aws.String("private"),
+					VpcSecurityGroupIds:  []string{"sg-1"},
+					Port:                 aws.Int64(5432),
+				},
+			},
+		},
+		"RestoresGivenSnapshot": {
+			args: args{
+				client: &fake.MockRDSCloneClient{
+					MockDescribeSnapshots: describeSnapshot(v1alpha1.DBSnapshotStateAvailable),
+				},
+				cr: clone(withExternalName(cloneName), withSnapshot(snapshotName)),
+			},
+			want: want{
+				cr: clone(withExternalName(cloneName), withSnapshot(snapshotName), withConditions(xpv1.Creating()),
+					withObservation(v1alpha1.RDSCloneObservation{DBSnapshotIdentifier: snapshotName, DBSnapshotStatus: v1alpha1.DBSnapshotStateAvailable})),
+				restore: &awsrds.RestoreDBInstanceFromDBSnapshotInput{
+					DBInstanceIdentifier: aws.String(cloneName),
+					DBSnapshotIdentifier: aws.String(snapshotName),
+				},
+			},
+		},
+		"GivenSnapshotNotFound": {
+			args: args{
+				client: &fake.MockRDSCloneClient{
+					MockDescribeSnapshots: describeSnapshot(""),
+				},
+				cr: clone(withExternalName(cloneName), withSnapshot(snapshotName)),
+			},
+			want: want{
+				cr: clone(withExternalName(cloneName), withSnapshot(snapshotName), withConditions(xpv1.Creating()),
+					withObservation(v1alpha1.RDSCloneObservation{DBSnapshotIdentifier: snapshotName})),
+				err: errors.New(errSnapshotNotFound),
+			},
+		},
+		"NoSource": {
+			args: args{
+				cr: clone(withExternalName(cloneName)),
+			},
+			want: want{
+				cr:  clone(withExternalName(cloneName)),
+				err: rdsclone.ValidateSource(v1alpha1.RDSCloneParameters{}),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var snapshot *awsrds.CreateDBSnapshotInput
+			var restore *awsrds.RestoreDBInstanceFromDBSnapshotInput
+			mc, _ := tc.client.(*fake.MockRDSCloneClient)
+			if mc != nil {
+				mc.MockCreateSnapshot = func(in *awsrds.CreateDBSnapshotInput) awsrds.CreateDBSnapshotRequest {
+					snapshot = in
+					return awsrds.CreateDBSnapshotRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.CreateDBSnapshotOutput{}},
+					}
+				}
+				mc.MockRestore = func(in *awsrds.RestoreDBInstanceFromDBSnapshotInput) awsrds.RestoreDBInstanceFromDBSnapshotRequest {
+					restore = in
+					return awsrds.RestoreDBInstanceFromDBSnapshotRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.RestoreDBInstanceFromDBSnapshotOutput{}},
+					}
+				}
+			}
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.snapshot, snapshot); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.restore, restore); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr    resource.Managed
+		input *awsrds.DeleteDBInstanceInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockRDSCloneClient{},
+				cr:     clone(withExternalName(cloneName)),
+			},
+			want: want{
+				cr: clone(withExternalName(cloneName), withConditions(xpv1.Deleting())),
+				input: &awsrds.DeleteDBInstanceInput{
+					DBInstanceIdentifier:   aws.String(cloneName),
+					SkipFinalSnapshot:      aws.Bool(true),
+					DeleteAutomatedBackups: aws.Bool(true),
+				},
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				client: &fake.MockRDSCloneClient{},
+				cr: clone(withExternalName(cloneName),
+					withObservation(v1alpha1.RDSCloneObservation{DBInstanceStatus: v1beta1.RDSInstanceStateDeleting})),
+			},
+			want: want{
+				cr: clone(withExternalName(cloneName), withConditions(xpv1.Deleting()),
+					withObservation(v1alpha1.RDSCloneObservation{DBInstanceStatus: v1beta1.RDSInstanceStateDeleting})),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awsrds.DeleteDBInstanceInput
+			tc.client.(*fake.MockRDSCloneClient).MockDeleteInstance = func(in *awsrds.DeleteDBInstanceInput) awsrds.DeleteDBInstanceRequest {
+				input = in
+				return awsrds.DeleteDBInstanceRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DeleteDBInstanceOutput{}},
+				}
+			}
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+// recordingManager is a manager that records events.
+type recordingManager struct {
+	*xpfake.Manager
+}
+
+func (m *recordingManager) GetEventRecorderFor(string) record.EventRecorder {
+	return record.NewFakeRecorder(10)
+}
+
+func TestConnectionSecret(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := v1alpha1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	var published *corev1.Secret
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			switch o := obj.(type) {
+			case *v1alpha1.RDSClone:
+				cr := clone(withExternalName(cloneName), withSource(sourceName))
+				cr.SetName(key.Name)
+				cr.SetFinalizers([]string{"finalizer.managedresource.crossplane.io"})
+				cr.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Namespace: "default", Name: "preview-42-conn"})
+				cr.DeepCopyInto(o)
+				return nil
+			case *corev1.Secret:
+				return kerrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, key.Name)
+			}
+			return errBoom
+		},
+		MockCreate: func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+			if sc, ok := obj.(*corev1.Secret); ok {
+				published = sc
+			}
+			return nil
+		},
+		MockUpdate:       test.NewMockUpdateFn(nil),
+		MockStatusUpdate: test.NewMockStatusUpdateFn(nil),
+	}
+	rds := &fake.MockRDSCloneClient{
+		MockDescribeInstances: describeInstances(map[string]awsrds.DBInstance{cloneName: {
+			DBInstanceStatus: aws.String(v1beta1.RDSInstanceStateAvailable),
+			MasterUsername:   aws.String("admin"),
+			Endpoint:         &awsrds.Endpoint{Address: aws.String(address), Port: aws.Int64(5432)},
+		}}, nil),
+	}
+
+	r := newReconciler(&recordingManager{&xpfake.Manager{Client: kube, Scheme: s}}, logging.NewNopLogger(), awsclient.Options{ReconcileTimeout: time.Minute},
+		managed.WithExternalConnecter(managed.ExternalConnectorFn(func(context.Context, resource.Managed) (managed.ExternalClient, error) {
+			return &external{client: rds}, nil
+		})))
+	if _, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: cloneName}}); err != nil {
+		t.Fatalf("Reconcile(...): %s", err)
+	}
+
+	if published == nil {
+		t.Fatal("Reconcile(...): no connection secret was written")
+	}
+	want := map[string][]byte{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(address),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte("5432"),
+		xpv1.ResourceCredentialsSecretUserKey:     []byte("admin"),
+	}
+	if diff := cmp.Diff(want, published.Data); diff != "" {
+		t.Errorf("Reconcile(...): -want secret data, +got:\n%s", diff)
+	}
+}
@@ -18,11 +18,16 @@ package database
 
 import (
 	"context"
+	"fmt"
+	"net"
 	"reflect"
 	"sort"
+	"strconv"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,14 +38,17 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
-	"github.com/crossplane/crossplane-runtime/pkg/password"
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
+	databasev1alpha1 "github.com/crossplane/provider-aws/apis/database/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	rdsv1alpha1 "github.com/crossplane/provider-aws/apis/rds/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/rds"
+	"github.com/crossplane/provider-aws/pkg/clients/secretsmanager"
 )
 
 const (
@@ -49,35 +57,105 @@ const (
 	errCreateFailed            = "cannot create RDS instance"
 	errModifyFailed            = "cannot modify RDS instance"
 	errAddTagsFailed           = "cannot add tags to RDS instance"
+	errRemoveTagsFailed        = "cannot remove tags from RDS instance"
+	errListTagsFailed          = "cannot list tags of RDS instance"
 	errDeleteFailed            = "cannot delete RDS instance"
 	errDescribeFailed          = "cannot describe RDS instance"
+	errDescribeClusterFailed   = "cannot describe the DB cluster of RDS instance"
 	errPatchCreationFailed     = "cannot create a patch object"
 	errUpToDateFailed          = "cannot check whether object is up-to-date"
 	errGetPasswordSecretFailed = "cannot get password secret"
+	errDescribeOrderable       = "cannot list the offerings that match the RDSInstance"
+	errNotOrderable            = "the given combination of engine, engine version, instance class and license model is not available in this region"
+	errMonitoringRoleMissing   = "monitoringRoleArn is required when monitoringInterval is not 0"
+	errMajorVersionUpgrade     = "allowMajorVersionUpgrade must be true to change the major engine version"
+	errSubnetGroupChange       = "allowSubnetGroupChange must be true to move the RDS instance to a different DB subnet group"
+	errReadinessProbeFailed    = "cannot connect to RDS instance endpoint"
+	errDescribeCandidateFailed = "cannot describe upgrade candidate of RDS instance"
+	errDescribeSnapshotFailed  = "cannot describe upgrade snapshot of RDS instance"
+	errCreateSnapshotFailed    = "cannot create upgrade snapshot of RDS instance"
+	errRestoreFailed           = "cannot restore upgrade snapshot of RDS instance"
+	errUpgradeCandidateFailed  = "cannot upgrade the engine version of the upgrade candidate"
+	errDeleteCandidateFailed   = "cannot delete upgrade candidate of RDS instance"
+	errDeleteSnapshotFailed    = "cannot delete upgrade snapshot of RDS instance"
+	errDeletePreviousFailed    = "cannot delete the DB instance the RDS instance was cut over from"
+
+	defaultReadinessProbeTimeout = 5 * time.Second
+
+	reasonUpgradeCutover event.Reason = "UpgradeCutover"
 )
 
 // SetupRDSInstance adds a controller that reconciles RDSInstances.
-func SetupRDSInstance(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+func SetupRDSInstance(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclient.Options) error {
 	name := managed.ControllerName(v1beta1.RDSInstanceGroupKind)
+	record := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
 		}).
 		For(&v1beta1.RDSInstance{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.RDSInstanceGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: rds.NewClient}),
+			managed.WithExternalConnecter(awsclient.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: rds.NewClient, cache: rds.NewDescribeCache(o.DescribeCacheTTL), record: record}, o)),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
-			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithReferenceResolver(&clusterReferenceResolver{
+				kube:     mgr.GetClient(),
+				resolver: managed.NewAPISimpleReferenceResolver(mgr.GetClient()),
+			}),
+			managed.WithConnectionPublishers(
+				&connectionSecretPublisher{
+					ConnectionPublisher: managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme()),
+					kube:                mgr.GetClient()},
+				&secretsManagerPublisher{kube: mgr.GetClient(), newClientFn: secretsmanager.NewClient, api: o.API}),
+			managed.WithTimeout(o.ReconcileTimeout),
 			managed.WithLogger(l.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+			managed.WithRecorder(record)))
+}
+
+// clusterReferenceResolver resolves the DB cluster reference of an
+// RDSInstance in addition to the references resolved by the wrapped resolver.
+// DBCluster imports the database API group, so the DB cluster reference
+// cannot be resolved by the RDSInstance itself without an import cycle.
+type clusterReferenceResolver struct {
+	kube     client.Client
+	resolver managed.ReferenceResolver
+}
+
+func (r *clusterReferenceResolver) ResolveReferences(ctx context.Context, mg resource.Managed) error {
+	if err := r.resolver.ResolveReferences(ctx, mg); err != nil {
+		return err
+	}
+	cr, ok := mg.(*v1beta1.RDSInstance)
+	if !ok {
+		return errors.New(errNotRDSInstance)
+	}
+	existing := cr.DeepCopyObject()
+	rsp, err := reference.NewAPIResolver(r.kube, cr).Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(cr.Spec.ForProvider.DBClusterIdentifier),
+		Reference:    cr.Spec.ForProvider.DBClusterIdentifierRef,
+		Selector:     cr.Spec.ForProvider.DBClusterIdentifierSelector,
+		To:           reference.To{Managed: &rdsv1alpha1.DBCluster{}, List: &rdsv1alpha1.DBClusterList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.dbClusterIdentifier")
+	}
+	cr.Spec.ForProvider.DBClusterIdentifier = reference.ToPtrValue(rsp.ResolvedValue)
+	cr.Spec.ForProvider.DBClusterIdentifierRef = rsp.ResolvedReference
+	if cmp.Equal(existing, cr) {
+		return nil
+	}
+	return errors.Wrap(r.kube.Update(ctx, cr), errKubeUpdateFailed)
 }
 
 type connector struct {
 	kube        client.Client
 	newClientFn func(config *aws.Config) rds.Client
+	cache       *rds.DescribeCache
+	record      event.Recorder
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -89,12 +167,47 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{c.newClientFn(cfg), c.kube}, nil
+	return &external{client: c.newClientFn(cfg), kube: c.kube, dial: (&net.Dialer{}).DialContext, cache: c.cache, record: c.record}, nil
 }
 
 type external struct {
 	client rds.Client
 	kube   client.Client
+	dial   func(ctx context.Context, network, address string) (net.Conn, error)
+	cache  *rds.DescribeCache
+	record event.Recorder
+}
+
+// describeCacheKey is the key the DB instance of the given RDSInstance is
+// cached with. The UID keeps instances with the same identifier in different
+// accounts or regions apart.
+func describeCacheKey(cr *v1beta1.RDSInstance) string {
+	return string(cr.GetUID()) + "/" + meta.GetExternalName(cr)
+}
+
+// describe returns the DB instance of the given RDSInstance and its tags. They
+// are served from the describe cache if the instance was described recently.
+func (e *external) describe(ctx context.Context, cr *v1beta1.RDSInstance) (awsrds.DBInstance, []awsrds.Tag, error) {
+	key := describeCacheKey(cr)
+	if db, tags, ok := e.cache.Get(key); ok {
+		return db, tags, nil
+	}
+	rsp, err := e.client.DescribeDBInstancesRequest(&awsrds.DescribeDBInstancesInput{DBInstanceIdentifier: aws.String(meta.GetExternalName(cr))}).Send(ctx)
+	if err != nil {
+		return awsrds.DBInstance{}, nil, awsclient.Wrap(err, errDescribeFailed)
+	}
+	// Describe requests can be used with filters, which then returns a list.
+	// But we use an explicit identifier, so, if there is no error, there should
+	// be only 1 element in the list.
+	db := rsp.DBInstances[0]
+	// DescribeDBInstancesOutput does not expose the tags of the RDS instance,
+	// so they have to be fetched separately.
+	tags, err := e.client.ListTagsForResourceRequest(&awsrds.ListTagsForResourceInput{ResourceName: db.DBInstanceArn}).Send(ctx)
+	if err != nil {
+		return awsrds.DBInstance{}, nil, awsclient.Wrap(err, errListTagsFailed)
+	}
+	e.cache.Set(key, db, tags.TagList)
+	return db, tags.TagList, nil
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
@@ -103,30 +216,34 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNotRDSInstance)
 	}
 
-	// TODO(muvaf): There are some parameters that require a specific call
-	// for retrieval. For example, DescribeDBInstancesOutput does not expose
-	// the tags map of the RDS instance, you have to make ListTagsForResourceRequest
-	req := e.client.DescribeDBInstancesRequest(&awsrds.DescribeDBInstancesInput{DBInstanceIdentifier: aws.String(meta.GetExternalName(cr))})
-	rsp, err := req.Send(ctx)
+	instance, tags, err := e.describe(ctx, cr)
+	if rds.IsErrorNotFound(err) && cr.GetAnnotations()[v1beta1.AnnotationKeyValidateOnly] == "true" && !meta.WasDeleted(cr) {
+		// An instance that is only validated is reported as existing and up
+		// to date so that it is validated again at the poll interval rather
+		// than created.
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, e.validateOnly(ctx, cr)
+	}
 	if err != nil {
-		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(rds.IsErrorNotFound, err), errDescribeFailed)
+		return managed.ExternalObservation{}, resource.Ignore(rds.IsErrorNotFound, err)
 	}
-
-	// Describe requests can be used with filters, which then returns a list.
-	// But we use an explicit identifier, so, if there is no error, there should
-	// be only 1 element in the list.
-	instance := rsp.DBInstances[0]
 	current := cr.Spec.ForProvider.DeepCopy()
 	rds.LateInitialize(&cr.Spec.ForProvider, &instance)
 	if !reflect.DeepEqual(current, &cr.Spec.ForProvider) {
 		if err := e.kube.Update(ctx, cr); err != nil {
-			return managed.ExternalObservation{}, errors.Wrap(e.kube.Update(ctx, cr), errKubeUpdateFailed)
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
 		}
 	}
+	// The instance an upgrade was cut over from is not observed anymore.
+	previous := cr.Status.AtProvider.PreviousDBInstanceIdentifier
 	cr.Status.AtProvider = rds.GenerateObservation(instance)
+	cr.Status.AtProvider.PreviousDBInstanceIdentifier = previous
 
 	switch cr.Status.AtProvider.DBInstanceStatus {
 	case v1beta1.RDSInstanceStateAvailable:
+		if err := e.probe(ctx, cr); err != nil {
+			cr.Status.SetConditions(xpv1.Unavailable().WithMessage(errors.Wrap(err, errReadinessProbeFailed).Error()))
+			break
+		}
 		cr.Status.SetConditions(xpv1.Available())
 	case v1beta1.RDSInstanceStateCreating:
 		cr.Status.SetConditions(xpv1.Creating())
@@ -139,14 +256,57 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if err != nil {
 		return managed.ExternalObservation{}, awsclient.Wrap(err, errUpToDateFailed)
 	}
+	add, remove := rds.DiffTags(cr.Spec.ForProvider.Tags, tags)
+	upToDate = upToDate && len(add) == 0 && len(remove) == 0 && !deletePreviousRequested(cr)
+	conn, err := e.connectionDetails(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
 
 	return managed.ExternalObservation{
 		ResourceExists:    true,
 		ResourceUpToDate:  upToDate,
-		ConnectionDetails: rds.GetConnectionDetails(*cr),
+		ConnectionDetails: conn,
 	}, nil
 }
 
+// connectionDetails returns the connection details of the instance, or those
+// of its DB cluster if the instance is a cluster member.
+func (e *external) connectionDetails(ctx context.Context, cr *v1beta1.RDSInstance) (managed.ConnectionDetails, error) {
+	id := aws.StringValue(cr.Spec.ForProvider.DBClusterIdentifier)
+	if id == "" {
+		return rds.GetConnectionDetails(*cr), nil
+	}
+	rsp, err := e.client.DescribeDBClustersRequest(&awsrds.DescribeDBClustersInput{DBClusterIdentifier: aws.String(id)}).Send(ctx)
+	if err != nil {
+		return nil, awsclient.Wrap(err, errDescribeClusterFailed)
+	}
+	if len(rsp.DBClusters) == 0 {
+		return nil, nil
+	}
+	return rds.GetClusterConnectionDetails(*cr, rsp.DBClusters[0]), nil
+}
+
+// probe dials the endpoint of the instance if a readiness probe is configured.
+func (e *external) probe(ctx context.Context, cr *v1beta1.RDSInstance) error {
+	p := cr.Spec.ReadinessProbe
+	if p == nil {
+		return nil
+	}
+	timeout := defaultReadinessProbeTimeout
+	if p.TimeoutSeconds != nil {
+		timeout = time.Duration(*p.TimeoutSeconds) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	ep := cr.Status.AtProvider.Endpoint
+	conn, err := e.dial(ctx, "tcp", net.JoinHostPort(ep.Address, strconv.Itoa(ep.Port)))
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
 func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*v1beta1.RDSInstance)
 	if !ok {
@@ -156,29 +316,94 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Status.AtProvider.DBInstanceStatus == v1beta1.RDSInstanceStateCreating {
 		return managed.ExternalCreation{}, nil
 	}
-	pw, _, err := rds.GetPassword(ctx, e.kube, cr.Spec.ForProvider.MasterPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
-	if err != nil {
+	if err := validateSpec(cr.Spec.ForProvider); err != nil {
 		return managed.ExternalCreation{}, err
 	}
-	if pw == "" {
-		pw, err = password.Generate()
+	// The master credentials of a cluster member are managed by the cluster.
+	member := cr.Spec.ForProvider.DBClusterIdentifier != nil
+	pw := ""
+	if !member {
+		var err error
+		pw, _, err = rds.GetPasswordForKey(ctx, e.kube, cr.Spec.ForProvider.MasterPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference,
+			rds.ConnectionSecretKey(cr.Spec.ConnectionSecretKeyMap, xpv1.ResourceCredentialsSecretPasswordKey))
 		if err != nil {
 			return managed.ExternalCreation{}, err
 		}
+		if pw == "" {
+			pw, err = rds.GeneratePassword(&cr.Spec.ForProvider)
+			if err != nil {
+				return managed.ExternalCreation{}, err
+			}
+		}
+	}
+
+	// This is best effort, e.g. the credentials may not be allowed to list
+	// the offerings, so only a definitive empty answer stops the creation.
+	if orderable, err := e.isOrderable(ctx, cr); err == nil && !orderable {
+		return managed.ExternalCreation{}, errors.New(errNotOrderable)
 	}
 
 	req := e.client.CreateDBInstanceRequest(rds.GenerateCreateDBInstanceInput(meta.GetExternalName(cr), pw, &cr.Spec.ForProvider))
-	_, err = req.Send(ctx)
+	_, err := req.Send(ctx)
 	if err != nil {
 		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreateFailed)
 	}
+	if member {
+		return managed.ExternalCreation{}, nil
+	}
 	conn := managed.ConnectionDetails{
 		xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
 	}
 	if cr.Spec.ForProvider.MasterUsername != nil {
 		conn[xpv1.ResourceCredentialsSecretUserKey] = []byte(aws.StringValue(cr.Spec.ForProvider.MasterUsername))
 	}
-	return managed.ExternalCreation{ConnectionDetails: conn}, nil
+	return managed.ExternalCreation{ConnectionDetails: rds.MapConnectionDetails(conn, cr.Spec.ConnectionSecretKeyMap)}, nil
+}
+
+// validateSpec runs the checks that do not need to call AWS.
+func validateSpec(p v1beta1.RDSInstanceParameters) error {
+	if !monitoringConfigValid(p) {
+		return errors.New(errMonitoringRoleMissing)
+	}
+	if err := rds.ValidateStorage(&p); err != nil {
+		return err
+	}
+	if err := rds.ValidateDBName(&p); err != nil {
+		return err
+	}
+	return rds.ValidateAvailabilityZone(&p)
+}
+
+// isOrderable checks whether the engine, engine version, instance class and
+// license model of the RDSInstance are offered in its region. AWS reports
+// typos in engine version or an instance class that is not offered with
+// rather opaque errors on creation, so they are checked beforehand.
+func (e *external) isOrderable(ctx context.Context, cr *v1beta1.RDSInstance) (bool, error) {
+	opts, err := e.client.DescribeOrderableDBInstanceOptionsRequest(rds.GenerateDescribeOrderableDBInstanceOptionsInput(&cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return false, err
+	}
+	return len(opts.OrderableDBInstanceOptions) != 0, nil
+}
+
+// validateOnly reports whether AWS would accept the spec of the RDSInstance
+// in its SpecValid condition without creating the instance. Unlike on
+// creation, the offerings have to be listed for the spec to be valid.
+func (e *external) validateOnly(ctx context.Context, cr *v1beta1.RDSInstance) error {
+	if err := validateSpec(cr.Spec.ForProvider); err != nil {
+		cr.SetConditions(v1beta1.SpecInvalid(err))
+		return nil
+	}
+	orderable, err := e.isOrderable(ctx, cr)
+	if err != nil {
+		return awsclient.Wrap(err, errDescribeOrderable)
+	}
+	if !orderable {
+		cr.SetConditions(v1beta1.SpecInvalid(errors.New(errNotOrderable)))
+		return nil
+	}
+	cr.SetConditions(v1beta1.SpecValid())
+	return nil
 }
 
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
@@ -190,28 +415,57 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	case v1beta1.RDSInstanceStateModifying, v1beta1.RDSInstanceStateCreating:
 		return managed.ExternalUpdate{}, nil
 	}
+	if deletePreviousRequested(cr) {
+		return managed.ExternalUpdate{}, e.deletePrevious(ctx, cr)
+	}
+	if !monitoringConfigValid(cr.Spec.ForProvider) {
+		return managed.ExternalUpdate{}, errors.New(errMonitoringRoleMissing)
+	}
+	if err := rds.ValidateStorage(&cr.Spec.ForProvider); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
 	// AWS rejects modification requests if you send fields whose value is same
 	// as the current one. So, we have to create a patch out of the desired state
 	// and the current state. Since the DBInstance is not fully mirrored in status,
 	// we lose the current state after a change is made to spec, which forces us
 	// to make a DescribeDBInstancesRequest to get the current state.
-	describe := e.client.DescribeDBInstancesRequest(&awsrds.DescribeDBInstancesInput{DBInstanceIdentifier: aws.String(meta.GetExternalName(cr))})
-	rsp, err := describe.Send(ctx)
+	db, tags, err := e.describe(ctx, cr)
 	if err != nil {
-		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribeFailed)
+		return managed.ExternalUpdate{}, err
 	}
-	patch, err := rds.CreatePatch(&rsp.DBInstances[0], &cr.Spec.ForProvider)
+	// Anything below may change the instance, so the next reconcile has to
+	// describe it again.
+	defer e.cache.Invalidate(describeCacheKey(cr))
+	patch, err := rds.CreatePatch(&db, &cr.Spec.ForProvider)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errPatchCreationFailed)
 	}
+	if err := rds.ValidateImmutableFields(patch); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if patch.EngineVersion != nil &&
+		rds.IsMajorVersionChange(cr.Spec.ForProvider.Engine, aws.StringValue(db.EngineVersion), aws.StringValue(patch.EngineVersion)) {
+		if !aws.BoolValue(cr.Spec.ForProvider.AllowMajorVersionUpgrade) {
+			return managed.ExternalUpdate{}, errors.New(errMajorVersionUpgrade)
+		}
+		if aws.StringValue(cr.Spec.ForProvider.MajorVersionUpgradeStrategy) == v1beta1.MajorVersionUpgradeStrategySnapshotRestore {
+			return managed.ExternalUpdate{}, e.upgradeFromSnapshot(ctx, cr, db, aws.StringValue(patch.EngineVersion))
+		}
+	}
+	// Moving an instance to another subnet group may move it to another
+	// Availability Zone, so we don't do it unless explicitly allowed.
+	if patch.DBSubnetGroupName != nil && !aws.BoolValue(cr.Spec.ForProvider.AllowSubnetGroupChange) {
+		return managed.ExternalUpdate{}, errors.New(errSubnetGroupChange)
+	}
 	modify := rds.GenerateModifyDBInstanceInput(meta.GetExternalName(cr), patch)
 	var conn managed.ConnectionDetails
 
-	pwd, changed, err := rds.GetPassword(ctx, e.kube, cr.Spec.ForProvider.MasterPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
+	pwd, changed, err := rds.GetPasswordForKey(ctx, e.kube, cr.Spec.ForProvider.MasterPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference,
+		rds.ConnectionSecretKey(cr.Spec.ConnectionSecretKeyMap, xpv1.ResourceCredentialsSecretPasswordKey))
 	if err != nil {
 		return managed.ExternalUpdate{}, err
 	}
-	if changed {
+	if changed && cr.Spec.ForProvider.DBClusterIdentifier == nil {
 		conn = managed.ConnectionDetails{
 			xpv1.ResourceCredentialsSecretPasswordKey: []byte(pwd),
 		}
@@ -221,20 +475,176 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if _, err = e.client.ModifyDBInstanceRequest(modify).Send(ctx); err != nil {
 		return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyFailed)
 	}
-	if len(patch.Tags) > 0 {
-		tags := make([]awsrds.Tag, len(patch.Tags))
-		for i, t := range patch.Tags {
-			tags[i] = awsrds.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	if err := e.updateTags(ctx, cr, db.DBInstanceArn, tags); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return managed.ExternalUpdate{ConnectionDetails: rds.MapConnectionDetails(conn, cr.Spec.ConnectionSecretKeyMap)}, nil
+}
+
+// updateTags makes the given current tags of the RDS instance match the
+// desired ones.
+func (e *external) updateTags(ctx context.Context, cr *v1beta1.RDSInstance, arn *string, current []awsrds.Tag) error {
+	add, remove := rds.DiffTags(cr.Spec.ForProvider.Tags, current)
+	if len(remove) > 0 {
+		if _, err := e.client.RemoveTagsFromResourceRequest(&awsrds.RemoveTagsFromResourceInput{ResourceName: arn, TagKeys: remove}).Send(ctx); err != nil {
+			return awsclient.Wrap(err, errRemoveTagsFailed)
 		}
-		_, err = e.client.AddTagsToResourceRequest(&awsrds.AddTagsToResourceInput{
-			ResourceName: aws.String(cr.Status.AtProvider.DBInstanceArn),
-			Tags:         tags,
+	}
+	if len(add) > 0 {
+		if _, err := e.client.AddTagsToResourceRequest(&awsrds.AddTagsToResourceInput{ResourceName: arn, Tags: add}).Send(ctx); err != nil {
+			return awsclient.Wrap(err, errAddTagsFailed)
+		}
+	}
+	return nil
+}
+
+// upgradeFromSnapshot upgrades the major engine version of an RDS instance
+// without modifying it. A snapshot of the instance is restored to an upgrade
+// candidate whose engine version is then upgraded, one step per call. Other
+// changes to the instance are held back until the upgrade is cut over or
+// abandoned.
+func (e *external) upgradeFromSnapshot(ctx context.Context, cr *v1beta1.RDSInstance, db awsrds.DBInstance, version string) error {
+	// Delete calls Update, and a deleted instance should not be upgraded.
+	if meta.WasDeleted(cr) {
+		return nil
+	}
+	id := rds.UpgradeCandidateIdentifier(meta.GetExternalName(cr), version)
+	rsp, err := e.client.DescribeDBInstancesRequest(&awsrds.DescribeDBInstancesInput{DBInstanceIdentifier: aws.String(id)}).Send(ctx)
+	if resource.Ignore(rds.IsErrorNotFound, err) != nil {
+		return awsclient.Wrap(err, errDescribeCandidateFailed)
+	}
+	if err == nil && len(rsp.DBInstances) != 0 {
+		return e.upgradeCandidate(ctx, cr, rsp.DBInstances[0], version)
+	}
+
+	snap, err := e.client.DescribeDBSnapshotsRequest(&awsrds.DescribeDBSnapshotsInput{DBSnapshotIdentifier: aws.String(id)}).Send(ctx)
+	if resource.Ignore(rds.IsSnapshotNotFound, err) != nil {
+		return awsclient.Wrap(err, errDescribeSnapshotFailed)
+	}
+	if err != nil || len(snap.DBSnapshots) == 0 {
+		_, err := e.client.CreateDBSnapshotRequest(&awsrds.CreateDBSnapshotInput{
+			DBInstanceIdentifier: aws.String(meta.GetExternalName(cr)),
+			DBSnapshotIdentifier: aws.String(id),
 		}).Send(ctx)
-		if err != nil {
-			return managed.ExternalUpdate{}, awsclient.Wrap(err, errAddTagsFailed)
+		return awsclient.Wrap(err, errCreateSnapshotFailed)
+	}
+	if aws.StringValue(snap.DBSnapshots[0].Status) != databasev1alpha1.DBSnapshotStateAvailable {
+		return nil
+	}
+	_, err = e.client.RestoreDBInstanceFromDBSnapshotRequest(rds.GenerateRestoreDBInstanceFromDBSnapshotInput(id, id, &cr.Spec.ForProvider, db)).Send(ctx)
+	return awsclient.Wrap(err, errRestoreFailed)
+}
+
+// upgradeCandidate upgrades the engine version of the given upgrade candidate
+// and cuts over to it once it is upgraded and the cutover is requested.
+func (e *external) upgradeCandidate(ctx context.Context, cr *v1beta1.RDSInstance, c awsrds.DBInstance, version string) error {
+	cr.Status.AtProvider.UpgradeCandidate = rds.GenerateUpgradeCandidate(c)
+	if aws.StringValue(c.DBInstanceStatus) != v1beta1.RDSInstanceStateAvailable {
+		return nil
+	}
+	if rds.IsMajorVersionChange(cr.Spec.ForProvider.Engine, aws.StringValue(c.EngineVersion), version) {
+		_, err := e.client.ModifyDBInstanceRequest(&awsrds.ModifyDBInstanceInput{
+			DBInstanceIdentifier:     c.DBInstanceIdentifier,
+			EngineVersion:            aws.String(version),
+			DBParameterGroupName:     cr.Spec.ForProvider.DBParameterGroupName,
+			AllowMajorVersionUpgrade: aws.Bool(true),
+			ApplyImmediately:         aws.Bool(true),
+		}).Send(ctx)
+		return awsclient.Wrap(err, errUpgradeCandidateFailed)
+	}
+	id := aws.StringValue(c.DBInstanceIdentifier)
+	if cr.GetAnnotations()[v1beta1.AnnotationKeyUpgradeCutover] != id {
+		return nil
+	}
+	previous := meta.GetExternalName(cr)
+	meta.SetExternalName(cr, id)
+	if err := awsclient.UpdateSpec(ctx, e.kube, cr); err != nil {
+		return errors.Wrap(err, errKubeUpdateFailed)
+	}
+	cr.Status.AtProvider.PreviousDBInstanceIdentifier = previous
+	e.record.Event(cr, event.Normal(reasonUpgradeCutover, fmt.Sprintf("Cut over from DB instance %s to upgrade candidate %s", previous, id)))
+	return nil
+}
+
+// deletePreviousRequested reports whether the deletion of the instance the
+// given RDSInstance was cut over from is requested.
+func deletePreviousRequested(cr *v1beta1.RDSInstance) bool {
+	previous := cr.Status.AtProvider.PreviousDBInstanceIdentifier
+	return previous != "" && cr.GetAnnotations()[v1beta1.AnnotationKeyDeletePreviousInstance] == previous
+}
+
+// deletePrevious deletes the instance the given RDSInstance was cut over from
+// and the snapshot its upgrade candidate was restored from. The candidate and
+// its snapshot share their identifier, which is now the external name.
+func (e *external) deletePrevious(ctx context.Context, cr *v1beta1.RDSInstance) error {
+	previous := cr.Status.AtProvider.PreviousDBInstanceIdentifier
+	in := &awsrds.DeleteDBInstanceInput{
+		DBInstanceIdentifier: aws.String(previous),
+		SkipFinalSnapshot:    aws.Bool(aws.BoolValue(cr.Spec.ForProvider.SkipFinalSnapshotBeforeDeletion)),
+	}
+	if !aws.BoolValue(in.SkipFinalSnapshot) {
+		in.FinalDBSnapshotIdentifier = aws.String(previous + "-final")
+	}
+	if err := e.deleteDBInstance(ctx, in); err != nil {
+		return awsclient.Wrap(err, errDeletePreviousFailed)
+	}
+	if err := e.deleteDBSnapshot(ctx, meta.GetExternalName(cr)); err != nil {
+		return awsclient.Wrap(err, errDeleteSnapshotFailed)
+	}
+	cr.Status.AtProvider.PreviousDBInstanceIdentifier = ""
+	return nil
+}
+
+// deleteUpgrade deletes what SnapshotRestore upgrades of the given RDSInstance
+// left behind: the instance it was cut over from, and the upgrade candidate
+// and snapshot of an upgrade that is in progress.
+func (e *external) deleteUpgrade(ctx context.Context, cr *v1beta1.RDSInstance) error {
+	if cr.Status.AtProvider.PreviousDBInstanceIdentifier != "" {
+		if err := e.deletePrevious(ctx, cr); err != nil {
+			return err
 		}
 	}
-	return managed.ExternalUpdate{ConnectionDetails: conn}, nil
+	if cr.Spec.ForProvider.EngineVersion == nil ||
+		aws.StringValue(cr.Spec.ForProvider.MajorVersionUpgradeStrategy) != v1beta1.MajorVersionUpgradeStrategySnapshotRestore {
+		return nil
+	}
+	// The candidate is a copy of the instance, which is deleted with a final
+	// snapshot if one is requested.
+	id := rds.UpgradeCandidateIdentifier(meta.GetExternalName(cr), aws.StringValue(cr.Spec.ForProvider.EngineVersion))
+	if err := e.deleteDBInstance(ctx, &awsrds.DeleteDBInstanceInput{DBInstanceIdentifier: aws.String(id), SkipFinalSnapshot: aws.Bool(true)}); err != nil {
+		return awsclient.Wrap(err, errDeleteCandidateFailed)
+	}
+	cr.Status.AtProvider.UpgradeCandidate = nil
+	return awsclient.Wrap(e.deleteDBSnapshot(ctx, id), errDeleteSnapshotFailed)
+}
+
+// deleteDBInstance deletes the given DB instance unless it does not exist or
+// is already being deleted.
+func (e *external) deleteDBInstance(ctx context.Context, in *awsrds.DeleteDBInstanceInput) error {
+	rsp, err := e.client.DescribeDBInstancesRequest(&awsrds.DescribeDBInstancesInput{DBInstanceIdentifier: in.DBInstanceIdentifier}).Send(ctx)
+	if rds.IsErrorNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(rsp.DBInstances) == 0 || aws.StringValue(rsp.DBInstances[0].DBInstanceStatus) == v1beta1.RDSInstanceStateDeleting {
+		return nil
+	}
+	_, err = e.client.DeleteDBInstanceRequest(in).Send(ctx)
+	return resource.Ignore(rds.IsErrorNotFound, err)
+}
+
+// deleteDBSnapshot deletes the given DB snapshot unless it does not exist.
+func (e *external) deleteDBSnapshot(ctx context.Context, id string) error {
+	_, err := e.client.DeleteDBSnapshotRequest(&awsrds.DeleteDBSnapshotInput{DBSnapshotIdentifier: aws.String(id)}).Send(ctx)
+	return resource.Ignore(rds.IsSnapshotNotFound, err)
+}
+
+// monitoringConfigValid reports whether a monitoring role is given when
+// enhanced monitoring is enabled.
+func monitoringConfigValid(p v1beta1.RDSInstanceParameters) bool {
+	return aws.IntValue(p.MonitoringInterval) == 0 || aws.StringValue(p.MonitoringRoleARN) != ""
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -243,6 +653,9 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 		return errors.New(errNotRDSInstance)
 	}
 	cr.SetConditions(xpv1.Deleting())
+	if err := e.deleteUpgrade(ctx, cr); err != nil {
+		return err
+	}
 	if cr.Status.AtProvider.DBInstanceStatus == v1beta1.RDSInstanceStateDeleting {
 		return nil
 	}
@@ -263,6 +676,7 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 		FinalDBSnapshotIdentifier: cr.Spec.ForProvider.FinalDBSnapshotIdentifier,
 	}
 	_, err = e.client.DeleteDBInstanceRequest(&input).Send(ctx)
+	e.cache.Invalidate(describeCacheKey(cr))
 	return awsclient.Wrap(resource.Ignore(rds.IsErrorNotFound, err), errDeleteFailed)
 }
 
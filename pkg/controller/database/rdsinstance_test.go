@@ -17,10 +17,13 @@ package database
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -30,9 +33,12 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
@@ -49,6 +55,7 @@ const (
 
 var (
 	masterUsername = "root"
+	clusterID      = "cool-cluster"
 	engineVersion  = "5.6"
 
 	replaceMe = "replace-me!"
@@ -58,6 +65,7 @@ var (
 type args struct {
 	rds  rds.Client
 	kube client.Client
+	dial func(ctx context.Context, network, address string) (net.Conn, error)
 	cr   *v1beta1.RDSInstance
 }
 
@@ -67,6 +75,9 @@ func withMasterUsername(s *string) rdsModifier {
 	return func(r *v1beta1.RDSInstance) { r.Spec.ForProvider.MasterUsername = s }
 }
 
+func withDBClusterIdentifier(s *string) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { r.Spec.ForProvider.DBClusterIdentifier = s }
+}
 func withConditions(c ...xpv1.Condition) rdsModifier {
 	return func(r *v1beta1.RDSInstance) { r.Status.ConditionedStatus.Conditions = c }
 }
@@ -93,6 +104,144 @@ func withPasswordSecretRef(s xpv1.SecretKeySelector) rdsModifier {
 	return func(r *v1beta1.RDSInstance) { r.Spec.ForProvider.MasterPasswordSecretRef = &s }
 }
 
+func withMonitoringInterval(i int) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { r.Spec.ForProvider.MonitoringInterval = &i }
+}
+
+func withReadinessProbe(p *v1beta1.RDSInstanceReadinessProbe) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { r.Spec.ReadinessProbe = p }
+}
+
+func withPort(p int) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { r.Spec.ForProvider.Port = &p }
+}
+
+func withDBSubnetGroupName(s *string) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { r.Spec.ForProvider.DBSubnetGroupName = s }
+}
+
+func withAllowSubnetGroupChange(b bool) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { r.Spec.ForProvider.AllowSubnetGroupChange = aws.Bool(b) }
+}
+
+func withEndpoint(e v1beta1.Endpoint) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { r.Status.AtProvider.Endpoint = e }
+}
+
+func withMultiAZAvailabilityZone(az string) rdsModifier {
+	return func(r *v1beta1.RDSInstance) {
+		r.Spec.ForProvider.MultiAZ = aws.Bool(true)
+		r.Spec.ForProvider.AvailabilityZone = aws.String(az)
+	}
+}
+
+func withExternalName(n string) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { meta.SetExternalName(r, n) }
+}
+
+func withAnnotations(a map[string]string) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { meta.AddAnnotations(r, a) }
+}
+
+func withDeletionTimestamp() rdsModifier {
+	return func(r *v1beta1.RDSInstance) {
+		t := metav1.Unix(1, 0)
+		r.SetDeletionTimestamp(&t)
+	}
+}
+
+func withSnapshotRestoreUpgrade() rdsModifier {
+	return func(r *v1beta1.RDSInstance) {
+		r.Spec.ForProvider.AllowMajorVersionUpgrade = aws.Bool(true)
+		r.Spec.ForProvider.MajorVersionUpgradeStrategy = aws.String(v1beta1.MajorVersionUpgradeStrategySnapshotRestore)
+	}
+}
+
+func withUpgradeCandidate(c *v1beta1.UpgradeCandidate) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { r.Status.AtProvider.UpgradeCandidate = c }
+}
+
+func withPreviousDBInstance(id string) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { r.Status.AtProvider.PreviousDBInstanceIdentifier = id }
+}
+
+// eventRecorder records the events emitted for an RDSInstance.
+type eventRecorder struct {
+	events []event.Event
+}
+
+func (r *eventRecorder) Event(_ runtime.Object, e event.Event) { r.events = append(r.events, e) }
+
+func (r *eventRecorder) WithAnnotations(_ ...string) event.Recorder { return r }
+
+// describeInstances returns the given instances by their identifier and a
+// not found error for any other identifier.
+func describeInstances(dbs ...awsrds.DBInstance) func(*awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+	return func(in *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+		for _, db := range dbs {
+			if aws.StringValue(db.DBInstanceIdentifier) == aws.StringValue(in.DBInstanceIdentifier) {
+				return awsrds.DescribeDBInstancesRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{DBInstances: []awsrds.DBInstance{db}}},
+				}
+			}
+		}
+		return awsrds.DescribeDBInstancesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errors.New(awsrds.ErrCodeDBInstanceNotFoundFault)},
+		}
+	}
+}
+
+func describeSnapshots(status string) func(*awsrds.DescribeDBSnapshotsInput) awsrds.DescribeDBSnapshotsRequest {
+	return func(in *awsrds.DescribeDBSnapshotsInput) awsrds.DescribeDBSnapshotsRequest {
+		if status == "" {
+			return awsrds.DescribeDBSnapshotsRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errors.New(awsrds.ErrCodeDBSnapshotNotFoundFault)},
+			}
+		}
+		return awsrds.DescribeDBSnapshotsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBSnapshotsOutput{
+				DBSnapshots: []awsrds.DBSnapshot{{DBSnapshotIdentifier: in.DBSnapshotIdentifier, Status: aws.String(status)}},
+			}},
+		}
+	}
+}
+
+// deletion is the deletion of a DB instance, or of a DB snapshot if no final
+// snapshot is given.
+type deletion struct {
+	id            string
+	finalSnapshot string
+}
+
+// deletions records the DB instances and snapshots deleted by an external
+// client.
+type deletions struct {
+	instances []deletion
+	snapshots []string
+}
+
+func (d *deletions) deleteInstance(in *awsrds.DeleteDBInstanceInput) awsrds.DeleteDBInstanceRequest {
+	d.instances = append(d.instances, deletion{id: aws.StringValue(in.DBInstanceIdentifier), finalSnapshot: aws.StringValue(in.FinalDBSnapshotIdentifier)})
+	return awsrds.DeleteDBInstanceRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DeleteDBInstanceOutput{}},
+	}
+}
+
+func (d *deletions) deleteSnapshot(in *awsrds.DeleteDBSnapshotInput) awsrds.DeleteDBSnapshotRequest {
+	d.snapshots = append(d.snapshots, aws.StringValue(in.DBSnapshotIdentifier))
+	return awsrds.DeleteDBSnapshotRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DeleteDBSnapshotOutput{}},
+	}
+}
+
+func listTags(err error, tags ...awsrds.Tag) func(*awsrds.ListTagsForResourceInput) awsrds.ListTagsForResourceRequest {
+	return func(*awsrds.ListTagsForResourceInput) awsrds.ListTagsForResourceRequest {
+		return awsrds.ListTagsForResourceRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ListTagsForResourceOutput{TagList: tags}, Error: err},
+		}
+	}
+}
+
 func instance(m ...rdsModifier) *v1beta1.RDSInstance {
 	cr := &v1beta1.RDSInstance{}
 	for _, f := range m {
@@ -118,6 +267,7 @@ func TestObserve(t *testing.T) {
 		"SuccessfulAvailable": {
 			args: args{
 				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
 					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
 						return awsrds.DescribeDBInstancesRequest{
 							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
@@ -143,27 +293,29 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
-		"DeletingState": {
+		"CutOverFromPrevious": {
 			args: args{
 				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
 					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
 						return awsrds.DescribeDBInstancesRequest{
 							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
 								DBInstances: []awsrds.DBInstance{
 									{
-										DBInstanceStatus: aws.String(string(v1beta1.RDSInstanceStateDeleting)),
+										DBInstanceStatus: aws.String(string(v1beta1.RDSInstanceStateAvailable)),
 									},
 								},
 							}},
 						}
 					},
 				},
-				cr: instance(),
+				cr: instance(withPreviousDBInstance("prod")),
 			},
 			want: want{
 				cr: instance(
-					withConditions(xpv1.Deleting()),
-					withDBInstanceStatus(string(v1beta1.RDSInstanceStateDeleting))),
+					withConditions(xpv1.Available()),
+					withDBInstanceStatus(string(v1beta1.RDSInstanceStateAvailable)),
+					withPreviousDBInstance("prod")),
 				result: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  true,
@@ -171,40 +323,80 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
-		"FailedState": {
+		"DeletePreviousRequested": {
 			args: args{
 				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
 					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
 						return awsrds.DescribeDBInstancesRequest{
 							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
 								DBInstances: []awsrds.DBInstance{
 									{
-										DBInstanceStatus: aws.String(string(v1beta1.RDSInstanceStateFailed)),
+										DBInstanceStatus: aws.String(string(v1beta1.RDSInstanceStateAvailable)),
 									},
 								},
 							}},
 						}
 					},
 				},
-				cr: instance(),
+				cr: instance(withPreviousDBInstance("prod"), withAnnotations(map[string]string{v1beta1.AnnotationKeyDeletePreviousInstance: "prod"})),
 			},
 			want: want{
 				cr: instance(
-					withConditions(xpv1.Unavailable()),
-					withDBInstanceStatus(string(v1beta1.RDSInstanceStateFailed))),
+					withConditions(xpv1.Available()),
+					withDBInstanceStatus(string(v1beta1.RDSInstanceStateAvailable)),
+					withPreviousDBInstance("prod"),
+					withAnnotations(map[string]string{v1beta1.AnnotationKeyDeletePreviousInstance: "prod"})),
 				result: managed.ExternalObservation{
 					ResourceExists:    true,
-					ResourceUpToDate:  true,
+					ResourceUpToDate:  false,
 					ConnectionDetails: rds.GetConnectionDetails(v1beta1.RDSInstance{}),
 				},
 			},
 		},
-		"FailedDescribeRequest": {
+		"TagsOutOfDate": {
 			args: args{
 				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil, awsrds.Tag{Key: aws.String("foo"), Value: aws.String("baz")}),
 					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
 						return awsrds.DescribeDBInstancesRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+								DBInstances: []awsrds.DBInstance{
+									{
+										DBInstanceStatus: aws.String(string(v1beta1.RDSInstanceStateAvailable)),
+									},
+								},
+							}},
+						}
+					},
+				},
+				cr: instance(withTags(map[string]string{"foo": "bar"})),
+			},
+			want: want{
+				cr: instance(
+					withTags(map[string]string{"foo": "bar"}),
+					withConditions(xpv1.Available()),
+					withDBInstanceStatus(string(v1beta1.RDSInstanceStateAvailable))),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: rds.GetConnectionDetails(v1beta1.RDSInstance{}),
+				},
+			},
+		},
+		"FailedListTags": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(errBoom),
+					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+						return awsrds.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+								DBInstances: []awsrds.DBInstance{
+									{
+										DBInstanceStatus: aws.String(string(v1beta1.RDSInstanceStateAvailable)),
+									},
+								},
+							}},
 						}
 					},
 				},
@@ -212,51 +404,160 @@ func TestObserve(t *testing.T) {
 			},
 			want: want{
 				cr:  instance(),
-				err: awsclient.Wrap(errBoom, errDescribeFailed),
+				err: awsclient.Wrap(errBoom, errListTagsFailed),
 			},
 		},
-		"NotFound": {
+		"SuccessfulClusterMember": {
 			args: args{
 				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
 					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
 						return awsrds.DescribeDBInstancesRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errors.New(awsrds.ErrCodeDBInstanceNotFoundFault)},
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+								DBInstances: []awsrds.DBInstance{
+									{
+										DBClusterIdentifier: aws.String(clusterID),
+										DBInstanceStatus:    aws.String(string(v1beta1.RDSInstanceStateAvailable)),
+									},
+								},
+							}},
+						}
+					},
+					MockDescribeClusters: func(input *awsrds.DescribeDBClustersInput) awsrds.DescribeDBClustersRequest {
+						return awsrds.DescribeDBClustersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBClustersOutput{
+								DBClusters: []awsrds.DBCluster{
+									{
+										Endpoint:       aws.String("writer"),
+										ReaderEndpoint: aws.String("reader"),
+										Port:           aws.Int64(3306),
+										MasterUsername: aws.String(masterUsername),
+									},
+								},
+							}},
 						}
 					},
 				},
-				cr: instance(),
+				cr: instance(withDBClusterIdentifier(&clusterID)),
 			},
 			want: want{
-				cr: instance(),
+				cr: instance(
+					withDBClusterIdentifier(&clusterID),
+					withConditions(xpv1.Available()),
+					withDBInstanceStatus(string(v1beta1.RDSInstanceStateAvailable))),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey:          []byte("writer"),
+						v1beta1.ResourceCredentialsSecretReaderEndpointKey: []byte("reader"),
+						xpv1.ResourceCredentialsSecretPortKey:              []byte("3306"),
+						xpv1.ResourceCredentialsSecretUserKey:              []byte(masterUsername),
+					},
+				},
 			},
 		},
-		"LateInitSuccess": {
+		"FailedDescribeCluster": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+						return awsrds.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+								DBInstances: []awsrds.DBInstance{
+									{
+										DBClusterIdentifier: aws.String(clusterID),
+										DBInstanceStatus:    aws.String(string(v1beta1.RDSInstanceStateAvailable)),
+									},
+								},
+							}},
+						}
+					},
+					MockDescribeClusters: func(input *awsrds.DescribeDBClustersInput) awsrds.DescribeDBClustersRequest {
+						return awsrds.DescribeDBClustersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instance(withDBClusterIdentifier(&clusterID)),
+			},
+			want: want{
+				cr: instance(
+					withDBClusterIdentifier(&clusterID),
+					withConditions(xpv1.Available()),
+					withDBInstanceStatus(string(v1beta1.RDSInstanceStateAvailable))),
+				err: awsclient.Wrap(errBoom, errDescribeClusterFailed),
+			},
+		},
+		"ReadinessProbeSucceeded": {
 			args: args{
 				kube: &test.MockClient{
 					MockUpdate: test.NewMockUpdateFn(nil),
 				},
 				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
 					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
 						return awsrds.DescribeDBInstancesRequest{
 							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
 								DBInstances: []awsrds.DBInstance{
 									{
-										EngineVersion:    aws.String(engineVersion),
-										DBInstanceStatus: aws.String(string(v1beta1.RDSInstanceStateCreating)),
+										DBInstanceStatus: aws.String(string(v1beta1.RDSInstanceStateAvailable)),
+										Endpoint:         &awsrds.Endpoint{Address: aws.String("db.example.com"), Port: aws.Int64(5432)},
 									},
 								},
 							}},
 						}
 					},
 				},
-				cr: instance(),
+				dial: func(_ context.Context, network, address string) (net.Conn, error) {
+					if network != "tcp" || address != "db.example.com:5432" {
+						return nil, errBoom
+					}
+					c, _ := net.Pipe()
+					return c, nil
+				},
+				cr: instance(withReadinessProbe(&v1beta1.RDSInstanceReadinessProbe{})),
 			},
 			want: want{
 				cr: instance(
-					withEngineVersion(&engineVersion),
-					withDBInstanceStatus(string(v1beta1.RDSInstanceStateCreating)),
-					withConditions(xpv1.Creating()),
-				),
+					withReadinessProbe(&v1beta1.RDSInstanceReadinessProbe{}),
+					withPort(5432),
+					withConditions(xpv1.Available()),
+					withEndpoint(v1beta1.Endpoint{Address: "db.example.com", Port: 5432}),
+					withDBInstanceStatus(string(v1beta1.RDSInstanceStateAvailable))),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: rds.GetConnectionDetails(*instance(withEndpoint(v1beta1.Endpoint{Address: "db.example.com", Port: 5432}))),
+				},
+			},
+		},
+		"ReadinessProbeFailed": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+						return awsrds.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+								DBInstances: []awsrds.DBInstance{
+									{
+										DBInstanceStatus: aws.String(string(v1beta1.RDSInstanceStateAvailable)),
+									},
+								},
+							}},
+						}
+					},
+				},
+				dial: func(_ context.Context, _, _ string) (net.Conn, error) {
+					return nil, errBoom
+				},
+				cr: instance(withReadinessProbe(&v1beta1.RDSInstanceReadinessProbe{})),
+			},
+			want: want{
+				cr: instance(
+					withReadinessProbe(&v1beta1.RDSInstanceReadinessProbe{}),
+					withConditions(xpv1.Unavailable().WithMessage(errors.Wrap(errBoom, errReadinessProbeFailed).Error())),
+					withDBInstanceStatus(string(v1beta1.RDSInstanceStateAvailable))),
 				result: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  true,
@@ -264,223 +565,994 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
-		"LateInitFailedKubeUpdate": {
+		"DeletingState": {
 			args: args{
-				kube: &test.MockClient{
-					MockUpdate: test.NewMockUpdateFn(errBoom),
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+						return awsrds.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+								DBInstances: []awsrds.DBInstance{
+									{
+										DBInstanceStatus: aws.String(string(v1beta1.RDSInstanceStateDeleting)),
+									},
+								},
+							}},
+						}
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr: instance(
+					withConditions(xpv1.Deleting()),
+					withDBInstanceStatus(string(v1beta1.RDSInstanceStateDeleting))),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: rds.GetConnectionDetails(v1beta1.RDSInstance{}),
 				},
+			},
+		},
+		"FailedState": {
+			args: args{
 				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
 					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
 						return awsrds.DescribeDBInstancesRequest{
 							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
 								DBInstances: []awsrds.DBInstance{
 									{
-										EngineVersion:    aws.String(engineVersion),
-										DBInstanceStatus: aws.String(string(v1beta1.RDSInstanceStateCreating)),
+										DBInstanceStatus: aws.String(string(v1beta1.RDSInstanceStateFailed)),
 									},
 								},
 							}},
 						}
 					},
 				},
-				cr: instance(),
+				cr: instance(),
+			},
+			want: want{
+				cr: instance(
+					withConditions(xpv1.Unavailable()),
+					withDBInstanceStatus(string(v1beta1.RDSInstanceStateFailed))),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: rds.GetConnectionDetails(v1beta1.RDSInstance{}),
+				},
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+						return awsrds.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr:  instance(),
+				err: awsclient.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"NotFound": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+						return awsrds.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errors.New(awsrds.ErrCodeDBInstanceNotFoundFault)},
+						}
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr: instance(),
+			},
+		},
+		"ValidateOnlyValid": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockDescribe: describeInstances(),
+					MockDescribeOrderableOptions: func(input *awsrds.DescribeOrderableDBInstanceOptionsInput) awsrds.DescribeOrderableDBInstanceOptionsRequest {
+						return awsrds.DescribeOrderableDBInstanceOptionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeOrderableDBInstanceOptionsOutput{
+								OrderableDBInstanceOptions: []awsrds.OrderableDBInstanceOption{{}},
+							}},
+						}
+					},
+				},
+				cr: instance(withAnnotations(map[string]string{v1beta1.AnnotationKeyValidateOnly: "true"})),
+			},
+			want: want{
+				cr: instance(
+					withAnnotations(map[string]string{v1beta1.AnnotationKeyValidateOnly: "true"}),
+					withConditions(v1beta1.SpecValid())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ValidateOnlyNotOrderable": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockDescribe: describeInstances(),
+					MockDescribeOrderableOptions: func(input *awsrds.DescribeOrderableDBInstanceOptionsInput) awsrds.DescribeOrderableDBInstanceOptionsRequest {
+						return awsrds.DescribeOrderableDBInstanceOptionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeOrderableDBInstanceOptionsOutput{}},
+						}
+					},
+				},
+				cr: instance(withAnnotations(map[string]string{v1beta1.AnnotationKeyValidateOnly: "true"})),
+			},
+			want: want{
+				cr: instance(
+					withAnnotations(map[string]string{v1beta1.AnnotationKeyValidateOnly: "true"}),
+					withConditions(v1beta1.SpecInvalid(errors.New(errNotOrderable)))),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ValidateOnlyInvalidSpec": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockDescribe: describeInstances(),
+				},
+				cr: instance(
+					withAnnotations(map[string]string{v1beta1.AnnotationKeyValidateOnly: "true"}),
+					withMonitoringInterval(60)),
+			},
+			want: want{
+				cr: instance(
+					withAnnotations(map[string]string{v1beta1.AnnotationKeyValidateOnly: "true"}),
+					withMonitoringInterval(60),
+					withConditions(v1beta1.SpecInvalid(errors.New(errMonitoringRoleMissing)))),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ValidateOnlyDescribeFail": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockDescribe: describeInstances(),
+					MockDescribeOrderableOptions: func(input *awsrds.DescribeOrderableDBInstanceOptionsInput) awsrds.DescribeOrderableDBInstanceOptionsRequest {
+						return awsrds.DescribeOrderableDBInstanceOptionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instance(withAnnotations(map[string]string{v1beta1.AnnotationKeyValidateOnly: "true"})),
+			},
+			want: want{
+				cr:     instance(withAnnotations(map[string]string{v1beta1.AnnotationKeyValidateOnly: "true"})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				err:    awsclient.Wrap(errBoom, errDescribeOrderable),
+			},
+		},
+		"ValidateOnlyDeleted": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockDescribe: describeInstances(),
+				},
+				cr: instance(withAnnotations(map[string]string{v1beta1.AnnotationKeyValidateOnly: "true"}), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: instance(withAnnotations(map[string]string{v1beta1.AnnotationKeyValidateOnly: "true"}), withDeletionTimestamp()),
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+						return awsrds.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+								DBInstances: []awsrds.DBInstance{
+									{
+										EngineVersion:    aws.String(engineVersion),
+										DBInstanceStatus: aws.String(string(v1beta1.RDSInstanceStateCreating)),
+									},
+								},
+							}},
+						}
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr: instance(
+					withEngineVersion(&engineVersion),
+					withDBInstanceStatus(string(v1beta1.RDSInstanceStateCreating)),
+					withConditions(xpv1.Creating()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: rds.GetConnectionDetails(v1beta1.RDSInstance{}),
+				},
+			},
+		},
+		"LateInitFailedKubeUpdate": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+						return awsrds.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+								DBInstances: []awsrds.DBInstance{
+									{
+										EngineVersion:    aws.String(engineVersion),
+										DBInstanceStatus: aws.String(string(v1beta1.RDSInstanceStateCreating)),
+									},
+								},
+							}},
+						}
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr: instance(
+					withEngineVersion(&engineVersion),
+				),
+				err: awsclient.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.rds, dial: tc.dial}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDescribeCache(t *testing.T) {
+	calls := map[string]int{}
+	c := &fake.MockRDSClient{
+		MockListTags: func(input *awsrds.ListTagsForResourceInput) awsrds.ListTagsForResourceRequest {
+			calls["ListTagsForResource"]++
+			return listTags(nil)(input)
+		},
+		MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+			calls["DescribeDBInstances"]++
+			return awsrds.DescribeDBInstancesRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+					DBInstances: []awsrds.DBInstance{{DBInstanceStatus: aws.String("backing-up")}},
+				}},
+			}
+		},
+		MockModify: func(input *awsrds.ModifyDBInstanceInput) awsrds.ModifyDBInstanceRequest {
+			calls["ModifyDBInstance"]++
+			return awsrds.ModifyDBInstanceRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ModifyDBInstanceOutput{}},
+			}
+		},
+		MockAddTags: func(input *awsrds.AddTagsToResourceInput) awsrds.AddTagsToResourceRequest {
+			calls["AddTagsToResource"]++
+			return awsrds.AddTagsToResourceRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.AddTagsToResourceOutput{}},
+			}
+		},
+	}
+	e := &external{
+		kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+		client: c,
+		cache:  rds.NewDescribeCache(time.Minute),
+	}
+	cr := instance(withExternalName("prod"), withTags(map[string]string{"team": "data"}))
+
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe(...): %s", err)
+	}
+	if diff := cmp.Diff(map[string]int{"DescribeDBInstances": 1, "ListTagsForResource": 1}, calls); diff != "" {
+		t.Errorf("Observe(...): -want calls, +got:\n%s", diff)
+	}
+
+	// A cached Observe makes no AWS API calls at all.
+	calls = map[string]int{}
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe(...): %s", err)
+	}
+	if diff := cmp.Diff(map[string]int{}, calls); diff != "" {
+		t.Errorf("cached Observe(...): -want calls, +got:\n%s", diff)
+	}
+
+	// Update reuses the instance and tags described by Observe, but they have
+	// to be described again once the instance was modified.
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update(...): %s", err)
+	}
+	if diff := cmp.Diff(map[string]int{"ModifyDBInstance": 1, "AddTagsToResource": 1}, calls); diff != "" {
+		t.Errorf("Update(...): -want calls, +got:\n%s", diff)
+	}
+	calls = map[string]int{}
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe(...): %s", err)
+	}
+	if diff := cmp.Diff(map[string]int{"DescribeDBInstances": 1, "ListTagsForResource": 1}, calls); diff != "" {
+		t.Errorf("Observe(...) after Update(...): -want calls, +got:\n%s", diff)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1beta1.RDSInstance
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockDescribeOrderableOptions: func(input *awsrds.DescribeOrderableDBInstanceOptionsInput) awsrds.DescribeOrderableDBInstanceOptionsRequest {
+						return awsrds.DescribeOrderableDBInstanceOptionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeOrderableDBInstanceOptionsOutput{
+								OrderableDBInstanceOptions: []awsrds.OrderableDBInstanceOption{{}},
+							}},
+						}
+					},
+					MockCreate: func(input *awsrds.CreateDBInstanceInput) awsrds.CreateDBInstanceRequest {
+						return awsrds.CreateDBInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.CreateDBInstanceOutput{}},
+						}
+					},
+				},
+				cr: instance(withMasterUsername(&masterUsername)),
+			},
+			want: want{
+				cr: instance(
+					withMasterUsername(&masterUsername),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(masterUsername),
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(replaceMe),
+					},
+				},
+			},
+		},
+		"SuccessfulClusterMember": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockDescribeOrderableOptions: func(input *awsrds.DescribeOrderableDBInstanceOptionsInput) awsrds.DescribeOrderableDBInstanceOptionsRequest {
+						return awsrds.DescribeOrderableDBInstanceOptionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeOrderableDBInstanceOptionsOutput{
+								OrderableDBInstanceOptions: []awsrds.OrderableDBInstanceOption{{}},
+							}},
+						}
+					},
+					MockCreate: func(input *awsrds.CreateDBInstanceInput) awsrds.CreateDBInstanceRequest {
+						if input.MasterUsername != nil || input.MasterUserPassword != nil {
+							return awsrds.CreateDBInstanceRequest{
+								Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+							}
+						}
+						return awsrds.CreateDBInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.CreateDBInstanceOutput{}},
+						}
+					},
+				},
+				cr: instance(withMasterUsername(&masterUsername), withDBClusterIdentifier(&clusterID)),
+			},
+			want: want{
+				cr: instance(
+					withMasterUsername(&masterUsername),
+					withDBClusterIdentifier(&clusterID),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"SuccessfulNoNeedForCreate": {
+			args: args{
+				cr: instance(withDBInstanceStatus(v1beta1.RDSInstanceStateCreating)),
+			},
+			want: want{
+				cr: instance(
+					withDBInstanceStatus(v1beta1.RDSInstanceStateCreating),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"SuccessfulNoUsername": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockDescribeOrderableOptions: func(input *awsrds.DescribeOrderableDBInstanceOptionsInput) awsrds.DescribeOrderableDBInstanceOptionsRequest {
+						return awsrds.DescribeOrderableDBInstanceOptionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeOrderableDBInstanceOptionsOutput{
+								OrderableDBInstanceOptions: []awsrds.OrderableDBInstanceOption{{}},
+							}},
+						}
+					},
+					MockCreate: func(input *awsrds.CreateDBInstanceInput) awsrds.CreateDBInstanceRequest {
+						return awsrds.CreateDBInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.CreateDBInstanceOutput{}},
+						}
+					},
+				},
+				cr: instance(withMasterUsername(nil)),
+			},
+			want: want{
+				cr: instance(
+					withMasterUsername(nil),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(replaceMe),
+					},
+				},
+			},
+		},
+		"SuccessfulWithSecret": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockDescribeOrderableOptions: func(input *awsrds.DescribeOrderableDBInstanceOptionsInput) awsrds.DescribeOrderableDBInstanceOptionsRequest {
+						return awsrds.DescribeOrderableDBInstanceOptionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeOrderableDBInstanceOptionsOutput{
+								OrderableDBInstanceOptions: []awsrds.OrderableDBInstanceOption{{}},
+							}},
+						}
+					},
+					MockCreate: func(input *awsrds.CreateDBInstanceInput) awsrds.CreateDBInstanceRequest {
+						return awsrds.CreateDBInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.CreateDBInstanceOutput{}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key types.NamespacedName, obj client.Object) error {
+						secret := corev1.Secret{
+							Data: map[string][]byte{},
+						}
+						secret.Data[secretKey] = []byte(credData)
+						secret.DeepCopyInto(obj.(*corev1.Secret))
+						return nil
+					},
+				},
+				cr: instance(withMasterUsername(&masterUsername), withPasswordSecretRef(xpv1.SecretKeySelector{Key: secretKey})),
+			},
+			want: want{
+				cr: instance(
+					withMasterUsername(&masterUsername),
+					withPasswordSecretRef(xpv1.SecretKeySelector{Key: secretKey}),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(credData),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(masterUsername),
+					},
+				},
+			},
+		},
+		"FailedWhileGettingSecret": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				cr: instance(withMasterUsername(&masterUsername), withPasswordSecretRef(xpv1.SecretKeySelector{})),
+			},
+			want: want{
+				cr: instance(
+					withMasterUsername(&masterUsername),
+					withPasswordSecretRef(xpv1.SecretKeySelector{}),
+					withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errGetPasswordSecretFailed),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockDescribeOrderableOptions: func(input *awsrds.DescribeOrderableDBInstanceOptionsInput) awsrds.DescribeOrderableDBInstanceOptionsRequest {
+						return awsrds.DescribeOrderableDBInstanceOptionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeOrderableDBInstanceOptionsOutput{
+								OrderableDBInstanceOptions: []awsrds.OrderableDBInstanceOption{{}},
+							}},
+						}
+					},
+					MockCreate: func(input *awsrds.CreateDBInstanceInput) awsrds.CreateDBInstanceRequest {
+						return awsrds.CreateDBInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr:  instance(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreateFailed),
+			},
+		},
+		"MonitoringRoleMissing": {
+			args: args{
+				cr: instance(withMonitoringInterval(60)),
+			},
+			want: want{
+				cr:  instance(withMonitoringInterval(60), withConditions(xpv1.Creating())),
+				err: errors.New(errMonitoringRoleMissing),
+			},
+		},
+		"AvailabilityZoneWithMultiAZ": {
+			args: args{
+				cr: instance(withMultiAZAvailabilityZone("us-east-1a")),
+			},
+			want: want{
+				cr:  instance(withMultiAZAvailabilityZone("us-east-1a"), withConditions(xpv1.Creating())),
+				err: errors.New("availabilityZone cannot be set for Multi-AZ instances"),
+			},
+		},
+		"NotOrderable": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockDescribeOrderableOptions: func(input *awsrds.DescribeOrderableDBInstanceOptionsInput) awsrds.DescribeOrderableDBInstanceOptionsRequest {
+						return awsrds.DescribeOrderableDBInstanceOptionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeOrderableDBInstanceOptionsOutput{}},
+						}
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr:  instance(withConditions(xpv1.Creating())),
+				err: errors.New(errNotOrderable),
+			},
+		},
+		"OrderableCheckFailed": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockDescribeOrderableOptions: func(input *awsrds.DescribeOrderableDBInstanceOptionsInput) awsrds.DescribeOrderableDBInstanceOptionsRequest {
+						return awsrds.DescribeOrderableDBInstanceOptionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+					MockCreate: func(input *awsrds.CreateDBInstanceInput) awsrds.CreateDBInstanceRequest {
+						return awsrds.CreateDBInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.CreateDBInstanceOutput{}},
+						}
+					},
+				},
+				cr: instance(withMasterUsername(&masterUsername)),
+			},
+			want: want{
+				cr: instance(
+					withMasterUsername(&masterUsername),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(masterUsername),
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(replaceMe),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.rds}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if string(tc.want.result.ConnectionDetails[xpv1.ResourceCredentialsSecretPasswordKey]) == replaceMe {
+				tc.want.result.ConnectionDetails[xpv1.ResourceCredentialsSecretPasswordKey] =
+					o.ConnectionDetails[xpv1.ResourceCredentialsSecretPasswordKey]
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	upgradeSource := awsrds.DBInstance{
+		DBInstanceIdentifier: aws.String("prod"),
+		EngineVersion:        aws.String("5.7.33"),
+		DBSubnetGroup:        &awsrds.DBSubnetGroup{DBSubnetGroupName: aws.String("private")},
+	}
+	upgradeCandidate := func(version string) awsrds.DBInstance {
+		return awsrds.DBInstance{
+			DBInstanceIdentifier: aws.String("prod-8-0-23"),
+			DBInstanceStatus:     aws.String(v1beta1.RDSInstanceStateAvailable),
+			EngineVersion:        aws.String(version),
+		}
+	}
+
+	type want struct {
+		cr     *v1beta1.RDSInstance
+		result managed.ExternalUpdate
+		err    error
+		events []event.Event
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockModify: func(input *awsrds.ModifyDBInstanceInput) awsrds.ModifyDBInstanceRequest {
+						return awsrds.ModifyDBInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ModifyDBInstanceOutput{}},
+						}
+					},
+					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+						return awsrds.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+								DBInstances: []awsrds.DBInstance{{}},
+							}},
+						}
+					},
+					MockAddTags: func(input *awsrds.AddTagsToResourceInput) awsrds.AddTagsToResourceRequest {
+						return awsrds.AddTagsToResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.AddTagsToResourceOutput{}},
+						}
+					},
+				},
+				cr: instance(withTags(map[string]string{"foo": "bar"})),
+			},
+			want: want{
+				cr: instance(withTags(map[string]string{"foo": "bar"})),
+			},
+		},
+		"AlreadyModifying": {
+			args: args{
+				cr: instance(withDBInstanceStatus(v1beta1.RDSInstanceStateModifying)),
+			},
+			want: want{
+				cr: instance(withDBInstanceStatus(v1beta1.RDSInstanceStateModifying)),
+			},
+		},
+		"MonitoringRoleMissing": {
+			args: args{
+				cr: instance(withMonitoringInterval(5)),
+			},
+			want: want{
+				cr:  instance(withMonitoringInterval(5)),
+				err: errors.New(errMonitoringRoleMissing),
+			},
+		},
+		"FailedDescribe": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+						return awsrds.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr:  instance(),
+				err: awsclient.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"ImmutableFieldChanged": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+						return awsrds.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+								DBInstances: []awsrds.DBInstance{{MasterUsername: aws.String("admin")}},
+							}},
+						}
+					},
+				},
+				cr: instance(withMasterUsername(aws.String("root"))),
+			},
+			want: want{
+				cr:  instance(withMasterUsername(aws.String("root"))),
+				err: rds.ValidateImmutableFields(&v1beta1.RDSInstanceParameters{MasterUsername: aws.String("root")}),
+			},
+		},
+		"MajorVersionUpgradeNotAllowed": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+						return awsrds.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+								DBInstances: []awsrds.DBInstance{{EngineVersion: aws.String("5.6.41")}},
+							}},
+						}
+					},
+				},
+				cr: instance(withEngineVersion(aws.String("5.7.33"))),
+			},
+			want: want{
+				cr:  instance(withEngineVersion(aws.String("5.7.33"))),
+				err: errors.New(errMajorVersionUpgrade),
+			},
+		},
+		"SubnetGroupChangeNotAllowed": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+						return awsrds.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+								DBInstances: []awsrds.DBInstance{{DBSubnetGroup: &awsrds.DBSubnetGroup{DBSubnetGroupName: aws.String("old")}}},
+							}},
+						}
+					},
+				},
+				cr: instance(withDBSubnetGroupName(aws.String("new"))),
+			},
+			want: want{
+				cr:  instance(withDBSubnetGroupName(aws.String("new"))),
+				err: errors.New(errSubnetGroupChange),
+			},
+		},
+		"SubnetGroupChangeAllowed": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockModify: func(input *awsrds.ModifyDBInstanceInput) awsrds.ModifyDBInstanceRequest {
+						if aws.StringValue(input.DBSubnetGroupName) != "new" {
+							return awsrds.ModifyDBInstanceRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom}}
+						}
+						return awsrds.ModifyDBInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ModifyDBInstanceOutput{}},
+						}
+					},
+					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+						return awsrds.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+								DBInstances: []awsrds.DBInstance{{DBSubnetGroup: &awsrds.DBSubnetGroup{DBSubnetGroupName: aws.String("old")}}},
+							}},
+						}
+					},
+				},
+				cr: instance(withDBSubnetGroupName(aws.String("new")), withAllowSubnetGroupChange(true)),
+			},
+			want: want{
+				cr: instance(withDBSubnetGroupName(aws.String("new")), withAllowSubnetGroupChange(true)),
+			},
+		},
+		"UpgradeSnapshotCreated": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockListTags:          listTags(nil),
+					MockDescribe:          describeInstances(upgradeSource),
+					MockDescribeSnapshots: describeSnapshots(""),
+					MockCreateSnapshot: func(input *awsrds.CreateDBSnapshotInput) awsrds.CreateDBSnapshotRequest {
+						if aws.StringValue(input.DBInstanceIdentifier) != "prod" || aws.StringValue(input.DBSnapshotIdentifier) != "prod-8-0-23" {
+							return awsrds.CreateDBSnapshotRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom}}
+						}
+						return awsrds.CreateDBSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.CreateDBSnapshotOutput{}},
+						}
+					},
+				},
+				cr: instance(withExternalName("prod"), withEngineVersion(aws.String("8.0.23")), withSnapshotRestoreUpgrade()),
+			},
+			want: want{
+				cr: instance(withExternalName("prod"), withEngineVersion(aws.String("8.0.23")), withSnapshotRestoreUpgrade()),
+			},
+		},
+		"UpgradeSnapshotPending": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockListTags:          listTags(nil),
+					MockDescribe:          describeInstances(upgradeSource),
+					MockDescribeSnapshots: describeSnapshots("creating"),
+				},
+				cr: instance(withExternalName("prod"), withEngineVersion(aws.String("8.0.23")), withSnapshotRestoreUpgrade()),
 			},
 			want: want{
-				cr: instance(
-					withEngineVersion(&engineVersion),
-				),
-				err: awsclient.Wrap(errBoom, errKubeUpdateFailed),
+				cr: instance(withExternalName("prod"), withEngineVersion(aws.String("8.0.23")), withSnapshotRestoreUpgrade()),
 			},
 		},
-	}
-
-	for name, tc := range cases {
-		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.rds}
-			o, err := e.Observe(context.Background(), tc.args.cr)
-
-			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
-				t.Errorf("r: -want, +got:\n%s", diff)
-			}
-			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
-				t.Errorf("r: -want, +got:\n%s", diff)
-			}
-			if diff := cmp.Diff(tc.want.result, o); diff != "" {
-				t.Errorf("r: -want, +got:\n%s", diff)
-			}
-		})
-	}
-}
-
-func TestCreate(t *testing.T) {
-	type want struct {
-		cr     *v1beta1.RDSInstance
-		result managed.ExternalCreation
-		err    error
-	}
-
-	cases := map[string]struct {
-		args
-		want
-	}{
-		"Successful": {
+		"UpgradeCandidateRestored": {
 			args: args{
 				rds: &fake.MockRDSClient{
-					MockCreate: func(input *awsrds.CreateDBInstanceInput) awsrds.CreateDBInstanceRequest {
-						return awsrds.CreateDBInstanceRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.CreateDBInstanceOutput{}},
+					MockListTags:          listTags(nil),
+					MockDescribe:          describeInstances(upgradeSource),
+					MockDescribeSnapshots: describeSnapshots("available"),
+					MockRestoreFromSnapshot: func(input *awsrds.RestoreDBInstanceFromDBSnapshotInput) awsrds.RestoreDBInstanceFromDBSnapshotRequest {
+						if aws.StringValue(input.DBInstanceIdentifier) != "prod-8-0-23" || aws.StringValue(input.DBSubnetGroupName) != "private" {
+							return awsrds.RestoreDBInstanceFromDBSnapshotRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom}}
+						}
+						return awsrds.RestoreDBInstanceFromDBSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.RestoreDBInstanceFromDBSnapshotOutput{}},
 						}
 					},
 				},
-				cr: instance(withMasterUsername(&masterUsername)),
+				cr: instance(withExternalName("prod"), withEngineVersion(aws.String("8.0.23")), withSnapshotRestoreUpgrade()),
 			},
 			want: want{
-				cr: instance(
-					withMasterUsername(&masterUsername),
-					withConditions(xpv1.Creating())),
-				result: managed.ExternalCreation{
-					ConnectionDetails: managed.ConnectionDetails{
-						xpv1.ResourceCredentialsSecretUserKey:     []byte(masterUsername),
-						xpv1.ResourceCredentialsSecretPasswordKey: []byte(replaceMe),
+				cr: instance(withExternalName("prod"), withEngineVersion(aws.String("8.0.23")), withSnapshotRestoreUpgrade()),
+			},
+		},
+		"UpgradeCandidateUpgraded": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockDescribe: describeInstances(upgradeSource, upgradeCandidate("5.7.33")),
+					MockModify: func(input *awsrds.ModifyDBInstanceInput) awsrds.ModifyDBInstanceRequest {
+						if aws.StringValue(input.DBInstanceIdentifier) != "prod-8-0-23" || aws.StringValue(input.EngineVersion) != "8.0.23" {
+							return awsrds.ModifyDBInstanceRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom}}
+						}
+						return awsrds.ModifyDBInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ModifyDBInstanceOutput{}},
+						}
 					},
 				},
+				cr: instance(withExternalName("prod"), withEngineVersion(aws.String("8.0.23")), withSnapshotRestoreUpgrade()),
+			},
+			want: want{
+				cr: instance(withExternalName("prod"), withEngineVersion(aws.String("8.0.23")), withSnapshotRestoreUpgrade(),
+					withUpgradeCandidate(&v1beta1.UpgradeCandidate{DBInstanceIdentifier: "prod-8-0-23", DBInstanceStatus: v1beta1.RDSInstanceStateAvailable, EngineVersion: "5.7.33"})),
 			},
 		},
-		"SuccessfulNoNeedForCreate": {
+		"UpgradeAwaitingCutover": {
 			args: args{
-				cr: instance(withDBInstanceStatus(v1beta1.RDSInstanceStateCreating)),
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockDescribe: describeInstances(upgradeSource, upgradeCandidate("8.0.23")),
+				},
+				cr: instance(withExternalName("prod"), withEngineVersion(aws.String("8.0.23")), withSnapshotRestoreUpgrade()),
 			},
 			want: want{
-				cr: instance(
-					withDBInstanceStatus(v1beta1.RDSInstanceStateCreating),
-					withConditions(xpv1.Creating())),
+				cr: instance(withExternalName("prod"), withEngineVersion(aws.String("8.0.23")), withSnapshotRestoreUpgrade(),
+					withUpgradeCandidate(&v1beta1.UpgradeCandidate{DBInstanceIdentifier: "prod-8-0-23", DBInstanceStatus: v1beta1.RDSInstanceStateAvailable, EngineVersion: "8.0.23"})),
 			},
 		},
-		"SuccessfulNoUsername": {
+		"UpgradeCutover": {
 			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
 				rds: &fake.MockRDSClient{
-					MockCreate: func(input *awsrds.CreateDBInstanceInput) awsrds.CreateDBInstanceRequest {
-						return awsrds.CreateDBInstanceRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.CreateDBInstanceOutput{}},
-						}
-					},
+					MockListTags: listTags(nil),
+					MockDescribe: describeInstances(upgradeSource, upgradeCandidate("8.0.23")),
 				},
-				cr: instance(withMasterUsername(nil)),
+				cr: instance(withExternalName("prod"), withEngineVersion(aws.String("8.0.23")), withSnapshotRestoreUpgrade(),
+					withAnnotations(map[string]string{v1beta1.AnnotationKeyUpgradeCutover: "prod-8-0-23"})),
 			},
 			want: want{
-				cr: instance(
-					withMasterUsername(nil),
-					withConditions(xpv1.Creating())),
-				result: managed.ExternalCreation{
-					ConnectionDetails: managed.ConnectionDetails{
-						xpv1.ResourceCredentialsSecretPasswordKey: []byte(replaceMe),
-					},
-				},
+				cr: instance(withExternalName("prod-8-0-23"), withEngineVersion(aws.String("8.0.23")), withSnapshotRestoreUpgrade(),
+					withAnnotations(map[string]string{v1beta1.AnnotationKeyUpgradeCutover: "prod-8-0-23"}),
+					withUpgradeCandidate(&v1beta1.UpgradeCandidate{DBInstanceIdentifier: "prod-8-0-23", DBInstanceStatus: v1beta1.RDSInstanceStateAvailable, EngineVersion: "8.0.23"}),
+					withPreviousDBInstance("prod")),
+				events: []event.Event{event.Normal(reasonUpgradeCutover, "Cut over from DB instance prod to upgrade candidate prod-8-0-23")},
 			},
 		},
-		"SuccessfulWithSecret": {
+		"DeletePrevious": {
 			args: args{
 				rds: &fake.MockRDSClient{
-					MockCreate: func(input *awsrds.CreateDBInstanceInput) awsrds.CreateDBInstanceRequest {
-						return awsrds.CreateDBInstanceRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.CreateDBInstanceOutput{}},
+					MockListTags: listTags(nil),
+					MockDescribe: describeInstances(upgradeSource),
+					MockDelete: func(input *awsrds.DeleteDBInstanceInput) awsrds.DeleteDBInstanceRequest {
+						if aws.StringValue(input.DBInstanceIdentifier) != "prod" || aws.StringValue(input.FinalDBSnapshotIdentifier) != "prod-final" {
+							return awsrds.DeleteDBInstanceRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom}}
+						}
+						return awsrds.DeleteDBInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DeleteDBInstanceOutput{}},
 						}
 					},
-				},
-				kube: &test.MockClient{
-					MockGet: func(_ context.Context, key types.NamespacedName, obj client.Object) error {
-						secret := corev1.Secret{
-							Data: map[string][]byte{},
+					MockDeleteSnapshot: func(input *awsrds.DeleteDBSnapshotInput) awsrds.DeleteDBSnapshotRequest {
+						if aws.StringValue(input.DBSnapshotIdentifier) != "prod-8-0-23" {
+							return awsrds.DeleteDBSnapshotRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom}}
+						}
+						return awsrds.DeleteDBSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errors.New(awsrds.ErrCodeDBSnapshotNotFoundFault)},
 						}
-						secret.Data[secretKey] = []byte(credData)
-						secret.DeepCopyInto(obj.(*corev1.Secret))
-						return nil
 					},
 				},
-				cr: instance(withMasterUsername(&masterUsername), withPasswordSecretRef(xpv1.SecretKeySelector{Key: secretKey})),
+				cr: instance(withExternalName("prod-8-0-23"), withPreviousDBInstance("prod"),
+					withAnnotations(map[string]string{v1beta1.AnnotationKeyDeletePreviousInstance: "prod"})),
 			},
 			want: want{
-				cr: instance(
-					withMasterUsername(&masterUsername),
-					withPasswordSecretRef(xpv1.SecretKeySelector{Key: secretKey}),
-					withConditions(xpv1.Creating())),
-				result: managed.ExternalCreation{
-					ConnectionDetails: managed.ConnectionDetails{
-						xpv1.ResourceCredentialsSecretPasswordKey: []byte(credData),
-						xpv1.ResourceCredentialsSecretUserKey:     []byte(masterUsername),
+				cr: instance(withExternalName("prod-8-0-23"),
+					withAnnotations(map[string]string{v1beta1.AnnotationKeyDeletePreviousInstance: "prod"})),
+			},
+		},
+		"DeletePreviousFailed": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockDescribe: describeInstances(upgradeSource),
+					MockDelete: func(input *awsrds.DeleteDBInstanceInput) awsrds.DeleteDBInstanceRequest {
+						return awsrds.DeleteDBInstanceRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom}}
 					},
 				},
+				cr: instance(withExternalName("prod-8-0-23"), withPreviousDBInstance("prod"),
+					withAnnotations(map[string]string{v1beta1.AnnotationKeyDeletePreviousInstance: "prod"})),
+			},
+			want: want{
+				cr: instance(withExternalName("prod-8-0-23"), withPreviousDBInstance("prod"),
+					withAnnotations(map[string]string{v1beta1.AnnotationKeyDeletePreviousInstance: "prod"})),
+				err: awsclient.Wrap(errBoom, errDeletePreviousFailed),
 			},
 		},
-		"FailedWhileGettingSecret": {
+		"UpgradeCutoverFailed": {
 			args: args{
-				kube: &test.MockClient{
-					MockGet: test.NewMockGetFn(errBoom),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockDescribe: describeInstances(upgradeSource, upgradeCandidate("8.0.23")),
 				},
-				cr: instance(withMasterUsername(&masterUsername), withPasswordSecretRef(xpv1.SecretKeySelector{})),
+				cr: instance(withExternalName("prod"), withEngineVersion(aws.String("8.0.23")), withSnapshotRestoreUpgrade(),
+					withAnnotations(map[string]string{v1beta1.AnnotationKeyUpgradeCutover: "prod-8-0-23"})),
 			},
 			want: want{
-				cr: instance(
-					withMasterUsername(&masterUsername),
-					withPasswordSecretRef(xpv1.SecretKeySelector{}),
-					withConditions(xpv1.Creating())),
-				err: awsclient.Wrap(errBoom, errGetPasswordSecretFailed),
+				cr: instance(withExternalName("prod-8-0-23"), withEngineVersion(aws.String("8.0.23")), withSnapshotRestoreUpgrade(),
+					withAnnotations(map[string]string{v1beta1.AnnotationKeyUpgradeCutover: "prod-8-0-23"}),
+					withUpgradeCandidate(&v1beta1.UpgradeCandidate{DBInstanceIdentifier: "prod-8-0-23", DBInstanceStatus: v1beta1.RDSInstanceStateAvailable, EngineVersion: "8.0.23"})),
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
 			},
 		},
-		"FailedRequest": {
+		"FailedModify": {
 			args: args{
 				rds: &fake.MockRDSClient{
-					MockCreate: func(input *awsrds.CreateDBInstanceInput) awsrds.CreateDBInstanceRequest {
-						return awsrds.CreateDBInstanceRequest{
+					MockListTags: listTags(nil),
+					MockModify: func(input *awsrds.ModifyDBInstanceInput) awsrds.ModifyDBInstanceRequest {
+						return awsrds.ModifyDBInstanceRequest{
 							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
 						}
 					},
+					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+						return awsrds.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+								DBInstances: []awsrds.DBInstance{{}},
+							}},
+						}
+					},
 				},
 				cr: instance(),
 			},
 			want: want{
-				cr:  instance(withConditions(xpv1.Creating())),
-				err: awsclient.Wrap(errBoom, errCreateFailed),
+				cr:  instance(),
+				err: awsclient.Wrap(errBoom, errModifyFailed),
 			},
 		},
-	}
-
-	for name, tc := range cases {
-		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.rds}
-			o, err := e.Create(context.Background(), tc.args.cr)
-
-			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
-				t.Errorf("r: -want, +got:\n%s", diff)
-			}
-			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
-				t.Errorf("r: -want, +got:\n%s", diff)
-			}
-			if string(tc.want.result.ConnectionDetails[xpv1.ResourceCredentialsSecretPasswordKey]) == replaceMe {
-				tc.want.result.ConnectionDetails[xpv1.ResourceCredentialsSecretPasswordKey] =
-					o.ConnectionDetails[xpv1.ResourceCredentialsSecretPasswordKey]
-			}
-			if diff := cmp.Diff(tc.want.result, o); diff != "" {
-				t.Errorf("r: -want, +got:\n%s", diff)
-			}
-		})
-	}
-}
-
-func TestUpdate(t *testing.T) {
-	type want struct {
-		cr     *v1beta1.RDSInstance
-		result managed.ExternalUpdate
-		err    error
-	}
-
-	cases := map[string]struct {
-		args
-		want
-	}{
-		"Successful": {
+		"RemovesStaleTags": {
 			args: args{
 				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil,
+						awsrds.Tag{Key: aws.String("foo"), Value: aws.String("bar")},
+						awsrds.Tag{Key: aws.String("stale"), Value: aws.String("tag")}),
 					MockModify: func(input *awsrds.ModifyDBInstanceInput) awsrds.ModifyDBInstanceRequest {
 						return awsrds.ModifyDBInstanceRequest{
 							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ModifyDBInstanceOutput{}},
@@ -493,9 +1565,12 @@ func TestUpdate(t *testing.T) {
 							}},
 						}
 					},
-					MockAddTags: func(input *awsrds.AddTagsToResourceInput) awsrds.AddTagsToResourceRequest {
-						return awsrds.AddTagsToResourceRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.AddTagsToResourceOutput{}},
+					MockRemoveTags: func(input *awsrds.RemoveTagsFromResourceInput) awsrds.RemoveTagsFromResourceRequest {
+						if diff := cmp.Diff([]string{"stale"}, input.TagKeys); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsrds.RemoveTagsFromResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.RemoveTagsFromResourceOutput{}},
 						}
 					},
 				},
@@ -505,19 +1580,24 @@ func TestUpdate(t *testing.T) {
 				cr: instance(withTags(map[string]string{"foo": "bar"})),
 			},
 		},
-		"AlreadyModifying": {
-			args: args{
-				cr: instance(withDBInstanceStatus(v1beta1.RDSInstanceStateModifying)),
-			},
-			want: want{
-				cr: instance(withDBInstanceStatus(v1beta1.RDSInstanceStateModifying)),
-			},
-		},
-		"FailedDescribe": {
+		"FailedRemoveTags": {
 			args: args{
 				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil, awsrds.Tag{Key: aws.String("stale"), Value: aws.String("tag")}),
+					MockModify: func(input *awsrds.ModifyDBInstanceInput) awsrds.ModifyDBInstanceRequest {
+						return awsrds.ModifyDBInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ModifyDBInstanceOutput{}},
+						}
+					},
 					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
 						return awsrds.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+								DBInstances: []awsrds.DBInstance{{}},
+							}},
+						}
+					},
+					MockRemoveTags: func(input *awsrds.RemoveTagsFromResourceInput) awsrds.RemoveTagsFromResourceRequest {
+						return awsrds.RemoveTagsFromResourceRequest{
 							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
 						}
 					},
@@ -526,15 +1606,16 @@ func TestUpdate(t *testing.T) {
 			},
 			want: want{
 				cr:  instance(),
-				err: awsclient.Wrap(errBoom, errDescribeFailed),
+				err: awsclient.Wrap(errBoom, errRemoveTagsFailed),
 			},
 		},
-		"FailedModify": {
+		"FailedListTags": {
 			args: args{
 				rds: &fake.MockRDSClient{
+					MockListTags: listTags(errBoom),
 					MockModify: func(input *awsrds.ModifyDBInstanceInput) awsrds.ModifyDBInstanceRequest {
 						return awsrds.ModifyDBInstanceRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ModifyDBInstanceOutput{}},
 						}
 					},
 					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
@@ -549,12 +1630,13 @@ func TestUpdate(t *testing.T) {
 			},
 			want: want{
 				cr:  instance(),
-				err: awsclient.Wrap(errBoom, errModifyFailed),
+				err: awsclient.Wrap(errBoom, errListTagsFailed),
 			},
 		},
 		"FailedAddTags": {
 			args: args{
 				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
 					MockModify: func(input *awsrds.ModifyDBInstanceInput) awsrds.ModifyDBInstanceRequest {
 						return awsrds.ModifyDBInstanceRequest{
 							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ModifyDBInstanceOutput{}},
@@ -584,7 +1666,8 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.rds}
+			rec := &eventRecorder{}
+			e := &external{kube: tc.kube, client: tc.rds, record: rec}
 			u, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -596,23 +1679,107 @@ func TestUpdate(t *testing.T) {
 			if diff := cmp.Diff(tc.want.result, u); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
+			if diff := cmp.Diff(tc.want.events, rec.events); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
 		})
 	}
 }
 
 func TestDelete(t *testing.T) {
+	upgradeSource := awsrds.DBInstance{
+		DBInstanceIdentifier: aws.String("prod"),
+		DBInstanceStatus:     aws.String(v1beta1.RDSInstanceStateAvailable),
+		EngineVersion:        aws.String("5.7.33"),
+	}
+	upgradeCandidate := awsrds.DBInstance{
+		DBInstanceIdentifier: aws.String("prod-8-0-23"),
+		DBInstanceStatus:     aws.String(v1beta1.RDSInstanceStateAvailable),
+		EngineVersion:        aws.String("8.0.23"),
+	}
+	upgrading, cutOver := &deletions{}, &deletions{}
+
 	type want struct {
-		cr  *v1beta1.RDSInstance
-		err error
+		cr      *v1beta1.RDSInstance
+		err     error
+		deleted *deletions
 	}
 
 	cases := map[string]struct {
 		args
 		want
+		deleted *deletions
 	}{
+		"UpgradeInProgress": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockListTags:       listTags(nil),
+					MockDescribe:       describeInstances(upgradeSource, upgradeCandidate),
+					MockDelete:         upgrading.deleteInstance,
+					MockDeleteSnapshot: upgrading.deleteSnapshot,
+				},
+				cr: instance(withExternalName("prod"), withEngineVersion(aws.String("8.0.23")), withSnapshotRestoreUpgrade(), withDeletionTimestamp(),
+					withUpgradeCandidate(&v1beta1.UpgradeCandidate{DBInstanceIdentifier: "prod-8-0-23", DBInstanceStatus: v1beta1.RDSInstanceStateAvailable, EngineVersion: "8.0.23"})),
+			},
+			deleted: upgrading,
+			want: want{
+				cr: instance(withExternalName("prod"), withEngineVersion(aws.String("8.0.23")), withSnapshotRestoreUpgrade(), withDeletionTimestamp(),
+					withConditions(xpv1.Deleting())),
+				deleted: &deletions{
+					instances: []deletion{{id: "prod-8-0-23"}, {id: "prod"}},
+					snapshots: []string{"prod-8-0-23"},
+				},
+			},
+		},
+		"UpgradeCutOver": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockDescribe:       describeInstances(upgradeSource, upgradeCandidate),
+					MockDelete:         cutOver.deleteInstance,
+					MockDeleteSnapshot: cutOver.deleteSnapshot,
+					MockListTags:       listTags(nil),
+					MockModify: func(input *awsrds.ModifyDBInstanceInput) awsrds.ModifyDBInstanceRequest {
+						return awsrds.ModifyDBInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ModifyDBInstanceOutput{}},
+						}
+					},
+				},
+				cr: instance(withExternalName("prod-8-0-23"), withEngineVersion(aws.String("8.0.23")), withSnapshotRestoreUpgrade(), withDeletionTimestamp(),
+					withPreviousDBInstance("prod")),
+			},
+			deleted: cutOver,
+			want: want{
+				cr: instance(withExternalName("prod-8-0-23"), withEngineVersion(aws.String("8.0.23")), withSnapshotRestoreUpgrade(), withDeletionTimestamp(),
+					withConditions(xpv1.Deleting())),
+				deleted: &deletions{
+					instances: []deletion{{id: "prod", finalSnapshot: "prod-final"}, {id: "prod-8-0-23"}},
+					snapshots: []string{"prod-8-0-23", "prod-8-0-23-8-0-23"},
+				},
+			},
+		},
+		"UpgradeCandidateDeleteFailed": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
+					MockDescribe: describeInstances(upgradeSource, upgradeCandidate),
+					MockDelete: func(input *awsrds.DeleteDBInstanceInput) awsrds.DeleteDBInstanceRequest {
+						return awsrds.DeleteDBInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instance(withExternalName("prod"), withEngineVersion(aws.String("8.0.23")), withSnapshotRestoreUpgrade(), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: instance(withExternalName("prod"), withEngineVersion(aws.String("8.0.23")), withSnapshotRestoreUpgrade(), withDeletionTimestamp(),
+					withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDeleteCandidateFailed),
+			},
+		},
 		"Successful": {
 			args: args{
 				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
 					MockDelete: func(input *awsrds.DeleteDBInstanceInput) awsrds.DeleteDBInstanceRequest {
 						return awsrds.DeleteDBInstanceRequest{
 							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DeleteDBInstanceOutput{}},
@@ -649,6 +1816,7 @@ func TestDelete(t *testing.T) {
 		"AlreadyDeleted": {
 			args: args{
 				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
 					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
 						return awsrds.DescribeDBInstancesRequest{
 							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errors.New(awsrds.ErrCodeDBInstanceNotFoundFault)},
@@ -664,6 +1832,7 @@ func TestDelete(t *testing.T) {
 		"Failed": {
 			args: args{
 				rds: &fake.MockRDSClient{
+					MockListTags: listTags(nil),
 					MockDelete: func(input *awsrds.DeleteDBInstanceInput) awsrds.DeleteDBInstanceRequest {
 						return awsrds.DeleteDBInstanceRequest{
 							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
@@ -702,6 +1871,9 @@ func TestDelete(t *testing.T) {
 			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
+			if diff := cmp.Diff(tc.want.deleted, tc.deleted, cmp.AllowUnexported(deletions{}, deletion{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
 		})
 	}
 }
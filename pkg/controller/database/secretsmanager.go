@@ -0,0 +1,218 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awssm "github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/rds"
+	"github.com/crossplane/provider-aws/pkg/clients/secretsmanager"
+)
+
+const (
+	errDescribeSMSecretFailed = "cannot describe Secrets Manager secret"
+	errGetSMSecretFailed      = "cannot get Secrets Manager secret"
+	errCreateSMSecretFailed   = "cannot create Secrets Manager secret"
+	errPutSMSecretFailed      = "cannot update Secrets Manager secret"
+	errDeleteSMSecretFailed   = "cannot delete Secrets Manager secret"
+	errMergeSMSecretFailed    = "cannot merge connection details into Secrets Manager secret"
+	errRemoveSMSecretFailed   = "cannot remove connection details from Secrets Manager secret"
+)
+
+// connectionKeys are the keys of all connection details an RDSInstance
+// publishes, before they are renamed by its connection secret key map.
+var connectionKeys = []string{
+	xpv1.ResourceCredentialsSecretEndpointKey,
+	v1beta1.ResourceCredentialsSecretReaderEndpointKey,
+	xpv1.ResourceCredentialsSecretPortKey,
+	xpv1.ResourceCredentialsSecretUserKey,
+	xpv1.ResourceCredentialsSecretPasswordKey,
+	v1beta1.ResourceCredentialsSecretDatabaseKey,
+}
+
+// secretsManagerPublisher mirrors the connection details of an RDSInstance
+// into the Secrets Manager secret named in its spec. Secrets it creates are
+// tagged as owned by the RDSInstance and deleted with it without a recovery
+// window; from secrets that existed before, only the connection details are
+// removed.
+type secretsManagerPublisher struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) secretsmanager.Client
+	api         awsclient.APIOptions
+}
+
+func (p *secretsManagerPublisher) connect(ctx context.Context, cr *v1beta1.RDSInstance) (secretsmanager.Client, error) {
+	cfg, err := awsclient.GetConfig(awsclient.WithAPIOptions(ctx, p.api), p.kube, cr, aws.StringValue(cr.Spec.ForProvider.Region))
+	if err != nil {
+		return nil, err
+	}
+	return p.newClientFn(*cfg), nil
+}
+
+func (p *secretsManagerPublisher) PublishConnection(ctx context.Context, mg resource.Managed, c managed.ConnectionDetails) error {
+	cr, ok := mg.(*v1beta1.RDSInstance)
+	if !ok {
+		return errors.New(errNotRDSInstance)
+	}
+	if cr.Spec.SecretsManagerSecretName == nil {
+		return nil
+	}
+	c, err := completeConnectionDetails(ctx, p.kube, cr, c)
+	if err != nil {
+		return err
+	}
+	if len(c) == 0 {
+		return nil
+	}
+	sm, err := p.connect(ctx, cr)
+	if err != nil {
+		return err
+	}
+	return publish(ctx, sm, cr, c)
+}
+
+// completeConnectionDetails adds the connection details that are missing from
+// c but stored in the connection secret of the RDSInstance. The password is
+// only part of the connection details of the reconcile that sets it, so a
+// Secrets Manager secret that could not be written then would never receive
+// it otherwise. If there is no connection secret, the password is read from
+// the master password secret instead.
+func completeConnectionDetails(ctx context.Context, kube client.Client, cr *v1beta1.RDSInstance, c managed.ConnectionDetails) (managed.ConnectionDetails, error) {
+	out := managed.ConnectionDetails{}
+	if ref := cr.GetWriteConnectionSecretToReference(); ref != nil {
+		s := &corev1.Secret{}
+		if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); resource.IgnoreNotFound(err) != nil {
+			return nil, errors.Wrap(err, errGetConnectionSecret)
+		}
+		for _, k := range connectionKeys {
+			k = rds.ConnectionSecretKey(cr.Spec.ConnectionSecretKeyMap, k)
+			if v, ok := s.Data[k]; ok {
+				out[k] = v
+			}
+		}
+	}
+	pwd := rds.ConnectionSecretKey(cr.Spec.ConnectionSecretKeyMap, xpv1.ResourceCredentialsSecretPasswordKey)
+	if _, ok := out[pwd]; !ok && c[pwd] == nil && cr.Spec.ForProvider.MasterPasswordSecretRef != nil {
+		v, _, err := rds.GetPassword(ctx, kube, cr.Spec.ForProvider.MasterPasswordSecretRef, nil)
+		if err != nil {
+			return nil, err
+		}
+		if v != "" {
+			out[pwd] = []byte(v)
+		}
+	}
+	for k, v := range c {
+		out[k] = v
+	}
+	return out, nil
+}
+
+func (p *secretsManagerPublisher) UnpublishConnection(ctx context.Context, mg resource.Managed, _ managed.ConnectionDetails) error {
+	cr, ok := mg.(*v1beta1.RDSInstance)
+	if !ok {
+		return errors.New(errNotRDSInstance)
+	}
+	if cr.Spec.SecretsManagerSecretName == nil {
+		return nil
+	}
+	sm, err := p.connect(ctx, cr)
+	if err != nil {
+		return err
+	}
+	return unpublish(ctx, sm, cr)
+}
+
+// publish merges the connection details into the secret, creating it tagged
+// as owned by the RDSInstance if it doesn't exist.
+func publish(ctx context.Context, sm secretsmanager.Client, cr *v1beta1.RDSInstance, c managed.ConnectionDetails) error {
+	name := cr.Spec.SecretsManagerSecretName
+	rsp, err := sm.GetSecretValueRequest(&awssm.GetSecretValueInput{SecretId: name}).Send(ctx)
+	if err != nil && !secretsmanager.IsNotFound(err) {
+		return awsclient.Wrap(err, errGetSMSecretFailed)
+	}
+	if secretsmanager.IsNotFound(err) {
+		val, _, err := secretsmanager.MergeConnectionDetails("", c)
+		if err != nil {
+			return errors.Wrap(err, errMergeSMSecretFailed)
+		}
+		_, err = sm.CreateSecretRequest(&awssm.CreateSecretInput{
+			Name:         name,
+			SecretString: aws.String(val),
+			Tags:         []awssm.Tag{{Key: aws.String(secretsmanager.TagKeyOwner), Value: aws.String(string(cr.GetUID()))}},
+		}).Send(ctx)
+		return awsclient.Wrap(err, errCreateSMSecretFailed)
+	}
+	val, changed, err := secretsmanager.MergeConnectionDetails(aws.StringValue(rsp.SecretString), c)
+	if err != nil {
+		return errors.Wrap(err, errMergeSMSecretFailed)
+	}
+	if !changed {
+		return nil
+	}
+	_, err = sm.PutSecretValueRequest(&awssm.PutSecretValueInput{SecretId: name, SecretString: aws.String(val)}).Send(ctx)
+	return awsclient.Wrap(err, errPutSMSecretFailed)
+}
+
+// unpublish deletes the secret if it is owned by the RDSInstance, and
+// otherwise removes the connection details from it.
+func unpublish(ctx context.Context, sm secretsmanager.Client, cr *v1beta1.RDSInstance) error {
+	name := cr.Spec.SecretsManagerSecretName
+	d, err := sm.DescribeSecretRequest(&awssm.DescribeSecretInput{SecretId: name}).Send(ctx)
+	if secretsmanager.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return awsclient.Wrap(err, errDescribeSMSecretFailed)
+	}
+	if secretsmanager.IsOwnedBy(d.Tags, string(cr.GetUID())) {
+		// The secret only mirrors the connection details of the instance that
+		// is deleted, and a recovery window would keep its name taken.
+		_, err = sm.DeleteSecretRequest(&awssm.DeleteSecretInput{SecretId: name, ForceDeleteWithoutRecovery: aws.Bool(true)}).Send(ctx)
+		return awsclient.Wrap(resource.Ignore(secretsmanager.IsNotFound, err), errDeleteSMSecretFailed)
+	}
+
+	rsp, err := sm.GetSecretValueRequest(&awssm.GetSecretValueInput{SecretId: name}).Send(ctx)
+	if err != nil {
+		return awsclient.Wrap(resource.Ignore(secretsmanager.IsNotFound, err), errGetSMSecretFailed)
+	}
+	keys := make([]string, len(connectionKeys))
+	for i, k := range connectionKeys {
+		keys[i] = rds.ConnectionSecretKey(cr.Spec.ConnectionSecretKeyMap, k)
+	}
+	val, changed, err := secretsmanager.RemoveConnectionDetails(aws.StringValue(rsp.SecretString), keys)
+	if err != nil {
+		return errors.Wrap(err, errRemoveSMSecretFailed)
+	}
+	if !changed {
+		return nil
+	}
+	_, err = sm.PutSecretValueRequest(&awssm.PutSecretValueInput{SecretId: name, SecretString: aws.String(val)}).Send(ctx)
+	return awsclient.Wrap(err, errPutSMSecretFailed)
+}
@@ -0,0 +1,262 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awssm "github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/secretsmanager"
+	"github.com/crossplane/provider-aws/pkg/clients/secretsmanager/fake"
+)
+
+var smSecretName = "db-credentials"
+
+func smInstance() *v1beta1.RDSInstance {
+	return &v1beta1.RDSInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", UID: "db-uid"},
+		Spec:       v1beta1.RDSInstanceSpec{SecretsManagerSecretName: &smSecretName},
+	}
+}
+
+func describeSecret(err error, tags ...awssm.Tag) func(*awssm.DescribeSecretInput) awssm.DescribeSecretRequest {
+	return func(*awssm.DescribeSecretInput) awssm.DescribeSecretRequest {
+		return awssm.DescribeSecretRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssm.DescribeSecretOutput{Tags: tags}, Error: err}}
+	}
+}
+
+func getSecretValue(err error, val string) func(*awssm.GetSecretValueInput) awssm.GetSecretValueRequest {
+	return func(*awssm.GetSecretValueInput) awssm.GetSecretValueRequest {
+		return awssm.GetSecretValueRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssm.GetSecretValueOutput{SecretString: aws.String(val)}, Error: err}}
+	}
+}
+
+func ownerTag(uid string) awssm.Tag {
+	return awssm.Tag{Key: aws.String(secretsmanager.TagKeyOwner), Value: aws.String(uid)}
+}
+
+func TestSecretsManagerPublish(t *testing.T) {
+	var created *awssm.CreateSecretInput
+	sm := &fake.MockClient{
+		MockGetValue: getSecretValue(awserr.New(awssm.ErrCodeResourceNotFoundException, "", nil), ""),
+		MockCreate: func(in *awssm.CreateSecretInput) awssm.CreateSecretRequest {
+			created = in
+			return awssm.CreateSecretRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssm.CreateSecretOutput{}}}
+		},
+	}
+
+	err := publish(context.Background(), sm, smInstance(), managed.ConnectionDetails{"password": []byte("pw")})
+	if diff := cmp.Diff(nil, err, test.EquateErrors()); diff != "" {
+		t.Errorf("publish(...): -want error, +got error:\n%s", diff)
+	}
+	want := &awssm.CreateSecretInput{
+		Name:         &smSecretName,
+		SecretString: aws.String(`{"password":"pw"}`),
+		Tags:         []awssm.Tag{ownerTag("db-uid")},
+	}
+	if diff := cmp.Diff(want, created); diff != "" {
+		t.Errorf("publish(...): -want CreateSecretInput, +got:\n%s", diff)
+	}
+}
+
+func TestCompleteConnectionDetails(t *testing.T) {
+	secrets := func(data map[string]map[string]string) test.MockGetFn {
+		return func(_ context.Context, key types.NamespacedName, obj client.Object) error {
+			d, ok := data[key.Name]
+			if !ok {
+				return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+			}
+			s := obj.(*corev1.Secret)
+			s.Data = map[string][]byte{}
+			for k, v := range d {
+				s.Data[k] = []byte(v)
+			}
+			return nil
+		}
+	}
+	withSecrets := func(cr *v1beta1.RDSInstance) *v1beta1.RDSInstance {
+		cr.Spec.WriteConnectionSecretToReference = &xpv1.SecretReference{Namespace: "default", Name: "db-conn"}
+		cr.Spec.ForProvider.MasterPasswordSecretRef = &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Namespace: "default", Name: "db-pwd"},
+			Key:             "pwd",
+		}
+		return cr
+	}
+
+	type want struct {
+		conn managed.ConnectionDetails
+		err  error
+	}
+
+	cases := map[string]struct {
+		cr   *v1beta1.RDSInstance
+		get  test.MockGetFn
+		conn managed.ConnectionDetails
+		want want
+	}{
+		"NoSecrets": {
+			cr:   smInstance(),
+			conn: managed.ConnectionDetails{"endpoint": []byte("db.example.org")},
+			want: want{conn: managed.ConnectionDetails{"endpoint": []byte("db.example.org")}},
+		},
+		"FromConnectionSecret": {
+			cr: func() *v1beta1.RDSInstance {
+				cr := withSecrets(smInstance())
+				cr.Spec.ConnectionSecretKeyMap = map[string]string{"password": "DB_PASSWORD"}
+				return cr
+			}(),
+			get: secrets(map[string]map[string]string{
+				"db-conn": {"endpoint": "old.example.org", "DB_PASSWORD": "published", "other": "ignored"},
+				"db-pwd":  {"pwd": "source"},
+			}),
+			conn: managed.ConnectionDetails{"endpoint": []byte("db.example.org")},
+			want: want{conn: managed.ConnectionDetails{"endpoint": []byte("db.example.org"), "DB_PASSWORD": []byte("published")}},
+		},
+		"FromPasswordSecret": {
+			cr: withSecrets(smInstance()),
+			get: secrets(map[string]map[string]string{
+				"db-pwd": {"pwd": "source"},
+			}),
+			conn: managed.ConnectionDetails{"endpoint": []byte("db.example.org")},
+			want: want{conn: managed.ConnectionDetails{"endpoint": []byte("db.example.org"), "password": []byte("source")}},
+		},
+		"PasswordChanged": {
+			cr: withSecrets(smInstance()),
+			get: secrets(map[string]map[string]string{
+				"db-conn": {"password": "old"},
+			}),
+			conn: managed.ConnectionDetails{"password": []byte("new")},
+			want: want{conn: managed.ConnectionDetails{"password": []byte("new")}},
+		},
+		"GetConnectionSecretFailed": {
+			cr:   withSecrets(smInstance()),
+			get:  test.NewMockGetFn(errBoom),
+			want: want{err: errors.Wrap(errBoom, errGetConnectionSecret)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			conn, err := completeConnectionDetails(context.Background(), &test.MockClient{MockGet: tc.get}, tc.cr, tc.conn)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("completeConnectionDetails(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.conn, conn); diff != "" {
+				t.Errorf("completeConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSecretsManagerUnpublish(t *testing.T) {
+	type want struct {
+		err          error
+		forceDeleted bool
+		put          *string
+	}
+
+	cases := map[string]struct {
+		cr   *v1beta1.RDSInstance
+		sm   *fake.MockClient
+		want want
+	}{
+		"NotFound": {
+			cr: smInstance(),
+			sm: &fake.MockClient{
+				MockDescribe: describeSecret(awserr.New(awssm.ErrCodeResourceNotFoundException, "", nil)),
+			},
+		},
+		"DescribeFailed": {
+			cr: smInstance(),
+			sm: &fake.MockClient{
+				MockDescribe: describeSecret(errBoom),
+			},
+			want: want{err: awsclient.Wrap(errBoom, errDescribeSMSecretFailed)},
+		},
+		"Owned": {
+			cr: smInstance(),
+			sm: &fake.MockClient{
+				MockDescribe: describeSecret(nil, ownerTag("db-uid")),
+			},
+			want: want{forceDeleted: true},
+		},
+		"OwnedByAnotherInstance": {
+			cr: smInstance(),
+			sm: &fake.MockClient{
+				MockDescribe: describeSecret(nil, ownerTag("other-db-uid")),
+				MockGetValue: getSecretValue(nil, `{"password":"pw","other":"keep"}`),
+			},
+			want: want{put: aws.String(`{"other":"keep"}`)},
+		},
+		"PreExisting": {
+			cr: func() *v1beta1.RDSInstance {
+				cr := smInstance()
+				cr.Spec.ConnectionSecretKeyMap = map[string]string{"endpoint": "DB_HOST"}
+				return cr
+			}(),
+			sm: &fake.MockClient{
+				MockDescribe: describeSecret(nil),
+				MockGetValue: getSecretValue(nil, `{"DB_HOST":"db.example.org","endpoint":"keep","password":"pw","api-key":"keep"}`),
+			},
+			want: want{put: aws.String(`{"api-key":"keep","endpoint":"keep"}`)},
+		},
+		"PreExistingWithoutConnectionDetails": {
+			cr: smInstance(),
+			sm: &fake.MockClient{
+				MockDescribe: describeSecret(nil),
+				MockGetValue: getSecretValue(nil, `{"api-key":"keep"}`),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got want
+			tc.sm.MockDelete = func(in *awssm.DeleteSecretInput) awssm.DeleteSecretRequest {
+				got.forceDeleted = aws.BoolValue(in.ForceDeleteWithoutRecovery)
+				return awssm.DeleteSecretRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssm.DeleteSecretOutput{}}}
+			}
+			tc.sm.MockPutValue = func(in *awssm.PutSecretValueInput) awssm.PutSecretValueRequest {
+				got.put = in.SecretString
+				return awssm.PutSecretValueRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssm.PutSecretValueOutput{}}}
+			}
+			got.err = unpublish(context.Background(), tc.sm, tc.cr)
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors(), cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("unpublish(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"sort"
 
 	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	svcsdk "github.com/aws/aws-sdk-go/service/dynamodb"
 	svcsdkapi "github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/google/go-cmp/cmp"
@@ -44,8 +45,15 @@ import (
 	aws "github.com/crossplane/provider-aws/pkg/clients"
 )
 
+const (
+	errDescribeTimeToLive        = "cannot describe time to live of Table"
+	errUpdateTimeToLive          = "cannot update time to live of Table"
+	errDescribeContinuousBackups = "cannot describe continuous backups of Table"
+	errUpdateContinuousBackups   = "cannot update continuous backups of Table"
+)
+
 // SetupTable adds a controller that reconciles Table.
-func SetupTable(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+func SetupTable(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o aws.Options) error {
 	name := managed.ControllerName(svcapitypes.TableGroupKind)
 	opts := []option{
 		func(e *external) {
@@ -54,24 +62,28 @@ func SetupTable(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) er
 			e.preCreate = preCreate
 			e.preDelete = preDelete
 			e.lateInitialize = lateInitialize
-			e.isUpToDate = isUpToDate
+			o := &observer{client: e.client}
+			e.isUpToDate = o.isUpToDate
 			u := &updateClient{client: e.client}
 			e.preUpdate = u.preUpdate
+			e.client = &tableClient{DynamoDBAPI: e.client}
 		},
 	}
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
 		}).
 		For(&svcapitypes.Table{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.TableGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(aws.NewConnecter(&connector{kube: mgr.GetClient(), opts: opts}, o)),
 			managed.WithInitializers(
 				managed.NewNameAsExternalName(mgr.GetClient()),
 				managed.NewDefaultProviderConfig(mgr.GetClient()),
 				&tagger{kube: mgr.GetClient()}),
+			managed.WithTimeout(o.ReconcileTimeout),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -272,16 +284,93 @@ func isUpToDate(cr *svcapitypes.Table, resp *svcsdk.DescribeTableOutput) (bool,
 	if err != nil {
 		return false, err
 	}
+	ignore := []string{"Region", "Tags", "GlobalSecondaryIndexes", "KeySchema", "LocalSecondaryIndexes", "CustomTableParameters"}
+	if awsgo.BoolValue(cr.Spec.ForProvider.ProvisionedThroughputAutoScaled) {
+		ignore = append(ignore, "ProvisionedThroughput")
+	}
 	return cmp.Equal(&svcapitypes.TableParameters{}, patch,
 		cmpopts.IgnoreTypes(&xpv1.Reference{}, &xpv1.Selector{}, []xpv1.Reference{}),
-		cmpopts.IgnoreFields(svcapitypes.TableParameters{}, "Region", "Tags", "GlobalSecondaryIndexes", "KeySchema", "LocalSecondaryIndexes", "CustomTableParameters")), nil
+		cmpopts.IgnoreFields(svcapitypes.TableParameters{}, ignore...)), nil
+}
+
+type observer struct {
+	client svcsdkapi.DynamoDBAPI
+}
+
+func (o *observer) isUpToDate(cr *svcapitypes.Table, resp *svcsdk.DescribeTableOutput) (bool, error) {
+	upToDate, err := isUpToDate(cr, resp)
+	if err != nil || !upToDate {
+		return upToDate, err
+	}
+	// TODO: isUpToDate hook does not receive the context yet.
+	ctx := context.TODO()
+	name := aws.String(meta.GetExternalName(cr))
+	if cr.Spec.ForProvider.TimeToLiveSpecification != nil {
+		ttl, err := o.client.DescribeTimeToLiveWithContext(ctx, &svcsdk.DescribeTimeToLiveInput{TableName: name})
+		if err != nil {
+			return false, aws.Wrap(err, errDescribeTimeToLive)
+		}
+		if !isTimeToLiveUpToDate(cr.Spec.ForProvider.TimeToLiveSpecification, ttl.TimeToLiveDescription) {
+			return false, nil
+		}
+	}
+	if cr.Spec.ForProvider.PointInTimeRecoveryEnabled != nil {
+		cb, err := o.client.DescribeContinuousBackupsWithContext(ctx, &svcsdk.DescribeContinuousBackupsInput{TableName: name})
+		if err != nil {
+			return false, aws.Wrap(err, errDescribeContinuousBackups)
+		}
+		if !isPointInTimeRecoveryUpToDate(cr.Spec.ForProvider.PointInTimeRecoveryEnabled, cb.ContinuousBackupsDescription) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// isTimeToLiveUpToDate returns whether the observed time to live settings
+// match the desired ones. The attribute name is only compared when time to
+// live is desired to be enabled.
+func isTimeToLiveUpToDate(spec *svcapitypes.CustomTimeToLiveSpecification, obs *svcsdk.TimeToLiveDescription) bool {
+	status := svcsdk.TimeToLiveStatusDisabled
+	if obs != nil {
+		status = aws.StringValue(obs.TimeToLiveStatus)
+	}
+	switch status {
+	case svcsdk.TimeToLiveStatusEnabled, svcsdk.TimeToLiveStatusEnabling:
+		return spec.Enabled && spec.AttributeName == aws.StringValue(obs.AttributeName)
+	default:
+		return !spec.Enabled
+	}
+}
+
+// isPointInTimeRecoveryUpToDate returns whether the observed point in time
+// recovery status matches the desired one.
+func isPointInTimeRecoveryUpToDate(enabled *bool, obs *svcsdk.ContinuousBackupsDescription) bool {
+	observed := obs != nil && obs.PointInTimeRecoveryDescription != nil &&
+		aws.StringValue(obs.PointInTimeRecoveryDescription.PointInTimeRecoveryStatus) == svcsdk.PointInTimeRecoveryStatusEnabled
+	return awsgo.BoolValue(enabled) == observed
+}
+
+// tableClient skips UpdateTable calls that carry no change for the table
+// itself, which is the case when preUpdate has only updated the time to live
+// or point in time recovery settings through their own calls.
+type tableClient struct {
+	svcsdkapi.DynamoDBAPI
+}
+
+func (c *tableClient) UpdateTableWithContext(ctx awsgo.Context, in *svcsdk.UpdateTableInput, opts ...request.Option) (*svcsdk.UpdateTableOutput, error) {
+	if in.ProvisionedThroughput == nil && in.StreamSpecification == nil &&
+		in.BillingMode == nil && in.SSESpecification == nil && len(in.AttributeDefinitions) == 0 &&
+		len(in.GlobalSecondaryIndexUpdates) == 0 && len(in.ReplicaUpdates) == 0 {
+		return &svcsdk.UpdateTableOutput{}, nil
+	}
+	return c.DynamoDBAPI.UpdateTableWithContext(ctx, in, opts...)
 }
 
 type updateClient struct {
 	client svcsdkapi.DynamoDBAPI
 }
 
-func (e *updateClient) preUpdate(_ context.Context, cr *svcapitypes.Table, u *svcsdk.UpdateTableInput) error {
+func (e *updateClient) preUpdate(ctx context.Context, cr *svcapitypes.Table, u *svcsdk.UpdateTableInput) error {
 	switch aws.StringValue(cr.Status.AtProvider.TableStatus) {
 	case string(svcapitypes.TableStatus_SDK_UPDATING), string(svcapitypes.TableStatus_SDK_CREATING):
 		return nil
@@ -290,6 +379,12 @@ func (e *updateClient) preUpdate(_ context.Context, cr *svcapitypes.Table, u *sv
 	if err != nil {
 		return aws.Wrap(err, errDescribe)
 	}
+	if err := e.updateTimeToLive(ctx, cr); err != nil {
+		return err
+	}
+	if err := e.updatePointInTimeRecovery(ctx, cr); err != nil {
+		return err
+	}
 
 	newUpdateObj := &svcsdk.UpdateTableInput{
 		TableName: aws.String(meta.GetExternalName(cr)),
@@ -298,6 +393,7 @@ func (e *updateClient) preUpdate(_ context.Context, cr *svcapitypes.Table, u *sv
 	// See https://github.com/aws/aws-sdk-go/blob/v1.34.32/service/dynamodb/api.go#L5605
 	switch {
 	case cr.Spec.ForProvider.ProvisionedThroughput != nil &&
+		!awsgo.BoolValue(cr.Spec.ForProvider.ProvisionedThroughputAutoScaled) &&
 		(aws.Int64Value(t.Table.ProvisionedThroughput.ReadCapacityUnits) != aws.Int64Value(cr.Spec.ForProvider.ProvisionedThroughput.ReadCapacityUnits) ||
 			aws.Int64Value(t.Table.ProvisionedThroughput.WriteCapacityUnits) != aws.Int64Value(cr.Spec.ForProvider.ProvisionedThroughput.WriteCapacityUnits)):
 		newUpdateObj.ProvisionedThroughput = u.ProvisionedThroughput
@@ -307,7 +403,15 @@ func (e *updateClient) preUpdate(_ context.Context, cr *svcapitypes.Table, u *sv
 		(awsgo.BoolValue(t.Table.StreamSpecification.StreamEnabled) != awsgo.BoolValue(cr.Spec.ForProvider.StreamSpecification.StreamEnabled)):
 		newUpdateObj.StreamSpecification = u.StreamSpecification
 	default:
-		return errors.New("only provisionedThroughput and streamSpecification updates are supported")
+		// The time to live and point in time recovery settings are updated
+		// above, in which case there is nothing left to update on the table.
+		upToDate, err := isUpToDate(cr, t)
+		if err != nil {
+			return err
+		}
+		if !upToDate {
+			return errors.New("only provisionedThroughput and streamSpecification updates are supported")
+		}
 	}
 	// TODO(muvaf): ReplicationGroupUpdate and GlobalSecondaryIndexUpdate features
 	// are not implemented yet.
@@ -315,3 +419,63 @@ func (e *updateClient) preUpdate(_ context.Context, cr *svcapitypes.Table, u *sv
 	*u = *newUpdateObj
 	return nil
 }
+
+func (e *updateClient) updateTimeToLive(ctx context.Context, cr *svcapitypes.Table) error {
+	spec := cr.Spec.ForProvider.TimeToLiveSpecification
+	if spec == nil {
+		return nil
+	}
+	name := aws.String(meta.GetExternalName(cr))
+	ttl, err := e.client.DescribeTimeToLiveWithContext(ctx, &svcsdk.DescribeTimeToLiveInput{TableName: name})
+	if err != nil {
+		return aws.Wrap(err, errDescribeTimeToLive)
+	}
+	if isTimeToLiveUpToDate(spec, ttl.TimeToLiveDescription) {
+		return nil
+	}
+	attr := spec.AttributeName
+	if d := ttl.TimeToLiveDescription; d != nil {
+		switch aws.StringValue(d.TimeToLiveStatus) {
+		// Time to live cannot be changed while a previous change is still
+		// in progress.
+		case svcsdk.TimeToLiveStatusEnabling, svcsdk.TimeToLiveStatusDisabling:
+			return nil
+		// Disabling time to live requires the name of the attribute it is
+		// currently enabled on.
+		case svcsdk.TimeToLiveStatusEnabled:
+			if !spec.Enabled {
+				attr = aws.StringValue(d.AttributeName)
+			}
+		}
+	}
+	_, err = e.client.UpdateTimeToLiveWithContext(ctx, &svcsdk.UpdateTimeToLiveInput{
+		TableName: name,
+		TimeToLiveSpecification: &svcsdk.TimeToLiveSpecification{
+			AttributeName: aws.String(attr),
+			Enabled:       awsgo.Bool(spec.Enabled),
+		},
+	})
+	return aws.Wrap(err, errUpdateTimeToLive)
+}
+
+func (e *updateClient) updatePointInTimeRecovery(ctx context.Context, cr *svcapitypes.Table) error {
+	enabled := cr.Spec.ForProvider.PointInTimeRecoveryEnabled
+	if enabled == nil {
+		return nil
+	}
+	name := aws.String(meta.GetExternalName(cr))
+	cb, err := e.client.DescribeContinuousBackupsWithContext(ctx, &svcsdk.DescribeContinuousBackupsInput{TableName: name})
+	if err != nil {
+		return aws.Wrap(err, errDescribeContinuousBackups)
+	}
+	if isPointInTimeRecoveryUpToDate(enabled, cb.ContinuousBackupsDescription) {
+		return nil
+	}
+	_, err = e.client.UpdateContinuousBackupsWithContext(ctx, &svcsdk.UpdateContinuousBackupsInput{
+		TableName: name,
+		PointInTimeRecoverySpecification: &svcsdk.PointInTimeRecoverySpecification{
+			PointInTimeRecoveryEnabled: enabled,
+		},
+	})
+	return aws.Wrap(err, errUpdateContinuousBackups)
+}
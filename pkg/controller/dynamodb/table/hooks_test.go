@@ -198,6 +198,34 @@ func TestIsUpToDate(t *testing.T) {
 				result: false,
 			},
 		},
+		"AutoScaledThroughput": {
+			args: args{
+				t: svcsdk.DescribeTableOutput{
+					Table: &svcsdk.TableDescription{
+						ProvisionedThroughput: &svcsdk.ProvisionedThroughputDescription{
+							ReadCapacityUnits:  aws.Int64(int64(readCapacityUnits + 10)),
+							WriteCapacityUnits: aws.Int64(int64(writeCapacityUnits)),
+						},
+					},
+				},
+				p: v1alpha1.Table{
+					Spec: v1alpha1.TableSpec{
+						ForProvider: v1alpha1.TableParameters{
+							ProvisionedThroughput: &v1alpha1.ProvisionedThroughput{
+								ReadCapacityUnits:  aws.Int64(int64(readCapacityUnits)),
+								WriteCapacityUnits: aws.Int64(int64(writeCapacityUnits)),
+							},
+							CustomTableParameters: v1alpha1.CustomTableParameters{
+								ProvisionedThroughputAutoScaled: aws.Bool(true),
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				result: true,
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -276,3 +304,112 @@ func TestLateInitialize(t *testing.T) {
 		})
 	}
 }
+
+func TestIsTimeToLiveUpToDate(t *testing.T) {
+	type args struct {
+		spec *v1alpha1.CustomTimeToLiveSpecification
+		obs  *svcsdk.TimeToLiveDescription
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"Enabled": {
+			args: args{
+				spec: &v1alpha1.CustomTimeToLiveSpecification{AttributeName: "expiry", Enabled: true},
+				obs:  &svcsdk.TimeToLiveDescription{AttributeName: aws.String("expiry"), TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabled)},
+			},
+			want: true,
+		},
+		"DifferentAttribute": {
+			args: args{
+				spec: &v1alpha1.CustomTimeToLiveSpecification{AttributeName: "expiry", Enabled: true},
+				obs:  &svcsdk.TimeToLiveDescription{AttributeName: aws.String("ttl"), TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabling)},
+			},
+			want: false,
+		},
+		"NeedsEnabling": {
+			args: args{
+				spec: &v1alpha1.CustomTimeToLiveSpecification{AttributeName: "expiry", Enabled: true},
+				obs:  &svcsdk.TimeToLiveDescription{TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusDisabled)},
+			},
+			want: false,
+		},
+		"NeedsDisabling": {
+			args: args{
+				spec: &v1alpha1.CustomTimeToLiveSpecification{AttributeName: "expiry"},
+				obs:  &svcsdk.TimeToLiveDescription{AttributeName: aws.String("ttl"), TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabled)},
+			},
+			want: false,
+		},
+		"Disabled": {
+			args: args{
+				spec: &v1alpha1.CustomTimeToLiveSpecification{AttributeName: "expiry"},
+				obs:  &svcsdk.TimeToLiveDescription{TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusDisabling)},
+			},
+			want: true,
+		},
+		"NoDescription": {
+			args: args{
+				spec: &v1alpha1.CustomTimeToLiveSpecification{AttributeName: "expiry"},
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := isTimeToLiveUpToDate(tc.args.spec, tc.args.obs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsPointInTimeRecoveryUpToDate(t *testing.T) {
+	type args struct {
+		enabled *bool
+		obs     *svcsdk.ContinuousBackupsDescription
+	}
+
+	pitr := func(status string) *svcsdk.ContinuousBackupsDescription {
+		return &svcsdk.ContinuousBackupsDescription{
+			PointInTimeRecoveryDescription: &svcsdk.PointInTimeRecoveryDescription{
+				PointInTimeRecoveryStatus: aws.String(status),
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"Enabled": {
+			args: args{enabled: aws.Bool(true), obs: pitr(svcsdk.PointInTimeRecoveryStatusEnabled)},
+			want: true,
+		},
+		"NeedsEnabling": {
+			args: args{enabled: aws.Bool(true), obs: pitr(svcsdk.PointInTimeRecoveryStatusDisabled)},
+			want: false,
+		},
+		"NeedsDisabling": {
+			args: args{enabled: aws.Bool(false), obs: pitr(svcsdk.PointInTimeRecoveryStatusEnabled)},
+			want: false,
+		},
+		"NoDescription": {
+			args: args{enabled: aws.Bool(false)},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := isPointInTimeRecoveryUpToDate(tc.args.enabled, tc.args.obs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -51,24 +51,26 @@ const (
 	errCreate        = "failed to create the Address resource"
 	errCreateTags    = "failed to create tags for the Address resource"
 	errDelete        = "failed to delete the Address resource"
+	errDisassociate  = "failed to disassociate the Address resource"
 	errStatusUpdate  = "cannot update status of Address custom resource"
 )
 
 // SetupAddress adds a controller that reconciles Address.
-func SetupAddress(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+func SetupAddress(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclient.Options) error {
 	name := managed.ControllerName(v1beta1.AddressGroupKind)
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
 		}).
 		For(&v1beta1.Address{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.AddressGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+			managed.WithExternalConnecter(awsclient.NewConnecter(&connector{kube: mgr.GetClient()}, o)),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithConnectionPublishers(),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
+			managed.WithTimeout(o.ReconcileTimeout),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -140,10 +142,18 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 
 	cr.Status.AtProvider = ec2.GenerateAddressObservation(observed)
 
+	var conn managed.ConnectionDetails
+	if cr.Status.AtProvider.PublicIP != "" {
+		conn = managed.ConnectionDetails{
+			v1beta1.ResourceCredentialsSecretPublicIPKey: []byte(cr.Status.AtProvider.PublicIP),
+		}
+	}
+
 	return managed.ExternalObservation{
 		ResourceExists:          true,
 		ResourceUpToDate:        ec2.IsAddressUpToDate(cr.Spec.ForProvider, observed),
 		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ConnectionDetails:       conn,
 	}, nil
 }
 
@@ -202,6 +212,17 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 	}
 
 	cr.Status.SetConditions(xpv1.Deleting())
+
+	// Addresses in a VPC can't be released while they are associated.
+	if cr.Status.AtProvider.AssociationID != "" {
+		_, err := e.client.DisassociateAddressRequest(&awsec2.DisassociateAddressInput{
+			AssociationId: aws.String(cr.Status.AtProvider.AssociationID),
+		}).Send(ctx)
+		if resource.Ignore(ec2.IsAddressAssociationNotFoundErr, err) != nil {
+			return awsclient.Wrap(err, errDisassociate)
+		}
+	}
+
 	var err error
 	if ec2.IsStandardDomain(cr.Spec.ForProvider) {
 		_, err = e.client.ReleaseAddressRequest(&awsec2.ReleaseAddressInput{
@@ -46,6 +46,7 @@ var (
 	domainVpc      = "vpc"
 	domainStandard = "standard"
 	publicIP       = "1.1.1.1"
+	associationID  = "some association"
 	errBoom        = errors.New("boom")
 )
 
@@ -138,6 +139,46 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"PublishesPublicIP": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockClient().Update,
+				},
+				address: &fake.MockAddressClient{
+					MockDescribe: func(input *awsec2.DescribeAddressesInput) awsec2.DescribeAddressesRequest {
+						return awsec2.DescribeAddressesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeAddressesOutput{
+								Addresses: []awsec2.Address{{
+									AllocationId: &allocationID,
+									PublicIp:     &publicIP,
+								}},
+							}},
+						}
+					},
+				},
+				cr: address(withSpec(v1beta1.AddressParameters{
+					Domain:  &domainVpc,
+					Address: &publicIP,
+				}), withExternalName(allocationID)),
+			},
+			want: want{
+				cr: address(withSpec(v1beta1.AddressParameters{
+					Domain:  &domainVpc,
+					Address: &publicIP,
+				}), withStatus(v1beta1.AddressObservation{
+					AllocationID: allocationID,
+					PublicIP:     publicIP,
+				}), withExternalName(allocationID),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1beta1.ResourceCredentialsSecretPublicIPKey: []byte(publicIP),
+					},
+				},
+			},
+		},
 		"MultipleAddresses": {
 			args: args{
 				kube: &test.MockClient{
@@ -425,6 +466,44 @@ func TestRelease(t *testing.T) {
 				),
 			},
 		},
+		"DisassociateFirst": {
+			args: args{
+				address: &fake.MockAddressClient{
+					MockDisassociate: func(input *awsec2.DisassociateAddressInput) awsec2.DisassociateAddressRequest {
+						return awsec2.DisassociateAddressRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DisassociateAddressOutput{}},
+						}
+					},
+					MockRelease: func(input *awsec2.ReleaseAddressInput) awsec2.ReleaseAddressRequest {
+						return awsec2.ReleaseAddressRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ReleaseAddressOutput{}},
+						}
+					},
+				},
+				cr: address(withStatus(v1beta1.AddressObservation{AssociationID: associationID})),
+			},
+			want: want{
+				cr: address(withStatus(v1beta1.AddressObservation{AssociationID: associationID}),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"DisassociateFailed": {
+			args: args{
+				address: &fake.MockAddressClient{
+					MockDisassociate: func(input *awsec2.DisassociateAddressInput) awsec2.DisassociateAddressRequest {
+						return awsec2.DisassociateAddressRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: address(withStatus(v1beta1.AddressObservation{AssociationID: associationID})),
+			},
+			want: want{
+				cr: address(withStatus(v1beta1.AddressObservation{AssociationID: associationID}),
+					withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDisassociate),
+			},
+		},
 		"DeleteFailed": {
 			args: args{
 				address: &fake.MockAddressClient{
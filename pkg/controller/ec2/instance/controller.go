@@ -0,0 +1,278 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an Instance resource"
+	errKubeUpdateFailed = "cannot update Instance custom resource"
+	errDescribe         = "failed to describe Instance"
+	errCreate           = "failed to create the Instance resource"
+	errDelete           = "failed to delete the Instance resource"
+	errStart            = "failed to start the Instance resource"
+	errStop             = "failed to stop the Instance resource"
+	errModifyGroups     = "failed to modify security groups of the Instance resource"
+	errDescribeAddress  = "failed to describe the Elastic IP address of the Instance resource"
+	errAssociateAddress = "failed to associate the Elastic IP address with the Instance resource"
+	errCreateTags       = "failed to create tags for the Instance resource"
+	errDeleteTags       = "failed to delete tags for the Instance resource"
+)
+
+// SetupInstance adds a controller that reconciles Instances.
+func SetupInstance(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclient.Options) error {
+	name := managed.ControllerName(v1alpha1.InstanceGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		For(&v1alpha1.Instance{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.InstanceGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewInstanceClient}, o)),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithTimeout(o.ReconcileTimeout),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.InstanceClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.InstanceClient
+}
+
+func (e *external) describe(ctx context.Context, cr *v1alpha1.Instance) (*awsec2.Instance, error) {
+	rsp, err := e.client.DescribeInstancesRequest(&awsec2.DescribeInstancesInput{
+		InstanceIds: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range rsp.Reservations {
+		if len(r.Instances) != 0 {
+			return &r.Instances[0], nil
+		}
+	}
+	return nil, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ec2.IsInstanceNotFoundErr, err), errDescribe)
+	}
+	// Terminated instances stay visible for a while after they are gone.
+	if observed == nil || (observed.State != nil && observed.State.Name == awsec2.InstanceStateNameTerminated) {
+		return managed.ExternalObservation{}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeInstance(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	cr.Status.AtProvider = ec2.GenerateInstanceObservation(*observed)
+
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.InstanceStatePending:
+		cr.SetConditions(xpv1.Creating())
+	case v1alpha1.InstanceStateRunning:
+		cr.SetConditions(xpv1.Available())
+	case v1alpha1.InstanceStateShuttingDown:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	if cr.Spec.ForProvider.AllocationID != nil {
+		rsp, err := e.client.DescribeAddressesRequest(&awsec2.DescribeAddressesInput{
+			AllocationIds: []string{aws.StringValue(cr.Spec.ForProvider.AllocationID)},
+		}).Send(ctx)
+		if err != nil {
+			return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribeAddress)
+		}
+		a := ec2.FindInstanceAddress(aws.StringValue(cr.Spec.ForProvider.AllocationID), rsp.Addresses)
+		if a != nil && aws.StringValue(a.InstanceId) == meta.GetExternalName(cr) {
+			cr.Status.AtProvider.AddressAssociationID = aws.StringValue(a.AssociationId)
+		}
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: ec2.IsInstanceUpToDate(cr.Spec.ForProvider, *observed) &&
+			(cr.Spec.ForProvider.AllocationID == nil || cr.Status.AtProvider.AddressAssociationID != ""),
+		ConnectionDetails: ec2.GetInstanceConnectionDetails(cr.Status.AtProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	rsp, err := e.client.RunInstancesRequest(ec2.GenerateRunInstancesInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	if len(rsp.Instances) == 0 {
+		return managed.ExternalCreation{}, errors.New(errCreate)
+	}
+	meta.SetExternalName(cr, aws.StringValue(rsp.Instances[0].InstanceId))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+	id := meta.GetExternalName(cr)
+
+	// Instances can only be started once they are stopped and stopped once
+	// they are running, so transitions are picked up by a later reconcile.
+	switch {
+	case ec2.GetInstancePower(cr.Spec.ForProvider) == v1alpha1.InstancePowerRunning && cr.Status.AtProvider.State == v1alpha1.InstanceStateStopped:
+		if _, err := e.client.StartInstancesRequest(&awsec2.StartInstancesInput{InstanceIds: []string{id}}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errStart)
+		}
+	case ec2.GetInstancePower(cr.Spec.ForProvider) == v1alpha1.InstancePowerStopped && cr.Status.AtProvider.State == v1alpha1.InstanceStateRunning:
+		if _, err := e.client.StopInstancesRequest(&awsec2.StopInstancesInput{InstanceIds: []string{id}}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errStop)
+		}
+	}
+
+	if !ec2.IsInstanceSecurityGroupsUpToDate(cr.Spec.ForProvider, *observed) {
+		if _, err := e.client.ModifyInstanceAttributeRequest(&awsec2.ModifyInstanceAttributeInput{
+			InstanceId: aws.String(id),
+			Groups:     cr.Spec.ForProvider.SecurityGroupIDs,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyGroups)
+		}
+	}
+
+	// Addresses can't be associated with pending instances.
+	if cr.Spec.ForProvider.AllocationID != nil && cr.Status.AtProvider.AddressAssociationID == "" &&
+		(cr.Status.AtProvider.State == v1alpha1.InstanceStateRunning || cr.Status.AtProvider.State == v1alpha1.InstanceStateStopped) {
+		rsp, err := e.client.AssociateAddressRequest(&awsec2.AssociateAddressInput{
+			AllocationId:       cr.Spec.ForProvider.AllocationID,
+			InstanceId:         aws.String(id),
+			AllowReassociation: aws.Bool(true),
+		}).Send(ctx)
+		if err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errAssociateAddress)
+		}
+		cr.Status.AtProvider.AddressAssociationID = aws.StringValue(rsp.AssociationId)
+	}
+
+	add, remove := awsclient.DiffEC2Tags(ec2.GenerateEC2Tags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awsec2.DeleteTagsInput{
+			Resources: []string{id},
+			Tags:      remove,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{id},
+			Tags:      add,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreateTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Instance)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.State == v1alpha1.InstanceStateShuttingDown {
+		return nil
+	}
+
+	_, err := e.client.TerminateInstancesRequest(&awsec2.TerminateInstancesInput{
+		InstanceIds: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	return awsclient.Wrap(resource.Ignore(ec2.IsInstanceNotFoundErr, err), errDelete)
+}
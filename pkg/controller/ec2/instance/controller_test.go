@@ -0,0 +1,614 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	instanceID = "i-123"
+	privateIP  = "10.0.0.1"
+	sgID       = "sg-1"
+	allocID    = "eipalloc-1"
+	assocID    = "eipassoc-1"
+	stopped    = v1alpha1.InstancePowerStopped
+	errBoom    = errors.New("boom")
+)
+
+type args struct {
+	kube     client.Client
+	instance ec2.InstanceClient
+	cr       *v1alpha1.Instance
+}
+
+type instanceModifier func(*v1alpha1.Instance)
+
+func withExternalName(name string) instanceModifier {
+	return func(r *v1alpha1.Instance) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) instanceModifier {
+	return func(r *v1alpha1.Instance) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.InstanceParameters) instanceModifier {
+	return func(r *v1alpha1.Instance) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.InstanceObservation) instanceModifier {
+	return func(r *v1alpha1.Instance) { r.Status.AtProvider = s }
+}
+
+func instance(m ...instanceModifier) *v1alpha1.Instance {
+	cr := &v1alpha1.Instance{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeInstance(state awsec2.InstanceStateName, groups ...string) func(*awsec2.DescribeInstancesInput) awsec2.DescribeInstancesRequest {
+	sgs := make([]awsec2.GroupIdentifier, len(groups))
+	for i, g := range groups {
+		sgs[i] = awsec2.GroupIdentifier{GroupId: aws.String(g)}
+	}
+	return func(_ *awsec2.DescribeInstancesInput) awsec2.DescribeInstancesRequest {
+		return awsec2.DescribeInstancesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeInstancesOutput{
+				Reservations: []awsec2.Reservation{{
+					Instances: []awsec2.Instance{{
+						InstanceId:       aws.String(instanceID),
+						PrivateIpAddress: aws.String(privateIP),
+						State:            &awsec2.InstanceState{Name: state},
+						SecurityGroups:   sgs,
+					}},
+				}},
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Instance
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Running": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribe: describeInstance(awsec2.InstanceStateNameRunning, sgID),
+				},
+				cr: instance(withExternalName(instanceID), withSpec(v1alpha1.InstanceParameters{
+					SecurityGroupIDs: []string{sgID},
+				})),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID),
+					withSpec(v1alpha1.InstanceParameters{SecurityGroupIDs: []string{sgID}}),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.InstanceObservation{
+						InstanceID:       instanceID,
+						PrivateIPAddress: privateIP,
+						State:            v1alpha1.InstanceStateRunning,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1alpha1.ResourceCredentialsSecretInstanceIDKey: []byte(instanceID),
+						v1alpha1.ResourceCredentialsSecretPrivateIPKey:  []byte(privateIP),
+					},
+				},
+			},
+		},
+		"AddressNotAssociated": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribe: describeInstance(awsec2.InstanceStateNameRunning, sgID),
+					MockDescribeAddresses: func(_ *awsec2.DescribeAddressesInput) awsec2.DescribeAddressesRequest {
+						return awsec2.DescribeAddressesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeAddressesOutput{
+								Addresses: []awsec2.Address{{AllocationId: aws.String(allocID)}},
+							}},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID), withSpec(v1alpha1.InstanceParameters{
+					SecurityGroupIDs: []string{sgID},
+					AllocationID:     aws.String(allocID),
+				})),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID),
+					withSpec(v1alpha1.InstanceParameters{SecurityGroupIDs: []string{sgID}, AllocationID: aws.String(allocID)}),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.InstanceObservation{
+						InstanceID:       instanceID,
+						PrivateIPAddress: privateIP,
+						State:            v1alpha1.InstanceStateRunning,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1alpha1.ResourceCredentialsSecretInstanceIDKey: []byte(instanceID),
+						v1alpha1.ResourceCredentialsSecretPrivateIPKey:  []byte(privateIP),
+					},
+				},
+			},
+		},
+		"AddressAssociated": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribe: describeInstance(awsec2.InstanceStateNameRunning, sgID),
+					MockDescribeAddresses: func(_ *awsec2.DescribeAddressesInput) awsec2.DescribeAddressesRequest {
+						return awsec2.DescribeAddressesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeAddressesOutput{
+								Addresses: []awsec2.Address{{
+									AllocationId:  aws.String(allocID),
+									AssociationId: aws.String(assocID),
+									InstanceId:    aws.String(instanceID),
+								}},
+							}},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID), withSpec(v1alpha1.InstanceParameters{
+					SecurityGroupIDs: []string{sgID},
+					AllocationID:     aws.String(allocID),
+				})),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID),
+					withSpec(v1alpha1.InstanceParameters{SecurityGroupIDs: []string{sgID}, AllocationID: aws.String(allocID)}),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.InstanceObservation{
+						InstanceID:           instanceID,
+						PrivateIPAddress:     privateIP,
+						State:                v1alpha1.InstanceStateRunning,
+						AddressAssociationID: assocID,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1alpha1.ResourceCredentialsSecretInstanceIDKey: []byte(instanceID),
+						v1alpha1.ResourceCredentialsSecretPrivateIPKey:  []byte(privateIP),
+					},
+				},
+			},
+		},
+		"Pending": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribe: describeInstance(awsec2.InstanceStateNamePending, sgID),
+				},
+				cr: instance(withExternalName(instanceID), withSpec(v1alpha1.InstanceParameters{
+					SecurityGroupIDs: []string{sgID},
+				})),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID),
+					withSpec(v1alpha1.InstanceParameters{SecurityGroupIDs: []string{sgID}}),
+					withConditions(xpv1.Creating()),
+					withStatus(v1alpha1.InstanceObservation{
+						InstanceID:       instanceID,
+						PrivateIPAddress: privateIP,
+						State:            v1alpha1.InstanceStatePending,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1alpha1.ResourceCredentialsSecretInstanceIDKey: []byte(instanceID),
+						v1alpha1.ResourceCredentialsSecretPrivateIPKey:  []byte(privateIP),
+					},
+				},
+			},
+		},
+		"LateInitFailedKubeUpdate": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				instance: &fake.MockInstanceClient{
+					MockDescribe: describeInstance(awsec2.InstanceStateNameRunning, sgID),
+				},
+				cr: instance(withExternalName(instanceID)),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID),
+					withSpec(v1alpha1.InstanceParameters{SecurityGroupIDs: []string{sgID}})),
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+		"Terminated": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribe: describeInstance(awsec2.InstanceStateNameTerminated),
+				},
+				cr: instance(withExternalName(instanceID)),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribe: func(_ *awsec2.DescribeInstancesInput) awsec2.DescribeInstancesRequest {
+						return awsec2.DescribeInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(ec2.InstanceIDNotFound, "", nil)},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID)),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID)),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribe: func(_ *awsec2.DescribeInstancesInput) awsec2.DescribeInstancesRequest {
+						return awsec2.DescribeInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID)),
+			},
+			want: want{
+				cr:  instance(withExternalName(instanceID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				instance: &fake.MockInstanceClient{},
+				cr:       instance(),
+			},
+			want: want{
+				cr: instance(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.instance}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Instance
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockRun: func(_ *awsec2.RunInstancesInput) awsec2.RunInstancesRequest {
+						return awsec2.RunInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.RunInstancesOutput{
+								Instances: []awsec2.Instance{{InstanceId: aws.String(instanceID)}},
+							}},
+						}
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr:     instance(withExternalName(instanceID)),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockRun: func(_ *awsec2.RunInstancesInput) awsec2.RunInstancesRequest {
+						return awsec2.RunInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr:  instance(),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.instance}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Stop": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribe: describeInstance(awsec2.InstanceStateNameRunning, sgID),
+					MockStop: func(_ *awsec2.StopInstancesInput) awsec2.StopInstancesRequest {
+						return awsec2.StopInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.StopInstancesOutput{}},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID),
+					withSpec(v1alpha1.InstanceParameters{Power: &stopped, SecurityGroupIDs: []string{sgID}}),
+					withStatus(v1alpha1.InstanceObservation{State: v1alpha1.InstanceStateRunning})),
+			},
+		},
+		"StartFailed": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribe: describeInstance(awsec2.InstanceStateNameStopped, sgID),
+					MockStart: func(_ *awsec2.StartInstancesInput) awsec2.StartInstancesRequest {
+						return awsec2.StartInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID),
+					withSpec(v1alpha1.InstanceParameters{SecurityGroupIDs: []string{sgID}}),
+					withStatus(v1alpha1.InstanceObservation{State: v1alpha1.InstanceStateStopped})),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errStart),
+			},
+		},
+		"ModifySecurityGroups": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribe: describeInstance(awsec2.InstanceStateNameRunning, sgID),
+					MockModifyInstanceAttribute: func(in *awsec2.ModifyInstanceAttributeInput) awsec2.ModifyInstanceAttributeRequest {
+						if diff := cmp.Diff([]string{"sg-2"}, in.Groups); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.ModifyInstanceAttributeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ModifyInstanceAttributeOutput{}},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID),
+					withSpec(v1alpha1.InstanceParameters{SecurityGroupIDs: []string{"sg-2"}}),
+					withStatus(v1alpha1.InstanceObservation{State: v1alpha1.InstanceStateRunning})),
+			},
+		},
+		"AssociateAddress": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribe: describeInstance(awsec2.InstanceStateNameRunning, sgID),
+					MockAssociateAddress: func(in *awsec2.AssociateAddressInput) awsec2.AssociateAddressRequest {
+						if diff := cmp.Diff(allocID, aws.StringValue(in.AllocationId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.AssociateAddressRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.AssociateAddressOutput{
+								AssociationId: aws.String(assocID),
+							}},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID),
+					withSpec(v1alpha1.InstanceParameters{SecurityGroupIDs: []string{sgID}, AllocationID: aws.String(allocID)}),
+					withStatus(v1alpha1.InstanceObservation{State: v1alpha1.InstanceStateRunning})),
+			},
+		},
+		"AssociateAddressFailed": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribe: describeInstance(awsec2.InstanceStateNameRunning, sgID),
+					MockAssociateAddress: func(_ *awsec2.AssociateAddressInput) awsec2.AssociateAddressRequest {
+						return awsec2.AssociateAddressRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID),
+					withSpec(v1alpha1.InstanceParameters{SecurityGroupIDs: []string{sgID}, AllocationID: aws.String(allocID)}),
+					withStatus(v1alpha1.InstanceObservation{State: v1alpha1.InstanceStateRunning})),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errAssociateAddress),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribe: func(_ *awsec2.DescribeInstancesInput) awsec2.DescribeInstancesRequest {
+						return awsec2.DescribeInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.instance}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Instance
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockTerminate: func(_ *awsec2.TerminateInstancesInput) awsec2.TerminateInstancesRequest {
+						return awsec2.TerminateInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.TerminateInstancesOutput{}},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID)),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyShuttingDown": {
+			args: args{
+				instance: &fake.MockInstanceClient{},
+				cr: instance(withExternalName(instanceID),
+					withStatus(v1alpha1.InstanceObservation{State: v1alpha1.InstanceStateShuttingDown})),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID),
+					withStatus(v1alpha1.InstanceObservation{State: v1alpha1.InstanceStateShuttingDown}),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockTerminate: func(_ *awsec2.TerminateInstancesInput) awsec2.TerminateInstancesRequest {
+						return awsec2.TerminateInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(ec2.InstanceIDNotFound, "", nil)},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID)),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockTerminate: func(_ *awsec2.TerminateInstancesInput) awsec2.TerminateInstancesRequest {
+						return awsec2.TerminateInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID)),
+			},
+			want: want{
+				cr:  instance(withExternalName(instanceID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.instance}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -146,6 +146,9 @@ func TestObserve(t *testing.T) {
 				result: managed.ExternalObservation{
 					ResourceExists:   true,
 					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1beta1.ResourceCredentialsSecretInternetGatewayIDKey: []byte(igID),
+					},
 				},
 			},
 		},
@@ -0,0 +1,331 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package networkacl
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a NetworkACL resource"
+
+	errDescribe          = "failed to describe NetworkACL"
+	errMultipleItems     = "retrieved multiple NetworkACLs for the given networkAclId"
+	errCreate            = "failed to create the NetworkACL resource"
+	errUpdateNotFound    = "cannot update the NetworkACL, since the NetworkACLID is not present"
+	errDelete            = "failed to delete the NetworkACL resource"
+	errCreateEntry       = "failed to create an entry in the NetworkACL resource"
+	errReplaceEntry      = "failed to replace an entry in the NetworkACL resource"
+	errDeleteEntry       = "failed to delete an entry in the NetworkACL resource"
+	errDescribeSubnetACL = "failed to describe the network ACL the subnet is associated with"
+	errSubnetACLNotFound = "cannot find the network ACL association of the subnet"
+	errDescribeDefault   = "failed to describe the default network ACL of the VPC"
+	errDefaultNotFound   = "cannot find the default network ACL of the VPC"
+	errAssociateSubnet   = "failed to associate subnet to the NetworkACL resource"
+	errDisassociate      = "failed to move subnet back to the default network ACL"
+	errCreateTags        = "failed to create tags for the NetworkACL resource"
+	errDeleteTags        = "failed to delete tags for the NetworkACL resource"
+
+	filterAssociationSubnetID = "association.subnet-id"
+	filterVPCID               = "vpc-id"
+	filterDefault             = "default"
+)
+
+// SetupNetworkACL adds a controller that reconciles NetworkACLs.
+func SetupNetworkACL(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclient.Options) error {
+	name := managed.ControllerName(v1alpha1.NetworkACLGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		For(&v1alpha1.NetworkACL{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.NetworkACLGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewNetworkACLClient}, o)),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithTimeout(o.ReconcileTimeout),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.NetworkACLClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.NetworkACL)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.NetworkACLClient
+}
+
+func (e *external) describe(ctx context.Context, id string) ([]awsec2.NetworkAcl, error) {
+	response, err := e.client.DescribeNetworkAclsRequest(&awsec2.DescribeNetworkAclsInput{
+		NetworkAclIds: []string{id},
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return response.NetworkAcls, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.NetworkACL)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	acls, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ec2.IsNetworkACLNotFoundErr, err), errDescribe)
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(acls) != 1 {
+		return managed.ExternalObservation{}, errors.New(errMultipleItems)
+	}
+
+	observed := acls[0]
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeNetworkACL(&cr.Spec.ForProvider, &observed)
+
+	// A network ACL has no state; it is usable as soon as it exists.
+	cr.SetConditions(xpv1.Available())
+
+	cr.Status.AtProvider = ec2.GenerateNetworkACLObservation(observed)
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        ec2.IsNetworkACLUpToDate(cr.Spec.ForProvider, observed),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.NetworkACL)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	result, err := e.client.CreateNetworkAclRequest(&awsec2.CreateNetworkAclInput{
+		VpcId: cr.Spec.ForProvider.VPCID,
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.StringValue(result.NetworkAcl.NetworkAclId))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.NetworkACL)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	acls, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(ec2.IsNetworkACLNotFoundErr, err), errDescribe)
+	}
+	if len(acls) == 0 {
+		return managed.ExternalUpdate{}, errors.New(errUpdateNotFound)
+	}
+	acl := acls[0]
+
+	if err := e.reconcileTags(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider.Tags, acl.Tags); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if err := e.reconcileEntries(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider.Entries, acl.Entries); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	add, remove := ec2.DiffNetworkACLAssociations(cr.Spec.ForProvider.Associations, acl.Associations)
+	for _, subnetID := range add {
+		if err := e.associate(ctx, meta.GetExternalName(cr), subnetID); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+	return managed.ExternalUpdate{}, e.disassociate(ctx, aws.StringValue(acl.VpcId), remove)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.NetworkACL)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	// A network ACL cannot be deleted while subnets are associated with it,
+	// so they are moved back to the default network ACL of the VPC first.
+	acls, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return awsclient.Wrap(resource.Ignore(ec2.IsNetworkACLNotFoundErr, err), errDescribe)
+	}
+	for _, acl := range acls {
+		if err := e.disassociate(ctx, aws.StringValue(acl.VpcId), acl.Associations); err != nil {
+			return err
+		}
+	}
+
+	_, err = e.client.DeleteNetworkAclRequest(&awsec2.DeleteNetworkAclInput{
+		NetworkAclId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+
+	return awsclient.Wrap(resource.Ignore(ec2.IsNetworkACLNotFoundErr, err), errDelete)
+}
+
+func (e *external) reconcileTags(ctx context.Context, id string, desired []v1beta1.Tag, observed []awsec2.Tag) error {
+	addTags, removeTags := awsclient.DiffEC2Tags(v1beta1.GenerateEC2Tags(desired), observed)
+	if len(addTags) > 0 {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{id},
+			Tags:      addTags,
+		}).Send(ctx); err != nil {
+			return awsclient.Wrap(err, errCreateTags)
+		}
+	}
+	if len(removeTags) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awsec2.DeleteTagsInput{
+			Resources: []string{id},
+			Tags:      removeTags,
+		}).Send(ctx); err != nil {
+			return awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	return nil
+}
+
+func (e *external) reconcileEntries(ctx context.Context, id string, desired []v1alpha1.NetworkACLEntry, observed []awsec2.NetworkAclEntry) error {
+	create, replace, remove := ec2.DiffNetworkACLEntries(desired, observed)
+	for _, en := range remove {
+		if _, err := e.client.DeleteNetworkAclEntryRequest(&awsec2.DeleteNetworkAclEntryInput{
+			NetworkAclId: aws.String(id),
+			RuleNumber:   aws.Int64(en.RuleNumber),
+			Egress:       aws.Bool(en.Egress),
+		}).Send(ctx); err != nil {
+			return awsclient.Wrap(err, errDeleteEntry)
+		}
+	}
+	for _, en := range replace {
+		if _, err := e.client.ReplaceNetworkAclEntryRequest(ec2.GenerateReplaceNetworkACLEntryInput(id, en)).Send(ctx); err != nil {
+			return awsclient.Wrap(err, errReplaceEntry)
+		}
+	}
+	for _, en := range create {
+		if _, err := e.client.CreateNetworkAclEntryRequest(ec2.GenerateCreateNetworkACLEntryInput(id, en)).Send(ctx); err != nil {
+			return awsclient.Wrap(err, errCreateEntry)
+		}
+	}
+	return nil
+}
+
+// associate moves the given subnet from the network ACL it is currently
+// associated with to the network ACL with the given ID. Every subnet is
+// associated with exactly one network ACL, so this is done by replacing its
+// existing association.
+func (e *external) associate(ctx context.Context, id, subnetID string) error {
+	response, err := e.client.DescribeNetworkAclsRequest(&awsec2.DescribeNetworkAclsInput{
+		Filters: []awsec2.Filter{{Name: aws.String(filterAssociationSubnetID), Values: []string{subnetID}}},
+	}).Send(ctx)
+	if err != nil {
+		return awsclient.Wrap(err, errDescribeSubnetACL)
+	}
+	for _, acl := range response.NetworkAcls {
+		for _, asc := range acl.Associations {
+			if aws.StringValue(asc.SubnetId) != subnetID {
+				continue
+			}
+			_, err := e.client.ReplaceNetworkAclAssociationRequest(&awsec2.ReplaceNetworkAclAssociationInput{
+				AssociationId: asc.NetworkAclAssociationId,
+				NetworkAclId:  aws.String(id),
+			}).Send(ctx)
+			return awsclient.Wrap(err, errAssociateSubnet)
+		}
+	}
+	return errors.New(errSubnetACLNotFound)
+}
+
+// disassociate moves the given associations to the default network ACL of
+// the VPC.
+func (e *external) disassociate(ctx context.Context, vpcID string, associations []awsec2.NetworkAclAssociation) error {
+	if len(associations) == 0 {
+		return nil
+	}
+	response, err := e.client.DescribeNetworkAclsRequest(&awsec2.DescribeNetworkAclsInput{
+		Filters: []awsec2.Filter{
+			{Name: aws.String(filterVPCID), Values: []string{vpcID}},
+			{Name: aws.String(filterDefault), Values: []string{"true"}},
+		},
+	}).Send(ctx)
+	if err != nil {
+		return awsclient.Wrap(err, errDescribeDefault)
+	}
+	if len(response.NetworkAcls) == 0 {
+		return errors.New(errDefaultNotFound)
+	}
+	for _, asc := range associations {
+		if _, err := e.client.ReplaceNetworkAclAssociationRequest(&awsec2.ReplaceNetworkAclAssociationInput{
+			AssociationId: asc.NetworkAclAssociationId,
+			NetworkAclId:  response.NetworkAcls[0].NetworkAclId,
+		}).Send(ctx); err != nil {
+			return awsclient.Wrap(err, errDisassociate)
+		}
+	}
+	return nil
+}
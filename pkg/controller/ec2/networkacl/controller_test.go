@@ -0,0 +1,512 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package networkacl
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	aclID            = "some acl"
+	defaultACLID     = "default acl"
+	vpcID            = "some vpc"
+	subnetID         = "some subnet"
+	associationID    = "some association"
+	newAssociationID = "new association"
+	CIDR             = "10.0.0.0/16"
+	errBoom          = errors.New("boom")
+)
+
+type args struct {
+	acl ec2.NetworkACLClient
+	cr  *v1alpha1.NetworkACL
+}
+
+type aclModifier func(*v1alpha1.NetworkACL)
+
+func withExternalName(name string) aclModifier {
+	return func(r *v1alpha1.NetworkACL) { meta.SetExternalName(r, name) }
+}
+
+func withSpec(p v1alpha1.NetworkACLParameters) aclModifier {
+	return func(r *v1alpha1.NetworkACL) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.NetworkACLObservation) aclModifier {
+	return func(r *v1alpha1.NetworkACL) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) aclModifier {
+	return func(r *v1alpha1.NetworkACL) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func networkACL(m ...aclModifier) *v1alpha1.NetworkACL {
+	cr := &v1alpha1.NetworkACL{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func entry() v1alpha1.NetworkACLEntry {
+	return v1alpha1.NetworkACLEntry{
+		RuleNumber: 100,
+		Protocol:   "6",
+		RuleAction: "allow",
+		CIDRBlock:  aws.String(CIDR),
+		FromPort:   aws.Int64(443),
+		ToPort:     aws.Int64(443),
+	}
+}
+
+func describe(acls ...awsec2.NetworkAcl) awsec2.DescribeNetworkAclsRequest {
+	return awsec2.DescribeNetworkAclsRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeNetworkAclsOutput{
+			NetworkAcls: acls,
+		}},
+	}
+}
+
+// describeByInput answers the lookups the controller makes: the managed ACL
+// by ID, the ACL a subnet is currently associated with and the default ACL
+// of the VPC.
+func describeByInput(acl awsec2.NetworkAcl) func(*awsec2.DescribeNetworkAclsInput) awsec2.DescribeNetworkAclsRequest {
+	return func(input *awsec2.DescribeNetworkAclsInput) awsec2.DescribeNetworkAclsRequest {
+		if len(input.NetworkAclIds) > 0 {
+			return describe(acl)
+		}
+		if aws.StringValue(input.Filters[0].Name) == filterAssociationSubnetID {
+			return describe(awsec2.NetworkAcl{
+				NetworkAclId: aws.String(defaultACLID),
+				Associations: []awsec2.NetworkAclAssociation{{
+					NetworkAclAssociationId: aws.String(associationID),
+					SubnetId:                aws.String(subnetID),
+				}},
+			})
+		}
+		return describe(awsec2.NetworkAcl{NetworkAclId: aws.String(defaultACLID), IsDefault: aws.Bool(true)})
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.NetworkACL
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(input *awsec2.DescribeNetworkAclsInput) awsec2.DescribeNetworkAclsRequest {
+						return describe(awsec2.NetworkAcl{
+							NetworkAclId: aws.String(aclID),
+							VpcId:        aws.String(vpcID),
+						})
+					},
+				},
+				cr: networkACL(withSpec(v1alpha1.NetworkACLParameters{
+					VPCID: aws.String(vpcID),
+				}), withExternalName(aclID)),
+			},
+			want: want{
+				cr: networkACL(withSpec(v1alpha1.NetworkACLParameters{
+					VPCID: aws.String(vpcID),
+				}), withExternalName(aclID), withConditions(xpv1.Available()), withStatus(v1alpha1.NetworkACLObservation{
+					NetworkACLID: aclID,
+				})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"EntriesOutOfDate": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(input *awsec2.DescribeNetworkAclsInput) awsec2.DescribeNetworkAclsRequest {
+						return describe(awsec2.NetworkAcl{
+							NetworkAclId: aws.String(aclID),
+							VpcId:        aws.String(vpcID),
+						})
+					},
+				},
+				cr: networkACL(withSpec(v1alpha1.NetworkACLParameters{
+					VPCID:   aws.String(vpcID),
+					Entries: []v1alpha1.NetworkACLEntry{entry()},
+				}), withExternalName(aclID)),
+			},
+			want: want{
+				cr: networkACL(withSpec(v1alpha1.NetworkACLParameters{
+					VPCID:   aws.String(vpcID),
+					Entries: []v1alpha1.NetworkACLEntry{entry()},
+				}), withExternalName(aclID), withConditions(xpv1.Available()), withStatus(v1alpha1.NetworkACLObservation{
+					NetworkACLID: aclID,
+				})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				cr: networkACL(),
+			},
+			want: want{
+				cr: networkACL(),
+			},
+		},
+		"MultipleACLs": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(input *awsec2.DescribeNetworkAclsInput) awsec2.DescribeNetworkAclsRequest {
+						return describe(awsec2.NetworkAcl{}, awsec2.NetworkAcl{})
+					},
+				},
+				cr: networkACL(withExternalName(aclID)),
+			},
+			want: want{
+				cr:  networkACL(withExternalName(aclID)),
+				err: errors.New(errMultipleItems),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(input *awsec2.DescribeNetworkAclsInput) awsec2.DescribeNetworkAclsRequest {
+						return awsec2.DescribeNetworkAclsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: networkACL(withExternalName(aclID)),
+			},
+			want: want{
+				cr:  networkACL(withExternalName(aclID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.acl}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.NetworkACL
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockCreate: func(input *awsec2.CreateNetworkAclInput) awsec2.CreateNetworkAclRequest {
+						return awsec2.CreateNetworkAclRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateNetworkAclOutput{
+								NetworkAcl: &awsec2.NetworkAcl{NetworkAclId: aws.String(aclID)},
+							}},
+						}
+					},
+				},
+				cr: networkACL(withSpec(v1alpha1.NetworkACLParameters{
+					VPCID: aws.String(vpcID),
+				})),
+			},
+			want: want{
+				cr: networkACL(withSpec(v1alpha1.NetworkACLParameters{
+					VPCID: aws.String(vpcID),
+				}), withExternalName(aclID)),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockCreate: func(input *awsec2.CreateNetworkAclInput) awsec2.CreateNetworkAclRequest {
+						return awsec2.CreateNetworkAclRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: networkACL(withSpec(v1alpha1.NetworkACLParameters{
+					VPCID: aws.String(vpcID),
+				})),
+			},
+			want: want{
+				cr: networkACL(withSpec(v1alpha1.NetworkACLParameters{
+					VPCID: aws.String(vpcID),
+				})),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.acl}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"CreateEntryAndAssociate": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: describeByInput(awsec2.NetworkAcl{
+						NetworkAclId: aws.String(aclID),
+						VpcId:        aws.String(vpcID),
+					}),
+					MockCreateEntry: func(input *awsec2.CreateNetworkAclEntryInput) awsec2.CreateNetworkAclEntryRequest {
+						if diff := cmp.Diff(ec2.GenerateCreateNetworkACLEntryInput(aclID, entry()), input); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.CreateNetworkAclEntryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateNetworkAclEntryOutput{}},
+						}
+					},
+					MockReplaceAssociation: func(input *awsec2.ReplaceNetworkAclAssociationInput) awsec2.ReplaceNetworkAclAssociationRequest {
+						if aws.StringValue(input.AssociationId) != associationID || aws.StringValue(input.NetworkAclId) != aclID {
+							t.Errorf("unexpected association replacement: %s", input)
+						}
+						return awsec2.ReplaceNetworkAclAssociationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ReplaceNetworkAclAssociationOutput{
+								NewAssociationId: aws.String(newAssociationID),
+							}},
+						}
+					},
+				},
+				cr: networkACL(withSpec(v1alpha1.NetworkACLParameters{
+					VPCID:        aws.String(vpcID),
+					Entries:      []v1alpha1.NetworkACLEntry{entry()},
+					Associations: []v1alpha1.NetworkACLAssociation{{SubnetID: aws.String(subnetID)}},
+				}), withExternalName(aclID)),
+			},
+		},
+		"DeleteEntryAndDisassociate": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: describeByInput(awsec2.NetworkAcl{
+						NetworkAclId: aws.String(aclID),
+						VpcId:        aws.String(vpcID),
+						Entries: []awsec2.NetworkAclEntry{{
+							RuleNumber: aws.Int64(100),
+							Egress:     aws.Bool(false),
+							Protocol:   aws.String("-1"),
+							RuleAction: awsec2.RuleActionAllow,
+							CidrBlock:  aws.String(CIDR),
+						}},
+						Associations: []awsec2.NetworkAclAssociation{{
+							NetworkAclAssociationId: aws.String(associationID),
+							SubnetId:                aws.String(subnetID),
+						}},
+					}),
+					MockDeleteEntry: func(input *awsec2.DeleteNetworkAclEntryInput) awsec2.DeleteNetworkAclEntryRequest {
+						return awsec2.DeleteNetworkAclEntryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteNetworkAclEntryOutput{}},
+						}
+					},
+					MockReplaceAssociation: func(input *awsec2.ReplaceNetworkAclAssociationInput) awsec2.ReplaceNetworkAclAssociationRequest {
+						if aws.StringValue(input.AssociationId) != associationID || aws.StringValue(input.NetworkAclId) != defaultACLID {
+							t.Errorf("unexpected association replacement: %s", input)
+						}
+						return awsec2.ReplaceNetworkAclAssociationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ReplaceNetworkAclAssociationOutput{}},
+						}
+					},
+				},
+				cr: networkACL(withSpec(v1alpha1.NetworkACLParameters{
+					VPCID: aws.String(vpcID),
+				}), withExternalName(aclID)),
+			},
+		},
+		"CreateEntryFail": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: describeByInput(awsec2.NetworkAcl{
+						NetworkAclId: aws.String(aclID),
+						VpcId:        aws.String(vpcID),
+					}),
+					MockCreateEntry: func(input *awsec2.CreateNetworkAclEntryInput) awsec2.CreateNetworkAclEntryRequest {
+						return awsec2.CreateNetworkAclEntryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: networkACL(withSpec(v1alpha1.NetworkACLParameters{
+					VPCID:   aws.String(vpcID),
+					Entries: []v1alpha1.NetworkACLEntry{entry()},
+				}), withExternalName(aclID)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errCreateEntry),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.acl}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.NetworkACL
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: describeByInput(awsec2.NetworkAcl{
+						NetworkAclId: aws.String(aclID),
+						VpcId:        aws.String(vpcID),
+						Associations: []awsec2.NetworkAclAssociation{{
+							NetworkAclAssociationId: aws.String(associationID),
+							SubnetId:                aws.String(subnetID),
+						}},
+					}),
+					MockReplaceAssociation: func(input *awsec2.ReplaceNetworkAclAssociationInput) awsec2.ReplaceNetworkAclAssociationRequest {
+						if aws.StringValue(input.NetworkAclId) != defaultACLID {
+							t.Errorf("subnet must be moved to the default network ACL, got %s", aws.StringValue(input.NetworkAclId))
+						}
+						return awsec2.ReplaceNetworkAclAssociationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ReplaceNetworkAclAssociationOutput{}},
+						}
+					},
+					MockDelete: func(input *awsec2.DeleteNetworkAclInput) awsec2.DeleteNetworkAclRequest {
+						return awsec2.DeleteNetworkAclRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteNetworkAclOutput{}},
+						}
+					},
+				},
+				cr: networkACL(withExternalName(aclID)),
+			},
+			want: want{
+				cr: networkACL(withExternalName(aclID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: describeByInput(awsec2.NetworkAcl{NetworkAclId: aws.String(aclID)}),
+					MockDelete: func(input *awsec2.DeleteNetworkAclInput) awsec2.DeleteNetworkAclRequest {
+						return awsec2.DeleteNetworkAclRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: networkACL(withExternalName(aclID)),
+			},
+			want: want{
+				cr:  networkACL(withExternalName(aclID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.acl}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -59,21 +59,23 @@ const (
 )
 
 // SetupRouteTable adds a controller that reconciles RouteTables.
-func SetupRouteTable(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+func SetupRouteTable(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclient.Options) error {
 	name := managed.ControllerName(v1beta1.RouteTableGroupKind)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
 		}).
 		For(&v1beta1.RouteTable{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.RouteTableGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewRouteTableClient}),
+			managed.WithExternalConnecter(awsclient.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewRouteTableClient}, o)),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
+			managed.WithTimeout(o.ReconcileTimeout),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -259,13 +261,16 @@ func (e *external) deleteRoutes(ctx context.Context, tableID string, desired []v
 	for _, rt := range observed {
 		found := false
 		for _, ds := range desired {
-			if aws.StringValue(ds.DestinationCIDRBlock) == rt.DestinationCIDRBlock && (aws.StringValue(ds.GatewayID) == rt.GatewayID &&
+			if aws.StringValue(ds.DestinationCIDRBlock) == rt.DestinationCIDRBlock &&
+				aws.StringValue(ds.DestinationIPV6CIDRBlock) == rt.DestinationIPV6CIDRBlock &&
+				aws.StringValue(ds.EgressOnlyInternetGatewayID) == rt.EgressOnlyInternetGatewayID &&
+				aws.StringValue(ds.GatewayID) == rt.GatewayID &&
 				aws.StringValue(ds.InstanceID) == rt.InstanceID &&
 				aws.StringValue(ds.LocalGatewayID) == rt.LocalGatewayID &&
 				aws.StringValue(ds.NatGatewayID) == rt.NatGatewayID &&
 				aws.StringValue(ds.NetworkInterfaceID) == rt.NetworkInterfaceID &&
 				aws.StringValue(ds.TransitGatewayID) == rt.TransitGatewayID &&
-				aws.StringValue(ds.VpcPeeringConnectionID) == rt.VpcPeeringConnectionID) {
+				aws.StringValue(ds.VpcPeeringConnectionID) == rt.VpcPeeringConnectionID {
 
 				found = true
 				break
@@ -300,13 +305,16 @@ func (e *external) createRoutes(ctx context.Context, tableID string, desired []v
 	for _, rt := range desired {
 		isObserved := false
 		for _, ob := range observed {
-			if ob.DestinationCIDRBlock == aws.StringValue(rt.DestinationCIDRBlock) && (ob.GatewayID == aws.StringValue(rt.GatewayID) &&
+			if ob.DestinationCIDRBlock == aws.StringValue(rt.DestinationCIDRBlock) &&
+				ob.DestinationIPV6CIDRBlock == aws.StringValue(rt.DestinationIPV6CIDRBlock) &&
+				ob.EgressOnlyInternetGatewayID == aws.StringValue(rt.EgressOnlyInternetGatewayID) &&
+				ob.GatewayID == aws.StringValue(rt.GatewayID) &&
 				ob.InstanceID == aws.StringValue(rt.InstanceID) &&
 				ob.LocalGatewayID == aws.StringValue(rt.LocalGatewayID) &&
 				ob.NatGatewayID == aws.StringValue(rt.NatGatewayID) &&
 				ob.NetworkInterfaceID == aws.StringValue(rt.NetworkInterfaceID) &&
 				ob.TransitGatewayID == aws.StringValue(rt.TransitGatewayID) &&
-				ob.VpcPeeringConnectionID == aws.StringValue(rt.VpcPeeringConnectionID)) {
+				ob.VpcPeeringConnectionID == aws.StringValue(rt.VpcPeeringConnectionID) {
 				isObserved = true
 				break
 			}
@@ -314,16 +322,17 @@ func (e *external) createRoutes(ctx context.Context, tableID string, desired []v
 		// if the route is already created, skip it
 		if !isObserved {
 			_, err := e.client.CreateRouteRequest(&awsec2.CreateRouteInput{
-				RouteTableId:             aws.String(tableID),
-				DestinationCidrBlock:     rt.DestinationCIDRBlock,
-				DestinationIpv6CidrBlock: rt.DestinationIPV6CIDRBlock,
-				GatewayId:                rt.GatewayID,
-				InstanceId:               rt.InstanceID,
-				LocalGatewayId:           rt.LocalGatewayID,
-				NatGatewayId:             rt.NatGatewayID,
-				NetworkInterfaceId:       rt.NetworkInterfaceID,
-				TransitGatewayId:         rt.TransitGatewayID,
-				VpcPeeringConnectionId:   rt.VpcPeeringConnectionID,
+				RouteTableId:                aws.String(tableID),
+				DestinationCidrBlock:        rt.DestinationCIDRBlock,
+				DestinationIpv6CidrBlock:    rt.DestinationIPV6CIDRBlock,
+				EgressOnlyInternetGatewayId: rt.EgressOnlyInternetGatewayID,
+				GatewayId:                   rt.GatewayID,
+				InstanceId:                  rt.InstanceID,
+				LocalGatewayId:              rt.LocalGatewayID,
+				NatGatewayId:                rt.NatGatewayID,
+				NetworkInterfaceId:          rt.NetworkInterfaceID,
+				TransitGatewayId:            rt.TransitGatewayID,
+				VpcPeeringConnectionId:      rt.VpcPeeringConnectionID,
 			}).Send(ctx)
 
 			if err != nil {
@@ -50,6 +50,9 @@ var (
 	testValue      = "testValue"
 	CIDR           = "10.0.0.0/8"
 	instanceCIDR   = "10.1.1.1/32"
+	ipv6CIDR       = "::/0"
+	staleIPv6CIDR  = "2600:1f18::/64"
+	eigwID         = "some eigw"
 	errBoom        = errors.New("boom")
 )
 
@@ -828,6 +831,76 @@ func TestUpdate(t *testing.T) {
 				err: errors.Wrap(errBoom, errDeleteRoute),
 			},
 		},
+		"DeleteStaleIPv6Route": {
+			args: args{
+				rt: &fake.MockRouteTableClient{
+					MockDescribe: func(input *awsec2.DescribeRouteTablesInput) awsec2.DescribeRouteTablesRequest {
+						return awsec2.DescribeRouteTablesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeRouteTablesOutput{
+								RouteTables: []awsec2.RouteTable{{
+									Routes: []awsec2.Route{
+										{
+											DestinationIpv6CidrBlock:    aws.String(ipv6CIDR),
+											EgressOnlyInternetGatewayId: aws.String(eigwID),
+										},
+										{
+											DestinationIpv6CidrBlock:    aws.String(staleIPv6CIDR),
+											EgressOnlyInternetGatewayId: aws.String(eigwID),
+										},
+									},
+								}},
+							}},
+						}
+					},
+					MockDeleteRoute: func(input *awsec2.DeleteRouteInput) awsec2.DeleteRouteRequest {
+						return awsec2.DeleteRouteRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: rt(withSpec(v1beta1.RouteTableParameters{
+					Routes: []v1beta1.Route{{
+						DestinationIPV6CIDRBlock:    aws.String(ipv6CIDR),
+						EgressOnlyInternetGatewayID: aws.String(eigwID),
+					}},
+				}),
+					withStatus(v1beta1.RouteTableObservation{
+						RouteTableID: rtID,
+						Routes: []v1beta1.RouteState{
+							{
+								DestinationIPV6CIDRBlock:    ipv6CIDR,
+								EgressOnlyInternetGatewayID: eigwID,
+							},
+							{
+								DestinationIPV6CIDRBlock:    staleIPv6CIDR,
+								EgressOnlyInternetGatewayID: eigwID,
+							},
+						},
+					})),
+			},
+			want: want{
+				cr: rt(withSpec(v1beta1.RouteTableParameters{
+					Routes: []v1beta1.Route{{
+						DestinationIPV6CIDRBlock:    aws.String(ipv6CIDR),
+						EgressOnlyInternetGatewayID: aws.String(eigwID),
+					}},
+				}),
+					withStatus(v1beta1.RouteTableObservation{
+						RouteTableID: rtID,
+						Routes: []v1beta1.RouteState{
+							{
+								DestinationIPV6CIDRBlock:    ipv6CIDR,
+								EgressOnlyInternetGatewayID: eigwID,
+							},
+							{
+								DestinationIPV6CIDRBlock:    staleIPv6CIDR,
+								EgressOnlyInternetGatewayID: eigwID,
+							},
+						},
+					})),
+				err: errors.Wrap(errBoom, errDeleteRoute),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -46,36 +46,39 @@ import (
 const (
 	errUnexpectedObject = "The managed resource is not an SecurityGroup resource"
 
-	errDescribe         = "failed to describe SecurityGroup"
-	errMultipleItems    = "retrieved multiple SecurityGroups for the given securityGroupId"
-	errCreate           = "failed to create the SecurityGroup resource"
-	errAuthorizeIngress = "failed to authorize ingress rules"
-	errAuthorizeEgress  = "failed to authorize egress rules"
-	errDelete           = "failed to delete the SecurityGroup resource"
-	errSpecUpdate       = "cannot update spec of the SecurityGroup custom resource"
-	errRevokeEgress     = "cannot remove the default egress rule"
-	errStatusUpdate     = "cannot update status of the SecurityGroup custom resource"
-	errUpdate           = "failed to update the SecurityGroup resource"
-	errCreateTags       = "failed to create tags for the Security Group resource"
-	errDeleteTags       = "failed to delete tags for the Security Group resource"
+	errDescribe          = "failed to describe SecurityGroup"
+	errMultipleItems     = "retrieved multiple SecurityGroups for the given securityGroupId"
+	errCreate            = "failed to create the SecurityGroup resource"
+	errAuthorizeIngress  = "failed to authorize ingress rules"
+	errAuthorizeEgress   = "failed to authorize egress rules"
+	errDelete            = "failed to delete the SecurityGroup resource"
+	errSpecUpdate        = "cannot update spec of the SecurityGroup custom resource"
+	errRevokeEgress      = "cannot remove the default egress rule"
+	errRevokeIngress     = "failed to revoke ingress rules"
+	errRevokeEgressRules = "failed to revoke egress rules"
+	errStatusUpdate      = "cannot update status of the SecurityGroup custom resource"
+	errCreateTags        = "failed to create tags for the Security Group resource"
+	errDeleteTags        = "failed to delete tags for the Security Group resource"
 )
 
 // SetupSecurityGroup adds a controller that reconciles SecurityGroups.
-func SetupSecurityGroup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+func SetupSecurityGroup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclient.Options) error {
 	name := managed.ControllerName(v1beta1.SecurityGroupGroupKind)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
 		}).
 		For(&v1beta1.SecurityGroup{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.SecurityGroupGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewSecurityGroupClient}),
+			managed.WithExternalConnecter(awsclient.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewSecurityGroupClient}, o)),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
+			managed.WithTimeout(o.ReconcileTimeout),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -211,11 +214,6 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(ec2.IsSecurityGroupNotFoundErr, err), errDescribe)
 	}
 
-	patch, err := ec2.CreateSGPatch(response.SecurityGroups[0], cr.Spec.ForProvider)
-	if err != nil {
-		return managed.ExternalUpdate{}, errors.New(errUpdate)
-	}
-
 	add, remove := awsclient.DiffEC2Tags(v1beta1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), response.SecurityGroups[0].Tags)
 	if len(remove) > 0 {
 		if _, err := e.sg.DeleteTagsRequest(&awsec2.DeleteTagsInput{
@@ -235,21 +233,45 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		}
 	}
 
-	if patch.Ingress != nil {
-		if _, err := e.sg.AuthorizeSecurityGroupIngressRequest(&awsec2.AuthorizeSecurityGroupIngressInput{
-			GroupId:       aws.String(meta.GetExternalName(cr)),
-			IpPermissions: ec2.GenerateEC2Permissions(cr.Spec.ForProvider.Ingress),
-		}).Send(ctx); err != nil && !ec2.IsRuleAlreadyExistsErr(err) {
-			return managed.ExternalUpdate{}, awsclient.Wrap(err, errAuthorizeIngress)
+	// An empty list of rules revokes all rules, while rules that are not given
+	// are left alone.
+	if cr.Spec.ForProvider.Ingress != nil {
+		add, remove := ec2.DiffPermissions(ec2.GenerateEC2Permissions(cr.Spec.ForProvider.Ingress), response.SecurityGroups[0].IpPermissions)
+		if len(add) > 0 {
+			if _, err := e.sg.AuthorizeSecurityGroupIngressRequest(&awsec2.AuthorizeSecurityGroupIngressInput{
+				GroupId:       aws.String(meta.GetExternalName(cr)),
+				IpPermissions: add,
+			}).Send(ctx); err != nil && !ec2.IsRuleAlreadyExistsErr(err) {
+				return managed.ExternalUpdate{}, awsclient.Wrap(err, errAuthorizeIngress)
+			}
+		}
+		if len(remove) > 0 {
+			if _, err := e.sg.RevokeSecurityGroupIngressRequest(&awsec2.RevokeSecurityGroupIngressInput{
+				GroupId:       aws.String(meta.GetExternalName(cr)),
+				IpPermissions: remove,
+			}).Send(ctx); err != nil {
+				return managed.ExternalUpdate{}, awsclient.Wrap(err, errRevokeIngress)
+			}
 		}
 	}
 
-	if patch.Egress != nil {
-		if _, err = e.sg.AuthorizeSecurityGroupEgressRequest(&awsec2.AuthorizeSecurityGroupEgressInput{
-			GroupId:       aws.String(meta.GetExternalName(cr)),
-			IpPermissions: ec2.GenerateEC2Permissions(cr.Spec.ForProvider.Egress),
-		}).Send(ctx); err != nil && !ec2.IsRuleAlreadyExistsErr(err) {
-			return managed.ExternalUpdate{}, awsclient.Wrap(err, errAuthorizeEgress)
+	if cr.Spec.ForProvider.Egress != nil {
+		add, remove := ec2.DiffPermissions(ec2.GenerateEC2Permissions(cr.Spec.ForProvider.Egress), response.SecurityGroups[0].IpPermissionsEgress)
+		if len(add) > 0 {
+			if _, err := e.sg.AuthorizeSecurityGroupEgressRequest(&awsec2.AuthorizeSecurityGroupEgressInput{
+				GroupId:       aws.String(meta.GetExternalName(cr)),
+				IpPermissions: add,
+			}).Send(ctx); err != nil && !ec2.IsRuleAlreadyExistsErr(err) {
+				return managed.ExternalUpdate{}, awsclient.Wrap(err, errAuthorizeEgress)
+			}
+		}
+		if len(remove) > 0 {
+			if _, err := e.sg.RevokeSecurityGroupEgressRequest(&awsec2.RevokeSecurityGroupEgressInput{
+				GroupId:       aws.String(meta.GetExternalName(cr)),
+				IpPermissions: remove,
+			}).Send(ctx); err != nil {
+				return managed.ExternalUpdate{}, awsclient.Wrap(err, errRevokeEgressRules)
+			}
 		}
 	}
 
@@ -268,5 +290,7 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 		GroupId: aws.String(meta.GetExternalName(cr)),
 	}).Send(ctx)
 
-	return awsclient.Wrap(resource.Ignore(ec2.IsSecurityGroupNotFoundErr, err), errDelete)
+	// Instances and other security groups that use the group have to let go
+	// of it first.
+	return awsclient.Wrap(ec2.IgnoreDependencyViolation(cr, resource.Ignore(ec2.IsSecurityGroupNotFoundErr, err)), errDelete)
 }
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -46,7 +47,8 @@ var (
 	cidr              = "192.168.0.0/32"
 	tcpProtocol       = "tcp"
 
-	errBoom = errors.New("boom")
+	errBoom       = errors.New("boom")
+	errDependency = awserr.New(ec2.DependencyViolation, "", nil)
 )
 
 type args struct {
@@ -354,6 +356,16 @@ func TestUpdate(t *testing.T) {
 							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.AuthorizeSecurityGroupEgressOutput{}},
 						}
 					},
+					MockRevokeIngress: func(input *awsec2.RevokeSecurityGroupIngressInput) awsec2.RevokeSecurityGroupIngressRequest {
+						return awsec2.RevokeSecurityGroupIngressRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.RevokeSecurityGroupIngressOutput{}},
+						}
+					},
+					MockRevokeEgress: func(input *awsec2.RevokeSecurityGroupEgressInput) awsec2.RevokeSecurityGroupEgressRequest {
+						return awsec2.RevokeSecurityGroupEgressRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.RevokeSecurityGroupEgressOutput{}},
+						}
+					},
 				},
 				cr: sg(withSpec(v1beta1.SecurityGroupParameters{
 					Ingress: specPermissions(),
@@ -373,6 +385,44 @@ func TestUpdate(t *testing.T) {
 					})),
 			},
 		},
+		"RevokeAllIngress": {
+			args: args{
+				sg: &fake.MockSecurityGroupClient{
+					MockDescribe: func(input *awsec2.DescribeSecurityGroupsInput) awsec2.DescribeSecurityGroupsRequest {
+						return awsec2.DescribeSecurityGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeSecurityGroupsOutput{
+								SecurityGroups: []awsec2.SecurityGroup{{
+									IpPermissions: sgPersmissions(),
+								}},
+							}},
+						}
+					},
+					MockRevokeIngress: func(input *awsec2.RevokeSecurityGroupIngressInput) awsec2.RevokeSecurityGroupIngressRequest {
+						var err error
+						if diff := cmp.Diff(sgPersmissions(), input.IpPermissions); diff != "" {
+							err = errors.New(diff)
+						}
+						return awsec2.RevokeSecurityGroupIngressRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.RevokeSecurityGroupIngressOutput{}, Error: err},
+						}
+					},
+				},
+				cr: sg(withSpec(v1beta1.SecurityGroupParameters{
+					Ingress: []v1beta1.IPPermission{},
+				}),
+					withStatus(v1beta1.SecurityGroupObservation{
+						SecurityGroupID: sgID,
+					})),
+			},
+			want: want{
+				cr: sg(withSpec(v1beta1.SecurityGroupParameters{
+					Ingress: []v1beta1.IPPermission{},
+				}),
+					withStatus(v1beta1.SecurityGroupObservation{
+						SecurityGroupID: sgID,
+					})),
+			},
+		},
 		"IngressFail": {
 			args: args{
 				sg: &fake.MockSecurityGroupClient{
@@ -411,6 +461,49 @@ func TestUpdate(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errAuthorizeIngress),
 			},
 		},
+		"RevokeIngressFail": {
+			args: args{
+				sg: &fake.MockSecurityGroupClient{
+					MockDescribe: func(input *awsec2.DescribeSecurityGroupsInput) awsec2.DescribeSecurityGroupsRequest {
+						return awsec2.DescribeSecurityGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeSecurityGroupsOutput{
+								SecurityGroups: []awsec2.SecurityGroup{{
+									IpPermissions:       sgPersmissions(),
+									IpPermissionsEgress: sgPersmissions(),
+								}},
+							}},
+						}
+					},
+					MockAuthorizeIgress: func(input *awsec2.AuthorizeSecurityGroupIngressInput) awsec2.AuthorizeSecurityGroupIngressRequest {
+						return awsec2.AuthorizeSecurityGroupIngressRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.AuthorizeSecurityGroupIngressOutput{}},
+						}
+					},
+					MockRevokeIngress: func(input *awsec2.RevokeSecurityGroupIngressInput) awsec2.RevokeSecurityGroupIngressRequest {
+						return awsec2.RevokeSecurityGroupIngressRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: sg(withSpec(v1beta1.SecurityGroupParameters{
+					Ingress: specPermissions(),
+					Egress:  specPermissions(),
+				}),
+					withStatus(v1beta1.SecurityGroupObservation{
+						SecurityGroupID: sgID,
+					})),
+			},
+			want: want{
+				cr: sg(withSpec(v1beta1.SecurityGroupParameters{
+					Ingress: specPermissions(),
+					Egress:  specPermissions(),
+				}),
+					withStatus(v1beta1.SecurityGroupObservation{
+						SecurityGroupID: sgID,
+					})),
+				err: awsclient.Wrap(errBoom, errRevokeIngress),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -697,6 +790,21 @@ func TestDelete(t *testing.T) {
 				cr: sg(withConditions(xpv1.Deleting())),
 			},
 		},
+		"DependencyViolation": {
+			args: args{
+				sg: &fake.MockSecurityGroupClient{
+					MockDelete: func(input *awsec2.DeleteSecurityGroupInput) awsec2.DeleteSecurityGroupRequest {
+						return awsec2.DeleteSecurityGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errDependency},
+						}
+					},
+				},
+				cr: sg(),
+			},
+			want: want{
+				cr: sg(withConditions(xpv1.Deleting().WithMessage("waiting for dependent resources to be deleted: " + errDependency.Error()))),
+			},
+		},
 		"DeleteFailure": {
 			args: args{
 				sg: &fake.MockSecurityGroupClient{
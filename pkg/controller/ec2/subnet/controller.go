@@ -53,20 +53,21 @@ const (
 )
 
 // SetupSubnet adds a controller that reconciles Subnets.
-func SetupSubnet(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+func SetupSubnet(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclient.Options) error {
 	name := managed.ControllerName(v1beta1.SubnetGroupKind)
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
 		}).
 		For(&v1beta1.Subnet{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.SubnetGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewSubnetClient}),
+			managed.WithExternalConnecter(awsclient.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewSubnetClient}, o)),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
-			managed.WithConnectionPublishers(),
+			managed.WithTimeout(o.ReconcileTimeout),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -137,6 +138,9 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		ResourceExists:          true,
 		ResourceUpToDate:        ec2.IsSubnetUpToDate(cr.Spec.ForProvider, observed),
 		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ConnectionDetails: managed.ConnectionDetails{
+			v1beta1.ResourceCredentialsSecretSubnetIDKey: []byte(meta.GetExternalName(cr)),
+		},
 	}, nil
 }
 
@@ -192,7 +196,8 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		}
 	}
 
-	if subnet.MapPublicIpOnLaunch != cr.Spec.ForProvider.MapPublicIPOnLaunch {
+	if cr.Spec.ForProvider.MapPublicIPOnLaunch != nil &&
+		aws.BoolValue(subnet.MapPublicIpOnLaunch) != aws.BoolValue(cr.Spec.ForProvider.MapPublicIPOnLaunch) {
 		_, err = e.client.ModifySubnetAttributeRequest(&awsec2.ModifySubnetAttributeInput{
 			MapPublicIpOnLaunch: &awsec2.AttributeBooleanValue{
 				Value: cr.Spec.ForProvider.MapPublicIPOnLaunch,
@@ -204,7 +209,8 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		}
 	}
 
-	if subnet.AssignIpv6AddressOnCreation != cr.Spec.ForProvider.AssignIPv6AddressOnCreation {
+	if cr.Spec.ForProvider.AssignIPv6AddressOnCreation != nil &&
+		aws.BoolValue(subnet.AssignIpv6AddressOnCreation) != aws.BoolValue(cr.Spec.ForProvider.AssignIPv6AddressOnCreation) {
 		_, err = e.client.ModifySubnetAttributeRequest(&awsec2.ModifySubnetAttributeInput{
 			AssignIpv6AddressOnCreation: &awsec2.AttributeBooleanValue{
 				Value: cr.Spec.ForProvider.AssignIPv6AddressOnCreation,
@@ -228,5 +234,6 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 		SubnetId: aws.String(meta.GetExternalName(cr)),
 	}).Send(ctx)
 
-	return awsclient.Wrap(resource.Ignore(ec2.IsSubnetNotFoundErr, err), errDelete)
+	// Instances and network interfaces in the subnet have to be deleted first.
+	return awsclient.Wrap(ec2.IgnoreDependencyViolation(cr, resource.Ignore(ec2.IsSubnetNotFoundErr, err)), errDelete)
 }
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
@@ -41,7 +42,8 @@ import (
 var (
 	subnetID = "some Id"
 
-	errBoom = errors.New("boom")
+	errBoom       = errors.New("boom")
+	errDependency = awserr.New(ec2.DependencyViolation, "", nil)
 )
 
 type args struct {
@@ -115,6 +117,9 @@ func TestObserve(t *testing.T) {
 				result: managed.ExternalObservation{
 					ResourceExists:   true,
 					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1beta1.ResourceCredentialsSecretSubnetIDKey: []byte(subnetID),
+					},
 				},
 			},
 		},
@@ -167,6 +172,9 @@ func TestObserve(t *testing.T) {
 				result: managed.ExternalObservation{
 					ResourceExists:   true,
 					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1beta1.ResourceCredentialsSecretSubnetIDKey: []byte(subnetID),
+					},
 				},
 			},
 		},
@@ -423,6 +431,21 @@ func TestDelete(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errDelete),
 			},
 		},
+		"DependencyViolation": {
+			args: args{
+				subnet: &fake.MockSubnetClient{
+					MockDelete: func(input *awsec2.DeleteSubnetInput) awsec2.DeleteSubnetRequest {
+						return awsec2.DeleteSubnetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errDependency},
+						}
+					},
+				},
+				cr: subnet(),
+			},
+			want: want{
+				cr: subnet(withConditions(xpv1.Deleting().WithMessage("waiting for dependent resources to be deleted: " + errDependency.Error()))),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -56,20 +56,21 @@ const (
 )
 
 // SetupVPC adds a controller that reconciles VPCs.
-func SetupVPC(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+func SetupVPC(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclient.Options) error {
 	name := managed.ControllerName(v1beta1.VPCGroupKind)
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
 		}).
 		For(&v1beta1.VPC{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.VPCGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewVPCClient}),
+			managed.WithExternalConnecter(awsclient.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewVPCClient}, o)),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithConnectionPublishers(),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
+			managed.WithTimeout(o.ReconcileTimeout),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -134,7 +135,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		r, err := e.client.DescribeVpcAttributeRequest(&awsec2.DescribeVpcAttributeInput{
 			VpcId:     aws.String(meta.GetExternalName(cr)),
 			Attribute: input,
-		}).Send(context.Background())
+		}).Send(ctx)
 
 		if err != nil {
 			return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribe)
@@ -164,6 +165,9 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		ResourceExists:          true,
 		ResourceUpToDate:        ec2.IsVpcUpToDate(cr.Spec.ForProvider, observed, o),
 		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ConnectionDetails: managed.ConnectionDetails{
+			v1beta1.ResourceCredentialsSecretVPCIDKey: []byte(meta.GetExternalName(cr)),
+		},
 	}, nil
 }
 
@@ -241,7 +245,8 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 		VpcId: aws.String(meta.GetExternalName(cr)),
 	}).Send(ctx)
 
-	return awsclient.Wrap(resource.Ignore(ec2.IsVPCNotFoundErr, err), errDelete)
+	// Subnets, gateways etc. that live in the VPC have to be deleted first.
+	return awsclient.Wrap(ec2.IgnoreDependencyViolation(cr, resource.Ignore(ec2.IsVPCNotFoundErr, err)), errDelete)
 }
 
 type tagger struct {
@@ -27,6 +27,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -47,7 +48,8 @@ var (
 	tenancyDefault = "default"
 	enableDNS      = true
 
-	errBoom = errors.New("boom")
+	errBoom       = errors.New("boom")
+	errDependency = awserr.New(ec2.DependencyViolation, "", nil)
 )
 
 type args struct {
@@ -147,6 +149,9 @@ func TestObserve(t *testing.T) {
 				result: managed.ExternalObservation{
 					ResourceExists:   true,
 					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1beta1.ResourceCredentialsSecretVPCIDKey: []byte(vpcID),
+					},
 				},
 			},
 		},
@@ -452,6 +457,21 @@ func TestDelete(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errDelete),
 			},
 		},
+		"DependencyViolation": {
+			args: args{
+				vpc: &fake.MockVPCClient{
+					MockDelete: func(input *awsec2.DeleteVpcInput) awsec2.DeleteVpcRequest {
+						return awsec2.DeleteVpcRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errDependency},
+						}
+					},
+				},
+				cr: vpc(),
+			},
+			want: want{
+				cr: vpc(withConditions(xpv1.Deleting().WithMessage("waiting for dependent resources to be deleted: " + errDependency.Error()))),
+			},
+		},
 	}
 
 	for name, tc := range cases {
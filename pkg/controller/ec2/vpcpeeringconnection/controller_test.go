@@ -0,0 +1,412 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package vpcpeeringconnection
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	pcID      = "pcx-1"
+	vpcID     = "some vpc"
+	peerVPCID = "peer vpc"
+	errBoom   = errors.New("boom")
+)
+
+type args struct {
+	pc ec2.VPCPeeringConnectionClient
+	cr *v1alpha1.VPCPeeringConnection
+}
+
+type pcModifier func(*v1alpha1.VPCPeeringConnection)
+
+func withExternalName(name string) pcModifier {
+	return func(r *v1alpha1.VPCPeeringConnection) { meta.SetExternalName(r, name) }
+}
+
+func withSpec(p v1alpha1.VPCPeeringConnectionParameters) pcModifier {
+	return func(r *v1alpha1.VPCPeeringConnection) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.VPCPeeringConnectionObservation) pcModifier {
+	return func(r *v1alpha1.VPCPeeringConnection) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) pcModifier {
+	return func(r *v1alpha1.VPCPeeringConnection) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func peeringConnection(m ...pcModifier) *v1alpha1.VPCPeeringConnection {
+	cr := &v1alpha1.VPCPeeringConnection{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(code awsec2.VpcPeeringConnectionStateReasonCode) func(*awsec2.DescribeVpcPeeringConnectionsInput) awsec2.DescribeVpcPeeringConnectionsRequest {
+	return func(*awsec2.DescribeVpcPeeringConnectionsInput) awsec2.DescribeVpcPeeringConnectionsRequest {
+		return awsec2.DescribeVpcPeeringConnectionsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeVpcPeeringConnectionsOutput{
+				VpcPeeringConnections: []awsec2.VpcPeeringConnection{{
+					VpcPeeringConnectionId: aws.String(pcID),
+					Status:                 &awsec2.VpcPeeringConnectionStateReason{Code: code},
+					RequesterVpcInfo:       &awsec2.VpcPeeringConnectionVpcInfo{VpcId: aws.String(vpcID)},
+					AccepterVpcInfo:        &awsec2.VpcPeeringConnectionVpcInfo{VpcId: aws.String(peerVPCID)},
+				}},
+			}},
+		}
+	}
+}
+
+func params() v1alpha1.VPCPeeringConnectionParameters {
+	return v1alpha1.VPCPeeringConnectionParameters{
+		VPCID:      aws.String(vpcID),
+		PeerVPCID:  aws.String(peerVPCID),
+		AutoAccept: true,
+	}
+}
+
+func observation(code awsec2.VpcPeeringConnectionStateReasonCode) v1alpha1.VPCPeeringConnectionObservation {
+	return v1alpha1.VPCPeeringConnectionObservation{
+		VPCPeeringConnectionID: pcID,
+		Status:                 string(code),
+		RequesterVPCInfo:       v1alpha1.VPCPeeringConnectionVPCInfo{VPCID: vpcID},
+		AccepterVPCInfo:        v1alpha1.VPCPeeringConnectionVPCInfo{VPCID: peerVPCID},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.VPCPeeringConnection
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Active": {
+			args: args{
+				pc: &fake.MockVPCPeeringConnectionClient{
+					MockDescribe: describe(awsec2.VpcPeeringConnectionStateReasonCodeActive),
+				},
+				cr: peeringConnection(withSpec(params()), withExternalName(pcID)),
+			},
+			want: want{
+				cr: peeringConnection(withSpec(params()), withExternalName(pcID),
+					withStatus(observation(awsec2.VpcPeeringConnectionStateReasonCodeActive)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						ConnectionDetailsVPCPeeringConnectionID: []byte(pcID),
+					},
+				},
+			},
+		},
+		"PendingAcceptance": {
+			args: args{
+				pc: &fake.MockVPCPeeringConnectionClient{
+					MockDescribe: describe(awsec2.VpcPeeringConnectionStateReasonCodePendingAcceptance),
+				},
+				cr: peeringConnection(withSpec(params()), withExternalName(pcID)),
+			},
+			want: want{
+				cr: peeringConnection(withSpec(params()), withExternalName(pcID),
+					withStatus(observation(awsec2.VpcPeeringConnectionStateReasonCodePendingAcceptance)),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						ConnectionDetailsVPCPeeringConnectionID: []byte(pcID),
+					},
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				pc: &fake.MockVPCPeeringConnectionClient{
+					MockDescribe: describe(awsec2.VpcPeeringConnectionStateReasonCodeDeleted),
+				},
+				cr: peeringConnection(withSpec(params()), withExternalName(pcID)),
+			},
+			want: want{
+				cr:     peeringConnection(withSpec(params()), withExternalName(pcID)),
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				pc: &fake.MockVPCPeeringConnectionClient{
+					MockDescribe: func(*awsec2.DescribeVpcPeeringConnectionsInput) awsec2.DescribeVpcPeeringConnectionsRequest {
+						return awsec2.DescribeVpcPeeringConnectionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: peeringConnection(withExternalName(pcID)),
+			},
+			want: want{
+				cr:  peeringConnection(withExternalName(pcID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.pc, accepter: tc.pc}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.VPCPeeringConnection
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				pc: &fake.MockVPCPeeringConnectionClient{
+					MockCreate: func(*awsec2.CreateVpcPeeringConnectionInput) awsec2.CreateVpcPeeringConnectionRequest {
+						return awsec2.CreateVpcPeeringConnectionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateVpcPeeringConnectionOutput{
+								VpcPeeringConnection: &awsec2.VpcPeeringConnection{VpcPeeringConnectionId: aws.String(pcID)},
+							}},
+						}
+					},
+				},
+				cr: peeringConnection(withSpec(params())),
+			},
+			want: want{
+				cr: peeringConnection(withSpec(params()), withExternalName(pcID)),
+				result: managed.ExternalCreation{
+					ExternalNameAssigned: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						ConnectionDetailsVPCPeeringConnectionID: []byte(pcID),
+					},
+				},
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				pc: &fake.MockVPCPeeringConnectionClient{
+					MockCreate: func(*awsec2.CreateVpcPeeringConnectionInput) awsec2.CreateVpcPeeringConnectionRequest {
+						return awsec2.CreateVpcPeeringConnectionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: peeringConnection(withSpec(params())),
+			},
+			want: want{
+				cr:  peeringConnection(withSpec(params())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.pc, accepter: tc.pc}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		accepter ec2.VPCPeeringConnectionClient
+		want
+	}{
+		"AcceptWithPeerClient": {
+			args: args{
+				pc: &fake.MockVPCPeeringConnectionClient{
+					MockDescribe: describe(awsec2.VpcPeeringConnectionStateReasonCodeProvisioning),
+				},
+				cr: peeringConnection(withSpec(params()), withExternalName(pcID),
+					withStatus(observation(awsec2.VpcPeeringConnectionStateReasonCodePendingAcceptance))),
+			},
+			accepter: &fake.MockVPCPeeringConnectionClient{
+				MockAccept: func(input *awsec2.AcceptVpcPeeringConnectionInput) awsec2.AcceptVpcPeeringConnectionRequest {
+					if diff := cmp.Diff(pcID, aws.StringValue(input.VpcPeeringConnectionId)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return awsec2.AcceptVpcPeeringConnectionRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.AcceptVpcPeeringConnectionOutput{}},
+					}
+				},
+			},
+		},
+		"AcceptFail": {
+			args: args{
+				pc: &fake.MockVPCPeeringConnectionClient{},
+				cr: peeringConnection(withSpec(params()), withExternalName(pcID),
+					withStatus(observation(awsec2.VpcPeeringConnectionStateReasonCodePendingAcceptance))),
+			},
+			accepter: &fake.MockVPCPeeringConnectionClient{
+				MockAccept: func(*awsec2.AcceptVpcPeeringConnectionInput) awsec2.AcceptVpcPeeringConnectionRequest {
+					return awsec2.AcceptVpcPeeringConnectionRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+					}
+				},
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errAccept),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.pc, accepter: tc.accepter}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.VPCPeeringConnection
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				pc: &fake.MockVPCPeeringConnectionClient{
+					MockDelete: func(*awsec2.DeleteVpcPeeringConnectionInput) awsec2.DeleteVpcPeeringConnectionRequest {
+						return awsec2.DeleteVpcPeeringConnectionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteVpcPeeringConnectionOutput{}},
+						}
+					},
+				},
+				cr: peeringConnection(withExternalName(pcID)),
+			},
+			want: want{
+				cr: peeringConnection(withExternalName(pcID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				pc: &fake.MockVPCPeeringConnectionClient{},
+				cr: peeringConnection(withExternalName(pcID),
+					withStatus(v1alpha1.VPCPeeringConnectionObservation{Status: string(awsec2.VpcPeeringConnectionStateReasonCodeDeleting)})),
+			},
+			want: want{
+				cr: peeringConnection(withExternalName(pcID), withConditions(xpv1.Deleting()),
+					withStatus(v1alpha1.VPCPeeringConnectionObservation{Status: string(awsec2.VpcPeeringConnectionStateReasonCodeDeleting)})),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				pc: &fake.MockVPCPeeringConnectionClient{
+					MockDelete: func(*awsec2.DeleteVpcPeeringConnectionInput) awsec2.DeleteVpcPeeringConnectionRequest {
+						return awsec2.DeleteVpcPeeringConnectionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: peeringConnection(withExternalName(pcID)),
+			},
+			want: want{
+				cr:  peeringConnection(withExternalName(pcID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.pc, accepter: tc.pc}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -58,23 +58,26 @@ const (
 	errUpdateScan          = "failed to update scan config for repository resource"
 	errUpdateMutability    = "failed to update mutability for repository resource"
 	errPatchCreationFailed = "cannot create a patch object"
+	errGetLifecyclePolicy  = "failed to get lifecycle policy for repository resource"
+	errPutLifecyclePolicy  = "failed to put lifecycle policy for repository resource"
 )
 
 // SetupRepository adds a controller that reconciles ECR.
-func SetupRepository(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+func SetupRepository(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclient.Options) error {
 	name := managed.ControllerName(v1alpha1.RepositoryGroupKind)
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
 		}).
 		For(&v1alpha1.Repository{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.RepositoryGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+			managed.WithExternalConnecter(awsclient.NewConnecter(&connector{kube: mgr.GetClient()}, o)),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithConnectionPublishers(),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
+			managed.WithTimeout(o.ReconcileTimeout),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -131,6 +134,10 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	if err != nil {
 		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ecr.IsRepoNotFoundErr, err), errListTags)
 	}
+	policy, err := e.getLifecyclePolicy(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
 	// update the CRD spec for any new values from provider
 	current := cr.Spec.ForProvider.DeepCopy()
 	ecr.LateInitializeRepository(&cr.Spec.ForProvider, &observed)
@@ -144,9 +151,13 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 
 	cr.Status.AtProvider = ecr.GenerateRepositoryObservation(observed)
 
+	upToDate := ecr.IsRepositoryUpToDate(&cr.Spec.ForProvider, tagsResp.Tags, &observed) &&
+		ecr.IsLifecyclePolicyUpToDate(cr.Spec.ForProvider.LifecyclePolicy, policy)
+
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: ecr.IsRepositoryUpToDate(&cr.Spec.ForProvider, tagsResp.Tags, &observed),
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: ecr.GetConnectionDetails(*cr),
 	}, nil
 }
 
@@ -165,6 +176,11 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 	if err != nil {
 		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
 	}
+	if cr.Spec.ForProvider.LifecyclePolicy != nil {
+		if err := e.putLifecyclePolicy(ctx, cr); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
 	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
 }
 
@@ -220,6 +236,16 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		}
 	}
 
+	policy, err := e.getLifecyclePolicy(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if !ecr.IsLifecyclePolicyUpToDate(cr.Spec.ForProvider.LifecyclePolicy, policy) {
+		if err := e.putLifecyclePolicy(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
 	return managed.ExternalUpdate{}, nil
 }
 
@@ -291,3 +317,29 @@ func (e *external) updateTags(ctx context.Context, repo *v1alpha1.Repository) er
 	}
 	return nil
 }
+
+// getLifecyclePolicy returns the lifecycle policy text of the repository if
+// the lifecycle policy is managed, i.e. specified in the spec.
+func (e *external) getLifecyclePolicy(ctx context.Context, repo *v1alpha1.Repository) (*string, error) {
+	if repo.Spec.ForProvider.LifecyclePolicy == nil {
+		return nil, nil
+	}
+	resp, err := e.client.GetLifecyclePolicyRequest(&awsecr.GetLifecyclePolicyInput{
+		RepositoryName: aws.String(meta.GetExternalName(repo)),
+	}).Send(ctx)
+	if ecr.IsLifecyclePolicyNotFoundErr(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, awsclient.Wrap(err, errGetLifecyclePolicy)
+	}
+	return resp.LifecyclePolicyText, nil
+}
+
+func (e *external) putLifecyclePolicy(ctx context.Context, repo *v1alpha1.Repository) error {
+	_, err := e.client.PutLifecyclePolicyRequest(&awsecr.PutLifecyclePolicyInput{
+		RepositoryName:      aws.String(meta.GetExternalName(repo)),
+		LifecyclePolicyText: repo.Spec.ForProvider.LifecyclePolicy,
+	}).Send(ctx)
+	return awsclient.Wrap(err, errPutLifecyclePolicy)
+}
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	awsecr "github.com/aws/aws-sdk-go-v2/service/ecr"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
@@ -42,6 +43,8 @@ import (
 
 var (
 	repoName            = "repoName"
+	repoURI             = "123456789012.dkr.ecr.us-east-1.amazonaws.com/repoName"
+	lifecyclePolicy     = `{"rules":[{"rulePriority":1,"selection":{"tagStatus":"untagged","countType":"sinceImagePushed","countUnit":"days","countNumber":14},"action":{"type":"expire"}}]}`
 	testARN             = "testARN"
 	tagKey              = "test"
 	tagValue            = "value"
@@ -127,6 +130,7 @@ func TestObserve(t *testing.T) {
 								Repositories: []awsecr.Repository{{
 									RepositoryArn:      &testARN,
 									RepositoryName:     &repoName,
+									RepositoryUri:      &repoURI,
 									ImageTagMutability: awsecr.ImageTagMutabilityMutable,
 								}},
 							}},
@@ -151,14 +155,105 @@ func TestObserve(t *testing.T) {
 				}), withStatus(v1alpha1.RepositoryObservation{
 					RepositoryName: repoName,
 					RepositoryArn:  testARN,
+					RepositoryURI:  repoURI,
 				}), withExternalName(repoName),
 					withConditions(xpv1.Available())),
 				result: managed.ExternalObservation{
 					ResourceExists:   true,
 					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1alpha1.ResourceCredentialsSecretRepositoryURIKey: []byte(repoURI),
+					},
+				},
+			},
+		},
+		"LifecyclePolicyOutdated": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockClient().Update,
+				},
+				repository: &fake.MockRepositoryClient{
+					MockDescribe: func(input *awsecr.DescribeRepositoriesInput) awsecr.DescribeRepositoriesRequest {
+						return awsecr.DescribeRepositoriesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsecr.DescribeRepositoriesOutput{
+								Repositories: []awsecr.Repository{{
+									RepositoryArn:      &testARN,
+									RepositoryName:     &repoName,
+									ImageTagMutability: awsecr.ImageTagMutabilityMutable,
+								}},
+							}},
+						}
+					},
+					MockListTags: func(input *awsecr.ListTagsForResourceInput) awsecr.ListTagsForResourceRequest {
+						return awsecr.ListTagsForResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsecr.ListTagsForResourceOutput{}},
+						}
+					},
+					MockGetLifecyclePolicy: func(input *awsecr.GetLifecyclePolicyInput) awsecr.GetLifecyclePolicyRequest {
+						return awsecr.GetLifecyclePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsecr.GetLifecyclePolicyOutput{
+								LifecyclePolicyText: aws.String(`{"rules":[]}`),
+							}},
+						}
+					},
+				},
+				cr: repository(withSpec(v1alpha1.RepositoryParameters{
+					LifecyclePolicy: &lifecyclePolicy,
+				}), withExternalName(repoName)),
+			},
+			want: want{
+				cr: repository(withSpec(v1alpha1.RepositoryParameters{
+					ImageTagMutability: aws.String(string(awsecr.ImageTagMutabilityMutable)),
+					LifecyclePolicy:    &lifecyclePolicy,
+				}), withStatus(v1alpha1.RepositoryObservation{
+					RepositoryName: repoName,
+					RepositoryArn:  testARN,
+				}), withExternalName(repoName),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
 				},
 			},
 		},
+		"GetLifecyclePolicyFail": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockClient().Update,
+				},
+				repository: &fake.MockRepositoryClient{
+					MockDescribe: func(input *awsecr.DescribeRepositoriesInput) awsecr.DescribeRepositoriesRequest {
+						return awsecr.DescribeRepositoriesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsecr.DescribeRepositoriesOutput{
+								Repositories: []awsecr.Repository{{
+									RepositoryArn:  &testARN,
+									RepositoryName: &repoName,
+								}},
+							}},
+						}
+					},
+					MockListTags: func(input *awsecr.ListTagsForResourceInput) awsecr.ListTagsForResourceRequest {
+						return awsecr.ListTagsForResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsecr.ListTagsForResourceOutput{}},
+						}
+					},
+					MockGetLifecyclePolicy: func(input *awsecr.GetLifecyclePolicyInput) awsecr.GetLifecyclePolicyRequest {
+						return awsecr.GetLifecyclePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: repository(withSpec(v1alpha1.RepositoryParameters{
+					LifecyclePolicy: &lifecyclePolicy,
+				}), withExternalName(repoName)),
+			},
+			want: want{
+				cr: repository(withSpec(v1alpha1.RepositoryParameters{
+					LifecyclePolicy: &lifecyclePolicy,
+				}), withExternalName(repoName)),
+				err: awsclient.Wrap(errBoom, errGetLifecyclePolicy),
+			},
+		},
 		"MultipleRepository": {
 			args: args{
 				kube: &test.MockClient{
@@ -291,6 +386,59 @@ func TestCreate(t *testing.T) {
 					withConditions(xpv1.Creating())),
 			},
 		},
+		"SuccessfulWithLifecyclePolicy": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate:       test.NewMockClient().Update,
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				repository: &fake.MockRepositoryClient{
+					MockCreate: func(input *awsecr.CreateRepositoryInput) awsecr.CreateRepositoryRequest {
+						return awsecr.CreateRepositoryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsecr.CreateRepositoryOutput{}},
+						}
+					},
+					MockPutLifecyclePolicy: func(input *awsecr.PutLifecyclePolicyInput) awsecr.PutLifecyclePolicyRequest {
+						return awsecr.PutLifecyclePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsecr.PutLifecyclePolicyOutput{}},
+						}
+					},
+				},
+				cr: repository(withSpec(v1alpha1.RepositoryParameters{LifecyclePolicy: &lifecyclePolicy})),
+			},
+			want: want{
+				cr: repository(
+					withSpec(v1alpha1.RepositoryParameters{LifecyclePolicy: &lifecyclePolicy}),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"PutLifecyclePolicyFail": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate:       test.NewMockClient().Update,
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				repository: &fake.MockRepositoryClient{
+					MockCreate: func(input *awsecr.CreateRepositoryInput) awsecr.CreateRepositoryRequest {
+						return awsecr.CreateRepositoryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsecr.CreateRepositoryOutput{}},
+						}
+					},
+					MockPutLifecyclePolicy: func(input *awsecr.PutLifecyclePolicyInput) awsecr.PutLifecyclePolicyRequest {
+						return awsecr.PutLifecyclePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: repository(withSpec(v1alpha1.RepositoryParameters{LifecyclePolicy: &lifecyclePolicy})),
+			},
+			want: want{
+				cr: repository(
+					withSpec(v1alpha1.RepositoryParameters{LifecyclePolicy: &lifecyclePolicy}),
+					withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errPutLifecyclePolicy),
+			},
+		},
 		"CreateFail": {
 			args: args{
 				kube: &test.MockClient{
@@ -376,6 +524,45 @@ func TestUpdate(t *testing.T) {
 				})),
 			},
 		},
+		"SuccessfulPutLifecyclePolicy": {
+			args: args{
+				repository: &fake.MockRepositoryClient{
+					MockListTags: func(input *awsecr.ListTagsForResourceInput) awsecr.ListTagsForResourceRequest {
+						return awsecr.ListTagsForResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsecr.ListTagsForResourceOutput{}},
+						}
+					},
+					MockDescribe: func(input *awsecr.DescribeRepositoriesInput) awsecr.DescribeRepositoriesRequest {
+						return awsecr.DescribeRepositoriesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsecr.DescribeRepositoriesOutput{
+								Repositories: []awsecr.Repository{{
+									RepositoryArn:  &testARN,
+									RepositoryName: &repoName,
+								}},
+							}},
+						}
+					},
+					MockGetLifecyclePolicy: func(input *awsecr.GetLifecyclePolicyInput) awsecr.GetLifecyclePolicyRequest {
+						return awsecr.GetLifecyclePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(ecr.LifecyclePolicyNotFoundException, "", nil)},
+						}
+					},
+					MockPutLifecyclePolicy: func(input *awsecr.PutLifecyclePolicyInput) awsecr.PutLifecyclePolicyRequest {
+						return awsecr.PutLifecyclePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsecr.PutLifecyclePolicyOutput{}},
+						}
+					},
+				},
+				cr: repository(withSpec(v1alpha1.RepositoryParameters{
+					LifecyclePolicy: &lifecyclePolicy,
+				})),
+			},
+			want: want{
+				cr: repository(withSpec(v1alpha1.RepositoryParameters{
+					LifecyclePolicy: &lifecyclePolicy,
+				})),
+			},
+		},
 		"SuccessfulRemoveTag": {
 			args: args{
 				repository: &fake.MockRepositoryClient{
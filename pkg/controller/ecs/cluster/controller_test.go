@@ -0,0 +1,272 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cluster
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsecs "github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ecs/fake"
+)
+
+var (
+	clusterName = "default"
+	clusterARN  = "arn:aws:ecs:us-east-1:123456789012:cluster/default"
+
+	errBoom = errors.New("boom")
+)
+
+type clusterModifier func(*v1alpha1.ECSCluster)
+
+func withConditions(c ...xpv1.Condition) clusterModifier {
+	return func(r *v1alpha1.ECSCluster) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(s string) clusterModifier {
+	return func(r *v1alpha1.ECSCluster) {
+		r.Status.AtProvider = v1alpha1.ECSClusterObservation{ClusterARN: clusterARN, Status: s}
+	}
+}
+
+func withContainerInsights(v string) clusterModifier {
+	return func(r *v1alpha1.ECSCluster) { r.Spec.ForProvider.ContainerInsights = aws.String(v) }
+}
+
+func cluster(m ...clusterModifier) *v1alpha1.ECSCluster {
+	cr := &v1alpha1.ECSCluster{}
+	meta.SetExternalName(cr, clusterName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed(status, insights string) awsecs.Cluster {
+	return awsecs.Cluster{
+		ClusterArn:  aws.String(clusterARN),
+		ClusterName: aws.String(clusterName),
+		Status:      aws.String(status),
+		Settings: []awsecs.ClusterSetting{{
+			Name:  awsecs.ClusterSettingNameContainerInsights,
+			Value: aws.String(insights),
+		}},
+	}
+}
+
+func describe(c []awsecs.Cluster, err error) func(*awsecs.DescribeClustersInput) awsecs.DescribeClustersRequest {
+	return func(*awsecs.DescribeClustersInput) awsecs.DescribeClustersRequest {
+		return awsecs.DescribeClustersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsecs.DescribeClustersOutput{Clusters: c}, Error: err},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClusterClient
+		kube   client.Client
+		cr     *v1alpha1.ECSCluster
+		want   want
+	}{
+		"Active": {
+			client: &fake.MockClusterClient{MockDescribe: describe([]awsecs.Cluster{observed(v1alpha1.ECSClusterStatusActive, "enabled")}, nil)},
+			cr:     cluster(withContainerInsights("enabled")),
+			want: want{
+				cr: cluster(withContainerInsights("enabled"), withStatus(v1alpha1.ECSClusterStatusActive),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Provisioning": {
+			client: &fake.MockClusterClient{MockDescribe: describe([]awsecs.Cluster{observed(v1alpha1.ECSClusterStatusProvisioning, "enabled")}, nil)},
+			cr:     cluster(withContainerInsights("enabled")),
+			want: want{
+				cr: cluster(withContainerInsights("enabled"), withStatus(v1alpha1.ECSClusterStatusProvisioning),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"SettingChanged": {
+			client: &fake.MockClusterClient{MockDescribe: describe([]awsecs.Cluster{observed(v1alpha1.ECSClusterStatusActive, "enabled")}, nil)},
+			cr:     cluster(withContainerInsights("disabled")),
+			want: want{
+				cr: cluster(withContainerInsights("disabled"), withStatus(v1alpha1.ECSClusterStatusActive),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"LateInitialized": {
+			client: &fake.MockClusterClient{MockDescribe: describe([]awsecs.Cluster{observed(v1alpha1.ECSClusterStatusActive, "disabled")}, nil)},
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			cr:     cluster(),
+			want: want{
+				cr: cluster(withContainerInsights("disabled"), withStatus(v1alpha1.ECSClusterStatusActive),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Inactive": {
+			client: &fake.MockClusterClient{MockDescribe: describe([]awsecs.Cluster{observed(v1alpha1.ECSClusterStatusInactive, "enabled")}, nil)},
+			cr:     cluster(),
+			want: want{
+				cr: cluster(),
+			},
+		},
+		"Missing": {
+			client: &fake.MockClusterClient{MockDescribe: describe(nil, nil)},
+			cr:     cluster(),
+			want: want{
+				cr: cluster(),
+			},
+		},
+		"DescribeFailed": {
+			client: &fake.MockClusterClient{MockDescribe: describe(nil, errBoom)},
+			cr:     cluster(),
+			want: want{
+				cr:  cluster(),
+				err: awsclient.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want error
+	}{
+		"Successful":   {},
+		"CreateFailed": {err: errBoom, want: awsclient.Wrap(errBoom, errCreateFailed)},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: &fake.MockClusterClient{MockCreate: func(in *awsecs.CreateClusterInput) awsecs.CreateClusterRequest {
+				return awsecs.CreateClusterRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsecs.CreateClusterOutput{}, Error: tc.err},
+				}
+			}}}
+			cr := cluster()
+			_, err := e.Create(context.Background(), cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(cluster(withConditions(xpv1.Creating())), cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want error
+	}{
+		"Successful":   {},
+		"UpdateFailed": {err: errBoom, want: awsclient.Wrap(errBoom, errUpdateFailed)},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var settings []awsecs.ClusterSetting
+			e := &external{client: &fake.MockClusterClient{MockUpdateSettings: func(in *awsecs.UpdateClusterSettingsInput) awsecs.UpdateClusterSettingsRequest {
+				settings = in.Settings
+				return awsecs.UpdateClusterSettingsRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsecs.UpdateClusterSettingsOutput{}, Error: tc.err},
+				}
+			}}}
+			_, err := e.Update(context.Background(), cluster(withContainerInsights("enabled")))
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			want := []awsecs.ClusterSetting{{Name: awsecs.ClusterSettingNameContainerInsights, Value: aws.String("enabled")}}
+			if diff := cmp.Diff(want, settings); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want error
+	}{
+		"Successful":   {},
+		"AlreadyGone":  {err: awserr.New(awsecs.ErrCodeClusterNotFoundException, "", nil)},
+		"DeleteFailed": {err: errBoom, want: awsclient.Wrap(errBoom, errDeleteFailed)},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: &fake.MockClusterClient{MockDelete: func(*awsecs.DeleteClusterInput) awsecs.DeleteClusterRequest {
+				return awsecs.DeleteClusterRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsecs.DeleteClusterOutput{}, Error: tc.err},
+				}
+			}}}
+			cr := cluster()
+			err := e.Delete(context.Background(), cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(cluster(withConditions(xpv1.Deleting())), cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,388 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsecs "github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ecs/fake"
+)
+
+var (
+	serviceName    = "web"
+	serviceARN     = "arn:aws:ecs:us-east-1:123456789012:service/default/web"
+	clusterARN     = "arn:aws:ecs:us-east-1:123456789012:cluster/default"
+	taskDefinition = "arn:aws:ecs:us-east-1:123456789012:task-definition/web:3"
+
+	errBoom = errors.New("boom")
+)
+
+type serviceModifier func(*v1alpha1.Service)
+
+func withConditions(c ...xpv1.Condition) serviceModifier {
+	return func(r *v1alpha1.Service) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.ServiceObservation) serviceModifier {
+	return func(r *v1alpha1.Service) { r.Status.AtProvider = o }
+}
+
+func withDesiredCount(n int64) serviceModifier {
+	return func(r *v1alpha1.Service) { r.Spec.ForProvider.DesiredCount = aws.Int64(n) }
+}
+
+func withStatus(s string) serviceModifier {
+	return func(r *v1alpha1.Service) { r.Status.AtProvider.Status = s }
+}
+
+func service(m ...serviceModifier) *v1alpha1.Service {
+	cr := &v1alpha1.Service{
+		Spec: v1alpha1.ServiceSpec{ForProvider: v1alpha1.ServiceParameters{
+			Cluster:        aws.String(clusterARN),
+			TaskDefinition: "web:3",
+		}},
+	}
+	meta.SetExternalName(cr, serviceName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observation(running int64, d ...awsecs.Deployment) (awsecs.Service, v1alpha1.ServiceObservation) {
+	svc := awsecs.Service{
+		ServiceArn:     aws.String(serviceARN),
+		ClusterArn:     aws.String(clusterARN),
+		Status:         aws.String(v1alpha1.ServiceStatusActive),
+		TaskDefinition: aws.String(taskDefinition),
+		DesiredCount:   aws.Int64(2),
+		RunningCount:   aws.Int64(running),
+		PendingCount:   aws.Int64(2 - running),
+		Deployments:    d,
+	}
+	o := v1alpha1.ServiceObservation{
+		ServiceARN:   serviceARN,
+		ClusterARN:   clusterARN,
+		Status:       v1alpha1.ServiceStatusActive,
+		RunningCount: running,
+		PendingCount: 2 - running,
+	}
+	for _, dep := range d {
+		o.Deployments = append(o.Deployments, v1alpha1.ServiceDeployment{
+			ID:             aws.StringValue(dep.Id),
+			Status:         aws.StringValue(dep.Status),
+			TaskDefinition: aws.StringValue(dep.TaskDefinition),
+		})
+	}
+	return svc, o
+}
+
+func describe(svc []awsecs.Service, err error) func(*awsecs.DescribeServicesInput) awsecs.DescribeServicesRequest {
+	return func(*awsecs.DescribeServicesInput) awsecs.DescribeServicesRequest {
+		return awsecs.DescribeServicesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsecs.DescribeServicesOutput{Services: svc}, Error: err},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	primary := awsecs.Deployment{Id: aws.String("ecs-svc/1"), Status: aws.String("PRIMARY"), TaskDefinition: aws.String(taskDefinition)}
+	previous := awsecs.Deployment{Id: aws.String("ecs-svc/0"), Status: aws.String("ACTIVE"), TaskDefinition: aws.String(taskDefinition)}
+
+	steady, steadyObs := observation(2, primary)
+	rolling, rollingObs := observation(2, primary, previous)
+	draining, drainingObs := observation(0)
+	draining.Status = aws.String(v1alpha1.ServiceStatusDraining)
+	drainingObs.Status = v1alpha1.ServiceStatusDraining
+	inactive, _ := observation(0)
+	inactive.Status = aws.String(v1alpha1.ServiceStatusInactive)
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockServiceClient
+		kube   client.Client
+		cr     *v1alpha1.Service
+		want   want
+	}{
+		"SteadyState": {
+			client: &fake.MockServiceClient{MockDescribe: describe([]awsecs.Service{steady}, nil)},
+			cr:     service(withDesiredCount(2)),
+			want: want{
+				cr:     service(withDesiredCount(2), withConditions(xpv1.Available()), withObservation(steadyObs)),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"RollingOut": {
+			client: &fake.MockServiceClient{MockDescribe: describe([]awsecs.Service{rolling}, nil)},
+			cr:     service(withDesiredCount(2)),
+			want: want{
+				cr:     service(withDesiredCount(2), withConditions(xpv1.Creating()), withObservation(rollingObs)),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"DesiredCountChanged": {
+			client: &fake.MockServiceClient{MockDescribe: describe([]awsecs.Service{steady}, nil)},
+			cr:     service(withDesiredCount(4)),
+			want: want{
+				cr:     service(withDesiredCount(4), withConditions(xpv1.Available()), withObservation(steadyObs)),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"LateInitialized": {
+			client: &fake.MockServiceClient{MockDescribe: describe([]awsecs.Service{steady}, nil)},
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			cr:     service(),
+			want: want{
+				cr:     service(withDesiredCount(2), withConditions(xpv1.Available()), withObservation(steadyObs)),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"SpecUpdateFailed": {
+			client: &fake.MockServiceClient{MockDescribe: describe([]awsecs.Service{steady}, nil)},
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			cr:     service(),
+			want: want{
+				cr:  service(withDesiredCount(2)),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+		"Draining": {
+			client: &fake.MockServiceClient{MockDescribe: describe([]awsecs.Service{draining}, nil)},
+			cr:     service(withDesiredCount(2)),
+			want: want{
+				cr:     service(withDesiredCount(2), withConditions(xpv1.Deleting()), withObservation(drainingObs)),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Inactive": {
+			client: &fake.MockServiceClient{MockDescribe: describe([]awsecs.Service{inactive}, nil)},
+			cr:     service(),
+			want: want{
+				cr: service(),
+			},
+		},
+		"Missing": {
+			client: &fake.MockServiceClient{MockDescribe: describe(nil, nil)},
+			cr:     service(),
+			want: want{
+				cr: service(),
+			},
+		},
+		"ClusterNotFound": {
+			client: &fake.MockServiceClient{MockDescribe: describe(nil, awserr.New(awsecs.ErrCodeClusterNotFoundException, "", nil))},
+			cr:     service(),
+			want: want{
+				cr: service(),
+			},
+		},
+		"DescribeFailed": {
+			client: &fake.MockServiceClient{MockDescribe: describe(nil, errBoom)},
+			cr:     service(),
+			want: want{
+				cr:  service(),
+				err: awsclient.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockServiceClient
+		cr     *v1alpha1.Service
+		want   want
+	}{
+		"Successful": {
+			client: &fake.MockServiceClient{MockCreate: func(in *awsecs.CreateServiceInput) awsecs.CreateServiceRequest {
+				if aws.StringValue(in.ServiceName) != serviceName || aws.StringValue(in.Cluster) != clusterARN {
+					return awsecs.CreateServiceRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom}}
+				}
+				return awsecs.CreateServiceRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsecs.CreateServiceOutput{}},
+				}
+			}},
+			cr: service(withDesiredCount(2)),
+			want: want{
+				cr: service(withDesiredCount(2), withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			client: &fake.MockServiceClient{MockCreate: func(*awsecs.CreateServiceInput) awsecs.CreateServiceRequest {
+				return awsecs.CreateServiceRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+				}
+			}},
+			cr: service(),
+			want: want{
+				cr:  service(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		client *fake.MockServiceClient
+		cr     *v1alpha1.Service
+		err    error
+	}{
+		"Successful": {
+			client: &fake.MockServiceClient{MockUpdate: func(in *awsecs.UpdateServiceInput) awsecs.UpdateServiceRequest {
+				if aws.Int64Value(in.DesiredCount) != 4 || aws.StringValue(in.TaskDefinition) != "web:3" {
+					return awsecs.UpdateServiceRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom}}
+				}
+				return awsecs.UpdateServiceRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsecs.UpdateServiceOutput{}},
+				}
+			}},
+			cr: service(withDesiredCount(4)),
+		},
+		"UpdateFailed": {
+			client: &fake.MockServiceClient{MockUpdate: func(*awsecs.UpdateServiceInput) awsecs.UpdateServiceRequest {
+				return awsecs.UpdateServiceRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+				}
+			}},
+			cr:  service(withDesiredCount(4)),
+			err: awsclient.Wrap(errBoom, errUpdateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	del := func(err error) func(*awsecs.DeleteServiceInput) awsecs.DeleteServiceRequest {
+		return func(in *awsecs.DeleteServiceInput) awsecs.DeleteServiceRequest {
+			if !aws.BoolValue(in.Force) {
+				err = errBoom
+			}
+			return awsecs.DeleteServiceRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsecs.DeleteServiceOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		client *fake.MockServiceClient
+		cr     *v1alpha1.Service
+		want   *v1alpha1.Service
+		err    error
+	}{
+		"Successful": {
+			client: &fake.MockServiceClient{MockDelete: del(nil)},
+			cr:     service(),
+			want:   service(withConditions(xpv1.Deleting())),
+		},
+		"AlreadyDraining": {
+			client: &fake.MockServiceClient{},
+			cr:     service(withStatus(v1alpha1.ServiceStatusDraining)),
+			want:   service(withStatus(v1alpha1.ServiceStatusDraining), withConditions(xpv1.Deleting())),
+		},
+		"AlreadyGone": {
+			client: &fake.MockServiceClient{MockDelete: del(awserr.New(awsecs.ErrCodeServiceNotFoundException, "", nil))},
+			cr:     service(),
+			want:   service(withConditions(xpv1.Deleting())),
+		},
+		"DeleteFailed": {
+			client: &fake.MockServiceClient{MockDelete: del(errBoom)},
+			cr:     service(),
+			want:   service(withConditions(xpv1.Deleting())),
+			err:    awsclient.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
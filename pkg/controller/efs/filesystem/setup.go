@@ -2,6 +2,7 @@ package filesystem
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	svcsdk "github.com/aws/aws-sdk-go/service/efs"
@@ -22,7 +23,7 @@ import (
 )
 
 // SetupFileSystem adds a controller that reconciles FileSystem.
-func SetupFileSystem(mgr ctrl.Manager, l logging.Logger, limiter workqueue.RateLimiter) error {
+func SetupFileSystem(mgr ctrl.Manager, l logging.Logger, limiter workqueue.RateLimiter, o awsclients.Options) error {
 	name := managed.ControllerName(svcapitypes.FileSystemGroupKind)
 	opts := []option{
 		func(e *external) {
@@ -38,13 +39,15 @@ func SetupFileSystem(mgr ctrl.Manager, l logging.Logger, limiter workqueue.RateL
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(limiter),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(limiter),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
 		}).
 		For(&svcapitypes.FileSystem{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.FileSystemGroupVersionKind),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(awsclients.NewConnecter(&connector{kube: mgr.GetClient(), opts: opts}, o)),
+			managed.WithTimeout(o.ReconcileTimeout),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -76,7 +79,8 @@ func postObserve(_ context.Context, cr *svcapitypes.FileSystem, obj *svcsdk.Desc
 		cr.SetConditions(xpv1.Available())
 	}
 	obs.ConnectionDetails = managed.ConnectionDetails{
-		svcapitypes.ResourceCredentialsSecretIDKey: []byte(meta.GetExternalName(cr)),
+		svcapitypes.ResourceCredentialsSecretIDKey:      []byte(meta.GetExternalName(cr)),
+		svcapitypes.ResourceCredentialsSecretDNSNameKey: []byte(fmt.Sprintf("%s.efs.%s.amazonaws.com", meta.GetExternalName(cr), cr.Spec.ForProvider.Region)),
 	}
 	return obs, nil
 }
@@ -0,0 +1,504 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mounttarget
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsefs "github.com/aws/aws-sdk-go-v2/service/efs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/efs/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/efs"
+	"github.com/crossplane/provider-aws/pkg/clients/efs/fake"
+)
+
+var (
+	errBoom       = errors.New("boom")
+	mountTargetID = "fsmt-12345678"
+	fileSystemID  = "fs-12345678"
+	subnetID      = "subnet-12345678"
+	ipAddress     = "10.0.0.10"
+	sg1           = "sg-1"
+	sg2           = "sg-2"
+)
+
+type args struct {
+	kube client.Client
+	efs  efs.Client
+	cr   *v1alpha1.MountTarget
+}
+
+type mountTargetModifier func(*v1alpha1.MountTarget)
+
+func withExternalName(n string) mountTargetModifier {
+	return func(r *v1alpha1.MountTarget) { meta.SetExternalName(r, n) }
+}
+
+func withConditions(c ...xpv1.Condition) mountTargetModifier {
+	return func(r *v1alpha1.MountTarget) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.MountTargetObservation) mountTargetModifier {
+	return func(r *v1alpha1.MountTarget) { r.Status.AtProvider = o }
+}
+
+func withIPAddress(ip string) mountTargetModifier {
+	return func(r *v1alpha1.MountTarget) { r.Spec.ForProvider.IPAddress = aws.String(ip) }
+}
+
+func withSecurityGroups(sg ...string) mountTargetModifier {
+	return func(r *v1alpha1.MountTarget) { r.Spec.ForProvider.SecurityGroups = sg }
+}
+
+func mountTarget(m ...mountTargetModifier) *v1alpha1.MountTarget {
+	cr := &v1alpha1.MountTarget{
+		Spec: v1alpha1.MountTargetSpec{
+			ForProvider: v1alpha1.MountTargetParameters{
+				FileSystemID: aws.String(fileSystemID),
+				SubnetID:     aws.String(subnetID),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(err error, state awsefs.LifeCycleState) func(*awsefs.DescribeMountTargetsInput) awsefs.DescribeMountTargetsRequest {
+	return func(*awsefs.DescribeMountTargetsInput) awsefs.DescribeMountTargetsRequest {
+		return awsefs.DescribeMountTargetsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsefs.DescribeMountTargetsOutput{
+				MountTargets: []awsefs.MountTargetDescription{{
+					MountTargetId:  aws.String(mountTargetID),
+					FileSystemId:   aws.String(fileSystemID),
+					SubnetId:       aws.String(subnetID),
+					IpAddress:      aws.String(ipAddress),
+					LifeCycleState: state,
+				}},
+			}},
+		}
+	}
+}
+
+func describeSecurityGroups(sg ...string) func(*awsefs.DescribeMountTargetSecurityGroupsInput) awsefs.DescribeMountTargetSecurityGroupsRequest {
+	return func(*awsefs.DescribeMountTargetSecurityGroupsInput) awsefs.DescribeMountTargetSecurityGroupsRequest {
+		return awsefs.DescribeMountTargetSecurityGroupsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsefs.DescribeMountTargetSecurityGroupsOutput{
+				SecurityGroups: sg,
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.MountTarget
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: mountTarget(),
+			},
+			want: want{
+				cr: mountTarget(),
+			},
+		},
+		"Available": {
+			args: args{
+				efs: &fake.MockClient{
+					MockDescribe:               describe(nil, awsefs.LifeCycleStateAvailable),
+					MockDescribeSecurityGroups: describeSecurityGroups(sg1),
+				},
+				cr: mountTarget(withExternalName(mountTargetID), withIPAddress(ipAddress), withSecurityGroups(sg1)),
+			},
+			want: want{
+				cr: mountTarget(
+					withExternalName(mountTargetID),
+					withIPAddress(ipAddress),
+					withSecurityGroups(sg1),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.MountTargetObservation{
+						MountTargetID:  mountTargetID,
+						LifeCycleState: string(awsefs.LifeCycleStateAvailable),
+						IPAddress:      ipAddress,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"SecurityGroupsChanged": {
+			args: args{
+				efs: &fake.MockClient{
+					MockDescribe:               describe(nil, awsefs.LifeCycleStateAvailable),
+					MockDescribeSecurityGroups: describeSecurityGroups(sg1),
+				},
+				cr: mountTarget(withExternalName(mountTargetID), withIPAddress(ipAddress), withSecurityGroups(sg2)),
+			},
+			want: want{
+				cr: mountTarget(
+					withExternalName(mountTargetID),
+					withIPAddress(ipAddress),
+					withSecurityGroups(sg2),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.MountTargetObservation{
+						MountTargetID:  mountTargetID,
+						LifeCycleState: string(awsefs.LifeCycleStateAvailable),
+						IPAddress:      ipAddress,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				efs: &fake.MockClient{
+					MockDescribe:               describe(nil, awsefs.LifeCycleStateCreating),
+					MockDescribeSecurityGroups: describeSecurityGroups(sg1),
+				},
+				cr: mountTarget(withExternalName(mountTargetID)),
+			},
+			want: want{
+				cr: mountTarget(
+					withExternalName(mountTargetID),
+					withIPAddress(ipAddress),
+					withSecurityGroups(sg1),
+					withConditions(xpv1.Creating()),
+					withObservation(v1alpha1.MountTargetObservation{
+						MountTargetID:  mountTargetID,
+						LifeCycleState: string(awsefs.LifeCycleStateCreating),
+						IPAddress:      ipAddress,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				efs: &fake.MockClient{
+					MockDescribe:               describe(nil, awsefs.LifeCycleStateCreating),
+					MockDescribeSecurityGroups: describeSecurityGroups(sg1),
+				},
+				cr: mountTarget(withExternalName(mountTargetID)),
+			},
+			want: want{
+				cr:  mountTarget(withExternalName(mountTargetID), withIPAddress(ipAddress), withSecurityGroups(sg1)),
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+		"Deleted": {
+			args: args{
+				efs: &fake.MockClient{
+					MockDescribe: describe(nil, awsefs.LifeCycleStateDeleted),
+				},
+				cr: mountTarget(withExternalName(mountTargetID)),
+			},
+			want: want{
+				cr: mountTarget(withExternalName(mountTargetID)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				efs: &fake.MockClient{
+					MockDescribe: describe(awserr.New(awsefs.ErrCodeMountTargetNotFound, "", nil), ""),
+				},
+				cr: mountTarget(withExternalName(mountTargetID)),
+			},
+			want: want{
+				cr: mountTarget(withExternalName(mountTargetID)),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				efs: &fake.MockClient{
+					MockDescribe: describe(errBoom, ""),
+				},
+				cr: mountTarget(withExternalName(mountTargetID)),
+			},
+			want: want{
+				cr:  mountTarget(withExternalName(mountTargetID)),
+				err: awsclient.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.efs}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.MountTarget
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				efs: &fake.MockClient{
+					MockCreate: func(input *awsefs.CreateMountTargetInput) awsefs.CreateMountTargetRequest {
+						if diff := cmp.Diff(fileSystemID, aws.StringValue(input.FileSystemId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsefs.CreateMountTargetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsefs.CreateMountTargetOutput{
+								MountTargetId: aws.String(mountTargetID),
+							}},
+						}
+					},
+				},
+				cr: mountTarget(),
+			},
+			want: want{
+				cr:     mountTarget(withExternalName(mountTargetID), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				efs: &fake.MockClient{
+					MockCreate: func(*awsefs.CreateMountTargetInput) awsefs.CreateMountTargetRequest {
+						return awsefs.CreateMountTargetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: mountTarget(),
+			},
+			want: want{
+				cr:  mountTarget(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.efs}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.MountTarget
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				efs: &fake.MockClient{
+					MockModifySecurityGroups: func(input *awsefs.ModifyMountTargetSecurityGroupsInput) awsefs.ModifyMountTargetSecurityGroupsRequest {
+						if diff := cmp.Diff([]string{sg2}, input.SecurityGroups); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsefs.ModifyMountTargetSecurityGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsefs.ModifyMountTargetSecurityGroupsOutput{}},
+						}
+					},
+				},
+				cr: mountTarget(withExternalName(mountTargetID), withSecurityGroups(sg2)),
+			},
+			want: want{
+				cr: mountTarget(withExternalName(mountTargetID), withSecurityGroups(sg2)),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				efs: &fake.MockClient{
+					MockModifySecurityGroups: func(*awsefs.ModifyMountTargetSecurityGroupsInput) awsefs.ModifyMountTargetSecurityGroupsRequest {
+						return awsefs.ModifyMountTargetSecurityGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: mountTarget(withExternalName(mountTargetID)),
+			},
+			want: want{
+				cr:  mountTarget(withExternalName(mountTargetID)),
+				err: awsclient.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.efs}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.MountTarget
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				efs: &fake.MockClient{
+					MockDelete: func(*awsefs.DeleteMountTargetInput) awsefs.DeleteMountTargetRequest {
+						return awsefs.DeleteMountTargetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsefs.DeleteMountTargetOutput{}},
+						}
+					},
+				},
+				cr: mountTarget(withExternalName(mountTargetID)),
+			},
+			want: want{
+				cr: mountTarget(withExternalName(mountTargetID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				cr: mountTarget(
+					withExternalName(mountTargetID),
+					withObservation(v1alpha1.MountTargetObservation{LifeCycleState: string(awsefs.LifeCycleStateDeleting)})),
+			},
+			want: want{
+				cr: mountTarget(
+					withExternalName(mountTargetID),
+					withObservation(v1alpha1.MountTargetObservation{LifeCycleState: string(awsefs.LifeCycleStateDeleting)}),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				efs: &fake.MockClient{
+					MockDelete: func(*awsefs.DeleteMountTargetInput) awsefs.DeleteMountTargetRequest {
+						return awsefs.DeleteMountTargetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsefs.ErrCodeMountTargetNotFound, "", nil)},
+						}
+					},
+				},
+				cr: mountTarget(withExternalName(mountTargetID)),
+			},
+			want: want{
+				cr: mountTarget(withExternalName(mountTargetID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				efs: &fake.MockClient{
+					MockDelete: func(*awsefs.DeleteMountTargetInput) awsefs.DeleteMountTargetRequest {
+						return awsefs.DeleteMountTargetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: mountTarget(withExternalName(mountTargetID)),
+			},
+			want: want{
+				cr:  mountTarget(withExternalName(mountTargetID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.efs}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
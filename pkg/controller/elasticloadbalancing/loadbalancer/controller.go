@@ -0,0 +1,305 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/elasticloadbalancing/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticloadbalancing/elbv2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a LoadBalancer resource"
+
+	errDescribe          = "cannot describe LoadBalancer with given name"
+	errDescribeListeners = "cannot describe listeners of LoadBalancer"
+	errDescribeTags      = "cannot describe tags of LoadBalancer"
+	errMultipleItems     = "retrieved multiple LoadBalancers for the given name"
+	errCreate            = "cannot create the LoadBalancer resource"
+	errCreateListener    = "cannot create listener of LoadBalancer"
+	errModifyListener    = "cannot modify listener of LoadBalancer"
+	errDeleteListener    = "cannot delete listener of LoadBalancer"
+	errUpdate            = "cannot update LoadBalancer resource"
+	errUpdateTags        = "cannot update tags of LoadBalancer"
+	errDelete            = "cannot delete the LoadBalancer resource"
+	errSpecUpdate        = "cannot update spec of LoadBalancer custom resource"
+)
+
+// SetupLoadBalancer adds a controller that reconciles LoadBalancers.
+func SetupLoadBalancer(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclient.Options) error {
+	name := managed.ControllerName(v1alpha1.LoadBalancerGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		For(&v1alpha1.LoadBalancer{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.LoadBalancerGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: elbv2.NewClient}, o)),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithTimeout(o.ReconcileTimeout),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) elbv2.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client elbv2.Client
+}
+
+// describe returns the load balancer with the given name together with its
+// listeners and tags.
+func (e *external) describe(ctx context.Context, name string) (awselbv2.LoadBalancer, []awselbv2.Listener, []awselbv2.Tag, error) {
+	rsp, err := e.client.DescribeLoadBalancersRequest(&awselbv2.DescribeLoadBalancersInput{
+		Names: []string{name},
+	}).Send(ctx)
+	if err != nil {
+		return awselbv2.LoadBalancer{}, nil, nil, awsclient.Wrap(err, errDescribe)
+	}
+	// in a successful response, there should be one and only one object
+	if len(rsp.LoadBalancers) != 1 {
+		return awselbv2.LoadBalancer{}, nil, nil, errors.New(errMultipleItems)
+	}
+	lb := rsp.LoadBalancers[0]
+
+	lrsp, err := e.client.DescribeListenersRequest(&awselbv2.DescribeListenersInput{
+		LoadBalancerArn: lb.LoadBalancerArn,
+	}).Send(ctx)
+	if err != nil {
+		return awselbv2.LoadBalancer{}, nil, nil, awsclient.Wrap(err, errDescribeListeners)
+	}
+
+	trsp, err := e.client.DescribeTagsRequest(&awselbv2.DescribeTagsInput{
+		ResourceArns: []string{aws.StringValue(lb.LoadBalancerArn)},
+	}).Send(ctx)
+	if err != nil {
+		return awselbv2.LoadBalancer{}, nil, nil, awsclient.Wrap(err, errDescribeTags)
+	}
+	var tags []awselbv2.Tag
+	if len(trsp.TagDescriptions) != 0 {
+		tags = trsp.TagDescriptions[0].Tags
+	}
+	return lb, lrsp.Listeners, tags, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	lb, listeners, tags, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, resource.Ignore(elbv2.IsNotFound, err)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	elbv2.LateInitialize(&cr.Spec.ForProvider, &lb)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = elbv2.GenerateObservation(lb)
+	switch cr.Status.AtProvider.State {
+	case string(awselbv2.LoadBalancerStateEnumActive):
+		cr.SetConditions(xpv1.Available())
+	case string(awselbv2.LoadBalancerStateEnumProvisioning):
+		cr.SetConditions(xpv1.Creating())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: elbv2.IsUpToDate(cr.Spec.ForProvider, lb, listeners, tags),
+		ConnectionDetails: managed.ConnectionDetails{
+			v1alpha1.ResourceCredentialsSecretDNSNameKey:      []byte(aws.StringValue(lb.DNSName)),
+			v1alpha1.ResourceCredentialsSecretHostedZoneIDKey: []byte(aws.StringValue(lb.CanonicalHostedZoneId)),
+		},
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	rsp, err := e.client.CreateLoadBalancerRequest(elbv2.GenerateCreateLoadBalancerInput(meta.GetExternalName(cr),
+		cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	if len(rsp.LoadBalancers) != 1 {
+		return managed.ExternalCreation{}, nil
+	}
+
+	// Listeners that fail to be created here are created by the next update.
+	arn := aws.StringValue(rsp.LoadBalancers[0].LoadBalancerArn)
+	for _, l := range cr.Spec.ForProvider.Listeners {
+		if _, err := e.client.CreateListenerRequest(elbv2.GenerateCreateListenerInput(arn, l)).Send(ctx); err != nil {
+			return managed.ExternalCreation{}, awsclient.Wrap(err, errCreateListener)
+		}
+	}
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	lb, listeners, tags, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	p := cr.Spec.ForProvider
+
+	if !elbv2.IsSubnetsUpToDate(p, lb) {
+		if _, err := e.client.SetSubnetsRequest(&awselbv2.SetSubnetsInput{
+			LoadBalancerArn: lb.LoadBalancerArn,
+			Subnets:         p.SubnetIDs,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+		}
+	}
+
+	if !elbv2.IsSecurityGroupsUpToDate(p, lb) {
+		if _, err := e.client.SetSecurityGroupsRequest(&awselbv2.SetSecurityGroupsInput{
+			LoadBalancerArn: lb.LoadBalancerArn,
+			SecurityGroups:  p.SecurityGroupIDs,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+		}
+	}
+
+	if !elbv2.IsIPAddressTypeUpToDate(p, lb) {
+		if _, err := e.client.SetIpAddressTypeRequest(&awselbv2.SetIpAddressTypeInput{
+			LoadBalancerArn: lb.LoadBalancerArn,
+			IpAddressType:   awselbv2.IpAddressType(aws.StringValue(p.IPAddressType)),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+		}
+	}
+
+	if err := e.updateListeners(ctx, aws.StringValue(lb.LoadBalancerArn), p.Listeners, listeners); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	return managed.ExternalUpdate{}, awsclient.Wrap(e.updateTags(ctx, aws.StringValue(lb.LoadBalancerArn), p.Tags, tags), errUpdateTags)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	// Listeners are deleted together with the load balancer.
+	_, err := e.client.DeleteLoadBalancerRequest(&awselbv2.DeleteLoadBalancerInput{
+		LoadBalancerArn: aws.String(cr.Status.AtProvider.LoadBalancerARN),
+	}).Send(ctx)
+
+	return awsclient.Wrap(resource.Ignore(elbv2.IsNotFound, err), errDelete)
+}
+
+func (e *external) updateListeners(ctx context.Context, arn string, desired []v1alpha1.LoadBalancerListener, observed []awselbv2.Listener) error {
+	create, modify, remove := elbv2.DiffListeners(desired, observed)
+
+	for _, l := range remove {
+		if _, err := e.client.DeleteListenerRequest(&awselbv2.DeleteListenerInput{ListenerArn: aws.String(l)}).Send(ctx); err != nil {
+			return awsclient.Wrap(err, errDeleteListener)
+		}
+	}
+	for l, p := range modify {
+		if _, err := e.client.ModifyListenerRequest(elbv2.GenerateModifyListenerInput(l, p)).Send(ctx); err != nil {
+			return awsclient.Wrap(err, errModifyListener)
+		}
+	}
+	for _, l := range create {
+		if _, err := e.client.CreateListenerRequest(elbv2.GenerateCreateListenerInput(arn, l)).Send(ctx); err != nil {
+			return awsclient.Wrap(err, errCreateListener)
+		}
+	}
+	return nil
+}
+
+func (e *external) updateTags(ctx context.Context, arn string, local []v1alpha1.Tag, remote []awselbv2.Tag) error {
+	add, remove := elbv2.DiffTags(local, remote)
+	if len(remove) != 0 {
+		if _, err := e.client.RemoveTagsRequest(&awselbv2.RemoveTagsInput{
+			ResourceArns: []string{arn},
+			TagKeys:      remove,
+		}).Send(ctx); err != nil {
+			return err
+		}
+	}
+	if len(add) != 0 {
+		if _, err := e.client.AddTagsRequest(&awselbv2.AddTagsInput{
+			ResourceArns: []string{arn},
+			Tags:         add,
+		}).Send(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,513 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/elasticloadbalancing/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticloadbalancing/elbv2"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticloadbalancing/elbv2/fake"
+)
+
+var (
+	lbName      = "some-alb"
+	lbARN       = "arn:lb"
+	listenerARN = "arn:listener"
+	dnsName     = "some-alb.elb.amazonaws.com"
+	zoneID      = "Z123"
+	subnets     = []string{"subnet-1", "subnet-2"}
+	scheme      = "internet-facing"
+	ipv4        = "ipv4"
+
+	httpListener = v1alpha1.LoadBalancerListener{
+		Port:     80,
+		Protocol: "HTTP",
+		DefaultAction: v1alpha1.ListenerAction{
+			Type:          "fixed-response",
+			FixedResponse: &v1alpha1.FixedResponseAction{StatusCode: "404"},
+		},
+	}
+	awsHTTPListener = awselbv2.Listener{
+		ListenerArn: &listenerARN,
+		Port:        aws.Int64(80),
+		Protocol:    awselbv2.ProtocolEnumHttp,
+		DefaultActions: []awselbv2.Action{{
+			Type:                awselbv2.ActionTypeEnumFixedResponse,
+			FixedResponseConfig: &awselbv2.FixedResponseActionConfig{StatusCode: aws.String("404")},
+		}},
+	}
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	kube  client.Client
+	elbv2 elbv2.Client
+	cr    resource.Managed
+}
+
+type lbModifier func(*v1alpha1.LoadBalancer)
+
+func withConditions(c ...xpv1.Condition) lbModifier {
+	return func(r *v1alpha1.LoadBalancer) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.LoadBalancerParameters) lbModifier {
+	return func(r *v1alpha1.LoadBalancer) { r.Spec.ForProvider = p }
+}
+
+func withStatus(o v1alpha1.LoadBalancerObservation) lbModifier {
+	return func(r *v1alpha1.LoadBalancer) { r.Status.AtProvider = o }
+}
+
+func loadBalancer(m ...lbModifier) *v1alpha1.LoadBalancer {
+	cr := &v1alpha1.LoadBalancer{}
+	meta.SetExternalName(cr, lbName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func awsLoadBalancer(state awselbv2.LoadBalancerStateEnum) awselbv2.LoadBalancer {
+	return awselbv2.LoadBalancer{
+		LoadBalancerArn:       &lbARN,
+		DNSName:               &dnsName,
+		CanonicalHostedZoneId: &zoneID,
+		Scheme:                awselbv2.LoadBalancerSchemeEnumInternetFacing,
+		IpAddressType:         awselbv2.IpAddressTypeIpv4,
+		AvailabilityZones: []awselbv2.AvailabilityZone{
+			{SubnetId: &subnets[0]},
+			{SubnetId: &subnets[1]},
+		},
+		State: &awselbv2.LoadBalancerState{Code: state},
+	}
+}
+
+func describeMocks(m *fake.MockClient, lb awselbv2.LoadBalancer, listeners []awselbv2.Listener) *fake.MockClient {
+	m.MockDescribeLoadBalancersRequest = func(*awselbv2.DescribeLoadBalancersInput) awselbv2.DescribeLoadBalancersRequest {
+		return awselbv2.DescribeLoadBalancersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DescribeLoadBalancersOutput{
+				LoadBalancers: []awselbv2.LoadBalancer{lb},
+			}},
+		}
+	}
+	m.MockDescribeListenersRequest = func(*awselbv2.DescribeListenersInput) awselbv2.DescribeListenersRequest {
+		return awselbv2.DescribeListenersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DescribeListenersOutput{
+				Listeners: listeners,
+			}},
+		}
+	}
+	m.MockDescribeTagsRequest = func(*awselbv2.DescribeTagsInput) awselbv2.DescribeTagsRequest {
+		return awselbv2.DescribeTagsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DescribeTagsOutput{
+				TagDescriptions: []awselbv2.TagDescription{{ResourceArn: &lbARN}},
+			}},
+		}
+	}
+	return m
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	lateInited := v1alpha1.LoadBalancerParameters{
+		Scheme:        &scheme,
+		IPAddressType: &ipv4,
+		SubnetIDs:     subnets,
+		Listeners:     []v1alpha1.LoadBalancerListener{httpListener},
+	}
+	conn := managed.ConnectionDetails{
+		v1alpha1.ResourceCredentialsSecretDNSNameKey:      []byte(dnsName),
+		v1alpha1.ResourceCredentialsSecretHostedZoneIDKey: []byte(zoneID),
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Active": {
+			args: args{
+				kube:  &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				elbv2: describeMocks(&fake.MockClient{}, awsLoadBalancer(awselbv2.LoadBalancerStateEnumActive), []awselbv2.Listener{awsHTTPListener}),
+				cr:    loadBalancer(withSpec(v1alpha1.LoadBalancerParameters{Listeners: []v1alpha1.LoadBalancerListener{httpListener}})),
+			},
+			want: want{
+				cr: loadBalancer(withSpec(lateInited),
+					withStatus(v1alpha1.LoadBalancerObservation{LoadBalancerARN: lbARN, DNSName: dnsName, CanonicalHostedZoneID: zoneID, State: "active"}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: conn,
+				},
+			},
+		},
+		"ProvisioningMissingListener": {
+			args: args{
+				kube:  &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				elbv2: describeMocks(&fake.MockClient{}, awsLoadBalancer(awselbv2.LoadBalancerStateEnumProvisioning), nil),
+				cr:    loadBalancer(withSpec(v1alpha1.LoadBalancerParameters{Listeners: []v1alpha1.LoadBalancerListener{httpListener}})),
+			},
+			want: want{
+				cr: loadBalancer(withSpec(lateInited),
+					withStatus(v1alpha1.LoadBalancerObservation{LoadBalancerARN: lbARN, DNSName: dnsName, CanonicalHostedZoneID: zoneID, State: "provisioning"}),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: conn,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				elbv2: &fake.MockClient{
+					MockDescribeLoadBalancersRequest: func(*awselbv2.DescribeLoadBalancersInput) awselbv2.DescribeLoadBalancersRequest {
+						return awselbv2.DescribeLoadBalancersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awselbv2.ErrCodeLoadBalancerNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: loadBalancer(),
+			},
+			want: want{
+				cr: loadBalancer(),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				elbv2: &fake.MockClient{
+					MockDescribeLoadBalancersRequest: func(*awselbv2.DescribeLoadBalancersInput) awselbv2.DescribeLoadBalancersRequest {
+						return awselbv2.DescribeLoadBalancersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: loadBalancer(),
+			},
+			want: want{
+				cr:  loadBalancer(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elbv2}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr        resource.Managed
+		listeners int
+		err       error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				elbv2: &fake.MockClient{
+					MockCreateLoadBalancerRequest: func(*awselbv2.CreateLoadBalancerInput) awselbv2.CreateLoadBalancerRequest {
+						return awselbv2.CreateLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.CreateLoadBalancerOutput{
+								LoadBalancers: []awselbv2.LoadBalancer{{LoadBalancerArn: &lbARN}},
+							}},
+						}
+					},
+					MockCreateListenerRequest: func(i *awselbv2.CreateListenerInput) awselbv2.CreateListenerRequest {
+						if aws.StringValue(i.LoadBalancerArn) != lbARN {
+							return awselbv2.CreateListenerRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom}}
+						}
+						return awselbv2.CreateListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.CreateListenerOutput{}},
+						}
+					},
+				},
+				cr: loadBalancer(withSpec(v1alpha1.LoadBalancerParameters{Listeners: []v1alpha1.LoadBalancerListener{httpListener}})),
+			},
+			want: want{
+				cr: loadBalancer(withSpec(v1alpha1.LoadBalancerParameters{Listeners: []v1alpha1.LoadBalancerListener{httpListener}}),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateError": {
+			args: args{
+				elbv2: &fake.MockClient{
+					MockCreateLoadBalancerRequest: func(*awselbv2.CreateLoadBalancerInput) awselbv2.CreateLoadBalancerRequest {
+						return awselbv2.CreateLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: loadBalancer(),
+			},
+			want: want{
+				cr:  loadBalancer(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+		"CreateListenerError": {
+			args: args{
+				elbv2: &fake.MockClient{
+					MockCreateLoadBalancerRequest: func(*awselbv2.CreateLoadBalancerInput) awselbv2.CreateLoadBalancerRequest {
+						return awselbv2.CreateLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.CreateLoadBalancerOutput{
+								LoadBalancers: []awselbv2.LoadBalancer{{LoadBalancerArn: &lbARN}},
+							}},
+						}
+					},
+					MockCreateListenerRequest: func(*awselbv2.CreateListenerInput) awselbv2.CreateListenerRequest {
+						return awselbv2.CreateListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: loadBalancer(withSpec(v1alpha1.LoadBalancerParameters{Listeners: []v1alpha1.LoadBalancerListener{httpListener}})),
+			},
+			want: want{
+				cr: loadBalancer(withSpec(v1alpha1.LoadBalancerParameters{Listeners: []v1alpha1.LoadBalancerListener{httpListener}}),
+					withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreateListener),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.elbv2}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	https := v1alpha1.LoadBalancerListener{
+		Port:           443,
+		Protocol:       "HTTPS",
+		CertificateARN: aws.String("arn:cert"),
+		DefaultAction:  v1alpha1.ListenerAction{Type: "forward", TargetGroupARN: aws.String("arn:tg")},
+	}
+	redirect := httpListener
+	redirect.DefaultAction = v1alpha1.ListenerAction{Type: "redirect", Redirect: &v1alpha1.RedirectAction{StatusCode: "HTTP_301", Protocol: aws.String("HTTPS")}}
+
+	cases := map[string]struct {
+		args
+		spec    v1alpha1.LoadBalancerParameters
+		lb      awselbv2.LoadBalancer
+		observe []awselbv2.Listener
+		want
+	}{
+		"NoChange": {
+			spec:    v1alpha1.LoadBalancerParameters{SubnetIDs: subnets, Listeners: []v1alpha1.LoadBalancerListener{httpListener}},
+			lb:      awsLoadBalancer(awselbv2.LoadBalancerStateEnumActive),
+			observe: []awselbv2.Listener{awsHTTPListener},
+		},
+		"ChangedSubnetsSecurityGroupsAndTags": {
+			spec: v1alpha1.LoadBalancerParameters{
+				SubnetIDs:        []string{"subnet-3", "subnet-4"},
+				SecurityGroupIDs: []string{"sg-1"},
+				Listeners:        []v1alpha1.LoadBalancerListener{httpListener},
+				Tags:             []v1alpha1.Tag{{Key: "k", Value: aws.String("v")}},
+			},
+			lb:      awsLoadBalancer(awselbv2.LoadBalancerStateEnumActive),
+			observe: []awselbv2.Listener{awsHTTPListener},
+			want:    want{calls: []string{"SetSubnets", "SetSecurityGroups", "AddTags"}},
+		},
+		"Listeners": {
+			spec:    v1alpha1.LoadBalancerParameters{SubnetIDs: subnets, Listeners: []v1alpha1.LoadBalancerListener{redirect, https}},
+			lb:      awsLoadBalancer(awselbv2.LoadBalancerStateEnumActive),
+			observe: []awselbv2.Listener{awsHTTPListener, {ListenerArn: aws.String("arn:old"), Port: aws.Int64(8080)}},
+			want:    want{calls: []string{"DeleteListener arn:old", "ModifyListener " + listenerARN, "CreateListener 443"}},
+		},
+		"ListenerError": {
+			spec:    v1alpha1.LoadBalancerParameters{SubnetIDs: subnets, Listeners: []v1alpha1.LoadBalancerListener{httpListener, https}},
+			lb:      awsLoadBalancer(awselbv2.LoadBalancerStateEnumActive),
+			observe: []awselbv2.Listener{awsHTTPListener},
+			args: args{
+				elbv2: &fake.MockClient{
+					MockCreateListenerRequest: func(*awselbv2.CreateListenerInput) awselbv2.CreateListenerRequest {
+						return awselbv2.CreateListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+			},
+			want: want{err: awsclient.Wrap(errBoom, errCreateListener)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			m, ok := tc.elbv2.(*fake.MockClient)
+			if !ok {
+				m = &fake.MockClient{}
+			}
+			describeMocks(m, tc.lb, tc.observe)
+			m.MockSetSubnetsRequest = func(*awselbv2.SetSubnetsInput) awselbv2.SetSubnetsRequest {
+				calls = append(calls, "SetSubnets")
+				return awselbv2.SetSubnetsRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.SetSubnetsOutput{}}}
+			}
+			m.MockSetSecurityGroupsRequest = func(*awselbv2.SetSecurityGroupsInput) awselbv2.SetSecurityGroupsRequest {
+				calls = append(calls, "SetSecurityGroups")
+				return awselbv2.SetSecurityGroupsRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.SetSecurityGroupsOutput{}}}
+			}
+			m.MockAddTagsRequest = func(*awselbv2.AddTagsInput) awselbv2.AddTagsRequest {
+				calls = append(calls, "AddTags")
+				return awselbv2.AddTagsRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.AddTagsOutput{}}}
+			}
+			m.MockDeleteListenerRequest = func(i *awselbv2.DeleteListenerInput) awselbv2.DeleteListenerRequest {
+				calls = append(calls, "DeleteListener "+aws.StringValue(i.ListenerArn))
+				return awselbv2.DeleteListenerRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DeleteListenerOutput{}}}
+			}
+			m.MockModifyListenerRequest = func(i *awselbv2.ModifyListenerInput) awselbv2.ModifyListenerRequest {
+				calls = append(calls, "ModifyListener "+aws.StringValue(i.ListenerArn))
+				return awselbv2.ModifyListenerRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.ModifyListenerOutput{}}}
+			}
+			if m.MockCreateListenerRequest == nil {
+				m.MockCreateListenerRequest = func(i *awselbv2.CreateListenerInput) awselbv2.CreateListenerRequest {
+					calls = append(calls, "CreateListener 443")
+					return awselbv2.CreateListenerRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.CreateListenerOutput{}}}
+				}
+			}
+
+			e := &external{client: m}
+			_, err := e.Update(context.Background(), loadBalancer(withSpec(tc.spec)))
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				elbv2: &fake.MockClient{
+					MockDeleteLoadBalancerRequest: func(i *awselbv2.DeleteLoadBalancerInput) awselbv2.DeleteLoadBalancerRequest {
+						if aws.StringValue(i.LoadBalancerArn) != lbARN {
+							return awselbv2.DeleteLoadBalancerRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom}}
+						}
+						return awselbv2.DeleteLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DeleteLoadBalancerOutput{}},
+						}
+					},
+				},
+				cr: loadBalancer(withStatus(v1alpha1.LoadBalancerObservation{LoadBalancerARN: lbARN})),
+			},
+			want: want{
+				cr: loadBalancer(withStatus(v1alpha1.LoadBalancerObservation{LoadBalancerARN: lbARN}),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				elbv2: &fake.MockClient{
+					MockDeleteLoadBalancerRequest: func(*awselbv2.DeleteLoadBalancerInput) awselbv2.DeleteLoadBalancerRequest {
+						return awselbv2.DeleteLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: loadBalancer(),
+			},
+			want: want{
+				cr:  loadBalancer(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.elbv2}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
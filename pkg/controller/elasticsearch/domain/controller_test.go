@@ -0,0 +1,455 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domain
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awses "github.com/aws/aws-sdk-go-v2/service/elasticsearchservice"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/elasticsearch/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticsearch"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticsearch/fake"
+)
+
+var (
+	errBoom       = errors.New("boom")
+	instanceType  = "m5.large.elasticsearch"
+	instanceCount = int64(2)
+	endpoint      = "search-test.eu-west-1.es.amazonaws.com"
+)
+
+type args struct {
+	es   elasticsearch.Client
+	kube client.Client
+	cr   *v1alpha1.Domain
+}
+
+type domainModifier func(*v1alpha1.Domain)
+
+func withConditions(c ...xpv1.Condition) domainModifier {
+	return func(r *v1alpha1.Domain) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.DomainObservation) domainModifier {
+	return func(r *v1alpha1.Domain) { r.Status.AtProvider = o }
+}
+
+func withInstanceCount(c int64) domainModifier {
+	return func(r *v1alpha1.Domain) { r.Spec.ForProvider.ElasticsearchClusterConfig.InstanceCount = aws.Int64(c) }
+}
+
+func domain(m ...domainModifier) *v1alpha1.Domain {
+	cr := &v1alpha1.Domain{
+		Spec: v1alpha1.DomainSpec{
+			ForProvider: v1alpha1.DomainParameters{
+				ElasticsearchClusterConfig: &v1alpha1.ElasticsearchClusterConfig{
+					InstanceType:  aws.String(instanceType),
+					InstanceCount: aws.Int64(instanceCount),
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(s *awses.ElasticsearchDomainStatus, err error) func(*awses.DescribeElasticsearchDomainInput) awses.DescribeElasticsearchDomainRequest {
+	return func(*awses.DescribeElasticsearchDomainInput) awses.DescribeElasticsearchDomainRequest {
+		return awses.DescribeElasticsearchDomainRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awses.DescribeElasticsearchDomainOutput{
+				DomainStatus: s,
+			}},
+		}
+	}
+}
+
+func status(created, processing bool) *awses.ElasticsearchDomainStatus {
+	return &awses.ElasticsearchDomainStatus{
+		Created:    aws.Bool(created),
+		Processing: aws.Bool(processing),
+		Endpoint:   aws.String(endpoint),
+		ElasticsearchClusterConfig: &awses.ElasticsearchClusterConfig{
+			InstanceType:  awses.ESPartitionInstanceType(instanceType),
+			InstanceCount: aws.Int64(instanceCount),
+		},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Domain
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				es: &fake.MockClient{
+					MockDescribe: describe(status(true, false), nil),
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr: domain(
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.DomainObservation{Created: true, Endpoint: endpoint})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+					},
+				},
+			},
+		},
+		"Creating": {
+			args: args{
+				es: &fake.MockClient{
+					MockDescribe: describe(status(false, true), nil),
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr: domain(
+					withConditions(xpv1.Creating()),
+					withObservation(v1alpha1.DomainObservation{Processing: true, Endpoint: endpoint})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+					},
+				},
+			},
+		},
+		"Processing": {
+			args: args{
+				es: &fake.MockClient{
+					MockDescribe: describe(status(true, true), nil),
+				},
+				cr: domain(withInstanceCount(3)),
+			},
+			want: want{
+				cr: domain(
+					withInstanceCount(3),
+					withConditions(xpv1.Unavailable()),
+					withObservation(v1alpha1.DomainObservation{Created: true, Processing: true, Endpoint: endpoint})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+					},
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				es: &fake.MockClient{
+					MockDescribe: describe(status(true, false), nil),
+				},
+				cr: &v1alpha1.Domain{},
+			},
+			want: want{
+				cr: domain(
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.DomainObservation{Created: true, Endpoint: endpoint})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+					},
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				es: &fake.MockClient{
+					MockDescribe: describe(nil, awserr.New(awses.ErrCodeResourceNotFoundException, "", nil)),
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr: domain(),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				es: &fake.MockClient{
+					MockDescribe: describe(nil, errBoom),
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr:  domain(),
+				err: awsclient.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.es}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Domain
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				es: &fake.MockClient{
+					MockCreate: func(*awses.CreateElasticsearchDomainInput) awses.CreateElasticsearchDomainRequest {
+						return awses.CreateElasticsearchDomainRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awses.CreateElasticsearchDomainOutput{}},
+						}
+					},
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr: domain(withConditions(xpv1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				es: &fake.MockClient{
+					MockCreate: func(*awses.CreateElasticsearchDomainInput) awses.CreateElasticsearchDomainRequest {
+						return awses.CreateElasticsearchDomainRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr:  domain(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.es}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Domain
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				es: &fake.MockClient{
+					MockUpdate: func(*awses.UpdateElasticsearchDomainConfigInput) awses.UpdateElasticsearchDomainConfigRequest {
+						return awses.UpdateElasticsearchDomainConfigRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awses.UpdateElasticsearchDomainConfigOutput{}},
+						}
+					},
+				},
+				cr: domain(withObservation(v1alpha1.DomainObservation{Created: true})),
+			},
+			want: want{
+				cr: domain(withObservation(v1alpha1.DomainObservation{Created: true})),
+			},
+		},
+		"AlreadyProcessing": {
+			args: args{
+				es: &fake.MockClient{},
+				cr: domain(withObservation(v1alpha1.DomainObservation{Created: true, Processing: true})),
+			},
+			want: want{
+				cr: domain(withObservation(v1alpha1.DomainObservation{Created: true, Processing: true})),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				es: &fake.MockClient{
+					MockUpdate: func(*awses.UpdateElasticsearchDomainConfigInput) awses.UpdateElasticsearchDomainConfigRequest {
+						return awses.UpdateElasticsearchDomainConfigRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: domain(withObservation(v1alpha1.DomainObservation{Created: true})),
+			},
+			want: want{
+				cr:  domain(withObservation(v1alpha1.DomainObservation{Created: true})),
+				err: awsclient.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.es}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Domain
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				es: &fake.MockClient{
+					MockDelete: func(*awses.DeleteElasticsearchDomainInput) awses.DeleteElasticsearchDomainRequest {
+						return awses.DeleteElasticsearchDomainRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awses.DeleteElasticsearchDomainOutput{}},
+						}
+					},
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr: domain(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				es: &fake.MockClient{},
+				cr: domain(withObservation(v1alpha1.DomainObservation{Deleted: true})),
+			},
+			want: want{
+				cr: domain(
+					withObservation(v1alpha1.DomainObservation{Deleted: true}),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				es: &fake.MockClient{
+					MockDelete: func(*awses.DeleteElasticsearchDomainInput) awses.DeleteElasticsearchDomainRequest {
+						return awses.DeleteElasticsearchDomainRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awses.ErrCodeResourceNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr: domain(withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				es: &fake.MockClient{
+					MockDelete: func(*awses.DeleteElasticsearchDomainInput) awses.DeleteElasticsearchDomainRequest {
+						return awses.DeleteElasticsearchDomainRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr:  domain(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.es}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,287 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package database
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsglue "github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/glue/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/glue/fake"
+)
+
+var (
+	databaseName = "some_database"
+	description  = "some description"
+
+	errBoom = errors.New("boom")
+)
+
+type databaseModifier func(*v1alpha1.Database)
+
+func withConditions(c ...xpv1.Condition) databaseModifier {
+	return func(r *v1alpha1.Database) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDescription(d string) databaseModifier {
+	return func(r *v1alpha1.Database) { r.Spec.ForProvider.Description = aws.String(d) }
+}
+
+func database(m ...databaseModifier) *v1alpha1.Database {
+	cr := &v1alpha1.Database{}
+	meta.SetExternalName(cr, databaseName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func mockGet(d *string, err error) func(*awsglue.GetDatabaseInput) awsglue.GetDatabaseRequest {
+	return func(_ *awsglue.GetDatabaseInput) awsglue.GetDatabaseRequest {
+		return awsglue.GetDatabaseRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsglue.GetDatabaseOutput{
+				Database: &awsglue.Database{
+					Name:        aws.String(databaseName),
+					Description: d,
+				},
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	conn := managed.ConnectionDetails{v1alpha1.ResourceCredentialsSecretDatabaseNameKey: []byte(databaseName)}
+
+	type want struct {
+		cr     *v1alpha1.Database
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockDatabaseClient
+		kube   client.Client
+		cr     *v1alpha1.Database
+		want   want
+	}{
+		"UpToDate": {
+			client: &fake.MockDatabaseClient{MockGet: mockGet(aws.String(description), nil)},
+			cr:     database(withDescription(description)),
+			want: want{
+				cr:     database(withDescription(description), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: conn},
+			},
+		},
+		"NeedsUpdate": {
+			client: &fake.MockDatabaseClient{MockGet: mockGet(aws.String("old description"), nil)},
+			cr:     database(withDescription(description)),
+			want: want{
+				cr:     database(withDescription(description), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: conn},
+			},
+		},
+		"LateInitialized": {
+			client: &fake.MockDatabaseClient{MockGet: mockGet(aws.String(description), nil)},
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			cr:     database(),
+			want: want{
+				cr:     database(withDescription(description), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: conn},
+			},
+		},
+		"LateInitFailed": {
+			client: &fake.MockDatabaseClient{MockGet: mockGet(aws.String(description), nil)},
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			cr:     database(),
+			want: want{
+				cr:  database(withDescription(description)),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+		"NotFound": {
+			client: &fake.MockDatabaseClient{MockGet: mockGet(nil, awserr.New(awsglue.ErrCodeEntityNotFoundException, "", nil))},
+			cr:     database(),
+			want: want{
+				cr: database(),
+			},
+		},
+		"GetFailed": {
+			client: &fake.MockDatabaseClient{MockGet: mockGet(nil, errBoom)},
+			cr:     database(),
+			want: want{
+				cr:  database(),
+				err: awsclient.Wrap(errBoom, errGetFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	create := func(err error) func(*awsglue.CreateDatabaseInput) awsglue.CreateDatabaseRequest {
+		return func(in *awsglue.CreateDatabaseInput) awsglue.CreateDatabaseRequest {
+			if diff := cmp.Diff(databaseName, aws.StringValue(in.DatabaseInput.Name)); diff != "" {
+				t.Errorf("Name: -want, +got:\n%s", diff)
+			}
+			return awsglue.CreateDatabaseRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsglue.CreateDatabaseOutput{}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		client *fake.MockDatabaseClient
+		cr     *v1alpha1.Database
+		want   *v1alpha1.Database
+		err    error
+	}{
+		"Successful": {
+			client: &fake.MockDatabaseClient{MockCreate: create(nil)},
+			cr:     database(),
+			want:   database(withConditions(xpv1.Creating())),
+		},
+		"CreateFailed": {
+			client: &fake.MockDatabaseClient{MockCreate: create(errBoom)},
+			cr:     database(),
+			want:   database(withConditions(xpv1.Creating())),
+			err:    awsclient.Wrap(errBoom, errCreateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	update := func(err error) func(*awsglue.UpdateDatabaseInput) awsglue.UpdateDatabaseRequest {
+		return func(in *awsglue.UpdateDatabaseInput) awsglue.UpdateDatabaseRequest {
+			if diff := cmp.Diff(description, aws.StringValue(in.DatabaseInput.Description)); diff != "" {
+				t.Errorf("Description: -want, +got:\n%s", diff)
+			}
+			return awsglue.UpdateDatabaseRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsglue.UpdateDatabaseOutput{}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		client *fake.MockDatabaseClient
+		cr     *v1alpha1.Database
+		err    error
+	}{
+		"Successful": {
+			client: &fake.MockDatabaseClient{MockUpdate: update(nil)},
+			cr:     database(withDescription(description)),
+		},
+		"UpdateFailed": {
+			client: &fake.MockDatabaseClient{MockUpdate: update(errBoom)},
+			cr:     database(withDescription(description)),
+			err:    awsclient.Wrap(errBoom, errUpdateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	del := func(err error) func(*awsglue.DeleteDatabaseInput) awsglue.DeleteDatabaseRequest {
+		return func(*awsglue.DeleteDatabaseInput) awsglue.DeleteDatabaseRequest {
+			return awsglue.DeleteDatabaseRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsglue.DeleteDatabaseOutput{}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		client *fake.MockDatabaseClient
+		err    error
+	}{
+		"Successful": {
+			client: &fake.MockDatabaseClient{MockDelete: del(nil)},
+		},
+		"AlreadyGone": {
+			client: &fake.MockDatabaseClient{MockDelete: del(awserr.New(awsglue.ErrCodeEntityNotFoundException, "", nil))},
+		},
+		"DeleteFailed": {
+			client: &fake.MockDatabaseClient{MockDelete: del(errBoom)},
+			err:    awsclient.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := database()
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(database(withConditions(xpv1.Deleting())), cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -22,6 +22,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -46,22 +48,29 @@ const (
 	errCreate           = "failed to create the IAMAccessKey resource"
 	errDelete           = "failed to delete the IAMAccessKey resource"
 	errUpdate           = "failed to update the IAMAccessKey resource"
+	errRotate           = "failed to create a new access key for rotation"
+	errDeactivate       = "failed to deactivate the previous access key"
+	errDeletePrevious   = "failed to delete the previous access key"
+	errKubeUpdateFailed = "cannot update IAMAccessKey custom resource"
+	errGetSecret        = "cannot get the connection secret of the IAMAccessKey"
 )
 
 // SetupIAMAccessKey adds a controller that reconciles IAMAccessKeys.
-func SetupIAMAccessKey(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+func SetupIAMAccessKey(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclient.Options) error {
 	name := managed.ControllerName(v1alpha1.IAMAccessKeyGroupKind)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
 		}).
 		For(&v1alpha1.IAMAccessKey{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.IAMAccessKeyGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewAccessClient}),
+			managed.WithExternalConnecter(awsclient.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewAccessClient}, o)),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithTimeout(o.ReconcileTimeout),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -98,17 +107,25 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	if err != nil || len(keys.AccessKeyMetadata) == 0 {
 		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errList)
 	}
-	found := false
+	cr.Status.AtProvider.PreviousAccessKeyID = previousAccessKeyID(cr)
+	found, previousActive := false, false
 	var accessKey awsiam.AccessKeyMetadata
 	for _, key := range keys.AccessKeyMetadata {
-		if aws.StringValue(key.AccessKeyId) == meta.GetExternalName(cr) {
+		switch aws.StringValue(key.AccessKeyId) {
+		case meta.GetExternalName(cr):
 			found = true
 			accessKey = key
+		case cr.Status.AtProvider.PreviousAccessKeyID:
+			previousActive = key.Status == awsiam.StatusTypeActive
 		}
 	}
 	if !found {
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
+	published, err := e.published(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
 	switch accessKey.Status {
 	case awsiam.StatusTypeActive:
 		cr.SetConditions(xpv1.Available())
@@ -119,11 +136,49 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	cr.Spec.ForProvider.Status = awsclient.LateInitializeString(cr.Spec.ForProvider.Status, aws.String(string(accessKey.Status)))
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        string(accessKey.Status) == cr.Spec.ForProvider.Status,
+		ResourceUpToDate:        string(accessKey.Status) == cr.Spec.ForProvider.Status && !rotationRequested(cr) && published && !previousActive,
 		ResourceLateInitialized: current != cr.Spec.ForProvider.Status,
 	}, nil
 }
 
+// rotationRequested returns whether the rotate annotation has changed since the
+// access key was last rotated.
+func rotationRequested(cr *v1alpha1.IAMAccessKey) bool {
+	a := cr.GetAnnotations()
+	return a[v1alpha1.AnnotationKeyRotate] != a[v1alpha1.AnnotationKeyRotated]
+}
+
+// previousAccessKeyID returns the ID of the access key that was replaced by the
+// last rotation, if any.
+func previousAccessKeyID(cr *v1alpha1.IAMAccessKey) string {
+	return cr.GetAnnotations()[v1alpha1.AnnotationKeyPreviousAccessKeyID]
+}
+
+// published returns whether the connection secret holds the current access
+// key. It always does if there was no rotation yet or the IAMAccessKey has no
+// connection secret.
+func (e *external) published(ctx context.Context, cr *v1alpha1.IAMAccessKey) (bool, error) {
+	ref := cr.GetWriteConnectionSecretToReference()
+	if previousAccessKeyID(cr) == "" || ref == nil {
+		return true, nil
+	}
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return false, errors.Wrap(resource.IgnoreNotFound(err), errGetSecret)
+	}
+	return string(s.Data[xpv1.ResourceCredentialsSecretUserKey]) == meta.GetExternalName(cr), nil
+}
+
+func connectionDetails(k *awsiam.AccessKey) managed.ConnectionDetails {
+	if k == nil {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretUserKey:     []byte(aws.StringValue(k.AccessKeyId)),
+		xpv1.ResourceCredentialsSecretPasswordKey: []byte(aws.StringValue(k.SecretAccessKey)),
+	}
+}
+
 func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mgd.(*v1alpha1.IAMAccessKey)
 	if !ok {
@@ -135,15 +190,13 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
 	}
 
-	var conn managed.ConnectionDetails
-	if response != nil && response.AccessKey != nil {
-		conn = managed.ConnectionDetails{
-			xpv1.ResourceCredentialsSecretUserKey:     []byte(aws.StringValue(response.AccessKey.AccessKeyId)),
-			xpv1.ResourceCredentialsSecretPasswordKey: []byte(aws.StringValue(response.AccessKey.SecretAccessKey)),
-		}
+	// A new access key does not need to be rotated for the current value of
+	// the rotate annotation.
+	if v, ok := cr.GetAnnotations()[v1alpha1.AnnotationKeyRotate]; ok {
+		meta.AddAnnotations(cr, map[string]string{v1alpha1.AnnotationKeyRotated: v})
 	}
 	meta.SetExternalName(cr, aws.StringValue(response.AccessKey.AccessKeyId))
-	return managed.ExternalCreation{ExternalNameAssigned: true, ConnectionDetails: conn}, nil
+	return managed.ExternalCreation{ExternalNameAssigned: true, ConnectionDetails: connectionDetails(response.AccessKey)}, nil
 }
 
 func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
@@ -152,7 +205,28 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
-	_, err := e.client.UpdateAccessKeyRequest(&awsiam.UpdateAccessKeyInput{
+	published, err := e.published(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if rotationRequested(cr) || !published {
+		return e.rotate(ctx, cr, published)
+	}
+
+	// The previous access key is only deactivated once the connection secret
+	// holds the current one, so that its users can switch over.
+	if previous := previousAccessKeyID(cr); previous != "" {
+		_, err := e.client.UpdateAccessKeyRequest(&awsiam.UpdateAccessKeyInput{
+			AccessKeyId: aws.String(previous),
+			Status:      awsiam.StatusTypeInactive,
+			UserName:    aws.String(cr.Spec.ForProvider.IAMUsername),
+		}).Send(ctx)
+		if err := resource.Ignore(iam.IsErrorNotFound, err); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeactivate)
+		}
+	}
+
+	_, err = e.client.UpdateAccessKeyRequest(&awsiam.UpdateAccessKeyInput{
 		AccessKeyId: aws.String(meta.GetExternalName(cr)),
 		Status:      awsiam.StatusType(cr.Spec.ForProvider.Status),
 		UserName:    aws.String(cr.Spec.ForProvider.IAMUsername),
@@ -161,6 +235,57 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
 }
 
+// rotate replaces the access key with a new one, deleting the one replaced by
+// the rotation before, if any. IAM users can have at most two access keys. The
+// replaced access key is deactivated by a later Update, once the new one was
+// published. If the current access key was never published, e.g. because
+// publishing failed after the last rotation, it is replaced itself and the
+// previous access key, which the connection secret still holds, is kept.
+func (e *external) rotate(ctx context.Context, cr *v1alpha1.IAMAccessKey, published bool) (managed.ExternalUpdate, error) {
+	replaced, previous := previousAccessKeyID(cr), meta.GetExternalName(cr)
+	if !published {
+		replaced, previous = previous, replaced
+	}
+	if err := e.deleteAccessKey(ctx, cr, replaced); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	response, err := e.client.CreateAccessKeyRequest(&awsiam.CreateAccessKeyInput{UserName: aws.String(cr.Spec.ForProvider.IAMUsername)}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errRotate)
+	}
+	// The previous access key is recorded in the same update as the new one
+	// so that it is still known if the status cannot be written afterwards.
+	meta.SetExternalName(cr, aws.StringValue(response.AccessKey.AccessKeyId))
+	meta.AddAnnotations(cr, map[string]string{
+		v1alpha1.AnnotationKeyRotated:             cr.GetAnnotations()[v1alpha1.AnnotationKeyRotate],
+		v1alpha1.AnnotationKeyPreviousAccessKeyID: previous,
+	})
+	if err := awsclient.UpdateSpec(ctx, e.kube, cr); err != nil {
+		// The new access key would not be tracked by anything otherwise.
+		_, _ = e.client.DeleteAccessKeyRequest(&awsiam.DeleteAccessKeyInput{
+			UserName:    aws.String(cr.Spec.ForProvider.IAMUsername),
+			AccessKeyId: response.AccessKey.AccessKeyId,
+		}).Send(ctx)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errKubeUpdateFailed)
+	}
+	cr.Status.AtProvider.PreviousAccessKeyID = previous
+	return managed.ExternalUpdate{ConnectionDetails: connectionDetails(response.AccessKey)}, nil
+}
+
+// deleteAccessKey deletes the access key with the supplied ID, if any. It is
+// used for the access keys replaced by rotations.
+func (e *external) deleteAccessKey(ctx context.Context, cr *v1alpha1.IAMAccessKey, id string) error {
+	if id == "" {
+		return nil
+	}
+	_, err := e.client.DeleteAccessKeyRequest(&awsiam.DeleteAccessKeyInput{
+		UserName:    aws.String(cr.Spec.ForProvider.IAMUsername),
+		AccessKeyId: aws.String(id),
+	}).Send(ctx)
+	return awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDeletePrevious)
+}
+
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 	cr, ok := mgd.(*v1alpha1.IAMAccessKey)
 	if !ok {
@@ -169,6 +294,10 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 
 	cr.Status.SetConditions(xpv1.Deleting())
 
+	if err := e.deleteAccessKey(ctx, cr, previousAccessKeyID(cr)); err != nil {
+		return err
+	}
+
 	_, err := e.client.DeleteAccessKeyRequest(&awsiam.DeleteAccessKeyInput{
 		UserName:    aws.String(cr.Spec.ForProvider.IAMUsername),
 		AccessKeyId: aws.String(meta.GetExternalName(cr)),
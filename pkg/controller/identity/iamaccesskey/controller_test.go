@@ -26,6 +26,7 @@ import (
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -47,6 +48,8 @@ var (
 	activeStatus   = awsiam.StatusTypeActive
 	inactiveStatus = awsiam.StatusTypeInactive
 	accessKeyID    = "accessKeyID"
+	newAccessKeyID = "newAccessKeyID"
+	oldAccessKeyID = "oldAccessKeyID"
 	secretKeyID    = "secretKeyID"
 
 	errBoom = errors.New("boom")
@@ -82,6 +85,43 @@ func withAccessKey(keyid string) accessModifier {
 	}
 }
 
+func withRotation(rotate, rotated string) accessModifier {
+	return func(r *v1alpha1.IAMAccessKey) {
+		meta.AddAnnotations(r, map[string]string{
+			v1alpha1.AnnotationKeyRotate:  rotate,
+			v1alpha1.AnnotationKeyRotated: rotated,
+		})
+	}
+}
+
+func withPreviousAccessKey(keyid string) accessModifier {
+	return func(r *v1alpha1.IAMAccessKey) {
+		withPreviousAccessKeyAnnotation(keyid)(r)
+		r.Status.AtProvider.PreviousAccessKeyID = keyid
+	}
+}
+
+func withPreviousAccessKeyAnnotation(keyid string) accessModifier {
+	return func(r *v1alpha1.IAMAccessKey) {
+		meta.AddAnnotations(r, map[string]string{v1alpha1.AnnotationKeyPreviousAccessKeyID: keyid})
+	}
+}
+
+func withConnectionSecret() accessModifier {
+	return func(r *v1alpha1.IAMAccessKey) {
+		r.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Namespace: "default", Name: "conn"})
+	}
+}
+
+// publishedKey returns a MockGetFn for a connection secret that holds the
+// supplied access key.
+func publishedKey(keyid string) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		obj.(*corev1.Secret).Data = map[string][]byte{xpv1.ResourceCredentialsSecretUserKey: []byte(keyid)}
+		return nil
+	}
+}
+
 func accesskey(m ...accessModifier) *v1alpha1.IAMAccessKey {
 	cr := &v1alpha1.IAMAccessKey{}
 	for _, f := range m {
@@ -129,6 +169,175 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"RotationRequested": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockListAccessKeysRequest: func(input *awsiam.ListAccessKeysInput) awsiam.ListAccessKeysRequest {
+						return awsiam.ListAccessKeysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListAccessKeysOutput{
+								AccessKeyMetadata: []awsiam.AccessKeyMetadata{{
+									AccessKeyId: aws.String(accessKeyID),
+									Status:      activeStatus,
+									UserName:    aws.String(userName),
+								}},
+							}},
+						}
+					},
+				},
+				cr: accesskey(withUsername(userName), withAccessKey(accessKeyID), withStatus(string(activeStatus)), withRotation("2", "1")),
+			},
+			want: want{
+				cr: accesskey(withUsername(userName),
+					withAccessKey(accessKeyID),
+					withStatus(string(activeStatus)),
+					withRotation("2", "1"),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"PreviousAccessKeyActive": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockListAccessKeysRequest: func(input *awsiam.ListAccessKeysInput) awsiam.ListAccessKeysRequest {
+						return awsiam.ListAccessKeysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListAccessKeysOutput{
+								AccessKeyMetadata: []awsiam.AccessKeyMetadata{{
+									AccessKeyId: aws.String(accessKeyID),
+									Status:      activeStatus,
+									UserName:    aws.String(userName),
+								}, {
+									AccessKeyId: aws.String(oldAccessKeyID),
+									Status:      activeStatus,
+									UserName:    aws.String(userName),
+								}},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockGet: publishedKey(accessKeyID)},
+				cr:   accesskey(withUsername(userName), withAccessKey(accessKeyID), withStatus(string(activeStatus)), withConnectionSecret(), withPreviousAccessKey(oldAccessKeyID)),
+			},
+			want: want{
+				cr: accesskey(withUsername(userName),
+					withAccessKey(accessKeyID),
+					withStatus(string(activeStatus)),
+					withConnectionSecret(),
+					withPreviousAccessKey(oldAccessKeyID),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"PreviousAccessKeyStatusLost": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockListAccessKeysRequest: func(input *awsiam.ListAccessKeysInput) awsiam.ListAccessKeysRequest {
+						return awsiam.ListAccessKeysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListAccessKeysOutput{
+								AccessKeyMetadata: []awsiam.AccessKeyMetadata{{
+									AccessKeyId: aws.String(accessKeyID),
+									Status:      activeStatus,
+									UserName:    aws.String(userName),
+								}, {
+									AccessKeyId: aws.String(oldAccessKeyID),
+									Status:      activeStatus,
+									UserName:    aws.String(userName),
+								}},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockGet: publishedKey(accessKeyID)},
+				cr:   accesskey(withUsername(userName), withAccessKey(accessKeyID), withStatus(string(activeStatus)), withConnectionSecret(), withPreviousAccessKeyAnnotation(oldAccessKeyID)),
+			},
+			want: want{
+				cr: accesskey(withUsername(userName),
+					withAccessKey(accessKeyID),
+					withStatus(string(activeStatus)),
+					withConnectionSecret(),
+					withPreviousAccessKey(oldAccessKeyID),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"PreviousAccessKeyInactive": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockListAccessKeysRequest: func(input *awsiam.ListAccessKeysInput) awsiam.ListAccessKeysRequest {
+						return awsiam.ListAccessKeysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListAccessKeysOutput{
+								AccessKeyMetadata: []awsiam.AccessKeyMetadata{{
+									AccessKeyId: aws.String(accessKeyID),
+									Status:      activeStatus,
+									UserName:    aws.String(userName),
+								}, {
+									AccessKeyId: aws.String(oldAccessKeyID),
+									Status:      inactiveStatus,
+									UserName:    aws.String(userName),
+								}},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockGet: publishedKey(accessKeyID)},
+				cr:   accesskey(withUsername(userName), withAccessKey(accessKeyID), withStatus(string(activeStatus)), withConnectionSecret(), withPreviousAccessKey(oldAccessKeyID)),
+			},
+			want: want{
+				cr: accesskey(withUsername(userName),
+					withAccessKey(accessKeyID),
+					withStatus(string(activeStatus)),
+					withConnectionSecret(),
+					withPreviousAccessKey(oldAccessKeyID),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PreviousAccessKeyUnpublished": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockListAccessKeysRequest: func(input *awsiam.ListAccessKeysInput) awsiam.ListAccessKeysRequest {
+						return awsiam.ListAccessKeysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListAccessKeysOutput{
+								AccessKeyMetadata: []awsiam.AccessKeyMetadata{{
+									AccessKeyId: aws.String(accessKeyID),
+									Status:      activeStatus,
+									UserName:    aws.String(userName),
+								}, {
+									AccessKeyId: aws.String(oldAccessKeyID),
+									Status:      activeStatus,
+									UserName:    aws.String(userName),
+								}},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockGet: publishedKey(oldAccessKeyID)},
+				cr:   accesskey(withUsername(userName), withAccessKey(accessKeyID), withStatus(string(activeStatus)), withConnectionSecret(), withPreviousAccessKey(oldAccessKeyID)),
+			},
+			want: want{
+				cr: accesskey(withUsername(userName),
+					withAccessKey(accessKeyID),
+					withStatus(string(activeStatus)),
+					withConnectionSecret(),
+					withPreviousAccessKey(oldAccessKeyID),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
 		"ValidInputNeedsUpdate": {
 			args: args{
 				iam: &fake.MockAccessClient{
@@ -209,7 +418,7 @@ func TestObserve(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -342,6 +551,22 @@ func TestDelete(t *testing.T) {
 					withConditions(xpv1.Deleting())),
 			},
 		},
+		"DeletesPreviousAccessKey": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockDeleteAccessKeyRequest: func(input *awsiam.DeleteAccessKeyInput) awsiam.DeleteAccessKeyRequest {
+						return awsiam.DeleteAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.DeleteAccessKeyOutput{}},
+						}
+					},
+				},
+				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withPreviousAccessKey(oldAccessKeyID)),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withPreviousAccessKey(oldAccessKeyID),
+					withConditions(xpv1.Deleting())),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpecedItem,
@@ -434,6 +659,156 @@ func TestUpdate(t *testing.T) {
 				err: errors.New(errUnexpectedObject),
 			},
 		},
+		"Rotate": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockDeleteAccessKeyRequest: func(input *awsiam.DeleteAccessKeyInput) awsiam.DeleteAccessKeyRequest {
+						if diff := cmp.Diff(oldAccessKeyID, aws.StringValue(input.AccessKeyId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsiam.DeleteAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.DeleteAccessKeyOutput{}},
+						}
+					},
+					MockCreateAccessKeyRequest: func(input *awsiam.CreateAccessKeyInput) awsiam.CreateAccessKeyRequest {
+						return awsiam.CreateAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.CreateAccessKeyOutput{
+								AccessKey: &awsiam.AccessKey{AccessKeyId: aws.String(newAccessKeyID), SecretAccessKey: aws.String(secretKeyID)},
+							}},
+						}
+					},
+					MockUpdateAccessKeyRequest: func(input *awsiam.UpdateAccessKeyInput) awsiam.UpdateAccessKeyRequest {
+						t.Errorf("the previous access key must not be deactivated before the new one is published")
+						return awsiam.UpdateAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.UpdateAccessKeyOutput{}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   accesskey(withAccessKey(accessKeyID), withUsername(userName), withRotation("2", "1"), withPreviousAccessKey(oldAccessKeyID)),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withRotation("2", "2"), withPreviousAccessKey(accessKeyID)),
+				update: managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{
+					xpv1.ResourceCredentialsSecretUserKey:     []byte(newAccessKeyID),
+					xpv1.ResourceCredentialsSecretPasswordKey: []byte(secretKeyID),
+				}},
+			},
+		},
+		"RotateCreateError": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockCreateAccessKeyRequest: func(input *awsiam.CreateAccessKeyInput) awsiam.CreateAccessKeyRequest {
+						return awsiam.CreateAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withRotation("2", "1")),
+			},
+			want: want{
+				cr:  accesskey(withAccessKey(accessKeyID), withUsername(userName), withRotation("2", "1")),
+				err: awsclient.Wrap(errBoom, errRotate),
+			},
+		},
+		"RotateKubeUpdateError": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockCreateAccessKeyRequest: func(input *awsiam.CreateAccessKeyInput) awsiam.CreateAccessKeyRequest {
+						return awsiam.CreateAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.CreateAccessKeyOutput{
+								AccessKey: &awsiam.AccessKey{AccessKeyId: aws.String(newAccessKeyID), SecretAccessKey: aws.String(secretKeyID)},
+							}},
+						}
+					},
+					MockDeleteAccessKeyRequest: func(input *awsiam.DeleteAccessKeyInput) awsiam.DeleteAccessKeyRequest {
+						if diff := cmp.Diff(newAccessKeyID, aws.StringValue(input.AccessKeyId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsiam.DeleteAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.DeleteAccessKeyOutput{}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				cr:   accesskey(withAccessKey(accessKeyID), withUsername(userName), withRotation("2", "1")),
+			},
+			want: want{
+				cr:  accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withRotation("2", "2"), withPreviousAccessKeyAnnotation(accessKeyID)),
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+		"RotateUnpublished": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockDeleteAccessKeyRequest: func(input *awsiam.DeleteAccessKeyInput) awsiam.DeleteAccessKeyRequest {
+						if diff := cmp.Diff(accessKeyID, aws.StringValue(input.AccessKeyId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsiam.DeleteAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.DeleteAccessKeyOutput{}},
+						}
+					},
+					MockCreateAccessKeyRequest: func(input *awsiam.CreateAccessKeyInput) awsiam.CreateAccessKeyRequest {
+						return awsiam.CreateAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.CreateAccessKeyOutput{
+								AccessKey: &awsiam.AccessKey{AccessKeyId: aws.String(newAccessKeyID), SecretAccessKey: aws.String(secretKeyID)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockGet: publishedKey(oldAccessKeyID), MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   accesskey(withAccessKey(accessKeyID), withUsername(userName), withRotation("1", "1"), withConnectionSecret(), withPreviousAccessKey(oldAccessKeyID)),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withRotation("1", "1"), withConnectionSecret(), withPreviousAccessKey(oldAccessKeyID)),
+				update: managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{
+					xpv1.ResourceCredentialsSecretUserKey:     []byte(newAccessKeyID),
+					xpv1.ResourceCredentialsSecretPasswordKey: []byte(secretKeyID),
+				}},
+			},
+		},
+		"DeactivatePrevious": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockUpdateAccessKeyRequest: func(input *awsiam.UpdateAccessKeyInput) awsiam.UpdateAccessKeyRequest {
+						if aws.StringValue(input.AccessKeyId) == oldAccessKeyID {
+							if diff := cmp.Diff(inactiveStatus, input.Status); diff != "" {
+								t.Errorf("r: -want, +got:\n%s", diff)
+							}
+						}
+						return awsiam.UpdateAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.UpdateAccessKeyOutput{}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockGet: publishedKey(accessKeyID)},
+				cr:   accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus)), withConnectionSecret(), withPreviousAccessKey(oldAccessKeyID)),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus)), withConnectionSecret(), withPreviousAccessKey(oldAccessKeyID)),
+			},
+		},
+		"DeactivatePreviousError": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockUpdateAccessKeyRequest: func(input *awsiam.UpdateAccessKeyInput) awsiam.UpdateAccessKeyRequest {
+						if diff := cmp.Diff(oldAccessKeyID, aws.StringValue(input.AccessKeyId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsiam.UpdateAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				kube: &test.MockClient{MockGet: publishedKey(accessKeyID)},
+				cr:   accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus)), withConnectionSecret(), withPreviousAccessKey(oldAccessKeyID)),
+			},
+			want: want{
+				cr:  accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus)), withConnectionSecret(), withPreviousAccessKey(oldAccessKeyID)),
+				err: awsclient.Wrap(errBoom, errDeactivate),
+			},
+		},
 		"ClientError": {
 			args: args{
 				iam: &fake.MockAccessClient{
@@ -454,7 +829,7 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			update, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
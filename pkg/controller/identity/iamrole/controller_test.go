@@ -43,6 +43,7 @@ var (
 	// an arbitrary managed resource
 	unexpecedItem resource.Managed
 	roleName      = "some arbitrary name"
+	roleARN       = "arn:aws:iam::123456789012:role/some-role"
 	description   = "some description"
 	policy        = `{
 		"Version": "2012-10-17",
@@ -75,6 +76,10 @@ func withRoleName(s *string) roleModifier {
 	return func(r *v1beta1.IAMRole) { meta.SetExternalName(r, *s) }
 }
 
+func withARN(s string) roleModifier {
+	return func(r *v1beta1.IAMRole) { r.Status.AtProvider.ARN = s }
+}
+
 func withPolicy() roleModifier {
 	return func(r *v1beta1.IAMRole) {
 		p, err := awsclient.CompactAndEscapeJSON(policy)
@@ -134,6 +139,33 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"PublishARN": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRoleRequest: func(input *awsiam.GetRoleInput) awsiam.GetRoleRequest {
+						return awsiam.GetRoleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetRoleOutput{
+								Role: &awsiam.Role{Arn: aws.String(roleARN)},
+							}},
+						}
+					},
+				},
+				cr: role(withRoleName(&roleName)),
+			},
+			want: want{
+				cr: role(
+					withRoleName(&roleName),
+					withARN(roleARN),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1beta1.ResourceCredentialsSecretARNKey: []byte(roleARN),
+					},
+				},
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpecedItem,
@@ -248,6 +280,23 @@ func TestCreate(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errCreate),
 			},
 		},
+		"AlreadyExists": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockCreateRoleRequest: func(input *awsiam.CreateRoleInput) awsiam.CreateRoleRequest {
+						return awsiam.CreateRoleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsiam.ErrCodeEntityAlreadyExistsException, "", nil)},
+						}
+					},
+				},
+				cr: role(withRoleName(&roleName)),
+			},
+			want: want{
+				cr: role(
+					withRoleName(&roleName),
+					withConditions(xpv1.Creating())),
+			},
+		},
 	}
 
 	for name, tc := range cases {
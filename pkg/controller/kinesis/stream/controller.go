@@ -0,0 +1,207 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stream
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awskinesis "github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/kinesis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/kinesis"
+)
+
+const (
+	errUnexpectedObject  = "managed resource is not a Kinesis Stream custom resource"
+	errDescribeFailed    = "cannot describe Kinesis Stream"
+	errCreateFailed      = "cannot create Kinesis Stream"
+	errUpdateShardCount  = "cannot update shard count of Kinesis Stream"
+	errIncreaseRetention = "cannot increase retention period of Kinesis Stream"
+	errDecreaseRetention = "cannot decrease retention period of Kinesis Stream"
+	errDeleteFailed      = "cannot delete Kinesis Stream"
+	errSpecUpdate        = "cannot update spec of Kinesis Stream custom resource"
+)
+
+// SetupStream adds a controller that reconciles Kinesis Streams.
+func SetupStream(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclient.Options) error {
+	name := managed.ControllerName(v1alpha1.StreamGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		For(&v1alpha1.Stream{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.StreamGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: kinesis.NewClient}, o)),
+			managed.WithTimeout(o.ReconcileTimeout),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) kinesis.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Stream)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client kinesis.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Stream)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	rsp, err := e.client.DescribeStreamSummaryRequest(&awskinesis.DescribeStreamSummaryInput{
+		StreamName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: false}, awsclient.Wrap(resource.Ignore(kinesis.IsNotFound, err), errDescribeFailed)
+	}
+	s := rsp.StreamDescriptionSummary
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	kinesis.LateInitialize(&cr.Spec.ForProvider, s)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = kinesis.GenerateObservation(*s)
+
+	switch s.StreamStatus {
+	case awskinesis.StreamStatusActive:
+		cr.SetConditions(xpv1.Available())
+	case awskinesis.StreamStatusCreating:
+		cr.SetConditions(xpv1.Creating())
+	case awskinesis.StreamStatusDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  kinesis.IsUpToDate(cr.Spec.ForProvider, *s),
+		ConnectionDetails: kinesis.GetConnectionDetails(meta.GetExternalName(cr), *s),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Stream)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	// The retention period cannot be given on creation, it is set by Update
+	// once the stream is active.
+	_, err := e.client.CreateStreamRequest(&awskinesis.CreateStreamInput{
+		StreamName: aws.String(meta.GetExternalName(cr)),
+		ShardCount: aws.Int64(cr.Spec.ForProvider.ShardCount),
+	}).Send(ctx)
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreateFailed)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Stream)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	// A stream can only be changed while it is active, and each change puts
+	// it into UPDATING until it is done. So only one change is made per
+	// reconcile.
+	if cr.Status.AtProvider.StreamStatus != string(awskinesis.StreamStatusActive) {
+		return managed.ExternalUpdate{}, nil
+	}
+	name := aws.String(meta.GetExternalName(cr))
+
+	if cr.Spec.ForProvider.ShardCount != cr.Status.AtProvider.OpenShardCount {
+		_, err := e.client.UpdateShardCountRequest(&awskinesis.UpdateShardCountInput{
+			StreamName:       name,
+			TargetShardCount: aws.Int64(cr.Spec.ForProvider.ShardCount),
+			ScalingType:      awskinesis.ScalingTypeUniformScaling,
+		}).Send(ctx)
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdateShardCount)
+	}
+
+	var err error
+	desired, current := aws.Int64Value(cr.Spec.ForProvider.RetentionPeriodHours), cr.Status.AtProvider.RetentionPeriodHours
+	switch {
+	case cr.Spec.ForProvider.RetentionPeriodHours == nil || desired == current:
+	case desired > current:
+		_, err = e.client.IncreaseStreamRetentionPeriodRequest(&awskinesis.IncreaseStreamRetentionPeriodInput{
+			StreamName:           name,
+			RetentionPeriodHours: aws.Int64(desired),
+		}).Send(ctx)
+		err = awsclient.Wrap(err, errIncreaseRetention)
+	default:
+		_, err = e.client.DecreaseStreamRetentionPeriodRequest(&awskinesis.DecreaseStreamRetentionPeriodInput{
+			StreamName:           name,
+			RetentionPeriodHours: aws.Int64(desired),
+		}).Send(ctx)
+		err = awsclient.Wrap(err, errDecreaseRetention)
+	}
+	return managed.ExternalUpdate{}, err
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Stream)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.StreamStatus == string(awskinesis.StreamStatusDeleting) {
+		return nil
+	}
+	_, err := e.client.DeleteStreamRequest(&awskinesis.DeleteStreamInput{
+		StreamName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return awsclient.Wrap(resource.Ignore(kinesis.IsNotFound, err), errDeleteFailed)
+}
@@ -0,0 +1,460 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stream
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awskinesis "github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/kinesis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/kinesis"
+	"github.com/crossplane/provider-aws/pkg/clients/kinesis/fake"
+)
+
+var (
+	errBoom    = errors.New("boom")
+	streamName = "test"
+	streamARN  = "arn:aws:kinesis:us-east-1:123456789012:stream/test"
+)
+
+type args struct {
+	kube    client.Client
+	kinesis kinesis.Client
+	cr      *v1alpha1.Stream
+}
+
+type streamModifier func(*v1alpha1.Stream)
+
+func withConditions(c ...xpv1.Condition) streamModifier {
+	return func(r *v1alpha1.Stream) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.StreamObservation) streamModifier {
+	return func(r *v1alpha1.Stream) { r.Status.AtProvider = o }
+}
+
+func withShardCount(c int64) streamModifier {
+	return func(r *v1alpha1.Stream) { r.Spec.ForProvider.ShardCount = c }
+}
+
+func withRetentionPeriodHours(h int64) streamModifier {
+	return func(r *v1alpha1.Stream) { r.Spec.ForProvider.RetentionPeriodHours = aws.Int64(h) }
+}
+
+func stream(m ...streamModifier) *v1alpha1.Stream {
+	cr := &v1alpha1.Stream{}
+	meta.SetExternalName(cr, streamName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(err error, status awskinesis.StreamStatus) func(*awskinesis.DescribeStreamSummaryInput) awskinesis.DescribeStreamSummaryRequest {
+	return func(*awskinesis.DescribeStreamSummaryInput) awskinesis.DescribeStreamSummaryRequest {
+		return awskinesis.DescribeStreamSummaryRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awskinesis.DescribeStreamSummaryOutput{
+				StreamDescriptionSummary: &awskinesis.StreamDescriptionSummary{
+					StreamName:           aws.String(streamName),
+					StreamARN:            aws.String(streamARN),
+					StreamStatus:         status,
+					OpenShardCount:       aws.Int64(1),
+					RetentionPeriodHours: aws.Int64(24),
+				},
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Stream
+		result managed.ExternalObservation
+		err    error
+	}
+
+	conn := managed.ConnectionDetails{
+		v1alpha1.ResourceCredentialsSecretARNKey:  []byte(streamARN),
+		v1alpha1.ResourceCredentialsSecretNameKey: []byte(streamName),
+	}
+	obs := func(status awskinesis.StreamStatus) v1alpha1.StreamObservation {
+		return v1alpha1.StreamObservation{StreamARN: streamARN, StreamStatus: string(status), OpenShardCount: 1, RetentionPeriodHours: 24}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Active": {
+			args: args{
+				kinesis: &fake.MockClient{MockDescribeSummary: describe(nil, awskinesis.StreamStatusActive)},
+				cr:      stream(withShardCount(1), withRetentionPeriodHours(24)),
+			},
+			want: want{
+				cr: stream(
+					withShardCount(1),
+					withRetentionPeriodHours(24),
+					withConditions(xpv1.Available()),
+					withObservation(obs(awskinesis.StreamStatusActive))),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: conn,
+				},
+			},
+		},
+		"Creating": {
+			args: args{
+				kinesis: &fake.MockClient{MockDescribeSummary: describe(nil, awskinesis.StreamStatusCreating)},
+				cr:      stream(withShardCount(1), withRetentionPeriodHours(24)),
+			},
+			want: want{
+				cr: stream(
+					withShardCount(1),
+					withRetentionPeriodHours(24),
+					withConditions(xpv1.Creating()),
+					withObservation(obs(awskinesis.StreamStatusCreating))),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: conn,
+				},
+			},
+		},
+		"ShardCountChanged": {
+			args: args{
+				kinesis: &fake.MockClient{MockDescribeSummary: describe(nil, awskinesis.StreamStatusActive)},
+				cr:      stream(withShardCount(2), withRetentionPeriodHours(24)),
+			},
+			want: want{
+				cr: stream(
+					withShardCount(2),
+					withRetentionPeriodHours(24),
+					withConditions(xpv1.Available()),
+					withObservation(obs(awskinesis.StreamStatusActive))),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: conn,
+				},
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				kube:    &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				kinesis: &fake.MockClient{MockDescribeSummary: describe(nil, awskinesis.StreamStatusActive)},
+				cr:      stream(withShardCount(1)),
+			},
+			want: want{
+				cr: stream(
+					withShardCount(1),
+					withRetentionPeriodHours(24),
+					withConditions(xpv1.Available()),
+					withObservation(obs(awskinesis.StreamStatusActive))),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: conn,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				kinesis: &fake.MockClient{MockDescribeSummary: describe(awserr.New(awskinesis.ErrCodeResourceNotFoundException, "", nil), "")},
+				cr:      stream(),
+			},
+			want: want{
+				cr: stream(),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				kinesis: &fake.MockClient{MockDescribeSummary: describe(errBoom, "")},
+				cr:      stream(),
+			},
+			want: want{
+				cr:  stream(),
+				err: awsclient.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.kinesis}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Stream
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kinesis: &fake.MockClient{
+					MockCreate: func(input *awskinesis.CreateStreamInput) awskinesis.CreateStreamRequest {
+						if diff := cmp.Diff(int64(2), aws.Int64Value(input.ShardCount)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awskinesis.CreateStreamRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awskinesis.CreateStreamOutput{}},
+						}
+					},
+				},
+				cr: stream(withShardCount(2)),
+			},
+			want: want{
+				cr: stream(withShardCount(2), withConditions(xpv1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				kinesis: &fake.MockClient{
+					MockCreate: func(*awskinesis.CreateStreamInput) awskinesis.CreateStreamRequest {
+						return awskinesis.CreateStreamRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: stream(),
+			},
+			want: want{
+				cr:  stream(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.kinesis}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	active := func(shards, hours int64) streamModifier {
+		return withObservation(v1alpha1.StreamObservation{StreamStatus: string(awskinesis.StreamStatusActive), OpenShardCount: shards, RetentionPeriodHours: hours})
+	}
+	updating := withObservation(v1alpha1.StreamObservation{StreamStatus: string(awskinesis.StreamStatusUpdating), OpenShardCount: 1, RetentionPeriodHours: 24})
+
+	cases := map[string]struct {
+		args
+		want error
+	}{
+		"NotActive": {
+			args: args{
+				kinesis: &fake.MockClient{},
+				cr:      stream(withShardCount(2), updating),
+			},
+		},
+		"ShardCount": {
+			args: args{
+				kinesis: &fake.MockClient{
+					MockUpdateShardCount: func(input *awskinesis.UpdateShardCountInput) awskinesis.UpdateShardCountRequest {
+						if diff := cmp.Diff(int64(2), aws.Int64Value(input.TargetShardCount)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awskinesis.UpdateShardCountRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awskinesis.UpdateShardCountOutput{}},
+						}
+					},
+				},
+				cr: stream(withShardCount(2), withRetentionPeriodHours(48), active(1, 24)),
+			},
+		},
+		"ShardCountFailed": {
+			args: args{
+				kinesis: &fake.MockClient{
+					MockUpdateShardCount: func(*awskinesis.UpdateShardCountInput) awskinesis.UpdateShardCountRequest {
+						return awskinesis.UpdateShardCountRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: stream(withShardCount(2), active(1, 24)),
+			},
+			want: awsclient.Wrap(errBoom, errUpdateShardCount),
+		},
+		"IncreaseRetention": {
+			args: args{
+				kinesis: &fake.MockClient{
+					MockIncreaseRetention: func(input *awskinesis.IncreaseStreamRetentionPeriodInput) awskinesis.IncreaseStreamRetentionPeriodRequest {
+						if diff := cmp.Diff(int64(48), aws.Int64Value(input.RetentionPeriodHours)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awskinesis.IncreaseStreamRetentionPeriodRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awskinesis.IncreaseStreamRetentionPeriodOutput{}},
+						}
+					},
+				},
+				cr: stream(withShardCount(1), withRetentionPeriodHours(48), active(1, 24)),
+			},
+		},
+		"DecreaseRetentionFailed": {
+			args: args{
+				kinesis: &fake.MockClient{
+					MockDecreaseRetention: func(*awskinesis.DecreaseStreamRetentionPeriodInput) awskinesis.DecreaseStreamRetentionPeriodRequest {
+						return awskinesis.DecreaseStreamRetentionPeriodRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: stream(withShardCount(1), withRetentionPeriodHours(24), active(1, 48)),
+			},
+			want: awsclient.Wrap(errBoom, errDecreaseRetention),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.kinesis}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Stream
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kinesis: &fake.MockClient{
+					MockDelete: func(*awskinesis.DeleteStreamInput) awskinesis.DeleteStreamRequest {
+						return awskinesis.DeleteStreamRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awskinesis.DeleteStreamOutput{}},
+						}
+					},
+				},
+				cr: stream(),
+			},
+			want: want{
+				cr: stream(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				kinesis: &fake.MockClient{},
+				cr:      stream(withObservation(v1alpha1.StreamObservation{StreamStatus: string(awskinesis.StreamStatusDeleting)})),
+			},
+			want: want{
+				cr: stream(
+					withObservation(v1alpha1.StreamObservation{StreamStatus: string(awskinesis.StreamStatusDeleting)}),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				kinesis: &fake.MockClient{
+					MockDelete: func(*awskinesis.DeleteStreamInput) awskinesis.DeleteStreamRequest {
+						return awskinesis.DeleteStreamRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awskinesis.ErrCodeResourceNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: stream(),
+			},
+			want: want{
+				cr: stream(withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				kinesis: &fake.MockClient{
+					MockDelete: func(*awskinesis.DeleteStreamInput) awskinesis.DeleteStreamRequest {
+						return awskinesis.DeleteStreamRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: stream(),
+			},
+			want: want{
+				cr:  stream(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.kinesis}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
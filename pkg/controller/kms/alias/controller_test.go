@@ -0,0 +1,371 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alias
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awskms "github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/kms"
+	"github.com/crossplane/provider-aws/pkg/clients/kms/fake"
+)
+
+var (
+	errBoom   = errors.New("boom")
+	aliasName = "test"
+	aliasARN  = "arn:aws:kms:us-east-1:123456789012:alias/test"
+	keyID     = "1234abcd-12ab-34cd-56ef-1234567890ab"
+	otherKey  = "0987dcba-09fe-87dc-65ba-ab0987654321"
+)
+
+type args struct {
+	kms kms.Client
+	cr  *v1alpha1.Alias
+}
+
+type aliasModifier func(*v1alpha1.Alias)
+
+func withConditions(c ...xpv1.Condition) aliasModifier {
+	return func(r *v1alpha1.Alias) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.AliasObservation) aliasModifier {
+	return func(r *v1alpha1.Alias) { r.Status.AtProvider = o }
+}
+
+func withTargetKeyID(id string) aliasModifier {
+	return func(r *v1alpha1.Alias) { r.Spec.ForProvider.TargetKeyID = aws.String(id) }
+}
+
+func alias(m ...aliasModifier) *v1alpha1.Alias {
+	cr := &v1alpha1.Alias{}
+	meta.SetExternalName(cr, aliasName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func list(err error, entries ...awskms.AliasListEntry) func(*awskms.ListAliasesInput) awskms.ListAliasesRequest {
+	return func(*awskms.ListAliasesInput) awskms.ListAliasesRequest {
+		return awskms.ListAliasesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awskms.ListAliasesOutput{
+				Aliases: entries,
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Alias
+		result managed.ExternalObservation
+		err    error
+	}
+
+	entry := awskms.AliasListEntry{
+		AliasName:   aws.String(kms.AliasName(aliasName)),
+		AliasArn:    aws.String(aliasARN),
+		TargetKeyId: aws.String(keyID),
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				kms: &fake.MockClient{MockList: list(nil, entry)},
+				cr:  alias(withTargetKeyID(keyID)),
+			},
+			want: want{
+				cr: alias(
+					withTargetKeyID(keyID),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.AliasObservation{AliasARN: aliasARN, TargetKeyID: keyID})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TargetChanged": {
+			args: args{
+				kms: &fake.MockClient{MockList: list(nil, entry)},
+				cr:  alias(withTargetKeyID(otherKey)),
+			},
+			want: want{
+				cr: alias(
+					withTargetKeyID(otherKey),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.AliasObservation{AliasARN: aliasARN, TargetKeyID: keyID})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				kms: &fake.MockClient{MockList: list(nil)},
+				cr:  alias(withTargetKeyID(keyID)),
+			},
+			want: want{
+				cr: alias(withTargetKeyID(keyID)),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				kms: &fake.MockClient{MockList: list(errBoom)},
+				cr:  alias(),
+			},
+			want: want{
+				cr:  alias(),
+				err: awsclient.Wrap(errBoom, errListFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.kms}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Alias
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kms: &fake.MockClient{
+					MockCreate: func(input *awskms.CreateAliasInput) awskms.CreateAliasRequest {
+						if diff := cmp.Diff(kms.AliasName(aliasName), aws.StringValue(input.AliasName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awskms.CreateAliasRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awskms.CreateAliasOutput{}},
+						}
+					},
+				},
+				cr: alias(withTargetKeyID(keyID)),
+			},
+			want: want{
+				cr: alias(withTargetKeyID(keyID), withConditions(xpv1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				kms: &fake.MockClient{
+					MockCreate: func(*awskms.CreateAliasInput) awskms.CreateAliasRequest {
+						return awskms.CreateAliasRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: alias(),
+			},
+			want: want{
+				cr:  alias(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.kms}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Alias
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kms: &fake.MockClient{
+					MockUpdate: func(input *awskms.UpdateAliasInput) awskms.UpdateAliasRequest {
+						if diff := cmp.Diff(otherKey, aws.StringValue(input.TargetKeyId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awskms.UpdateAliasRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awskms.UpdateAliasOutput{}},
+						}
+					},
+				},
+				cr: alias(withTargetKeyID(otherKey)),
+			},
+			want: want{
+				cr: alias(withTargetKeyID(otherKey)),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				kms: &fake.MockClient{
+					MockUpdate: func(*awskms.UpdateAliasInput) awskms.UpdateAliasRequest {
+						return awskms.UpdateAliasRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: alias(),
+			},
+			want: want{
+				cr:  alias(),
+				err: awsclient.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.kms}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Alias
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kms: &fake.MockClient{
+					MockDelete: func(*awskms.DeleteAliasInput) awskms.DeleteAliasRequest {
+						return awskms.DeleteAliasRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awskms.DeleteAliasOutput{}},
+						}
+					},
+				},
+				cr: alias(),
+			},
+			want: want{
+				cr: alias(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				kms: &fake.MockClient{
+					MockDelete: func(*awskms.DeleteAliasInput) awskms.DeleteAliasRequest {
+						return awskms.DeleteAliasRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awskms.ErrCodeNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: alias(),
+			},
+			want: want{
+				cr: alias(withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				kms: &fake.MockClient{
+					MockDelete: func(*awskms.DeleteAliasInput) awskms.DeleteAliasRequest {
+						return awskms.DeleteAliasRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: alias(),
+			},
+			want: want{
+				cr:  alias(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.kms}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
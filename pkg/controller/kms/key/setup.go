@@ -23,7 +23,7 @@ import (
 )
 
 // SetupKey adds a controller that reconciles Key.
-func SetupKey(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+func SetupKey(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclients.Options) error {
 	name := managed.ControllerName(svcapitypes.KeyGroupKind)
 	opts := []option{
 		func(e *external) {
@@ -42,12 +42,14 @@ func SetupKey(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) erro
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
 		}).
 		For(&svcapitypes.Key{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.KeyGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(awsclients.NewConnecter(&connector{kube: mgr.GetClient(), opts: opts}, o)),
+			managed.WithTimeout(o.ReconcileTimeout),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -76,6 +78,10 @@ func postObserve(_ context.Context, cr *svcapitypes.Key, obj *svcsdk.DescribeKey
 		cr.SetConditions(xpv1.Unavailable())
 	}
 
+	obs.ConnectionDetails = managed.ConnectionDetails{
+		svcapitypes.ResourceCredentialsSecretARNKey:   []byte(awsclients.StringValue(obj.KeyMetadata.Arn)),
+		svcapitypes.ResourceCredentialsSecretKeyIDKey: []byte(awsclients.StringValue(obj.KeyMetadata.KeyId)),
+	}
 	return obs, nil
 }
 
@@ -125,6 +131,11 @@ func (u *updater) update(ctx context.Context, mg resource.Managed) (managed.Exte
 		return managed.ExternalUpdate{}, err
 	}
 
+	// Rotation
+	if err := u.updateKeyRotation(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
 	return managed.ExternalUpdate{}, nil
 }
 
@@ -182,6 +193,19 @@ func (u *updater) enableDisableKey(ctx context.Context, cr *svcapitypes.Key) err
 	return nil
 }
 
+func (u *updater) updateKeyRotation(ctx context.Context, cr *svcapitypes.Key) error {
+	if cr.Spec.ForProvider.EnableKeyRotation == nil {
+		return nil
+	}
+	id := awsclients.String(meta.GetExternalName(cr))
+	if awsclients.BoolValue(cr.Spec.ForProvider.EnableKeyRotation) {
+		_, err := u.client.EnableKeyRotationWithContext(ctx, &svcsdk.EnableKeyRotationInput{KeyId: id})
+		return awsclients.Wrap(err, "cannot enable key rotation")
+	}
+	_, err := u.client.DisableKeyRotationWithContext(ctx, &svcsdk.DisableKeyRotationInput{KeyId: id})
+	return awsclients.Wrap(err, "cannot disable key rotation")
+}
+
 type deleter struct {
 	client svcsdkapi.KMSAPI
 }
@@ -279,6 +303,19 @@ func (o *observer) isUpToDate(cr *svcapitypes.Key, obj *svcsdk.DescribeKeyOutput
 		return false, nil
 	}
 
+	// Rotation
+	if cr.Spec.ForProvider.EnableKeyRotation != nil {
+		resRotation, err := o.client.GetKeyRotationStatus(&svcsdk.GetKeyRotationStatusInput{
+			KeyId: awsclients.String(meta.GetExternalName(cr)),
+		})
+		if err != nil {
+			return false, awsclients.Wrap(err, "cannot get key rotation status")
+		}
+		if awsclients.BoolValue(cr.Spec.ForProvider.EnableKeyRotation) != awsclients.BoolValue(resRotation.KeyRotationEnabled) {
+			return false, nil
+		}
+	}
+
 	// Tags
 	resTags, err := o.client.ListResourceTags(&svcsdk.ListResourceTagsInput{
 		KeyId: awsclients.String(meta.GetExternalName(cr)),
@@ -0,0 +1,357 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package eventsourcemapping
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awslambda "github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/lambda/fake"
+)
+
+var (
+	errBoom     = errors.New("boom")
+	uuid        = "a1b2c3d4-5678-90ab-cdef-11111EXAMPLE"
+	functionARN = "arn:aws:lambda:us-east-1:123456789012:function:test"
+	queueARN    = "arn:aws:sqs:us-east-1:123456789012:test"
+	batchSize   = int64(10)
+	window      = int64(0)
+)
+
+type mappingModifier func(*v1alpha1.EventSourceMapping)
+
+func withConditions(c ...xpv1.Condition) mappingModifier {
+	return func(r *v1alpha1.EventSourceMapping) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withState(s string) mappingModifier {
+	return func(r *v1alpha1.EventSourceMapping) { r.Status.AtProvider.State = s }
+}
+
+func withObservation(o v1alpha1.EventSourceMappingObservation) mappingModifier {
+	return func(r *v1alpha1.EventSourceMapping) { r.Status.AtProvider = o }
+}
+
+func withExternalName(n string) mappingModifier {
+	return func(r *v1alpha1.EventSourceMapping) { meta.SetExternalName(r, n) }
+}
+
+func withBatching(size, w int64) mappingModifier {
+	return func(r *v1alpha1.EventSourceMapping) {
+		r.Spec.ForProvider.BatchSize = aws.Int64(size)
+		r.Spec.ForProvider.MaximumBatchingWindowInSeconds = aws.Int64(w)
+	}
+}
+
+func withEnabled(e bool) mappingModifier {
+	return func(r *v1alpha1.EventSourceMapping) { r.Spec.ForProvider.Enabled = aws.Bool(e) }
+}
+
+func mapping(m ...mappingModifier) *v1alpha1.EventSourceMapping {
+	cr := &v1alpha1.EventSourceMapping{
+		Spec: v1alpha1.EventSourceMappingSpec{
+			ForProvider: v1alpha1.EventSourceMappingParameters{
+				FunctionName:   aws.String("test"),
+				EventSourceARN: aws.String(queueARN),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func get(state string, err error) func(*awslambda.GetEventSourceMappingInput) awslambda.GetEventSourceMappingRequest {
+	return func(*awslambda.GetEventSourceMappingInput) awslambda.GetEventSourceMappingRequest {
+		return awslambda.GetEventSourceMappingRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awslambda.GetEventSourceMappingOutput{
+				UUID:                           aws.String(uuid),
+				FunctionArn:                    aws.String(functionARN),
+				EventSourceArn:                 aws.String(queueARN),
+				BatchSize:                      aws.Int64(batchSize),
+				MaximumBatchingWindowInSeconds: aws.Int64(window),
+				State:                          aws.String(state),
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.EventSourceMapping
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockEventSourceMappingClient
+		kube   client.Client
+		cr     *v1alpha1.EventSourceMapping
+		want   want
+	}{
+		"NoExternalName": {
+			cr: mapping(),
+			want: want{
+				cr: mapping(),
+			},
+		},
+		"LateInitAndAvailable": {
+			client: &fake.MockEventSourceMappingClient{MockGet: get(v1alpha1.EventSourceMappingStateEnabled, nil)},
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			cr:     mapping(withExternalName(uuid)),
+			want: want{
+				cr: mapping(withExternalName(uuid), withBatching(batchSize, window),
+					withObservation(v1alpha1.EventSourceMappingObservation{FunctionARN: functionARN, State: v1alpha1.EventSourceMappingStateEnabled}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Disabled": {
+			client: &fake.MockEventSourceMappingClient{MockGet: get(v1alpha1.EventSourceMappingStateEnabled, nil)},
+			cr:     mapping(withExternalName(uuid), withBatching(batchSize, window), withEnabled(false)),
+			want: want{
+				cr: mapping(withExternalName(uuid), withBatching(batchSize, window), withEnabled(false),
+					withObservation(v1alpha1.EventSourceMappingObservation{FunctionARN: functionARN, State: v1alpha1.EventSourceMappingStateEnabled}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"Creating": {
+			client: &fake.MockEventSourceMappingClient{MockGet: get(v1alpha1.EventSourceMappingStateCreating, nil)},
+			cr:     mapping(withExternalName(uuid), withBatching(batchSize, window)),
+			want: want{
+				cr: mapping(withExternalName(uuid), withBatching(batchSize, window),
+					withObservation(v1alpha1.EventSourceMappingObservation{FunctionARN: functionARN, State: v1alpha1.EventSourceMappingStateCreating}),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockEventSourceMappingClient{MockGet: get("", awserr.New(awslambda.ErrCodeResourceNotFoundException, "", nil))},
+			cr:     mapping(withExternalName(uuid)),
+			want: want{
+				cr: mapping(withExternalName(uuid)),
+			},
+		},
+		"GetFailed": {
+			client: &fake.MockEventSourceMappingClient{MockGet: get("", errBoom)},
+			cr:     mapping(withExternalName(uuid)),
+			want: want{
+				cr:  mapping(withExternalName(uuid)),
+				err: awsclient.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"KubeUpdateFailed": {
+			client: &fake.MockEventSourceMappingClient{MockGet: get(v1alpha1.EventSourceMappingStateEnabled, nil)},
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			cr:     mapping(withExternalName(uuid)),
+			want: want{
+				cr:  mapping(withExternalName(uuid), withBatching(batchSize, window)),
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.EventSourceMapping
+		result managed.ExternalCreation
+		err    error
+	}
+
+	create := func(err error) func(*awslambda.CreateEventSourceMappingInput) awslambda.CreateEventSourceMappingRequest {
+		return func(*awslambda.CreateEventSourceMappingInput) awslambda.CreateEventSourceMappingRequest {
+			return awslambda.CreateEventSourceMappingRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awslambda.CreateEventSourceMappingOutput{
+					UUID: aws.String(uuid),
+				}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		client *fake.MockEventSourceMappingClient
+		cr     *v1alpha1.EventSourceMapping
+		want   want
+	}{
+		"Successful": {
+			client: &fake.MockEventSourceMappingClient{MockCreate: create(nil)},
+			cr:     mapping(),
+			want: want{
+				cr:     mapping(withExternalName(uuid), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFailed": {
+			client: &fake.MockEventSourceMappingClient{MockCreate: create(errBoom)},
+			cr:     mapping(),
+			want: want{
+				cr:  mapping(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	called := false
+	update := func(err error) func(*awslambda.UpdateEventSourceMappingInput) awslambda.UpdateEventSourceMappingRequest {
+		return func(in *awslambda.UpdateEventSourceMappingInput) awslambda.UpdateEventSourceMappingRequest {
+			called = true
+			if diff := cmp.Diff(uuid, aws.StringValue(in.UUID)); diff != "" {
+				t.Errorf("UUID: -want, +got:\n%s", diff)
+			}
+			return awslambda.UpdateEventSourceMappingRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awslambda.UpdateEventSourceMappingOutput{}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		client *fake.MockEventSourceMappingClient
+		cr     *v1alpha1.EventSourceMapping
+		called bool
+		err    error
+	}{
+		"Successful": {
+			client: &fake.MockEventSourceMappingClient{MockUpdate: update(nil)},
+			cr:     mapping(withExternalName(uuid), withState(v1alpha1.EventSourceMappingStateEnabled)),
+			called: true,
+		},
+		"InTransition": {
+			client: &fake.MockEventSourceMappingClient{MockUpdate: update(nil)},
+			cr:     mapping(withExternalName(uuid), withState(v1alpha1.EventSourceMappingStateUpdating)),
+		},
+		"UpdateFailed": {
+			client: &fake.MockEventSourceMappingClient{MockUpdate: update(errBoom)},
+			cr:     mapping(withExternalName(uuid), withState(v1alpha1.EventSourceMappingStateDisabled)),
+			called: true,
+			err:    awsclient.Wrap(errBoom, errUpdateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			called = false
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.called, called); diff != "" {
+				t.Errorf("called: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	del := func(err error) func(*awslambda.DeleteEventSourceMappingInput) awslambda.DeleteEventSourceMappingRequest {
+		return func(*awslambda.DeleteEventSourceMappingInput) awslambda.DeleteEventSourceMappingRequest {
+			return awslambda.DeleteEventSourceMappingRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awslambda.DeleteEventSourceMappingOutput{}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		client *fake.MockEventSourceMappingClient
+		cr     *v1alpha1.EventSourceMapping
+		err    error
+	}{
+		"Successful": {
+			client: &fake.MockEventSourceMappingClient{MockDelete: del(nil)},
+			cr:     mapping(withExternalName(uuid)),
+		},
+		"AlreadyDeleting": {
+			cr: mapping(withExternalName(uuid), withState(v1alpha1.EventSourceMappingStateDeleting)),
+		},
+		"AlreadyGone": {
+			client: &fake.MockEventSourceMappingClient{MockDelete: del(awserr.New(awslambda.ErrCodeResourceNotFoundException, "", nil))},
+			cr:     mapping(withExternalName(uuid)),
+		},
+		"DeleteFailed": {
+			client: &fake.MockEventSourceMappingClient{MockDelete: del(errBoom)},
+			cr:     mapping(withExternalName(uuid)),
+			err:    awsclient.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(xpv1.Deleting(), tc.cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
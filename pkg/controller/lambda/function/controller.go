@@ -0,0 +1,198 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package function
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awslambda "github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/lambda"
+)
+
+const (
+	errUnexpectedObject   = "managed resource is not a Lambda Function custom resource"
+	errKubeUpdateFailed   = "cannot update Lambda Function custom resource"
+	errGetFailed          = "cannot get Lambda Function"
+	errCreateFailed       = "cannot create Lambda Function"
+	errUpdateConfigFailed = "cannot update Lambda Function configuration"
+	errUpdateCodeFailed   = "cannot update Lambda Function code"
+	errDeleteFailed       = "cannot delete Lambda Function"
+)
+
+// SetupFunction adds a controller that reconciles Lambda Functions.
+func SetupFunction(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclient.Options) error {
+	name := managed.ControllerName(v1alpha1.FunctionGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		For(&v1alpha1.Function{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.FunctionGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: lambda.NewClient}, o)),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithTimeout(o.ReconcileTimeout),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) lambda.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Function)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client lambda.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Function)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	rsp, err := e.client.GetFunctionRequest(&awslambda.GetFunctionInput{
+		FunctionName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(lambda.IsNotFound, err), errGetFailed)
+	}
+	config := *rsp.Configuration
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	lambda.LateInitialize(&cr.Spec.ForProvider, &config)
+	if !reflect.DeepEqual(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	// The deployed object version can't be observed, so it is carried over.
+	deployed := cr.Status.AtProvider.CodeS3ObjectVersion
+	cr.Status.AtProvider = lambda.GenerateObservation(config)
+	cr.Status.AtProvider.CodeS3ObjectVersion = deployed
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.FunctionStateActive:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.FunctionStatePending:
+		cr.Status.SetConditions(xpv1.Creating())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+
+	upToDate := lambda.IsConfigurationUpToDate(cr.Spec.ForProvider, config) &&
+		lambda.IsCodeUpToDate(cr.Spec.ForProvider, cr.Status.AtProvider)
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: lambda.GetConnectionDetails(*cr),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Function)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.client.CreateFunctionRequest(lambda.GenerateCreateFunctionInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreateFailed)
+	}
+	cr.Status.AtProvider.CodeS3ObjectVersion = aws.StringValue(cr.Spec.ForProvider.Code.S3ObjectVersion)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Function)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	// Lambda rejects changes while the function is being created or while a
+	// previous update is still in progress.
+	if cr.Status.AtProvider.State == v1alpha1.FunctionStatePending ||
+		cr.Status.AtProvider.LastUpdateStatus == string(awslambda.LastUpdateStatusInProgress) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	rsp, err := e.client.GetFunctionRequest(&awslambda.GetFunctionInput{
+		FunctionName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errGetFailed)
+	}
+
+	// Configuration and code are updated in separate reconciles since the
+	// second update would conflict with the first one while it's in progress.
+	if !lambda.IsConfigurationUpToDate(cr.Spec.ForProvider, *rsp.Configuration) {
+		_, err := e.client.UpdateFunctionConfigurationRequest(lambda.GenerateUpdateFunctionConfigurationInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdateConfigFailed)
+	}
+	if !lambda.IsCodeUpToDate(cr.Spec.ForProvider, cr.Status.AtProvider) {
+		if _, err := e.client.UpdateFunctionCodeRequest(lambda.GenerateUpdateFunctionCodeInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdateCodeFailed)
+		}
+		cr.Status.AtProvider.CodeS3ObjectVersion = aws.StringValue(cr.Spec.ForProvider.Code.S3ObjectVersion)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Function)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteFunctionRequest(&awslambda.DeleteFunctionInput{
+		FunctionName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return awsclient.Wrap(resource.Ignore(lambda.IsNotFound, err), errDeleteFailed)
+}
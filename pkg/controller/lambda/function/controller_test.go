@@ -0,0 +1,527 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package function
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awslambda "github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/lambda"
+	"github.com/crossplane/provider-aws/pkg/clients/lambda/fake"
+)
+
+var (
+	errBoom     = errors.New("boom")
+	functionARN = "arn:aws:lambda:us-east-1:123456789012:function:test"
+	roleARN     = "arn:aws:iam::123456789012:role/lambda"
+	runtime     = "go1.x"
+	handler     = "main"
+	memorySize  = int64(128)
+	timeout     = int64(3)
+	v1          = "v1"
+	v2          = "v2"
+)
+
+type args struct {
+	lambda lambda.Client
+	kube   client.Client
+	cr     *v1alpha1.Function
+}
+
+type functionModifier func(*v1alpha1.Function)
+
+func withConditions(c ...xpv1.Condition) functionModifier {
+	return func(r *v1alpha1.Function) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.FunctionObservation) functionModifier {
+	return func(r *v1alpha1.Function) { r.Status.AtProvider = o }
+}
+
+func withMemorySize(s int64) functionModifier {
+	return func(r *v1alpha1.Function) { r.Spec.ForProvider.MemorySize = aws.Int64(s) }
+}
+
+func withObjectVersion(v string) functionModifier {
+	return func(r *v1alpha1.Function) { r.Spec.ForProvider.Code.S3ObjectVersion = aws.String(v) }
+}
+
+func function(m ...functionModifier) *v1alpha1.Function {
+	cr := &v1alpha1.Function{
+		Spec: v1alpha1.FunctionSpec{
+			ForProvider: v1alpha1.FunctionParameters{
+				Runtime:    runtime,
+				Handler:    handler,
+				Role:       aws.String(roleARN),
+				MemorySize: aws.Int64(memorySize),
+				Timeout:    aws.Int64(timeout),
+				Code: v1alpha1.FunctionCode{
+					S3Bucket: aws.String("bucket"),
+					S3Key:    "function.zip",
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func configuration(state awslambda.State) *awslambda.FunctionConfiguration {
+	return &awslambda.FunctionConfiguration{
+		FunctionArn: aws.String(functionARN),
+		State:       state,
+		Runtime:     awslambda.Runtime(runtime),
+		Handler:     aws.String(handler),
+		Role:        aws.String(roleARN),
+		MemorySize:  aws.Int64(memorySize),
+		Timeout:     aws.Int64(timeout),
+	}
+}
+
+func get(c *awslambda.FunctionConfiguration, err error) func(*awslambda.GetFunctionInput) awslambda.GetFunctionRequest {
+	return func(*awslambda.GetFunctionInput) awslambda.GetFunctionRequest {
+		return awslambda.GetFunctionRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awslambda.GetFunctionOutput{
+				Configuration: c,
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Function
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Active": {
+			args: args{
+				lambda: &fake.MockClient{
+					MockGet: get(configuration(awslambda.StateActive), nil),
+				},
+				cr: function(),
+			},
+			want: want{
+				cr: function(
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.FunctionObservation{FunctionARN: functionARN, State: v1alpha1.FunctionStateActive})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1alpha1.ResourceCredentialsSecretARNKey: []byte(functionARN),
+					},
+				},
+			},
+		},
+		"Pending": {
+			args: args{
+				lambda: &fake.MockClient{
+					MockGet: get(configuration(awslambda.StatePending), nil),
+				},
+				cr: function(),
+			},
+			want: want{
+				cr: function(
+					withConditions(xpv1.Creating()),
+					withObservation(v1alpha1.FunctionObservation{FunctionARN: functionARN, State: v1alpha1.FunctionStatePending})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1alpha1.ResourceCredentialsSecretARNKey: []byte(functionARN),
+					},
+				},
+			},
+		},
+		"ConfigurationOutdated": {
+			args: args{
+				lambda: &fake.MockClient{
+					MockGet: get(configuration(awslambda.StateActive), nil),
+				},
+				cr: function(withMemorySize(256)),
+			},
+			want: want{
+				cr: function(
+					withMemorySize(256),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.FunctionObservation{FunctionARN: functionARN, State: v1alpha1.FunctionStateActive})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1alpha1.ResourceCredentialsSecretARNKey: []byte(functionARN),
+					},
+				},
+			},
+		},
+		"CodeOutdated": {
+			args: args{
+				lambda: &fake.MockClient{
+					MockGet: get(configuration(awslambda.StateActive), nil),
+				},
+				cr: function(
+					withObjectVersion(v2),
+					withObservation(v1alpha1.FunctionObservation{CodeS3ObjectVersion: v1})),
+			},
+			want: want{
+				cr: function(
+					withObjectVersion(v2),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.FunctionObservation{FunctionARN: functionARN, State: v1alpha1.FunctionStateActive, CodeS3ObjectVersion: v1})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1alpha1.ResourceCredentialsSecretARNKey: []byte(functionARN),
+					},
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				lambda: &fake.MockClient{
+					MockGet: get(configuration(awslambda.StateActive), nil),
+				},
+				cr: function(func(r *v1alpha1.Function) { r.Spec.ForProvider.Timeout = nil }),
+			},
+			want: want{
+				cr: function(
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.FunctionObservation{FunctionARN: functionARN, State: v1alpha1.FunctionStateActive})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1alpha1.ResourceCredentialsSecretARNKey: []byte(functionARN),
+					},
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				lambda: &fake.MockClient{
+					MockGet: get(nil, awserr.New(awslambda.ErrCodeResourceNotFoundException, "", nil)),
+				},
+				cr: function(),
+			},
+			want: want{
+				cr: function(),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				lambda: &fake.MockClient{
+					MockGet: get(nil, errBoom),
+				},
+				cr: function(),
+			},
+			want: want{
+				cr:  function(),
+				err: awsclient.Wrap(errBoom, errGetFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.lambda}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Function
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				lambda: &fake.MockClient{
+					MockCreate: func(*awslambda.CreateFunctionInput) awslambda.CreateFunctionRequest {
+						return awslambda.CreateFunctionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslambda.CreateFunctionOutput{}},
+						}
+					},
+				},
+				cr: function(withObjectVersion(v1)),
+			},
+			want: want{
+				cr: function(
+					withObjectVersion(v1),
+					withConditions(xpv1.Creating()),
+					withObservation(v1alpha1.FunctionObservation{CodeS3ObjectVersion: v1})),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				lambda: &fake.MockClient{
+					MockCreate: func(*awslambda.CreateFunctionInput) awslambda.CreateFunctionRequest {
+						return awslambda.CreateFunctionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: function(),
+			},
+			want: want{
+				cr:  function(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.lambda}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Function
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpdateConfiguration": {
+			args: args{
+				lambda: &fake.MockClient{
+					MockGet: get(configuration(awslambda.StateActive), nil),
+					MockUpdateConfig: func(*awslambda.UpdateFunctionConfigurationInput) awslambda.UpdateFunctionConfigurationRequest {
+						return awslambda.UpdateFunctionConfigurationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslambda.UpdateFunctionConfigurationOutput{}},
+						}
+					},
+				},
+				cr: function(withMemorySize(256)),
+			},
+			want: want{
+				cr: function(withMemorySize(256)),
+			},
+		},
+		"UpdateConfigurationFailed": {
+			args: args{
+				lambda: &fake.MockClient{
+					MockGet: get(configuration(awslambda.StateActive), nil),
+					MockUpdateConfig: func(*awslambda.UpdateFunctionConfigurationInput) awslambda.UpdateFunctionConfigurationRequest {
+						return awslambda.UpdateFunctionConfigurationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: function(withMemorySize(256)),
+			},
+			want: want{
+				cr:  function(withMemorySize(256)),
+				err: awsclient.Wrap(errBoom, errUpdateConfigFailed),
+			},
+		},
+		"UpdateCode": {
+			args: args{
+				lambda: &fake.MockClient{
+					MockGet: get(configuration(awslambda.StateActive), nil),
+					MockUpdateCode: func(*awslambda.UpdateFunctionCodeInput) awslambda.UpdateFunctionCodeRequest {
+						return awslambda.UpdateFunctionCodeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslambda.UpdateFunctionCodeOutput{}},
+						}
+					},
+				},
+				cr: function(
+					withObjectVersion(v2),
+					withObservation(v1alpha1.FunctionObservation{CodeS3ObjectVersion: v1})),
+			},
+			want: want{
+				cr: function(
+					withObjectVersion(v2),
+					withObservation(v1alpha1.FunctionObservation{CodeS3ObjectVersion: v2})),
+			},
+		},
+		"UpdateCodeFailed": {
+			args: args{
+				lambda: &fake.MockClient{
+					MockGet: get(configuration(awslambda.StateActive), nil),
+					MockUpdateCode: func(*awslambda.UpdateFunctionCodeInput) awslambda.UpdateFunctionCodeRequest {
+						return awslambda.UpdateFunctionCodeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: function(
+					withObjectVersion(v2),
+					withObservation(v1alpha1.FunctionObservation{CodeS3ObjectVersion: v1})),
+			},
+			want: want{
+				cr: function(
+					withObjectVersion(v2),
+					withObservation(v1alpha1.FunctionObservation{CodeS3ObjectVersion: v1})),
+				err: awsclient.Wrap(errBoom, errUpdateCodeFailed),
+			},
+		},
+		"Pending": {
+			args: args{
+				lambda: &fake.MockClient{},
+				cr:     function(withObservation(v1alpha1.FunctionObservation{State: v1alpha1.FunctionStatePending})),
+			},
+			want: want{
+				cr: function(withObservation(v1alpha1.FunctionObservation{State: v1alpha1.FunctionStatePending})),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.lambda}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Function
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				lambda: &fake.MockClient{
+					MockDelete: func(*awslambda.DeleteFunctionInput) awslambda.DeleteFunctionRequest {
+						return awslambda.DeleteFunctionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslambda.DeleteFunctionOutput{}},
+						}
+					},
+				},
+				cr: function(),
+			},
+			want: want{
+				cr: function(withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				lambda: &fake.MockClient{
+					MockDelete: func(*awslambda.DeleteFunctionInput) awslambda.DeleteFunctionRequest {
+						return awslambda.DeleteFunctionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awslambda.ErrCodeResourceNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: function(),
+			},
+			want: want{
+				cr: function(withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				lambda: &fake.MockClient{
+					MockDelete: func(*awslambda.DeleteFunctionInput) awslambda.DeleteFunctionRequest {
+						return awslambda.DeleteFunctionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: function(),
+			},
+			want: want{
+				cr:  function(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.lambda}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
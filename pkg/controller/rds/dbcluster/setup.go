@@ -2,6 +2,7 @@ package dbcluster
 
 import (
 	"context"
+	"strconv"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/rds"
 	svcsdkapi "github.com/aws/aws-sdk-go/service/rds/rdsiface"
@@ -25,7 +26,7 @@ import (
 )
 
 // SetupDBCluster adds a controller that reconciles DbCluster.
-func SetupDBCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+func SetupDBCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o aws.Options) error {
 	name := managed.ControllerName(svcapitypes.DBClusterGroupKind)
 	opts := []option{
 		func(e *external) {
@@ -41,12 +42,14 @@ func SetupDBCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
 		}).
 		For(&svcapitypes.DBCluster{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.DBClusterGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(aws.NewConnecter(&connector{kube: mgr.GetClient(), opts: opts}, o)),
+			managed.WithTimeout(o.ReconcileTimeout),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -56,25 +59,51 @@ func preObserve(_ context.Context, cr *svcapitypes.DBCluster, obj *svcsdk.Descri
 	return nil
 }
 
-// This probably requires custom Conditions to be defined for handling all statuses
-// described here https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/Aurora.Status.html
-// Need to get help from community on how to deal with this. Ideally the status should reflect
-// the true status value as described by the provider.
+// postObserve maps the cluster state to conditions and publishes the writer
+// and reader endpoints. The state of the cluster itself is reported in
+// status.atProvider.status, separately from the states of its instances.
 func postObserve(_ context.Context, cr *svcapitypes.DBCluster, resp *svcsdk.DescribeDBClustersOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
 	switch aws.StringValue(resp.DBClusters[0].Status) {
-	case "available":
+	case svcapitypes.DBClusterStateAvailable,
+		svcapitypes.DBClusterStateBackingUp,
+		svcapitypes.DBClusterStateBacktracking,
+		svcapitypes.DBClusterStateMaintenance,
+		svcapitypes.DBClusterStateModifying,
+		svcapitypes.DBClusterStateRenaming,
+		svcapitypes.DBClusterStateResettingPassword,
+		svcapitypes.DBClusterStateStorageOptimizing,
+		svcapitypes.DBClusterStateUpdatingIAMDBAuth,
+		svcapitypes.DBClusterStateUpgrading:
+		// The cluster keeps serving connections in these states.
 		cr.SetConditions(xpv1.Available())
-	case "deleting", "stopped", "stopping":
-		cr.SetConditions(xpv1.Unavailable())
-	case "creating":
+	case svcapitypes.DBClusterStateCreating:
 		cr.SetConditions(xpv1.Creating())
+	case svcapitypes.DBClusterStateDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
 	}
+	obs.ConnectionDetails = getConnectionDetails(resp.DBClusters[0])
 	return obs, nil
 }
 
+func getConnectionDetails(c *svcsdk.DBCluster) managed.ConnectionDetails {
+	conn := managed.ConnectionDetails{}
+	if aws.StringValue(c.Endpoint) != "" {
+		conn[xpv1.ResourceCredentialsSecretEndpointKey] = []byte(aws.StringValue(c.Endpoint))
+	}
+	if aws.StringValue(c.ReaderEndpoint) != "" {
+		conn[svcapitypes.ResourceCredentialsSecretReaderEndpointKey] = []byte(aws.StringValue(c.ReaderEndpoint))
+	}
+	if c.Port != nil {
+		conn[xpv1.ResourceCredentialsSecretPortKey] = []byte(strconv.FormatInt(aws.Int64Value(c.Port), 10))
+	}
+	return conn
+}
+
 type custom struct {
 	kube   client.Client
 	client svcsdkapi.RDSAPI
@@ -24,7 +24,7 @@ import (
 )
 
 // SetupDBParameterGroup adds a controller that reconciles DBParametergroup.
-func SetupDBParameterGroup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+func SetupDBParameterGroup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclients.Options) error {
 	name := managed.ControllerName(svcapitypes.DBParameterGroupGroupKind)
 	opts := []option{
 		func(e *external) {
@@ -32,20 +32,21 @@ func SetupDBParameterGroup(mgr ctrl.Manager, l logging.Logger, rl workqueue.Rate
 			e.preObserve = preObserve
 			e.preUpdate = preUpdate
 			e.preDelete = preDelete
-			e.postObserve = postObserve
 			c := &custom{client: e.client, kube: e.kube}
-			e.isUpToDate = c.isUpToDate
+			e.postObserve = c.postObserve
 		},
 	}
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
 		}).
 		For(&svcapitypes.DBParameterGroup{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.DBParameterGroupGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(awsclients.NewConnecter(&connector{kube: mgr.GetClient(), opts: opts}, o)),
+			managed.WithTimeout(o.ReconcileTimeout),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -60,14 +61,6 @@ func preObserve(_ context.Context, cr *svcapitypes.DBParameterGroup, obj *svcsdk
 	return nil
 }
 
-func postObserve(_ context.Context, cr *svcapitypes.DBParameterGroup, obj *svcsdk.DescribeDBParameterGroupsOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
-	if err != nil {
-		return managed.ExternalObservation{}, err
-	}
-	cr.SetConditions(xpv1.Available())
-	return obs, err
-}
-
 func preCreate(_ context.Context, cr *svcapitypes.DBParameterGroup, obj *svcsdk.CreateDBParameterGroupInput) error {
 	obj.DBParameterGroupName = awsclients.String(meta.GetExternalName(cr))
 	return nil
@@ -104,9 +97,23 @@ func preDelete(_ context.Context, cr *svcapitypes.DBParameterGroup, obj *svcsdk.
 	return false, nil
 }
 
-func (e *custom) isUpToDate(cr *svcapitypes.DBParameterGroup, obj *svcsdk.DescribeDBParameterGroupsOutput) (bool, error) {
-	// TODO(Dkaykay): We need isUpToDate to have context.
-	ctx := context.TODO()
+// postObserve compares the parameters of the parameter group here rather than
+// in the isUpToDate hook, because describing them needs the context of the
+// reconcile, which isUpToDate does not receive.
+func (e *custom) postObserve(ctx context.Context, cr *svcapitypes.DBParameterGroup, _ *svcsdk.DescribeDBParameterGroupsOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	upToDate, err := e.isUpToDate(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "isUpToDate check failed")
+	}
+	obs.ResourceUpToDate = upToDate
+	return obs, nil
+}
+
+func (e *custom) isUpToDate(ctx context.Context, cr *svcapitypes.DBParameterGroup) (bool, error) {
 	results, err := e.getCurrentDBParameters(ctx, cr)
 	if err != nil {
 		return false, err
@@ -50,10 +50,11 @@ const (
 	errFmtKeyNotFound   = "key %s is not found in referenced Kubernetes secret"
 	errGetSecretFailed  = "failed to get Kubernetes secret"
 	errGetSecretValue   = "cannot get the value of secret from AWS"
+	errRotate           = "cannot configure rotation of the secret"
 )
 
 // SetupSecret adds a controller that reconciles a Secret.
-func SetupSecret(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+func SetupSecret(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclients.Options) error {
 	name := managed.ControllerName(svcapitypes.SecretGroupKind)
 	opts := []option{
 		func(e *external) {
@@ -70,14 +71,16 @@ func SetupSecret(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) e
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
 		}).
 		For(&svcapitypes.Secret{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.SecretGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(awsclients.NewConnecter(&connector{kube: mgr.GetClient(), opts: opts}, o)),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
+			managed.WithTimeout(o.ReconcileTimeout),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -124,6 +127,13 @@ func (e *hooks) isUpToDate(check bool, cr *svcapitypes.Secret, resp *svcsdk.Desc
 	if len(add) != 0 && len(remove) != 0 {
 		return false, nil
 	}
+	if !isRotationUpToDate(cr.Spec.ForProvider, resp) {
+		return false, nil
+	}
+	// The value of a rotated secret is owned by the rotation function.
+	if cr.Spec.ForProvider.RotationLambdaARN != nil {
+		return true, nil
+	}
 	// TODO(muvaf): We need isUpToDate to have context.
 	ctx := context.TODO()
 	s, err := e.client.GetSecretValueWithContext(ctx, &svcsdk.GetSecretValueInput{
@@ -205,6 +215,21 @@ func (e *hooks) preUpdate(ctx context.Context, cr *svcapitypes.Secret, obj *svcs
 			return awsclients.Wrap(err, errCreateTags)
 		}
 	}
+	if !isRotationUpToDate(cr.Spec.ForProvider, resp) {
+		if _, err := e.client.RotateSecretWithContext(ctx, &svcsdk.RotateSecretInput{
+			SecretId:          awsclients.String(meta.GetExternalName(cr)),
+			RotationLambdaARN: cr.Spec.ForProvider.RotationLambdaARN,
+			RotationRules:     generateRotationRules(cr.Spec.ForProvider.RotationRules),
+		}); err != nil {
+			return awsclients.Wrap(err, errRotate)
+		}
+	}
+	obj.SecretId = awsclients.String(meta.GetExternalName(cr))
+	obj.Description = cr.Spec.ForProvider.Description
+	obj.KmsKeyId = cr.Spec.ForProvider.KMSKeyID
+	if cr.Spec.ForProvider.RotationLambdaARN != nil {
+		return nil
+	}
 	payload, err := e.getPayload(ctx, cr)
 	if err != nil {
 		return err
@@ -215,12 +240,33 @@ func (e *hooks) preUpdate(ctx context.Context, cr *svcapitypes.Secret, obj *svcs
 	case cr.Spec.ForProvider.BinarySecretRef != nil:
 		obj.SecretBinary = payload
 	}
-	obj.SecretId = awsclients.String(meta.GetExternalName(cr))
-	obj.Description = cr.Spec.ForProvider.Description
-	obj.KmsKeyId = cr.Spec.ForProvider.KMSKeyID
 	return nil
 }
 
+// isRotationUpToDate checks whether rotation is configured as desired. It is
+// not managed unless a rotation function is given.
+func isRotationUpToDate(p svcapitypes.SecretParameters, resp *svcsdk.DescribeSecretOutput) bool {
+	if p.RotationLambdaARN == nil {
+		return true
+	}
+	if !awsclients.BoolValue(resp.RotationEnabled) ||
+		awsclients.StringValue(p.RotationLambdaARN) != awsclients.StringValue(resp.RotationLambdaARN) {
+		return false
+	}
+	if p.RotationRules == nil || p.RotationRules.AutomaticallyAfterDays == nil {
+		return true
+	}
+	return resp.RotationRules != nil &&
+		awsclients.Int64Value(p.RotationRules.AutomaticallyAfterDays) == awsclients.Int64Value(resp.RotationRules.AutomaticallyAfterDays)
+}
+
+func generateRotationRules(r *svcapitypes.RotationRulesType) *svcsdk.RotationRulesType {
+	if r == nil {
+		return nil
+	}
+	return &svcsdk.RotationRulesType{AutomaticallyAfterDays: r.AutomaticallyAfterDays}
+}
+
 func (e *hooks) preCreate(ctx context.Context, cr *svcapitypes.Secret, obj *svcsdk.CreateSecretInput) error {
 	payload, err := e.getPayload(ctx, cr)
 	if err != nil {
@@ -0,0 +1,79 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/secretsmanager/v1alpha1"
+)
+
+func TestIsRotationUpToDate(t *testing.T) {
+	lambdaARN := "arn:aws:lambda:us-east-1:123456789012:function:rotate"
+	enabled := &svcsdk.DescribeSecretOutput{
+		RotationEnabled:   aws.Bool(true),
+		RotationLambdaARN: aws.String(lambdaARN),
+		RotationRules:     &svcsdk.RotationRulesType{AutomaticallyAfterDays: aws.Int64(30)},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.SecretParameters
+		resp *svcsdk.DescribeSecretOutput
+		want bool
+	}{
+		"NotManaged": {
+			resp: &svcsdk.DescribeSecretOutput{},
+			want: true,
+		},
+		"NotEnabled": {
+			p: v1alpha1.SecretParameters{CustomSecretParameters: v1alpha1.CustomSecretParameters{
+				RotationLambdaARN: aws.String(lambdaARN),
+			}},
+			resp: &svcsdk.DescribeSecretOutput{},
+			want: false,
+		},
+		"SameConfiguration": {
+			p: v1alpha1.SecretParameters{CustomSecretParameters: v1alpha1.CustomSecretParameters{
+				RotationLambdaARN: aws.String(lambdaARN),
+				RotationRules:     &v1alpha1.RotationRulesType{AutomaticallyAfterDays: aws.Int64(30)},
+			}},
+			resp: enabled,
+			want: true,
+		},
+		"DifferentSchedule": {
+			p: v1alpha1.SecretParameters{CustomSecretParameters: v1alpha1.CustomSecretParameters{
+				RotationLambdaARN: aws.String(lambdaARN),
+				RotationRules:     &v1alpha1.RotationRulesType{AutomaticallyAfterDays: aws.Int64(7)},
+			}},
+			resp: enabled,
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := isRotationUpToDate(tc.p, tc.resp)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package parameter
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsssm "github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ssm/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ssm"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Parameter custom resource"
+	errGetFailed        = "cannot get Parameter"
+	errDescribeFailed   = "cannot describe Parameter"
+	errPutFailed        = "cannot put Parameter"
+	errDeleteFailed     = "cannot delete Parameter"
+	errSpecUpdate       = "cannot update spec of Parameter custom resource"
+	errGetSecretFailed  = "cannot get the Kubernetes Secret of the value"
+	errNoValue          = "either value or valueSecretRef has to be given"
+)
+
+// SetupParameter adds a controller that reconciles Parameters.
+func SetupParameter(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclient.Options) error {
+	name := managed.ControllerName(v1alpha1.ParameterGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		For(&v1alpha1.Parameter{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ParameterGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: ssm.NewClient}, o)),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithTimeout(o.ReconcileTimeout),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ssm.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Parameter)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ssm.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Parameter)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	rsp, err := e.client.GetParameterRequest(&awsssm.GetParameterInput{
+		Name:           aws.String(meta.GetExternalName(cr)),
+		WithDecryption: aws.Bool(true),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: false}, awsclient.Wrap(resource.Ignore(ssm.IsNotFound, err), errGetFailed)
+	}
+	// The value and its version are returned by GetParameter whereas the rest
+	// of the configuration is only returned by DescribeParameters.
+	drsp, err := e.client.DescribeParametersRequest(ssm.GenerateDescribeParametersInput(meta.GetExternalName(cr))).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribeFailed)
+	}
+	if len(drsp.Parameters) == 0 {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	md := drsp.Parameters[0]
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ssm.LateInitialize(&cr.Spec.ForProvider, &md)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = ssm.GenerateObservation(*rsp.Parameter)
+	cr.SetConditions(xpv1.Available())
+
+	value, err := e.value(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ssm.IsUpToDate(value, cr.Spec.ForProvider, *rsp.Parameter, md),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Parameter)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	value, err := e.value(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	_, err = e.client.PutParameterRequest(ssm.GeneratePutParameterInput(meta.GetExternalName(cr), value, cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errPutFailed)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Parameter)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	value, err := e.value(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	in := ssm.GeneratePutParameterInput(meta.GetExternalName(cr), value, cr.Spec.ForProvider)
+	in.Overwrite = aws.Bool(true)
+	_, err = e.client.PutParameterRequest(in).Send(ctx)
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errPutFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Parameter)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteParameterRequest(&awsssm.DeleteParameterInput{
+		Name: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return awsclient.Wrap(resource.Ignore(ssm.IsNotFound, err), errDeleteFailed)
+}
+
+// value returns the desired value of the parameter, which is read from the
+// referenced Kubernetes Secret if there is one.
+func (e *external) value(ctx context.Context, cr *v1alpha1.Parameter) (string, error) {
+	ref := cr.Spec.ForProvider.ValueSecretRef
+	if ref == nil {
+		if cr.Spec.ForProvider.Value == nil {
+			return "", errors.New(errNoValue)
+		}
+		return *cr.Spec.ForProvider.Value, nil
+	}
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return "", errors.Wrap(err, errGetSecretFailed)
+	}
+	return string(s.Data[ref.Key]), nil
+}
@@ -0,0 +1,359 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package parameter
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsssm "github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ssm/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ssm/fake"
+)
+
+var (
+	parameterName = "/some/parameter"
+	parameterARN  = "arn:aws:ssm:us-east-1:123456789012:parameter/some/parameter"
+	value         = "some-value"
+	secretValue   = "secret-value"
+
+	errBoom = errors.New("boom")
+)
+
+type parameterModifier func(*v1alpha1.Parameter)
+
+func withConditions(c ...xpv1.Condition) parameterModifier {
+	return func(r *v1alpha1.Parameter) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.ParameterObservation) parameterModifier {
+	return func(r *v1alpha1.Parameter) { r.Status.AtProvider = o }
+}
+
+func withValue(v *string) parameterModifier {
+	return func(r *v1alpha1.Parameter) { r.Spec.ForProvider.Value = v }
+}
+
+func withValueSecretRef() parameterModifier {
+	return func(r *v1alpha1.Parameter) {
+		r.Spec.ForProvider.ValueSecretRef = &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Name: "some-secret", Namespace: "some-namespace"},
+			Key:             "value",
+		}
+	}
+}
+
+func withTier(t string) parameterModifier {
+	return func(r *v1alpha1.Parameter) { r.Spec.ForProvider.Tier = aws.String(t) }
+}
+
+func parameter(m ...parameterModifier) *v1alpha1.Parameter {
+	cr := &v1alpha1.Parameter{
+		Spec: v1alpha1.ParameterSpec{
+			ForProvider: v1alpha1.ParameterParameters{
+				Type:  v1alpha1.ParameterTypeString,
+				Value: aws.String(value),
+			},
+		},
+	}
+	meta.SetExternalName(cr, parameterName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func mockGet(v string, err error) func(*awsssm.GetParameterInput) awsssm.GetParameterRequest {
+	return func(_ *awsssm.GetParameterInput) awsssm.GetParameterRequest {
+		return awsssm.GetParameterRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsssm.GetParameterOutput{
+				Parameter: &awsssm.Parameter{
+					ARN:     aws.String(parameterARN),
+					Name:    aws.String(parameterName),
+					Type:    awsssm.ParameterTypeString,
+					Value:   aws.String(v),
+					Version: aws.Int64(3),
+				},
+			}},
+		}
+	}
+}
+
+func mockDescribe(err error) func(*awsssm.DescribeParametersInput) awsssm.DescribeParametersRequest {
+	return func(_ *awsssm.DescribeParametersInput) awsssm.DescribeParametersRequest {
+		return awsssm.DescribeParametersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsssm.DescribeParametersOutput{
+				Parameters: []awsssm.ParameterMetadata{{
+					Name: aws.String(parameterName),
+					Type: awsssm.ParameterTypeString,
+					Tier: awsssm.ParameterTierStandard,
+				}},
+			}},
+		}
+	}
+}
+
+func mockPut(t *testing.T, want string, overwrite bool, err error) func(*awsssm.PutParameterInput) awsssm.PutParameterRequest {
+	return func(in *awsssm.PutParameterInput) awsssm.PutParameterRequest {
+		if diff := cmp.Diff(want, aws.StringValue(in.Value)); diff != "" {
+			t.Errorf("Value: -want, +got:\n%s", diff)
+		}
+		if diff := cmp.Diff(overwrite, aws.BoolValue(in.Overwrite)); diff != "" {
+			t.Errorf("Overwrite: -want, +got:\n%s", diff)
+		}
+		return awsssm.PutParameterRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsssm.PutParameterOutput{}},
+		}
+	}
+}
+
+func secretGetFn(err error) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		if s, ok := obj.(*corev1.Secret); ok {
+			s.Data = map[string][]byte{"value": []byte(secretValue)}
+		}
+		return err
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observation := v1alpha1.ParameterObservation{ARN: parameterARN, Version: 3}
+
+	cases := map[string]struct {
+		kube   client.Client
+		client *fake.MockParameterClient
+		cr     resource.Managed
+		want   want
+	}{
+		"LateInitAndUpToDate": {
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			client: &fake.MockParameterClient{MockGet: mockGet(value, nil), MockDescribe: mockDescribe(nil)},
+			cr:     parameter(),
+			want: want{
+				cr: parameter(withTier("Standard"), withConditions(xpv1.Available()), withObservation(observation)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ValueChanged": {
+			client: &fake.MockParameterClient{MockGet: mockGet("old-value", nil), MockDescribe: mockDescribe(nil)},
+			cr:     parameter(withTier("Standard")),
+			want: want{
+				cr: parameter(withTier("Standard"), withConditions(xpv1.Available()), withObservation(observation)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"ValueFromSecret": {
+			kube:   &test.MockClient{MockGet: secretGetFn(nil)},
+			client: &fake.MockParameterClient{MockGet: mockGet(secretValue, nil), MockDescribe: mockDescribe(nil)},
+			cr:     parameter(withValue(nil), withValueSecretRef(), withTier("Standard")),
+			want: want{
+				cr: parameter(withValue(nil), withValueSecretRef(), withTier("Standard"), withConditions(xpv1.Available()), withObservation(observation)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockParameterClient{MockGet: mockGet("", awserr.New(awsssm.ErrCodeParameterNotFound, "", nil))},
+			cr:     parameter(),
+			want: want{
+				cr: parameter(),
+			},
+		},
+		"GetFail": {
+			client: &fake.MockParameterClient{MockGet: mockGet("", errBoom)},
+			cr:     parameter(),
+			want: want{
+				cr:  parameter(),
+				err: awsclient.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"DescribeFail": {
+			client: &fake.MockParameterClient{MockGet: mockGet(value, nil), MockDescribe: mockDescribe(errBoom)},
+			cr:     parameter(),
+			want: want{
+				cr:  parameter(),
+				err: awsclient.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"SecretGetFail": {
+			kube:   &test.MockClient{MockGet: secretGetFn(errBoom)},
+			client: &fake.MockParameterClient{MockGet: mockGet(secretValue, nil), MockDescribe: mockDescribe(nil)},
+			cr:     parameter(withValue(nil), withValueSecretRef(), withTier("Standard")),
+			want: want{
+				cr:  parameter(withValue(nil), withValueSecretRef(), withTier("Standard"), withConditions(xpv1.Available()), withObservation(observation)),
+				err: errors.Wrap(errBoom, errGetSecretFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cases := map[string]struct {
+		kube   client.Client
+		client *fake.MockParameterClient
+		cr     *v1alpha1.Parameter
+		err    error
+	}{
+		"Successful": {
+			client: &fake.MockParameterClient{MockPut: mockPut(t, value, false, nil)},
+			cr:     parameter(),
+		},
+		"ValueFromSecret": {
+			kube:   &test.MockClient{MockGet: secretGetFn(nil)},
+			client: &fake.MockParameterClient{MockPut: mockPut(t, secretValue, false, nil)},
+			cr:     parameter(withValue(nil), withValueSecretRef()),
+		},
+		"NoValue": {
+			cr:  parameter(withValue(nil)),
+			err: errors.New(errNoValue),
+		},
+		"PutFail": {
+			client: &fake.MockParameterClient{MockPut: mockPut(t, value, false, errBoom)},
+			cr:     parameter(),
+			err:    awsclient.Wrap(errBoom, errPutFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(xpv1.Creating(), tc.cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		client *fake.MockParameterClient
+		err    error
+	}{
+		"Successful": {
+			client: &fake.MockParameterClient{MockPut: mockPut(t, value, true, nil)},
+		},
+		"PutFail": {
+			client: &fake.MockParameterClient{MockPut: mockPut(t, value, true, errBoom)},
+			err:    awsclient.Wrap(errBoom, errPutFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), parameter())
+
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	mockDelete := func(err error) func(*awsssm.DeleteParameterInput) awsssm.DeleteParameterRequest {
+		return func(in *awsssm.DeleteParameterInput) awsssm.DeleteParameterRequest {
+			if diff := cmp.Diff(parameterName, aws.StringValue(in.Name)); diff != "" {
+				t.Errorf("Name: -want, +got:\n%s", diff)
+			}
+			return awsssm.DeleteParameterRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsssm.DeleteParameterOutput{}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		client *fake.MockParameterClient
+		err    error
+	}{
+		"Successful": {
+			client: &fake.MockParameterClient{MockDelete: mockDelete(nil)},
+		},
+		"AlreadyGone": {
+			client: &fake.MockParameterClient{MockDelete: mockDelete(awserr.New(awsssm.ErrCodeParameterNotFound, "", nil))},
+		},
+		"DeleteFail": {
+			client: &fake.MockParameterClient{MockDelete: mockDelete(errBoom)},
+			err:    awsclient.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			cr := parameter()
+			err := e.Delete(context.Background(), cr)
+
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(parameter(withConditions(xpv1.Deleting())), cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,337 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awstransfer "github.com/aws/aws-sdk-go-v2/service/transfer"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/transfer/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/transfer/fake"
+)
+
+var (
+	serverID  = "s-01234567890abcdef"
+	serverARN = "arn:aws:transfer:us-east-1:123456789012:server/s-01234567890abcdef"
+	region    = "us-east-1"
+	endpoint  = "s-01234567890abcdef.server.transfer.us-east-1.amazonaws.com"
+
+	errBoom = errors.New("boom")
+)
+
+type serverModifier func(*v1alpha1.Server)
+
+func withExternalName(n string) serverModifier {
+	return func(r *v1alpha1.Server) { meta.SetExternalName(r, n) }
+}
+
+func withConditions(c ...xpv1.Condition) serverModifier {
+	return func(r *v1alpha1.Server) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withState(s string) serverModifier {
+	return func(r *v1alpha1.Server) {
+		r.Status.AtProvider = v1alpha1.ServerObservation{ARN: serverARN, ServerID: serverID, State: s, Endpoint: endpoint}
+	}
+}
+
+func withProtocols(p ...string) serverModifier {
+	return func(r *v1alpha1.Server) { r.Spec.ForProvider.Protocols = p }
+}
+
+func withEndpointType(t string) serverModifier {
+	return func(r *v1alpha1.Server) { r.Spec.ForProvider.EndpointType = aws.String(t) }
+}
+
+func withIdentityProviderType(t string) serverModifier {
+	return func(r *v1alpha1.Server) { r.Spec.ForProvider.IdentityProviderType = aws.String(t) }
+}
+
+func server(m ...serverModifier) *v1alpha1.Server {
+	cr := &v1alpha1.Server{}
+	cr.Spec.ForProvider.Region = region
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// initialized returns a server whose parameters match observed().
+func initialized(m ...serverModifier) *v1alpha1.Server {
+	return server(append([]serverModifier{
+		withExternalName(serverID),
+		withProtocols("SFTP"),
+		withEndpointType("PUBLIC"),
+		withIdentityProviderType("SERVICE_MANAGED"),
+	}, m...)...)
+}
+
+func observed(state awstransfer.State) *awstransfer.DescribedServer {
+	return &awstransfer.DescribedServer{
+		Arn:                  aws.String(serverARN),
+		ServerId:             aws.String(serverID),
+		State:                state,
+		Protocols:            []awstransfer.Protocol{awstransfer.ProtocolSftp},
+		EndpointType:         awstransfer.EndpointTypePublic,
+		IdentityProviderType: awstransfer.IdentityProviderTypeServiceManaged,
+	}
+}
+
+func describe(s *awstransfer.DescribedServer, err error) func(*awstransfer.DescribeServerInput) awstransfer.DescribeServerRequest {
+	return func(*awstransfer.DescribeServerInput) awstransfer.DescribeServerRequest {
+		return awstransfer.DescribeServerRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awstransfer.DescribeServerOutput{Server: s}, Error: err},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	conn := managed.ConnectionDetails{xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint)}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		kube   client.Client
+		cr     *v1alpha1.Server
+		want   want
+	}{
+		"Online": {
+			client: &fake.MockClient{MockDescribe: describe(observed(awstransfer.StateOnline), nil)},
+			cr:     initialized(),
+			want: want{
+				cr:     initialized(withState(v1alpha1.ServerStateOnline), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: conn},
+			},
+		},
+		"Starting": {
+			client: &fake.MockClient{MockDescribe: describe(observed(awstransfer.StateStarting), nil)},
+			cr:     initialized(),
+			want: want{
+				cr:     initialized(withState(v1alpha1.ServerStateStarting), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: conn},
+			},
+		},
+		"Offline": {
+			client: &fake.MockClient{MockDescribe: describe(observed(awstransfer.StateOffline), nil)},
+			cr:     initialized(),
+			want: want{
+				cr:     initialized(withState(v1alpha1.ServerStateOffline), withConditions(xpv1.Unavailable())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: conn},
+			},
+		},
+		"ProtocolsChanged": {
+			client: &fake.MockClient{MockDescribe: describe(observed(awstransfer.StateOnline), nil)},
+			cr:     initialized(withProtocols("SFTP", "FTPS")),
+			want: want{
+				cr:     initialized(withProtocols("SFTP", "FTPS"), withState(v1alpha1.ServerStateOnline), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: conn},
+			},
+		},
+		"LateInitialized": {
+			client: &fake.MockClient{MockDescribe: describe(observed(awstransfer.StateOnline), nil)},
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			cr:     server(withExternalName(serverID)),
+			want: want{
+				cr:     initialized(withState(v1alpha1.ServerStateOnline), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: conn},
+			},
+		},
+		"LateInitFailed": {
+			client: &fake.MockClient{MockDescribe: describe(observed(awstransfer.StateOnline), nil)},
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			cr:     server(withExternalName(serverID)),
+			want: want{
+				cr:  initialized(),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+		"NoExternalName": {
+			cr: server(),
+			want: want{
+				cr: server(),
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{MockDescribe: describe(nil, awserr.New(awstransfer.ErrCodeResourceNotFoundException, "", nil))},
+			cr:     initialized(),
+			want: want{
+				cr: initialized(),
+			},
+		},
+		"DescribeFailed": {
+			client: &fake.MockClient{MockDescribe: describe(nil, errBoom)},
+			cr:     initialized(),
+			want: want{
+				cr:  initialized(),
+				err: awsclient.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	create := func(err error) func(*awstransfer.CreateServerInput) awstransfer.CreateServerRequest {
+		return func(*awstransfer.CreateServerInput) awstransfer.CreateServerRequest {
+			return awstransfer.CreateServerRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awstransfer.CreateServerOutput{ServerId: aws.String(serverID)}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.Server
+		want   want
+	}{
+		"Successful": {
+			client: &fake.MockClient{MockCreate: create(nil)},
+			cr:     server(withProtocols("SFTP")),
+			want: want{
+				cr:     server(withProtocols("SFTP"), withExternalName(serverID), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFailed": {
+			client: &fake.MockClient{MockCreate: create(errBoom)},
+			cr:     server(withProtocols("SFTP")),
+			want: want{
+				cr:  server(withProtocols("SFTP"), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want error
+	}{
+		"Successful":   {},
+		"UpdateFailed": {err: errBoom, want: awsclient.Wrap(errBoom, errUpdateFailed)},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: &fake.MockClient{
+				MockUpdate: func(in *awstransfer.UpdateServerInput) awstransfer.UpdateServerRequest {
+					if aws.StringValue(in.ServerId) != serverID {
+						t.Errorf("unexpected server ID %q", aws.StringValue(in.ServerId))
+					}
+					return awstransfer.UpdateServerRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awstransfer.UpdateServerOutput{}, Error: tc.err},
+					}
+				},
+			}}
+			_, err := e.Update(context.Background(), initialized())
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want error
+	}{
+		"Successful":   {},
+		"AlreadyGone":  {err: awserr.New(awstransfer.ErrCodeResourceNotFoundException, "", nil)},
+		"DeleteFailed": {err: errBoom, want: awsclient.Wrap(errBoom, errDeleteFailed)},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: &fake.MockClient{
+				MockDelete: func(*awstransfer.DeleteServerInput) awstransfer.DeleteServerRequest {
+					return awstransfer.DeleteServerRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awstransfer.DeleteServerOutput{}, Error: tc.err},
+					}
+				},
+			}}
+			cr := initialized()
+			err := e.Delete(context.Background(), cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(initialized(withConditions(xpv1.Deleting())), cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
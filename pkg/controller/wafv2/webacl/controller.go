@@ -0,0 +1,300 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webacl
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awswafv2 "github.com/aws/aws-sdk-go-v2/service/wafv2"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/wafv2/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/wafv2"
+)
+
+const (
+	errUnexpectedObject = "the managed resource is not a WebACL"
+	errList             = "cannot list WebACLs"
+	errGet              = "cannot get WebACL"
+	errListResources    = "cannot list the resources associated with the WebACL"
+	errListTags         = "cannot list the tags of the WebACL"
+	errCreate           = "cannot create WebACL"
+	errUpdate           = "cannot update WebACL"
+	errAssociate        = "cannot associate WebACL"
+	errDisassociate     = "cannot disassociate WebACL"
+	errTag              = "cannot tag WebACL"
+	errUntag            = "cannot untag WebACL"
+	errDelete           = "cannot delete WebACL"
+)
+
+// SetupWebACL adds a controller that reconciles WebACLs.
+func SetupWebACL(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, o awsclient.Options) error {
+	name := managed.ControllerName(v1alpha1.WebACLGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		For(&v1alpha1.WebACL{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.WebACLGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: wafv2.NewClient}, o)),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithTimeout(o.ReconcileTimeout),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) wafv2.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.WebACL)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client wafv2.Client
+}
+
+// find returns the summary of the WebACL whose name is the external name of
+// the given resource. WAFv2 identifies a WebACL by its name and an ID that is
+// assigned by AWS, and only the name is known before the WebACL is created.
+func (e *external) find(ctx context.Context, cr *v1alpha1.WebACL) (*awswafv2.WebACLSummary, error) {
+	in := &awswafv2.ListWebACLsInput{Scope: awswafv2.Scope(cr.Spec.ForProvider.Scope)}
+	for {
+		rsp, err := e.client.ListWebACLsRequest(in).Send(ctx)
+		if err != nil {
+			return nil, awsclient.Wrap(err, errList)
+		}
+		for i := range rsp.WebACLs {
+			if aws.StringValue(rsp.WebACLs[i].Name) == meta.GetExternalName(cr) {
+				return &rsp.WebACLs[i], nil
+			}
+		}
+		if rsp.NextMarker == nil {
+			return nil, nil
+		}
+		in.NextMarker = rsp.NextMarker
+	}
+}
+
+func (e *external) get(ctx context.Context, cr *v1alpha1.WebACL, s *awswafv2.WebACLSummary) (*awswafv2.GetWebACLOutput, error) {
+	rsp, err := e.client.GetWebACLRequest(&awswafv2.GetWebACLInput{
+		Name:  s.Name,
+		Id:    s.Id,
+		Scope: awswafv2.Scope(cr.Spec.ForProvider.Scope),
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.GetWebACLOutput, nil
+}
+
+func (e *external) associatedResources(ctx context.Context, cr *v1alpha1.WebACL, arn *string) ([]string, error) {
+	// Only WebACLs with the REGIONAL scope are associated with resources.
+	// CloudFront distributions reference their WebACL themselves.
+	if cr.Spec.ForProvider.Scope != v1alpha1.ScopeRegional {
+		return nil, nil
+	}
+	var arns []string
+	for _, t := range wafv2.AssociableResourceTypes {
+		rsp, err := e.client.ListResourcesForWebACLRequest(&awswafv2.ListResourcesForWebACLInput{
+			WebACLArn:    arn,
+			ResourceType: t,
+		}).Send(ctx)
+		if err != nil {
+			return nil, awsclient.Wrap(err, errListResources)
+		}
+		arns = append(arns, rsp.ResourceArns...)
+	}
+	sort.Strings(arns)
+	return arns, nil
+}
+
+func (e *external) tags(ctx context.Context, arn *string) ([]awswafv2.Tag, error) {
+	rsp, err := e.client.ListTagsForResourceRequest(&awswafv2.ListTagsForResourceInput{ResourceARN: arn}).Send(ctx)
+	if err != nil {
+		return nil, awsclient.Wrap(err, errListTags)
+	}
+	if rsp.TagInfoForResource == nil {
+		return nil, nil
+	}
+	return rsp.TagInfoForResource.TagList, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.WebACL)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	s, err := e.find(ctx, cr)
+	if err != nil || s == nil {
+		return managed.ExternalObservation{}, err
+	}
+	rsp, err := e.get(ctx, cr, s)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(wafv2.IsNotFound, err), errGet)
+	}
+	associated, err := e.associatedResources(ctx, cr, rsp.WebACL.ARN)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	tags, err := e.tags(ctx, rsp.WebACL.ARN)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.Status.AtProvider = wafv2.GenerateObservation(*rsp.WebACL, associated)
+	cr.Status.SetConditions(xpv1.Available())
+
+	associate, disassociate := wafv2.DiffAssociations(cr.Spec.ForProvider.ResourceARNs, associated)
+	add, remove := wafv2.DiffTags(cr.Spec.ForProvider.Tags, tags)
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: wafv2.IsUpToDate(cr.Spec.ForProvider, *rsp.WebACL) &&
+			len(associate) == 0 && len(disassociate) == 0 &&
+			len(add) == 0 && len(remove) == 0,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.WebACL)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+	// The WebACL is associated with resources by the first update.
+	_, err := e.client.CreateWebACLRequest(wafv2.GenerateCreateWebACLInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.WebACL)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	s, err := e.find(ctx, cr)
+	if err != nil || s == nil {
+		return managed.ExternalUpdate{}, err
+	}
+	rsp, err := e.get(ctx, cr, s)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errGet)
+	}
+	if !wafv2.IsUpToDate(cr.Spec.ForProvider, *rsp.WebACL) {
+		if _, err := e.client.UpdateWebACLRequest(wafv2.GenerateUpdateWebACLInput(*rsp.WebACL, rsp.LockToken, cr.Spec.ForProvider)).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+		}
+	}
+
+	associate, disassociate := wafv2.DiffAssociations(cr.Spec.ForProvider.ResourceARNs, cr.Status.AtProvider.AssociatedResourceARNs)
+	for _, arn := range disassociate {
+		if _, err := e.client.DisassociateWebACLRequest(&awswafv2.DisassociateWebACLInput{ResourceArn: aws.String(arn)}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDisassociate)
+		}
+	}
+	for _, arn := range associate {
+		if _, err := e.client.AssociateWebACLRequest(&awswafv2.AssociateWebACLInput{
+			ResourceArn: aws.String(arn),
+			WebACLArn:   rsp.WebACL.ARN,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errAssociate)
+		}
+	}
+
+	tags, err := e.tags(ctx, rsp.WebACL.ARN)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	add, remove := wafv2.DiffTags(cr.Spec.ForProvider.Tags, tags)
+	if len(remove) != 0 {
+		if _, err := e.client.UntagResourceRequest(&awswafv2.UntagResourceInput{
+			ResourceARN: rsp.WebACL.ARN,
+			TagKeys:     remove,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUntag)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := e.client.TagResourceRequest(&awswafv2.TagResourceInput{
+			ResourceARN: rsp.WebACL.ARN,
+			Tags:        add,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errTag)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.WebACL)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+	// A WebACL cannot be deleted while it is associated with resources.
+	for _, arn := range cr.Status.AtProvider.AssociatedResourceARNs {
+		if _, err := e.client.DisassociateWebACLRequest(&awswafv2.DisassociateWebACLInput{ResourceArn: aws.String(arn)}).Send(ctx); err != nil {
+			return awsclient.Wrap(err, errDisassociate)
+		}
+	}
+
+	s, err := e.find(ctx, cr)
+	if err != nil || s == nil {
+		return err
+	}
+	_, err = e.client.DeleteWebACLRequest(&awswafv2.DeleteWebACLInput{
+		Name:      s.Name,
+		Id:        s.Id,
+		LockToken: s.LockToken,
+		Scope:     awswafv2.Scope(cr.Spec.ForProvider.Scope),
+	}).Send(ctx)
+	return awsclient.Wrap(resource.Ignore(wafv2.IsNotFound, err), errDelete)
+}
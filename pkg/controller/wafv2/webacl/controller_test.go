@@ -0,0 +1,535 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webacl
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awswafv2 "github.com/aws/aws-sdk-go-v2/service/wafv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/wafv2/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/wafv2"
+	"github.com/crossplane/provider-aws/pkg/clients/wafv2/fake"
+)
+
+var (
+	aclName = "some-acl"
+	aclID   = "some-id"
+	aclARN  = "arn:aws:wafv2:us-east-1:123456789012:regional/webacl/some-acl/some-id"
+	albARN  = "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/some-alb/50dc6c495c0c9188"
+	lock    = "some-lock-token"
+
+	visibility = v1alpha1.VisibilityConfig{MetricName: "some-metric"}
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client wafv2.Client
+	cr     resource.Managed
+}
+
+type webACLModifier func(*v1alpha1.WebACL)
+
+func withConditions(c ...xpv1.Condition) webACLModifier {
+	return func(r *v1alpha1.WebACL) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDefaultAction(a string) webACLModifier {
+	return func(r *v1alpha1.WebACL) { r.Spec.ForProvider.DefaultAction = a }
+}
+
+func withResourceARNs(arns ...string) webACLModifier {
+	return func(r *v1alpha1.WebACL) { r.Spec.ForProvider.ResourceARNs = arns }
+}
+
+func withTags(t ...v1alpha1.Tag) webACLModifier {
+	return func(r *v1alpha1.WebACL) { r.Spec.ForProvider.Tags = t }
+}
+
+func withObservation(o v1alpha1.WebACLObservation) webACLModifier {
+	return func(r *v1alpha1.WebACL) { r.Status.AtProvider = o }
+}
+
+func webACL(m ...webACLModifier) *v1alpha1.WebACL {
+	cr := &v1alpha1.WebACL{
+		Spec: v1alpha1.WebACLSpec{
+			ForProvider: v1alpha1.WebACLParameters{
+				Scope:            v1alpha1.ScopeRegional,
+				DefaultAction:    v1alpha1.ActionAllow,
+				VisibilityConfig: visibility,
+			},
+		},
+	}
+	meta.SetExternalName(cr, aclName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observedACL() *awswafv2.WebACL {
+	return &awswafv2.WebACL{
+		Name:          aws.String(aclName),
+		Id:            aws.String(aclID),
+		ARN:           aws.String(aclARN),
+		Capacity:      aws.Int64(700),
+		DefaultAction: &awswafv2.DefaultAction{Allow: &awswafv2.AllowAction{}},
+		VisibilityConfig: &awswafv2.VisibilityConfig{
+			SampledRequestsEnabled:   aws.Bool(false),
+			CloudWatchMetricsEnabled: aws.Bool(false),
+			MetricName:               aws.String("some-metric"),
+		},
+	}
+}
+
+func mockList(err error, summaries ...awswafv2.WebACLSummary) func(*awswafv2.ListWebACLsInput) awswafv2.ListWebACLsRequest {
+	return func(_ *awswafv2.ListWebACLsInput) awswafv2.ListWebACLsRequest {
+		return awswafv2.ListWebACLsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awswafv2.ListWebACLsOutput{WebACLs: summaries}, Error: err},
+		}
+	}
+}
+
+func summary() awswafv2.WebACLSummary {
+	return awswafv2.WebACLSummary{Name: aws.String(aclName), Id: aws.String(aclID), ARN: aws.String(aclARN), LockToken: aws.String(lock)}
+}
+
+func mockGet(acl *awswafv2.WebACL) func(*awswafv2.GetWebACLInput) awswafv2.GetWebACLRequest {
+	return func(_ *awswafv2.GetWebACLInput) awswafv2.GetWebACLRequest {
+		return awswafv2.GetWebACLRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awswafv2.GetWebACLOutput{WebACL: acl, LockToken: aws.String(lock)}},
+		}
+	}
+}
+
+func mockListResources(arns ...string) func(*awswafv2.ListResourcesForWebACLInput) awswafv2.ListResourcesForWebACLRequest {
+	return func(in *awswafv2.ListResourcesForWebACLInput) awswafv2.ListResourcesForWebACLRequest {
+		out := &awswafv2.ListResourcesForWebACLOutput{}
+		if in.ResourceType == awswafv2.ResourceTypeApplicationLoadBalancer {
+			out.ResourceArns = arns
+		}
+		return awswafv2.ListResourcesForWebACLRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+		}
+	}
+}
+
+func mockListTags(tags ...awswafv2.Tag) func(*awswafv2.ListTagsForResourceInput) awswafv2.ListTagsForResourceRequest {
+	return func(_ *awswafv2.ListTagsForResourceInput) awswafv2.ListTagsForResourceRequest {
+		return awswafv2.ListTagsForResourceRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awswafv2.ListTagsForResourceOutput{
+				TagInfoForResource: &awswafv2.TagInfoForResource{TagList: tags},
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AvailableAndUpToDate": {
+			args: args{
+				client: &fake.MockWebACLClient{
+					MockList:          mockList(nil, summary()),
+					MockGet:           mockGet(observedACL()),
+					MockListResources: mockListResources(albARN),
+					MockListTags:      mockListTags(awswafv2.Tag{Key: aws.String("k"), Value: aws.String("v")}),
+				},
+				cr: webACL(withResourceARNs(albARN), withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: webACL(withResourceARNs(albARN), withTags(v1alpha1.Tag{Key: "k", Value: "v"}),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.WebACLObservation{
+						ARN:                    aclARN,
+						ID:                     aclID,
+						Capacity:               700,
+						AssociatedResourceARNs: []string{albARN},
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotAssociated": {
+			args: args{
+				client: &fake.MockWebACLClient{
+					MockList:          mockList(nil, summary()),
+					MockGet:           mockGet(observedACL()),
+					MockListResources: mockListResources(),
+					MockListTags:      mockListTags(),
+				},
+				cr: webACL(withResourceARNs(albARN)),
+			},
+			want: want{
+				cr: webACL(withResourceARNs(albARN),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.WebACLObservation{ARN: aclARN, ID: aclID, Capacity: 700})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"DifferentDefaultAction": {
+			args: args{
+				client: &fake.MockWebACLClient{
+					MockList:          mockList(nil, summary()),
+					MockGet:           mockGet(observedACL()),
+					MockListResources: mockListResources(),
+					MockListTags:      mockListTags(),
+				},
+				cr: webACL(withDefaultAction(v1alpha1.ActionBlock)),
+			},
+			want: want{
+				cr: webACL(withDefaultAction(v1alpha1.ActionBlock),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.WebACLObservation{ARN: aclARN, ID: aclID, Capacity: 700})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockWebACLClient{
+					MockList: mockList(nil, awswafv2.WebACLSummary{Name: aws.String("another-acl")}),
+				},
+				cr: webACL(),
+			},
+			want: want{
+				cr: webACL(),
+			},
+		},
+		"ListFail": {
+			args: args{
+				client: &fake.MockWebACLClient{
+					MockList: mockList(errBoom),
+				},
+				cr: webACL(),
+			},
+			want: want{
+				cr:  webACL(),
+				err: awsclient.Wrap(errBoom, errList),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockWebACLClient{
+					MockCreate: func(in *awswafv2.CreateWebACLInput) awswafv2.CreateWebACLRequest {
+						if aws.StringValue(in.Name) != aclName {
+							return awswafv2.CreateWebACLRequest{
+								Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errors.New("unexpected name")},
+							}
+						}
+						return awswafv2.CreateWebACLRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awswafv2.CreateWebACLOutput{}},
+						}
+					},
+				},
+				cr: webACL(),
+			},
+			want: want{
+				cr: webACL(withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				client: &fake.MockWebACLClient{
+					MockCreate: func(_ *awswafv2.CreateWebACLInput) awswafv2.CreateWebACLRequest {
+						return awswafv2.CreateWebACLRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: webACL(),
+			},
+			want: want{
+				cr:  webACL(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type calls struct {
+		update       bool
+		associate    []string
+		disassociate []string
+		tag          []string
+		untag        []string
+	}
+	type want struct {
+		calls calls
+		err   error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpdateAssociationsAndTags": {
+			args: args{
+				client: &fake.MockWebACLClient{
+					MockList:     mockList(nil, summary()),
+					MockGet:      mockGet(observedACL()),
+					MockListTags: mockListTags(awswafv2.Tag{Key: aws.String("old"), Value: aws.String("v")}),
+				},
+				cr: webACL(withDefaultAction(v1alpha1.ActionBlock),
+					withResourceARNs(albARN),
+					withTags(v1alpha1.Tag{Key: "new", Value: "v"}),
+					withObservation(v1alpha1.WebACLObservation{AssociatedResourceARNs: []string{"arn:old"}})),
+			},
+			want: want{
+				calls: calls{
+					update:       true,
+					associate:    []string{albARN},
+					disassociate: []string{"arn:old"},
+					tag:          []string{"new"},
+					untag:        []string{"old"},
+				},
+			},
+		},
+		"UpToDate": {
+			args: args{
+				client: &fake.MockWebACLClient{
+					MockList:     mockList(nil, summary()),
+					MockGet:      mockGet(observedACL()),
+					MockListTags: mockListTags(),
+				},
+				cr: webACL(),
+			},
+		},
+		"ListFail": {
+			args: args{
+				client: &fake.MockWebACLClient{
+					MockList: mockList(errBoom),
+				},
+				cr: webACL(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errList),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := calls{}
+			mc := tc.client.(*fake.MockWebACLClient)
+			mc.MockUpdate = func(in *awswafv2.UpdateWebACLInput) awswafv2.UpdateWebACLRequest {
+				got.update = aws.StringValue(in.LockToken) == lock
+				return awswafv2.UpdateWebACLRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awswafv2.UpdateWebACLOutput{}},
+				}
+			}
+			mc.MockAssociate = func(in *awswafv2.AssociateWebACLInput) awswafv2.AssociateWebACLRequest {
+				got.associate = append(got.associate, aws.StringValue(in.ResourceArn))
+				return awswafv2.AssociateWebACLRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awswafv2.AssociateWebACLOutput{}},
+				}
+			}
+			mc.MockDisassociate = func(in *awswafv2.DisassociateWebACLInput) awswafv2.DisassociateWebACLRequest {
+				got.disassociate = append(got.disassociate, aws.StringValue(in.ResourceArn))
+				return awswafv2.DisassociateWebACLRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awswafv2.DisassociateWebACLOutput{}},
+				}
+			}
+			mc.MockTagResource = func(in *awswafv2.TagResourceInput) awswafv2.TagResourceRequest {
+				for _, t := range in.Tags {
+					got.tag = append(got.tag, aws.StringValue(t.Key))
+				}
+				return awswafv2.TagResourceRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awswafv2.TagResourceOutput{}},
+				}
+			}
+			mc.MockUntagResource = func(in *awswafv2.UntagResourceInput) awswafv2.UntagResourceRequest {
+				got.untag = append(got.untag, in.TagKeys...)
+				return awswafv2.UntagResourceRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awswafv2.UntagResourceOutput{}},
+				}
+			}
+			e := &external{client: mc}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, got, cmp.AllowUnexported(calls{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr           resource.Managed
+		disassociate []string
+		err          error
+	}
+
+	cases := map[string]struct {
+		args
+		deleteErr error
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockWebACLClient{
+					MockList: mockList(nil, summary()),
+				},
+				cr: webACL(withObservation(v1alpha1.WebACLObservation{AssociatedResourceARNs: []string{albARN}})),
+			},
+			want: want{
+				cr: webACL(withConditions(xpv1.Deleting()),
+					withObservation(v1alpha1.WebACLObservation{AssociatedResourceARNs: []string{albARN}})),
+				disassociate: []string{albARN},
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				client: &fake.MockWebACLClient{
+					MockList: mockList(nil),
+				},
+				cr: webACL(),
+			},
+			want: want{
+				cr: webACL(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				client: &fake.MockWebACLClient{
+					MockList: mockList(nil, summary()),
+				},
+				cr: webACL(),
+			},
+			deleteErr: errBoom,
+			want: want{
+				cr:  webACL(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var disassociated []string
+			mc := tc.client.(*fake.MockWebACLClient)
+			mc.MockDisassociate = func(in *awswafv2.DisassociateWebACLInput) awswafv2.DisassociateWebACLRequest {
+				disassociated = append(disassociated, aws.StringValue(in.ResourceArn))
+				return awswafv2.DisassociateWebACLRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awswafv2.DisassociateWebACLOutput{}},
+				}
+			}
+			mc.MockDelete = func(in *awswafv2.DeleteWebACLInput) awswafv2.DeleteWebACLRequest {
+				if aws.StringValue(in.LockToken) != lock {
+					return awswafv2.DeleteWebACLRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errors.New("unexpected lock token")},
+					}
+				}
+				return awswafv2.DeleteWebACLRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awswafv2.DeleteWebACLOutput{}, Error: tc.deleteErr},
+				}
+			}
+			e := &external{client: mc}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.disassociate, disassociated); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	"github.com/crossplane/provider-aws/pkg/webhook/database"
+)
+
+// Setup registers all AWS admission webhooks with the webhook server of the
+// supplied manager. The webhooks that guard deletions always allow those made
+// by the trusted users.
+func Setup(mgr ctrl.Manager, l logging.Logger, trusted []string) error {
+	for _, setup := range []func(ctrl.Manager, logging.Logger, []string) error{
+		database.SetupRDSInstance,
+	} {
+		if err := setup(mgr, l, trusted); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
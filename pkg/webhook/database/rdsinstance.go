@@ -0,0 +1,315 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/rds"
+)
+
+// RDSInstanceDeletionPath is the path the RDSInstance deletion webhook is
+// served at.
+const RDSInstanceDeletionPath = "/validate-database-aws-crossplane-io-v1beta1-rdsinstance"
+
+// RDSInstanceSpecPath is the path the RDSInstance spec validation webhook is
+// served at.
+const RDSInstanceSpecPath = "/validate-spec-database-aws-crossplane-io-v1beta1-rdsinstance"
+
+const (
+	// Crossplane propagates these labels from a claim to the resources
+	// composed for it.
+	labelKeyClaimName      = "crossplane.io/claim-name"
+	labelKeyClaimNamespace = "crossplane.io/claim-namespace"
+
+	errDecode    = "cannot decode RDSInstance"
+	errInUseFmt  = "RDSInstance %s is in use by claim %s/%s; annotate it with %s=true to delete it anyway"
+	reasonForced = "deletion forced by annotation"
+
+	errClusterManaged = "is managed by the DB cluster the instance belongs to"
+	errClusterEngine  = "instances of a DB cluster must use an aurora engine"
+	errNoCluster      = "aurora engines require the DB cluster the instance belongs to"
+	errNoMonitoring   = "is required when monitoringInterval is greater than 0"
+	errFinalSnapshot  = "cannot be set when skipFinalSnapshotBeforeDeletion is true"
+	errImmutable      = "cannot be changed once the instance is created"
+)
+
+// engines are the database engines RDS instances can be created with.
+var engines = []string{
+	"aurora", "aurora-mysql", "aurora-postgresql",
+	"mariadb", "mysql", "postgres",
+	"oracle-ee", "oracle-se2", "oracle-se1", "oracle-se",
+	"sqlserver-ee", "sqlserver-se", "sqlserver-ex", "sqlserver-web",
+}
+
+// garbageCollectors are the users the Kubernetes garbage collector deletes
+// objects as, depending on whether the controller manager uses service account
+// credentials. RDSInstances are garbage collected when the claim they were
+// composed for is deleted, which must not be blocked.
+var garbageCollectors = []string{
+	"system:serviceaccount:kube-system:generic-garbage-collector",
+	"system:kube-controller-manager",
+}
+
+// SetupRDSInstance registers a webhook that rejects the deletion of
+// RDSInstances that are in use by a claim, and one that rejects invalid
+// RDSInstance specs. Deletions made by the garbage collector or by one of the
+// supplied trusted users, e.g. Crossplane removing a resource from a
+// composite, are always allowed.
+func SetupRDSInstance(mgr ctrl.Manager, l logging.Logger, trusted []string) error {
+	mgr.GetWebhookServer().Register(RDSInstanceDeletionPath, &webhook.Admission{
+		Handler: newDeletionGuard(l.WithValues("webhook", RDSInstanceDeletionPath), trusted),
+	})
+	mgr.GetWebhookServer().Register(RDSInstanceSpecPath, &webhook.Admission{
+		Handler: &specValidator{},
+	})
+	return nil
+}
+
+// A deletionGuard rejects the deletion of RDSInstances that are in use by a
+// claim, unless the deletion is forced by annotation or made by a trusted
+// user, e.g. the garbage collector because the claim itself was deleted.
+type deletionGuard struct {
+	log     logging.Logger
+	trusted map[string]bool
+}
+
+func newDeletionGuard(l logging.Logger, trusted []string) *deletionGuard {
+	g := &deletionGuard{log: l, trusted: map[string]bool{}}
+	for _, u := range append(garbageCollectors, trusted...) {
+		g.trusted[u] = true
+	}
+	return g
+}
+
+func (g *deletionGuard) Handle(_ context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Delete {
+		return admission.Allowed("")
+	}
+	if g.trusted[req.UserInfo.Username] {
+		return admission.Allowed("")
+	}
+	cr := &v1beta1.RDSInstance{}
+	if err := json.Unmarshal(req.OldObject.Raw, cr); err != nil {
+		return admission.Errored(http.StatusBadRequest, errors.Wrap(err, errDecode))
+	}
+	claim := cr.GetLabels()[labelKeyClaimName]
+	if claim == "" {
+		return admission.Allowed("")
+	}
+	if cr.GetAnnotations()[v1beta1.AnnotationKeyForceDelete] == "true" {
+		g.log.Debug("Allowing deletion of RDSInstance in use by claim", "name", cr.GetName(), "claim", claim, "user", req.UserInfo.Username)
+		return admission.Allowed(reasonForced)
+	}
+	return admission.Denied(fmt.Sprintf(errInUseFmt, cr.GetName(), cr.GetLabels()[labelKeyClaimNamespace], claim, v1beta1.AnnotationKeyForceDelete))
+}
+
+// A specValidator rejects RDSInstance specs that AWS would refuse, so that
+// they fail when applied rather than on the first reconcile. Updates may not
+// change immutable fields or make a valid spec invalid. The controller
+// late-initializes unset fields of existing instances from what AWS reports,
+// e.g. the storage of a cluster member, so errors on fields an update sets for
+// the first time are not reported.
+type specValidator struct{}
+
+func (v *specValidator) Handle(_ context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return admission.Allowed("")
+	}
+	cr := &v1beta1.RDSInstance{}
+	if err := json.Unmarshal(req.Object.Raw, cr); err != nil {
+		return admission.Errored(http.StatusBadRequest, errors.Wrap(err, errDecode))
+	}
+	var errs field.ErrorList
+	if req.Operation == admissionv1.Create {
+		errs = validateParameters(&cr.Spec.ForProvider)
+	} else {
+		old := &v1beta1.RDSInstance{}
+		if err := json.Unmarshal(req.OldObject.Raw, old); err != nil {
+			return admission.Errored(http.StatusBadRequest, errors.Wrap(err, errDecode))
+		}
+		errs = append(validateImmutableParameters(&old.Spec.ForProvider, &cr.Spec.ForProvider),
+			introducedErrors(&old.Spec.ForProvider, &cr.Spec.ForProvider)...)
+	}
+	if len(errs) > 0 {
+		return admission.Denied(errs.ToAggregate().Error())
+	}
+	return admission.Allowed("")
+}
+
+func validateParameters(p *v1beta1.RDSInstanceParameters) field.ErrorList { // nolint:gocyclo
+	path := field.NewPath("spec", "forProvider")
+	errs := field.ErrorList{}
+
+	switch {
+	case p.Engine == "":
+		errs = append(errs, field.Required(path.Child("engine"), ""))
+	case !contains(engines, strings.ToLower(p.Engine)):
+		errs = append(errs, field.NotSupported(path.Child("engine"), p.Engine, engines))
+	}
+	if p.DBInstanceClass == "" {
+		errs = append(errs, field.Required(path.Child("dbInstanceClass"), ""))
+	}
+
+	aurora := strings.HasPrefix(strings.ToLower(p.Engine), "aurora")
+	member := p.DBClusterIdentifier != nil || p.DBClusterIdentifierRef != nil || p.DBClusterIdentifierSelector != nil
+	switch {
+	case member && !aurora:
+		errs = append(errs, field.Invalid(path.Child("engine"), p.Engine, errClusterEngine))
+	case !member && aurora:
+		errs = append(errs, field.Required(path.Child("dbClusterIdentifier"), errNoCluster))
+	case !member:
+		if p.AllocatedStorage == nil {
+			errs = append(errs, field.Required(path.Child("allocatedStorage"), ""))
+		}
+		if p.MasterUsername == nil {
+			errs = append(errs, field.Required(path.Child("masterUsername"), ""))
+		}
+	}
+	if member {
+		managed := []struct {
+			name string
+			set  bool
+		}{
+			{"masterUsername", p.MasterUsername != nil},
+			{"masterPasswordSecretRef", p.MasterPasswordSecretRef != nil},
+			{"allocatedStorage", p.AllocatedStorage != nil},
+			{"storageType", p.StorageType != nil},
+			{"iops", p.IOPS != nil},
+			{"backupRetentionPeriod", p.BackupRetentionPeriod != nil},
+			{"preferredBackupWindow", p.PreferredBackupWindow != nil},
+			{"storageEncrypted", p.StorageEncrypted != nil},
+			{"kmsKeyId", p.KMSKeyID != nil},
+		}
+		for _, f := range managed {
+			if f.set {
+				errs = append(errs, field.Forbidden(path.Child(f.name), errClusterManaged))
+			}
+		}
+	}
+
+	if err := rds.ValidateStorage(p); err != nil {
+		errs = append(errs, field.Invalid(path.Child("iops"), p.IOPS, err.Error()))
+	}
+	if err := rds.ValidateDBName(p); err != nil {
+		errs = append(errs, field.Invalid(path.Child("dbName"), p.DBName, err.Error()))
+	}
+	if err := rds.ValidateAvailabilityZone(p); err != nil {
+		errs = append(errs, field.Invalid(path.Child("availabilityZone"), p.AvailabilityZone, err.Error()))
+	}
+	if p.MonitoringInterval != nil && *p.MonitoringInterval > 0 &&
+		p.MonitoringRoleARN == nil && p.MonitoringRoleARNRef == nil && p.MonitoringRoleARNSelector == nil {
+		errs = append(errs, field.Required(path.Child("monitoringRoleArn"), errNoMonitoring))
+	}
+	if p.SkipFinalSnapshotBeforeDeletion != nil && *p.SkipFinalSnapshotBeforeDeletion && p.FinalDBSnapshotIdentifier != nil {
+		errs = append(errs, field.Forbidden(path.Child("finalDBSnapshotIdentifier"), errFinalSnapshot))
+	}
+	return errs
+}
+
+// validateImmutableParameters rejects changes to the fields RDS cannot modify.
+// Fields that were not set before may be set, because the controller
+// late-initializes them from the observed instance.
+func validateImmutableParameters(old, p *v1beta1.RDSInstanceParameters) field.ErrorList {
+	path := field.NewPath("spec", "forProvider")
+	errs := field.ErrorList{}
+	immutable := []struct {
+		name    string
+		was, is interface{}
+	}{
+		{"engine", old.Engine, p.Engine},
+		{"masterUsername", old.MasterUsername, p.MasterUsername},
+		{"dbName", old.DBName, p.DBName},
+		{"characterSetName", old.CharacterSetName, p.CharacterSetName},
+		{"storageEncrypted", old.StorageEncrypted, p.StorageEncrypted},
+		{"timezone", old.Timezone, p.Timezone},
+		{"dbClusterIdentifier", old.DBClusterIdentifier, p.DBClusterIdentifier},
+	}
+	for _, f := range immutable {
+		if isZero(f.was) || reflect.DeepEqual(f.was, f.is) {
+			continue
+		}
+		errs = append(errs, field.Forbidden(path.Child(f.name), errImmutable))
+	}
+	return errs
+}
+
+// introducedErrors returns the errors of the updated parameters that the old
+// ones did not have, leaving out errors on fields the update sets for the
+// first time.
+func introducedErrors(old, p *v1beta1.RDSInstanceParameters) field.ErrorList {
+	existing := map[string]bool{}
+	for _, err := range validateParameters(old) {
+		existing[err.Field+"/"+string(err.Type)] = true
+	}
+	was, err := toMap(old)
+	if err != nil {
+		return field.ErrorList{field.InternalError(field.NewPath("spec", "forProvider"), err)}
+	}
+	is, err := toMap(p)
+	if err != nil {
+		return field.ErrorList{field.InternalError(field.NewPath("spec", "forProvider"), err)}
+	}
+	errs := field.ErrorList{}
+	for _, err := range validateParameters(p) {
+		name := strings.TrimPrefix(err.Field, "spec.forProvider.")
+		if existing[err.Field+"/"+string(err.Type)] || (isZero(was[name]) && !isZero(is[name])) {
+			continue
+		}
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// toMap returns the parameters keyed by their JSON field names.
+func toMap(p *v1beta1.RDSInstanceParameters) (map[string]interface{}, error) {
+	raw, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	m := map[string]interface{}{}
+	return m, json.Unmarshal(raw, &m)
+}
+
+func isZero(v interface{}) bool {
+	rv := reflect.ValueOf(v)
+	return !rv.IsValid() || rv.IsZero()
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
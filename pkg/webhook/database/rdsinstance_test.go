@@ -0,0 +1,322 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+)
+
+func deleteRequest(t *testing.T, user string, labels, annotations map[string]string) admission.Request {
+	raw, err := json.Marshal(&v1beta1.RDSInstance{ObjectMeta: metav1.ObjectMeta{Name: "db", Labels: labels, Annotations: annotations}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+		Operation: admissionv1.Delete,
+		UserInfo:  authenticationv1.UserInfo{Username: user},
+		OldObject: runtime.RawExtension{Raw: raw},
+	}}
+}
+
+func TestDeletionGuard(t *testing.T) {
+	claimed := map[string]string{labelKeyClaimName: "app-db", labelKeyClaimNamespace: "app"}
+
+	cases := map[string]struct {
+		req  func(t *testing.T) admission.Request
+		want admission.Response
+	}{
+		"NotDeletion": {
+			req: func(t *testing.T) admission.Request {
+				return admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Operation: admissionv1.Update}}
+			},
+			want: admission.Allowed(""),
+		},
+		"NotClaimed": {
+			req: func(t *testing.T) admission.Request {
+				return deleteRequest(t, "dev", nil, nil)
+			},
+			want: admission.Allowed(""),
+		},
+		"Claimed": {
+			req: func(t *testing.T) admission.Request {
+				return deleteRequest(t, "dev", claimed, nil)
+			},
+			want: admission.Denied(fmt.Sprintf(errInUseFmt, "db", "app", "app-db", v1beta1.AnnotationKeyForceDelete)),
+		},
+		"Forced": {
+			req: func(t *testing.T) admission.Request {
+				return deleteRequest(t, "dev", claimed, map[string]string{v1beta1.AnnotationKeyForceDelete: "true"})
+			},
+			want: admission.Allowed(reasonForced),
+		},
+		"GarbageCollected": {
+			req: func(t *testing.T) admission.Request {
+				return deleteRequest(t, "system:serviceaccount:kube-system:generic-garbage-collector", claimed, nil)
+			},
+			want: admission.Allowed(""),
+		},
+		"Crossplane": {
+			req: func(t *testing.T) admission.Request {
+				return deleteRequest(t, "system:serviceaccount:crossplane-system:crossplane", claimed, nil)
+			},
+			want: admission.Allowed(""),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			g := newDeletionGuard(logging.NewNopLogger(), []string{"system:serviceaccount:crossplane-system:crossplane"})
+			got := g.Handle(context.Background(), tc.req(t))
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Handle(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func instanceRequest(t *testing.T, op admissionv1.Operation, old, p *v1beta1.RDSInstanceParameters) admission.Request {
+	marshal := func(p *v1beta1.RDSInstanceParameters) runtime.RawExtension {
+		if p == nil {
+			return runtime.RawExtension{}
+		}
+		raw, err := json.Marshal(&v1beta1.RDSInstance{Spec: v1beta1.RDSInstanceSpec{ForProvider: *p}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return runtime.RawExtension{Raw: raw}
+	}
+	return admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+		Operation: op,
+		Object:    marshal(p),
+		OldObject: marshal(old),
+	}}
+}
+
+func standalone() *v1beta1.RDSInstanceParameters {
+	return &v1beta1.RDSInstanceParameters{
+		Engine:           "postgres",
+		DBInstanceClass:  "db.t3.micro",
+		AllocatedStorage: intPtr(20),
+		MasterUsername:   strPtr("admin"),
+	}
+}
+
+func member() *v1beta1.RDSInstanceParameters {
+	return &v1beta1.RDSInstanceParameters{
+		Engine:              "aurora-postgresql",
+		DBInstanceClass:     "db.r5.large",
+		DBClusterIdentifier: strPtr("cluster"),
+	}
+}
+
+func denied(errs ...*field.Error) admission.Response {
+	return admission.Denied(field.ErrorList(errs).ToAggregate().Error())
+}
+
+func TestSpecValidator(t *testing.T) {
+	path := field.NewPath("spec", "forProvider")
+
+	cases := map[string]struct {
+		req  func(t *testing.T) admission.Request
+		want admission.Response
+	}{
+		"Deletion": {
+			req: func(t *testing.T) admission.Request {
+				return admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Operation: admissionv1.Delete}}
+			},
+			want: admission.Allowed(""),
+		},
+		"ValidStandalone": {
+			req: func(t *testing.T) admission.Request {
+				return instanceRequest(t, admissionv1.Create, nil, standalone())
+			},
+			want: admission.Allowed(""),
+		},
+		"ValidMember": {
+			req: func(t *testing.T) admission.Request {
+				return instanceRequest(t, admissionv1.Create, nil, member())
+			},
+			want: admission.Allowed(""),
+		},
+		"UnknownEngine": {
+			req: func(t *testing.T) admission.Request {
+				p := standalone()
+				p.Engine = "mongodb"
+				return instanceRequest(t, admissionv1.Create, nil, p)
+			},
+			want: denied(field.NotSupported(path.Child("engine"), "mongodb", engines)),
+		},
+		"MissingRequiredFields": {
+			req: func(t *testing.T) admission.Request {
+				return instanceRequest(t, admissionv1.Create, nil, &v1beta1.RDSInstanceParameters{Engine: "mysql"})
+			},
+			want: denied(
+				field.Required(path.Child("dbInstanceClass"), ""),
+				field.Required(path.Child("allocatedStorage"), ""),
+				field.Required(path.Child("masterUsername"), ""),
+			),
+		},
+		"AuroraWithoutCluster": {
+			req: func(t *testing.T) admission.Request {
+				p := member()
+				p.DBClusterIdentifier = nil
+				return instanceRequest(t, admissionv1.Create, nil, p)
+			},
+			want: denied(field.Required(path.Child("dbClusterIdentifier"), errNoCluster)),
+		},
+		"MemberWithClusterManagedFields": {
+			req: func(t *testing.T) admission.Request {
+				p := member()
+				p.Engine = "postgres"
+				p.MasterUsername = strPtr("admin")
+				p.AllocatedStorage = intPtr(20)
+				return instanceRequest(t, admissionv1.Create, nil, p)
+			},
+			want: denied(
+				field.Invalid(path.Child("engine"), "postgres", errClusterEngine),
+				field.Forbidden(path.Child("masterUsername"), errClusterManaged),
+				field.Forbidden(path.Child("allocatedStorage"), errClusterManaged),
+			),
+		},
+		"MemberByReference": {
+			req: func(t *testing.T) admission.Request {
+				p := member()
+				p.DBClusterIdentifier = nil
+				p.DBClusterIdentifierRef = &xpv1.Reference{Name: "cluster"}
+				return instanceRequest(t, admissionv1.Create, nil, p)
+			},
+			want: admission.Allowed(""),
+		},
+		"MonitoringWithoutRole": {
+			req: func(t *testing.T) admission.Request {
+				p := standalone()
+				p.MonitoringInterval = intPtr(60)
+				return instanceRequest(t, admissionv1.Create, nil, p)
+			},
+			want: denied(field.Required(path.Child("monitoringRoleArn"), errNoMonitoring)),
+		},
+		"SkippedFinalSnapshotWithIdentifier": {
+			req: func(t *testing.T) admission.Request {
+				p := standalone()
+				p.SkipFinalSnapshotBeforeDeletion = boolPtr(true)
+				p.FinalDBSnapshotIdentifier = strPtr("final")
+				return instanceRequest(t, admissionv1.Create, nil, p)
+			},
+			want: denied(field.Forbidden(path.Child("finalDBSnapshotIdentifier"), errFinalSnapshot)),
+		},
+		"MultiAZWithAvailabilityZone": {
+			req: func(t *testing.T) admission.Request {
+				p := standalone()
+				p.MultiAZ = boolPtr(true)
+				p.AvailabilityZone = strPtr("us-east-1a")
+				return instanceRequest(t, admissionv1.Create, nil, p)
+			},
+			want: denied(field.Invalid(path.Child("availabilityZone"), strPtr("us-east-1a"), "availabilityZone cannot be set for Multi-AZ instances")),
+		},
+		"UpdateLateInitializedMember": {
+			req: func(t *testing.T) admission.Request {
+				p := member()
+				p.MasterUsername = strPtr("admin")
+				p.AllocatedStorage = intPtr(1)
+				return instanceRequest(t, admissionv1.Update, member(), p)
+			},
+			want: admission.Allowed(""),
+		},
+		"UpdateIntroducesInvalidSpec": {
+			req: func(t *testing.T) admission.Request {
+				p := standalone()
+				p.MonitoringInterval = intPtr(60)
+				return instanceRequest(t, admissionv1.Update, standalone(), p)
+			},
+			want: denied(field.Required(path.Child("monitoringRoleArn"), errNoMonitoring)),
+		},
+		"UpdateChangesFieldToInvalidValue": {
+			req: func(t *testing.T) admission.Request {
+				old := standalone()
+				old.StorageType = strPtr("io1")
+				old.AllocatedStorage = intPtr(100)
+				old.IOPS = intPtr(1000)
+				p := old.DeepCopy()
+				p.AllocatedStorage = intPtr(10)
+				return instanceRequest(t, admissionv1.Update, old, p)
+			},
+			want: denied(field.Invalid(path.Child("iops"), intPtr(1000), "iops must be between 1 and 50 times the allocated storage for io1 storage type")),
+		},
+		"UpdateLateInitializedAvailabilityZone": {
+			req: func(t *testing.T) admission.Request {
+				old := standalone()
+				old.MultiAZ = boolPtr(true)
+				p := standalone()
+				p.MultiAZ = boolPtr(true)
+				p.AvailabilityZone = strPtr("us-east-1a")
+				p.StorageType = strPtr("gp3")
+				p.IOPS = intPtr(3000)
+				return instanceRequest(t, admissionv1.Update, old, p)
+			},
+			want: admission.Allowed(""),
+		},
+		"UpdateAlreadyInvalid": {
+			req: func(t *testing.T) admission.Request {
+				old := standalone()
+				old.MonitoringInterval = intPtr(60)
+				p := standalone()
+				p.MonitoringInterval = intPtr(60)
+				p.DeletionProtection = boolPtr(true)
+				return instanceRequest(t, admissionv1.Update, old, p)
+			},
+			want: admission.Allowed(""),
+		},
+		"UpdateImmutableField": {
+			req: func(t *testing.T) admission.Request {
+				p := standalone()
+				p.MasterUsername = strPtr("root")
+				return instanceRequest(t, admissionv1.Update, standalone(), p)
+			},
+			want: denied(field.Forbidden(path.Child("masterUsername"), errImmutable)),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			v := &specValidator{}
+			got := v.Handle(context.Background(), tc.req(t))
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Handle(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func strPtr(s string) *string { return &s }
+func intPtr(i int) *int       { return &i }
+func boolPtr(b bool) *bool    { return &b }